@@ -72,6 +72,10 @@ func NewAnteHandler(
 		// Ensure that the blob shares occupied by the tx <= the max shares
 		// available to blob data in a data square.
 		blobante.NewBlobShareDecorator(blobKeeper),
+		// Ensure that the signer of a PFB may publish blobs to namespaces
+		// reserved by another account.
+		// Note: does not consume gas from the gas meter.
+		blobante.NewNamespaceReservationDecorator(blobKeeper),
 		// Ensure that txs with MsgSubmitProposal/MsgExec have at least one message and param filters are applied.
 		NewParamFilterDecorator(paramFilters),
 		// Side effect: increment the nonce for all tx signers.
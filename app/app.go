@@ -10,6 +10,7 @@ import (
 
 	"cosmossdk.io/client/v2/autocli"
 	"cosmossdk.io/core/appmodule"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/circuit"
@@ -32,7 +33,9 @@ import (
 	warptypes "github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
 	"github.com/celestiaorg/celestia-app/v7/app/ante"
 	"github.com/celestiaorg/celestia-app/v7/app/encoding"
+	"github.com/celestiaorg/celestia-app/v7/app/grpc/blobquery"
 	"github.com/celestiaorg/celestia-app/v7/app/grpc/gasestimation"
+	"github.com/celestiaorg/celestia-app/v7/app/grpc/squarepreview"
 	celestiatx "github.com/celestiaorg/celestia-app/v7/app/grpc/tx"
 	"github.com/celestiaorg/celestia-app/v7/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v7/pkg/proof"
@@ -55,6 +58,7 @@ import (
 	zkismkeeper "github.com/celestiaorg/celestia-app/v7/x/zkism/keeper"
 	zkismtypes "github.com/celestiaorg/celestia-app/v7/x/zkism/types"
 	"github.com/celestiaorg/go-square/v3/share"
+	blobtx "github.com/celestiaorg/go-square/v3/tx"
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmjson "github.com/cometbft/cometbft/libs/json"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -64,6 +68,11 @@ import (
 	tmservice "github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
 	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	runtimeservices "github.com/cosmos/cosmos-sdk/runtime/services"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -71,6 +80,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -146,6 +156,7 @@ var maccPerms = map[string][]string{
 	hyperlanetypes.ModuleName:      nil,
 	warptypes.ModuleName:           {authtypes.Minter, authtypes.Burner},
 	forwardingtypes.ModuleName:     nil, // No special permissions needed - only holds tokens temporarily
+	blobtypes.ModuleName:           nil, // Holds namespace reservation deposits
 }
 
 var (
@@ -203,6 +214,13 @@ type App struct {
 	configurator  module.Configurator
 	// txCache caches blob transaction from CheckTx to be reused in ProcessProposal
 	txCache *TxCache
+	// rejectedTxs retains the txs dropped in PrepareProposal for tx status queries
+	rejectedTxs *RejectedTxs
+	// txServer is the tx service shared by the gRPC router and the websocket
+	// route so that both use a single event bus subscription. It is created by
+	// whichever of them is registered first.
+	txServer     celestiatx.TxServer
+	txServerOnce *sync.Once
 	// treePool used for ProcessProposal and PrepareProposal to optimize root calculation allocs
 	treePool                *wrapper.TreePool
 	delayedPrecommitTimeout time.Duration
@@ -246,8 +264,10 @@ func New(
 		tkeys:                   tkeys,
 		memKeys:                 memKeys,
 		txCache:                 NewTxCache(),
+		rejectedTxs:             NewRejectedTxs(maxRejectedTxs),
 		delayedPrecommitTimeout: delayedPrecommitTimeout,
 		checkStateMu:            &sync.RWMutex{},
+		txServerOnce:            &sync.Once{},
 	}
 
 	// needed for migration from x/params -> module's ownership of own params
@@ -316,6 +336,7 @@ func New(
 		encodingConfig.Codec,
 		keys[signaltypes.StoreKey],
 		app.StakingKeeper,
+		govModuleAddr,
 	)
 
 	app.IBCKeeper = ibckeeper.NewKeeper(
@@ -385,6 +406,7 @@ func New(
 		encodingConfig.Codec,
 		keys[blobtypes.StoreKey],
 		app.GetSubspace(blobtypes.ModuleName),
+		app.BankKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
@@ -755,9 +777,14 @@ func (app *App) TreePool() *wrapper.TreePool {
 	return app.treePool
 }
 
+// RejectedTxs returns the txs this node dropped while preparing proposals.
+func (app *App) RejectedTxs() *RejectedTxs {
+	return app.rejectedTxs
+}
+
 // RegisterAPIRoutes registers all application module routes with the provided
 // API server.
-func (app *App) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {
+func (app *App) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig) {
 	clientCtx := apiSvr.ClientCtx
 	// Register new cometbft routes from grpc-gateway.
 	tmservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
@@ -767,6 +794,10 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {
 	nodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	// Register new celestia routes from grpc-gateway.
 	celestiatx.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	// Register the websocket route for tx status subscriptions.
+	celestiatx.RegisterWebsocketRoutes(app.getTxServer(clientCtx), apiSvr.Router, apiConfig.EnableUnsafeCORS)
+	// Register the blob query routes from grpc-gateway.
+	blobquery.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	// Register grpc-gateway routes for all modules.
 	app.BasicManager.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 }
@@ -774,8 +805,19 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *App) RegisterTxService(clientCtx client.Context) {
 	authtx.RegisterTxService(app.GRPCQueryRouter(), clientCtx, app.Simulate, app.encodingConfig.InterfaceRegistry)
-	celestiatx.RegisterTxService(app.GRPCQueryRouter(), clientCtx, app.encodingConfig.InterfaceRegistry)
-	gasestimation.RegisterGasEstimationService(app.GRPCQueryRouter(), clientCtx, app.encodingConfig.TxConfig.TxDecoder(), app.getGovMaxSquareBytes, app.Simulate, app.getMinGasPrice)
+	celestiatx.RegisterTxServer(app.GRPCQueryRouter(), app.getTxServer(clientCtx))
+	gasestimation.RegisterGasEstimationService(app.GRPCQueryRouter(), clientCtx, app.encodingConfig.TxConfig.TxDecoder(), app.getGovMaxSquareBytes, app.Simulate, app.getMinGasPrice, app.getBlobGasParams, app.fillInclusionSquare)
+	blobquery.RegisterBlobQueryService(app.GRPCQueryRouter(), clientCtx, app.encodingConfig.TxConfig.TxDecoder())
+	squarepreview.RegisterSquarePreviewService(app.GRPCQueryRouter(), app.DryRunSquare)
+}
+
+// getTxServer returns the tx service shared by the gRPC router and the
+// websocket route, creating it with clientCtx on first use.
+func (app *App) getTxServer(clientCtx client.Context) celestiatx.TxServer {
+	app.txServerOnce.Do(func() {
+		app.txServer = celestiatx.NewTxServer(clientCtx, app.encodingConfig.InterfaceRegistry, app.rejectedTxs.Get)
+	})
+	return app.txServer
 }
 
 func (app *App) getGovMaxSquareBytes() (uint64, error) {
@@ -803,6 +845,104 @@ func (app *App) getMinGasPrice() (float64, error) {
 	return math.Max(networkMinGasPrice, localMinGasPrice), nil
 }
 
+// getBlobGasParams is used by the gas estimation service to estimate the gas
+// consumed by a PFB signed by signer without simulating it.
+func (app *App) getBlobGasParams(signer string) (gasestimation.BlobGasParams, error) {
+	ctx, err := app.CreateQueryContext(app.LastBlockHeight(), false)
+	if err != nil {
+		return gasestimation.BlobGasParams{}, err
+	}
+	authParams := app.AccountKeeper.GetParams(ctx)
+	params := gasestimation.BlobGasParams{
+		TxSizeCostPerByte:    authParams.TxSizeCostPerByte,
+		SigVerifyCost:        authParams.SigVerifyCostSecp256k1,
+		DefaultSigVerifyCost: authParams.SigVerifyCostSecp256k1,
+		NamespaceParams:      app.BlobKeeper.GetNamespaceParams(ctx),
+	}
+	if signer == "" {
+		return params, nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(signer)
+	if err != nil {
+		return gasestimation.BlobGasParams{}, err
+	}
+	if account := app.AccountKeeper.GetAccount(ctx, addr); account != nil && account.GetPubKey() != nil {
+		params.SigVerifyCost, err = sigVerifyCost(account.GetPubKey(), authParams)
+		if err != nil {
+			return gasestimation.BlobGasParams{}, err
+		}
+	}
+	return params, nil
+}
+
+// sigVerifyCost returns the gas consumed by ante.DefaultSigVerificationGasConsumer
+// to verify a signature of pubKey. Multisig keys are assumed to be signed by
+// exactly their threshold of keys. Keys that the ante handler rejects return
+// an error.
+func sigVerifyCost(pubKey cryptotypes.PubKey, params authtypes.Params) (uint64, error) {
+	switch pubKey := pubKey.(type) {
+	case *secp256k1.PubKey:
+		return params.SigVerifyCostSecp256k1, nil
+	case *secp256r1.PubKey:
+		return params.SigVerifyCostSecp256r1(), nil
+	case *ed25519.PubKey:
+		return 0, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "ED25519 public keys are unsupported")
+	case *multisig.LegacyAminoPubKey:
+		keys := pubKey.GetPubKeys()
+		if int(pubKey.Threshold) > len(keys) {
+			return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "multisig threshold %d exceeds its %d keys", pubKey.Threshold, len(keys))
+		}
+		var cost uint64
+		for _, key := range keys[:pubKey.Threshold] {
+			keyCost, err := sigVerifyCost(key, params)
+			if err != nil {
+				return 0, err
+			}
+			cost += keyCost
+		}
+		return cost, nil
+	default:
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "unrecognized public key type: %T", pubKey)
+	}
+}
+
+// fillInclusionSquare is used by the gas estimation service to pack txs, in
+// order, into a square as PrepareProposal would. The txs are not executed: the
+// mempool txs already passed CheckTx and the estimated PFB is unsigned. It
+// returns the included txs and the fraction of the max square they occupy.
+func (app *App) fillInclusionSquare(txs [][]byte) ([][]byte, float64, error) {
+	ctx, err := app.CreateQueryContext(app.LastBlockHeight(), false)
+	if err != nil {
+		return nil, 0, err
+	}
+	maxSquareSize := app.MaxEffectiveSquareSize(ctx)
+	fsb, err := NewFilteredSquareBuilder(
+		func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil },
+		app.encodingConfig.TxConfig,
+		maxSquareSize,
+		appconsts.SubtreeRootThreshold,
+		app.BlobKeeper.GetNamespaceParams(ctx),
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// FilteredSquareBuilder panics on malformed blob txs so filter those out
+	// first.
+	wellFormed := make([][]byte, 0, len(txs))
+	for _, rawTx := range txs {
+		if _, isBlob, err := blobtx.UnmarshalBlobTx(rawTx); isBlob && err != nil {
+			continue
+		}
+		wellFormed = append(wellFormed, rawTx)
+	}
+
+	included := fsb.Fill(ctx, wellFormed)
+	usedRatio := float64(fsb.Builder().CurrentSize()) / float64(maxSquareSize*maxSquareSize)
+	return included, usedRatio, nil
+}
+
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
 func (app *App) RegisterTendermintService(clientCtx client.Context) {
 	tmservice.RegisterTendermintService(clientCtx, app.GRPCQueryRouter(), app.encodingConfig.InterfaceRegistry, app.Query)
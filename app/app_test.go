@@ -113,6 +113,7 @@ func TestModuleAccountAddrs(t *testing.T) {
 			"celestia17xpfvakm2amg962yls6f84z3kell8c5lpnjs3s": true,
 			"celestia1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3y3clr6": true,
 			"celestia1jv65s3grqf6v6jl3dp4t6c9t9rk99cd8k44vnj": true,
+			"celestia1lgkge38js9mthmk5kumd7456xnree5mjcpjx20": true,
 			"celestia1m20fddqpmfuwcz2r9ckj6wd70p5e75t8y22wqj": true,
 			"celestia1m3h30wlvsf8llruxtpukdvsy0km2kum8emkgad": true,
 			"celestia1mqcszwafr476x3rud8qyufdegn7gvxh99rc2gk": true,
@@ -138,6 +139,7 @@ func TestModuleAccountAddrs(t *testing.T) {
 			"hyperlane",
 			"warp",
 			"forwarding",
+			"blob",
 		}
 		for _, moduleName := range moduleNames {
 			address := authtypes.NewModuleAddress(moduleName).String()
@@ -167,6 +169,7 @@ func TestBlockedAddresses(t *testing.T) {
 			"hyperlane",
 			"warp",
 			"forwarding",
+			"blob",
 		}
 		for _, moduleName := range moduleNames {
 			address := authtypes.NewModuleAddress(moduleName).String()
@@ -73,6 +73,8 @@ func BenchmarkGasPriceEstimation(b *testing.B) {
 				func() (uint64, error) { return 128 * 128 * share.ContinuationSparseShareContentSize, nil },
 				func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error) { return sdk.GasInfo{}, nil, nil },
 				func() (float64, error) { return appconsts.DefaultNetworkMinGasPrice, nil },
+				func(string) (gasestimation.BlobGasParams, error) { return gasestimation.BlobGasParams{}, nil },
+				func(txs [][]byte) ([][]byte, float64, error) { return txs, 1, nil },
 			)
 			for b.Loop() {
 				_, err := gasEstimationServer.EstimateGasPrice(context.Background(), &gasestimation.EstimateGasPriceRequest{})
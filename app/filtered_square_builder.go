@@ -1,7 +1,12 @@
 package app
 
 import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	celestiatx "github.com/celestiaorg/celestia-app/v7/app/grpc/tx"
 	"github.com/celestiaorg/celestia-app/v7/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v7/x/blob/types"
 	square "github.com/celestiaorg/go-square/v3"
 	"github.com/celestiaorg/go-square/v3/tx"
 	tmbytes "github.com/cometbft/cometbft/libs/bytes"
@@ -14,9 +19,36 @@ import (
 // FilteredSquareBuilder filters txs and blobs using a copy of the state and tx validity
 // rules before adding it the square.
 type FilteredSquareBuilder struct {
-	handler  sdk.AnteHandler
-	txConfig client.TxConfig
-	builder  *square.Builder
+	handler         sdk.AnteHandler
+	txConfig        client.TxConfig
+	builder         *square.Builder
+	namespaceParams []blobtypes.NamespaceParams
+	dropped         []DroppedTx
+}
+
+// DroppedTx is a tx that was not added to the square by Fill.
+type DroppedTx struct {
+	// TxHash is the hash of the tx, or of the sdk.Tx of a blob tx.
+	TxHash []byte
+	// Reason is the reason the tx was dropped.
+	Reason celestiatx.RejectionReason
+	// Code and Codespace are the ABCI error code and codespace of an ante
+	// handler failure.
+	Code      uint32
+	Codespace string
+	// Log describes why the tx was dropped.
+	Log string
+}
+
+// Rejection returns the dropped tx as a TxRejection for the block at height.
+func (d DroppedTx) Rejection(height int64) *celestiatx.TxRejection {
+	return &celestiatx.TxRejection{
+		Reason:    d.Reason,
+		Height:    height,
+		Code:      d.Code,
+		Codespace: d.Codespace,
+		Log:       d.Log,
+	}
 }
 
 func NewFilteredSquareBuilder(
@@ -24,15 +56,17 @@ func NewFilteredSquareBuilder(
 	txConfig client.TxConfig,
 	maxSquareSize,
 	subtreeRootThreshold int,
+	namespaceParams []blobtypes.NamespaceParams,
 ) (*FilteredSquareBuilder, error) {
 	builder, err := square.NewBuilder(maxSquareSize, subtreeRootThreshold)
 	if err != nil {
 		return nil, err
 	}
 	return &FilteredSquareBuilder{
-		handler:  handler,
-		txConfig: txConfig,
-		builder:  builder,
+		handler:         handler,
+		txConfig:        txConfig,
+		builder:         builder,
+		namespaceParams: namespaceParams,
 	}, nil
 }
 
@@ -44,11 +78,34 @@ func (fsb *FilteredSquareBuilder) Builder() *square.Builder {
 	return fsb.builder
 }
 
+// DroppedTxs returns the txs that Fill did not add to the square, in the order
+// in which they were dropped.
+func (fsb *FilteredSquareBuilder) DroppedTxs() []DroppedTx {
+	return fsb.dropped
+}
+
+func (fsb *FilteredSquareBuilder) drop(tx []byte, reason celestiatx.RejectionReason, log string) {
+	fsb.dropped = append(fsb.dropped, DroppedTx{TxHash: coretypes.Tx(tx).Hash(), Reason: reason, Log: log})
+}
+
+// dropAnteFailure records a tx that failed the ante handler along with the
+// ABCI code of err.
+func (fsb *FilteredSquareBuilder) dropAnteFailure(tx []byte, err error) {
+	codespace, code, log := errorsmod.ABCIInfo(err, false)
+	fsb.dropped = append(fsb.dropped, DroppedTx{
+		TxHash:    coretypes.Tx(tx).Hash(),
+		Reason:    celestiatx.RejectionReason_REJECTION_REASON_ANTE_FAILURE,
+		Code:      code,
+		Codespace: codespace,
+		Log:       log,
+	})
+}
+
 func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte) [][]byte {
 	logger := ctx.Logger().With("app/filtered-square-builder")
 
 	// note that there is an additional filter step for tx size of raw txs here
-	normalTxs, blobTxs := separateTxs(fsb.txConfig, txs)
+	normalTxs, blobTxs := fsb.separateTxs(txs)
 
 	var (
 		nonPFBMessageCount = 0
@@ -62,6 +119,7 @@ func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte) [][]byte {
 		sdkTx, err := dec(tx)
 		if err != nil {
 			logger.Error("decoding already checked transaction", "tx", tmbytes.HexBytes(coretypes.Tx(tx).Hash()), "error", err)
+			fsb.drop(tx, celestiatx.RejectionReason_REJECTION_REASON_DECODE_ERROR, err.Error())
 			continue
 		}
 
@@ -71,11 +129,13 @@ func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte) [][]byte {
 		msgTypes := msgTypes(sdkTx)
 		if nonPFBMessageCount+len(sdkTx.GetMsgs()) > appconsts.MaxNonPFBMessages {
 			logger.Debug("skipping tx because the max non PFB message count was reached", "tx", tmbytes.HexBytes(coretypes.Tx(tx).Hash()))
+			fsb.drop(tx, celestiatx.RejectionReason_REJECTION_REASON_TOO_MANY_NON_PFB_MESSAGES, "the max non PFB message count was reached")
 			continue
 		}
 
 		if !fsb.builder.AppendTx(tx) {
 			logger.Debug("skipping tx because it was too large to fit in the square", "tx", tmbytes.HexBytes(coretypes.Tx(tx).Hash()))
+			fsb.drop(tx, celestiatx.RejectionReason_REJECTION_REASON_SQUARE_FULL, "the tx does not fit in the square")
 			continue
 		}
 
@@ -91,6 +151,7 @@ func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte) [][]byte {
 				"msgs", msgTypes,
 			)
 			telemetry.IncrCounter(1, "prepare_proposal", "invalid_std_txs")
+			fsb.dropAnteFailure(tx, err)
 			err = fsb.builder.RevertLastTx()
 			if err != nil {
 				logger.Error("reverting last transaction", "error", err)
@@ -107,6 +168,7 @@ func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte) [][]byte {
 		sdkTx, err := dec(tx.Tx)
 		if err != nil {
 			logger.Error("decoding already checked blob transaction", "tx", tmbytes.HexBytes(coretypes.Tx(tx.Tx).Hash()), "error", err)
+			fsb.drop(tx.Tx, celestiatx.RejectionReason_REJECTION_REASON_DECODE_ERROR, err.Error())
 			continue
 		}
 
@@ -115,11 +177,20 @@ func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte) [][]byte {
 
 		if pfbMessageCount+len(sdkTx.GetMsgs()) > appconsts.MaxPFBMessages {
 			logger.Debug("skipping blob tx because the max pfb message count was reached", "tx", tmbytes.HexBytes(coretypes.Tx(tx.Tx).Hash()))
+			fsb.drop(tx.Tx, celestiatx.RejectionReason_REJECTION_REASON_TOO_MANY_PFB_MESSAGES, "the max PFB message count was reached")
+			continue
+		}
+
+		if err := fsb.validateBlobSizes(tx); err != nil {
+			logger.Debug("skipping blob tx because a blob exceeds the max blob size of its namespace", "tx", tmbytes.HexBytes(coretypes.Tx(tx.Tx).Hash()), "error", err)
+			telemetry.IncrCounter(1, "prepare_proposal", "invalid_blob_txs")
+			fsb.drop(tx.Tx, celestiatx.RejectionReason_REJECTION_REASON_INVALID_BLOBS, err.Error())
 			continue
 		}
 
 		if !fsb.builder.AppendBlobTx(tx) {
 			logger.Debug("skipping tx because it was too large to fit in the square", "tx", tmbytes.HexBytes(coretypes.Tx(tx.Tx).Hash()))
+			fsb.drop(tx.Tx, celestiatx.RejectionReason_REJECTION_REASON_SQUARE_FULL, "the blob tx does not fit in the square")
 			continue
 		}
 
@@ -132,6 +203,7 @@ func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte) [][]byte {
 				"filtering already checked blob transaction", "tx", tmbytes.HexBytes(coretypes.Tx(tx.Tx).Hash()), "error", err,
 			)
 			telemetry.IncrCounter(1, "prepare_proposal", "invalid_blob_txs")
+			fsb.dropAnteFailure(tx.Tx, err)
 			err = fsb.builder.RevertLastBlobTx()
 			if err != nil {
 				logger.Error("reverting last blob transaction failed", "error", err)
@@ -150,6 +222,17 @@ func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte) [][]byte {
 	return kept
 }
 
+// validateBlobSizes returns an error if a blob of the blob tx exceeds the max
+// blob size of its namespace.
+func (fsb *FilteredSquareBuilder) validateBlobSizes(blobTx *tx.BlobTx) error {
+	for _, blob := range blobTx.Blobs {
+		if err := blobtypes.ValidateBlobSizeForNamespace(fsb.namespaceParams, blob.Namespace().Bytes(), uint32(len(blob.Data()))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func msgTypes(sdkTx sdk.Tx) []string {
 	msgs := sdkTx.GetMsgs()
 	msgNames := make([]string, len(msgs))
@@ -172,18 +255,25 @@ func encodeBlobTxs(blobTxs []*tx.BlobTx) [][]byte {
 }
 
 // separateTxs decodes raw tendermint txs into normal and blob txs.
-func separateTxs(_ client.TxConfig, rawTxs [][]byte) ([][]byte, []*tx.BlobTx) {
+func (fsb *FilteredSquareBuilder) separateTxs(rawTxs [][]byte) ([][]byte, []*tx.BlobTx) {
 	normalTxs := make([][]byte, 0, len(rawTxs))
 	blobTxs := make([]*tx.BlobTx, 0, len(rawTxs))
 	for _, rawTx := range rawTxs {
+		bTx, isBlob, err := tx.UnmarshalBlobTx(rawTx)
+
 		// this check in theory shouldn't get hit, as txs should be filtered
 		// in CheckTx. However in tests we're inserting too large of txs
 		// therefore also filter here.
 		if len(rawTx) > appconsts.MaxTxSize {
+			// Blob txs are tracked by the hash of their inner tx.
+			txKey := rawTx
+			if isBlob && err == nil {
+				txKey = bTx.Tx
+			}
+			fsb.drop(txKey, celestiatx.RejectionReason_REJECTION_REASON_TX_TOO_LARGE, fmt.Sprintf("the tx size %d exceeds the max tx size %d", len(rawTx), appconsts.MaxTxSize))
 			continue
 		}
 
-		bTx, isBlob, err := tx.UnmarshalBlobTx(rawTx)
 		if isBlob {
 			if err != nil {
 				panic(err)
@@ -0,0 +1,273 @@
+package blobquery
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/celestiaorg/celestia-app/v7/pkg/appconsts"
+	v5 "github.com/celestiaorg/celestia-app/v7/pkg/appconsts/v5"
+	"github.com/celestiaorg/celestia-app/v7/pkg/da"
+	"github.com/celestiaorg/celestia-app/v7/pkg/proof"
+	blobtypes "github.com/celestiaorg/celestia-app/v7/x/blob/types"
+	square "github.com/celestiaorg/go-square/v3"
+	"github.com/celestiaorg/go-square/v3/share"
+	blobtx "github.com/celestiaorg/go-square/v3/tx"
+	"github.com/celestiaorg/rsmt2d"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RegisterBlobQueryService registers the blob query service on the gRPC
+// router.
+func RegisterBlobQueryService(qrt gogogrpc.Server, clientCtx client.Context, txDecoder sdk.TxDecoder) {
+	blobtypes.RegisterBlobQueryServer(qrt, NewBlobQueryServer(clientCtx, txDecoder))
+}
+
+// RegisterGRPCGatewayRoutes mounts the blob query service's GRPC-gateway
+// routes on the given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	err := blobtypes.RegisterBlobQueryHandlerClient(context.Background(), mux, blobtypes.NewBlobQueryClient(clientConn))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// blockClient is the subset of the CometBFT RPC client used to read committed
+// blocks.
+type blockClient interface {
+	Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error)
+}
+
+var _ blobtypes.BlobQueryServer = &blobQueryServer{}
+
+type blobQueryServer struct {
+	blockClientFn func() (blockClient, error)
+	txDecoder     sdk.TxDecoder
+}
+
+func NewBlobQueryServer(clientCtx client.Context, txDecoder sdk.TxDecoder) blobtypes.BlobQueryServer {
+	return &blobQueryServer{
+		blockClientFn: func() (blockClient, error) {
+			return clientCtx.GetNode()
+		},
+		txDecoder: txDecoder,
+	}
+}
+
+// NamespaceBlobs reads the block at the requested height, rebuilds its data
+// square and returns every blob published under the requested namespace with
+// the proof of its shares to the block's data root.
+func (s *blobQueryServer) NamespaceBlobs(ctx context.Context, req *blobtypes.QueryNamespaceBlobsRequest) (*blobtypes.QueryNamespaceBlobsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if req.Height < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d cannot be negative", req.Height)
+	}
+	namespace, err := parseBlobNamespace(req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	block, err := s.block(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := block.Txs.ToSliceOfBytes()
+	eds, err := da.ConstructEDS(txs, block.Version.App, -1)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "constructing data square: %v", err)
+	}
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "computing data availability header: %v", err)
+	}
+	dataRoot := dah.Hash()
+	if !bytes.Equal(dataRoot, block.DataHash) {
+		return nil, status.Errorf(codes.Internal, "reconstructed data root %X does not match the data root %X of block %d", dataRoot, block.DataHash, block.Height)
+	}
+
+	// the builder is only used to locate the blobs in the square.
+	builder, err := square.NewBuilder(appconsts.SquareSizeUpperBound, appconsts.SubtreeRootThreshold, txs...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "building data square: %v", err)
+	}
+
+	var blobs []*blobtypes.NamespaceBlob
+	for txIndex, rawTx := range txs {
+		blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(rawTx)
+		if !isBlobTx {
+			continue
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unmarshalling blob tx %d: %v", txIndex, err)
+		}
+
+		var msg *blobtypes.MsgPayForBlobs
+		for blobIndex, blob := range blobTx.Blobs {
+			if !blob.Namespace().Equals(namespace) {
+				continue
+			}
+			if msg == nil {
+				if msg, err = s.decodePayForBlobs(blobTx.Tx); err != nil {
+					return nil, status.Errorf(codes.Internal, "decoding blob tx %d: %v", txIndex, err)
+				}
+			}
+
+			namespaceBlob, err := newNamespaceBlob(builder, eds, txIndex, blobIndex, blob, msg)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "proving blob %d of tx %d: %v", blobIndex, txIndex, err)
+			}
+			blobs = append(blobs, namespaceBlob)
+		}
+	}
+
+	sort.Slice(blobs, func(i, j int) bool { return blobs[i].StartShare < blobs[j].StartShare })
+
+	return &blobtypes.QueryNamespaceBlobsResponse{
+		Height:   block.Height,
+		DataRoot: dataRoot,
+		Blobs:    blobs,
+	}, nil
+}
+
+// CommitmentProof reads the block at the requested height, rebuilds its data
+// square and returns the proof of the subtree roots of the blob with the
+// requested share commitment to the block's data availability header.
+func (s *blobQueryServer) CommitmentProof(ctx context.Context, req *blobtypes.QueryCommitmentProofRequest) (*blobtypes.QueryCommitmentProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if req.Height < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d cannot be negative", req.Height)
+	}
+	namespace, err := parseBlobNamespace(req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Commitment) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "commitment cannot be empty")
+	}
+	block, err := s.block(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	commitmentProof, dah, err := proof.NewCommitmentInclusionProof(block.Txs.ToSliceOfBytes(), namespace, req.Commitment)
+	if errors.Is(err, proof.ErrCommitmentNotFound) {
+		return nil, status.Errorf(codes.NotFound, "no blob of namespace %X with commitment %X at height %d", namespace.Bytes(), req.Commitment, block.Height)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "proving commitment: %v", err)
+	}
+	dataRoot := dah.Hash()
+	if !bytes.Equal(dataRoot, block.DataHash) {
+		return nil, status.Errorf(codes.Internal, "reconstructed data root %X does not match the data root %X of block %d", dataRoot, block.DataHash, block.Height)
+	}
+	dahProto, err := dah.ToProto()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting data availability header: %v", err)
+	}
+
+	return &blobtypes.QueryCommitmentProofResponse{
+		Height:   block.Height,
+		DataRoot: dataRoot,
+		Dah:      *dahProto,
+		Proof:    commitmentProof,
+	}, nil
+}
+
+// parseBlobNamespace parses a namespace that blobs can be published under.
+func parseBlobNamespace(bz []byte) (share.Namespace, error) {
+	namespace, err := share.NewNamespaceFromBytes(bz)
+	if err != nil {
+		return share.Namespace{}, status.Errorf(codes.InvalidArgument, "invalid namespace: %v", err)
+	}
+	if err := namespace.ValidateForBlob(); err != nil {
+		return share.Namespace{}, status.Errorf(codes.InvalidArgument, "invalid namespace: %v", err)
+	}
+	return namespace, nil
+}
+
+// block returns the block at height, or the latest block if height is zero.
+// Only blocks whose square can be rebuilt with the current square layout are
+// returned.
+func (s *blobQueryServer) block(ctx context.Context, height int64) (*types.Block, error) {
+	node, err := s.blockClientFn()
+	if err != nil {
+		return nil, err
+	}
+	var heightPtr *int64
+	if height > 0 {
+		heightPtr = &height
+	}
+	result, err := node.Block(ctx, heightPtr)
+	if err != nil {
+		return nil, err
+	}
+	if appVersion := result.Block.Version.App; appVersion <= v5.Version {
+		return nil, status.Errorf(codes.Unimplemented, "blocks of app version %d are not supported", appVersion)
+	}
+	return result.Block, nil
+}
+
+func (s *blobQueryServer) decodePayForBlobs(rawTx []byte) (*blobtypes.MsgPayForBlobs, error) {
+	tx, err := s.txDecoder(rawTx)
+	if err != nil {
+		return nil, err
+	}
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil, fmt.Errorf("expected a single message, got %d", len(msgs))
+	}
+	msg, ok := msgs[0].(*blobtypes.MsgPayForBlobs)
+	if !ok {
+		return nil, fmt.Errorf("expected a MsgPayForBlobs, got %T", msgs[0])
+	}
+	return msg, nil
+}
+
+// newNamespaceBlob locates the blob at blobIndex of the blob tx at txIndex in
+// the square and proves its shares.
+func newNamespaceBlob(
+	builder *square.Builder,
+	eds *rsmt2d.ExtendedDataSquare,
+	txIndex, blobIndex int,
+	blob *share.Blob,
+	msg *blobtypes.MsgPayForBlobs,
+) (*blobtypes.NamespaceBlob, error) {
+	start, err := builder.FindBlobStartingIndex(txIndex, blobIndex)
+	if err != nil {
+		return nil, err
+	}
+	length, err := builder.BlobShareLength(txIndex, blobIndex)
+	if err != nil {
+		return nil, err
+	}
+	shareProof, err := proof.NewShareInclusionProofFromEDS(eds, blob.Namespace(), share.NewRange(start, start+length))
+	if err != nil {
+		return nil, err
+	}
+	if blobIndex >= len(msg.ShareCommitments) {
+		return nil, fmt.Errorf("no share commitment for blob %d", blobIndex)
+	}
+
+	return &blobtypes.NamespaceBlob{
+		Data:         blob.Data(),
+		ShareVersion: uint32(blob.ShareVersion()),
+		Signer:       msg.Signer,
+		Commitment:   msg.ShareCommitments[blobIndex],
+		StartShare:   uint32(start),
+		EndShare:     uint32(start + length),
+		Proof:        shareProof,
+	}, nil
+}
@@ -0,0 +1,222 @@
+package blobquery
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v7/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v7/pkg/da"
+	blobtypes "github.com/celestiaorg/celestia-app/v7/x/blob/types"
+	"github.com/celestiaorg/go-square/v3/share"
+	blobtx "github.com/celestiaorg/go-square/v3/tx"
+	rpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockTx struct {
+	sdk.Tx
+	msgs []sdk.Msg
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+// mockBlockClient serves a single block whose transactions are decoded by its
+// decode method.
+type mockBlockClient struct {
+	block *types.Block
+	txs   map[string]sdk.Tx
+}
+
+func (c *mockBlockClient) Block(_ context.Context, height *int64) (*rpctypes.ResultBlock, error) {
+	if height != nil && *height != c.block.Height {
+		return nil, fmt.Errorf("height %d is not available", *height)
+	}
+	return &rpctypes.ResultBlock{Block: c.block}, nil
+}
+
+func (c *mockBlockClient) decode(txBytes []byte) (sdk.Tx, error) {
+	tx, ok := c.txs[string(txBytes)]
+	if !ok {
+		return nil, fmt.Errorf("unknown tx %X", txBytes)
+	}
+	return tx, nil
+}
+
+func testSigner(i int) string {
+	return sdk.AccAddress(bytes.Repeat([]byte{byte(i + 1)}, 20)).String()
+}
+
+// newMockBlockClient returns a client serving a block at height 10 holding a
+// normal tx followed by a blob tx for each entry of pfbs.
+func newMockBlockClient(t *testing.T, pfbs ...[]*share.Blob) *mockBlockClient {
+	c := &mockBlockClient{txs: map[string]sdk.Tx{"transfer": mockTx{}}}
+	txs := types.Txs{[]byte("transfer")}
+	for i, blobs := range pfbs {
+		id := fmt.Sprintf("pfb-%d", i)
+		msg, err := blobtypes.NewMsgPayForBlobs(testSigner(i), appconsts.Version, blobs...)
+		require.NoError(t, err)
+		c.txs[id] = mockTx{msgs: []sdk.Msg{msg}}
+
+		rawTx, err := blobtx.MarshalBlobTx([]byte(id), blobs...)
+		require.NoError(t, err)
+		txs = append(txs, rawTx)
+	}
+
+	eds, err := da.ConstructEDS(txs.ToSliceOfBytes(), appconsts.Version, -1)
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	c.block = &types.Block{
+		Header: types.Header{Height: 10, DataHash: dah.Hash()},
+		Data:   types.Data{Txs: txs},
+	}
+	c.block.Version.App = appconsts.Version
+	return c
+}
+
+func TestNamespaceBlobs(t *testing.T) {
+	rollup := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	other := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+	newBlob := func(ns share.Namespace, size int) *share.Blob {
+		blob, err := share.NewV0Blob(ns, bytes.Repeat([]byte{byte(size)}, size))
+		require.NoError(t, err)
+		return blob
+	}
+
+	chain := newMockBlockClient(t,
+		[]*share.Blob{newBlob(other, 100), newBlob(rollup, 2000)},
+		[]*share.Blob{newBlob(other, 600)},
+		[]*share.Blob{newBlob(rollup, 50)},
+	)
+	server := &blobQueryServer{
+		blockClientFn: func() (blockClient, error) { return chain, nil },
+		txDecoder:     chain.decode,
+	}
+
+	resp, err := server.NamespaceBlobs(context.Background(), &blobtypes.QueryNamespaceBlobsRequest{Namespace: rollup.Bytes()})
+	require.NoError(t, err)
+	require.EqualValues(t, 10, resp.Height)
+	require.Equal(t, []byte(chain.block.DataHash), resp.DataRoot)
+	require.Len(t, resp.Blobs, 2)
+
+	msgs := []*blobtypes.MsgPayForBlobs{
+		chain.txs["pfb-0"].GetMsgs()[0].(*blobtypes.MsgPayForBlobs),
+		chain.txs["pfb-2"].GetMsgs()[0].(*blobtypes.MsgPayForBlobs),
+	}
+	wantCommitments := [][]byte{msgs[0].ShareCommitments[1], msgs[1].ShareCommitments[0]}
+	wantSizes := []int{2000, 50}
+	for i, blob := range resp.Blobs {
+		require.Len(t, blob.Data, wantSizes[i])
+		require.Equal(t, testSigner(i*2), blob.Signer)
+		require.Equal(t, wantCommitments[i], blob.Commitment)
+		require.EqualValues(t, share.ShareVersionZero, blob.ShareVersion)
+		require.NoError(t, blob.Proof.Validate(resp.DataRoot))
+		require.EqualValues(t, blob.EndShare-blob.StartShare, len(blob.Proof.Data))
+
+		shares, err := share.FromBytes(blob.Proof.Data)
+		require.NoError(t, err)
+		parsed, err := share.ParseBlobs(shares)
+		require.NoError(t, err)
+		require.Len(t, parsed, 1)
+		require.Equal(t, blob.Data, parsed[0].Data())
+	}
+
+	t.Run("at height", func(t *testing.T) {
+		resp, err := server.NamespaceBlobs(context.Background(), &blobtypes.QueryNamespaceBlobsRequest{Height: 10, Namespace: other.Bytes()})
+		require.NoError(t, err)
+		require.Len(t, resp.Blobs, 2)
+	})
+
+	t.Run("no blobs for the namespace", func(t *testing.T) {
+		empty := share.MustNewV0Namespace(bytes.Repeat([]byte{3}, share.NamespaceVersionZeroIDSize))
+		resp, err := server.NamespaceBlobs(context.Background(), &blobtypes.QueryNamespaceBlobsRequest{Namespace: empty.Bytes()})
+		require.NoError(t, err)
+		require.Empty(t, resp.Blobs)
+	})
+
+	t.Run("invalid namespace", func(t *testing.T) {
+		_, err := server.NamespaceBlobs(context.Background(), &blobtypes.QueryNamespaceBlobsRequest{Namespace: []byte{1, 2, 3}})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = server.NamespaceBlobs(context.Background(), &blobtypes.QueryNamespaceBlobsRequest{Namespace: share.TxNamespace.Bytes()})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("data root mismatch", func(t *testing.T) {
+		tampered := newMockBlockClient(t, []*share.Blob{newBlob(rollup, 10)})
+		tampered.block.DataHash = chain.block.DataHash
+		server := &blobQueryServer{
+			blockClientFn: func() (blockClient, error) { return tampered, nil },
+			txDecoder:     tampered.decode,
+		}
+		_, err := server.NamespaceBlobs(context.Background(), &blobtypes.QueryNamespaceBlobsRequest{Namespace: rollup.Bytes()})
+		require.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("unsupported app version", func(t *testing.T) {
+		legacy := newMockBlockClient(t)
+		legacy.block.Version.App = 5
+		server := &blobQueryServer{
+			blockClientFn: func() (blockClient, error) { return legacy, nil },
+			txDecoder:     legacy.decode,
+		}
+		_, err := server.NamespaceBlobs(context.Background(), &blobtypes.QueryNamespaceBlobsRequest{Namespace: rollup.Bytes()})
+		require.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
+
+func TestCommitmentProof(t *testing.T) {
+	rollup := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	other := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+	newBlob := func(ns share.Namespace, size int) *share.Blob {
+		blob, err := share.NewV0Blob(ns, bytes.Repeat([]byte{byte(size)}, size))
+		require.NoError(t, err)
+		return blob
+	}
+
+	chain := newMockBlockClient(t,
+		[]*share.Blob{newBlob(other, 100), newBlob(rollup, 20_000)},
+		[]*share.Blob{newBlob(rollup, 50)},
+	)
+	server := &blobQueryServer{
+		blockClientFn: func() (blockClient, error) { return chain, nil },
+		txDecoder:     chain.decode,
+	}
+	msg := chain.txs["pfb-0"].GetMsgs()[0].(*blobtypes.MsgPayForBlobs)
+	commitment := msg.ShareCommitments[1]
+
+	resp, err := server.CommitmentProof(context.Background(), &blobtypes.QueryCommitmentProofRequest{
+		Height:     10,
+		Namespace:  rollup.Bytes(),
+		Commitment: commitment,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 10, resp.Height)
+	require.Equal(t, []byte(chain.block.DataHash), resp.DataRoot)
+
+	dah, err := da.DataAvailabilityHeaderFromProto(&resp.Dah)
+	require.NoError(t, err)
+	require.Equal(t, resp.DataRoot, dah.Hash())
+	require.NoError(t, resp.Proof.Verify(*dah, commitment))
+
+	t.Run("commitment not found", func(t *testing.T) {
+		_, err := server.CommitmentProof(context.Background(), &blobtypes.QueryCommitmentProofRequest{
+			Namespace:  other.Bytes(),
+			Commitment: commitment,
+		})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		_, err := server.CommitmentProof(context.Background(), &blobtypes.QueryCommitmentProofRequest{Namespace: rollup.Bytes()})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = server.CommitmentProof(context.Background(), &blobtypes.QueryCommitmentProofRequest{Namespace: []byte{1}, Commitment: commitment})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,132 @@
+package gasestimation
+
+import (
+	"context"
+	"math"
+
+	"github.com/celestiaorg/celestia-app/v7/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v7/x/blob/types"
+	"github.com/celestiaorg/go-square/v3/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BlobGasParams are the chain parameters used to estimate the gas consumed by
+// a PFB without simulating it.
+type BlobGasParams struct {
+	// TxSizeCostPerByte is the gas consumed per byte of the transaction.
+	TxSizeCostPerByte uint64
+	// SigVerifyCost is the gas consumed verifying the signer's signature. It
+	// is the cost of a secp256k1 signature if the signer is unknown.
+	SigVerifyCost uint64
+	// DefaultSigVerifyCost is the cost of a secp256k1 signature which is
+	// already accounted for by blobtypes.PFBGasFixedCost.
+	DefaultSigVerifyCost uint64
+	// NamespaceParams are the per namespace blob parameters scaling the gas
+	// charged per blob byte.
+	NamespaceParams []blobtypes.NamespaceParams
+}
+
+// blobGasParamsFn is the signature of a function that returns the current
+// BlobGasParams for the provided, possibly empty, signer address.
+type blobGasParamsFn func(signer string) (BlobGasParams, error)
+
+// EstimateBlobGas estimates the gas used and the fee of a PFB paying for the
+// requested blobs. Unlike EstimateGasPriceAndUsage, it does not need a signed
+// transaction: the gas used follows blobtypes.EstimateGas and is adjusted for
+// the gas multipliers of the blobs' namespaces and for signers whose public
+// key costs more to verify than a secp256k1 key.
+func (s *gasEstimatorServer) EstimateBlobGas(ctx context.Context, request *EstimateBlobGasRequest) (*EstimateBlobGasResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	msg, err := newBlobGasMsg(request)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	params, err := s.blobGasParamsFn(request.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	gasPrice, err := s.estimateGasPrice(ctx, request.TxPriority)
+	if err != nil {
+		return nil, err
+	}
+
+	gasUsed := blobtypes.EstimateGas(msg, appconsts.GasPerBlobByte, params.TxSizeCostPerByte)
+	// the gas charged for the blobs is replaced by the gas scaled by the
+	// multipliers of their namespaces.
+	gasUsed -= blobtypes.GasToConsume(msg, appconsts.GasPerBlobByte)
+	gasUsed += blobtypes.GasToConsumeWithNamespaceParams(msg, appconsts.GasPerBlobByte, params.NamespaceParams)
+	if params.SigVerifyCost > params.DefaultSigVerifyCost {
+		gasUsed += params.SigVerifyCost - params.DefaultSigVerifyCost
+	}
+
+	return &EstimateBlobGasResponse{
+		EstimatedGasPrice: gasPrice,
+		EstimatedGasUsed:  gasUsed,
+		EstimatedFee:      uint64(math.Ceil(gasPrice * float64(gasUsed))),
+		SharesUsed:        sharesUsed(msg),
+	}, nil
+}
+
+// newBlobGasMsg validates the requested blobs and returns a MsgPayForBlobs
+// carrying their namespaces, sizes and share versions. The share commitments
+// are left empty as they do not affect the gas consumed.
+func newBlobGasMsg(request *EstimateBlobGasRequest) (*blobtypes.MsgPayForBlobs, error) {
+	if len(request.Blobs) == 0 {
+		return nil, blobtypes.ErrNoBlobs
+	}
+	if request.Signer != "" {
+		if _, err := sdk.AccAddressFromBech32(request.Signer); err != nil {
+			return nil, blobtypes.ErrInvalidBlobSigner.Wrap(err.Error())
+		}
+	}
+
+	msg := &blobtypes.MsgPayForBlobs{
+		Signer:        request.Signer,
+		Namespaces:    make([][]byte, len(request.Blobs)),
+		BlobSizes:     make([]uint32, len(request.Blobs)),
+		ShareVersions: make([]uint32, len(request.Blobs)),
+	}
+	for i, blob := range request.Blobs {
+		ns, err := share.NewNamespaceFromBytes(blob.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		if err := blobtypes.ValidateBlobNamespace(ns); err != nil {
+			return nil, err
+		}
+		if blob.BlobSize == 0 {
+			return nil, blobtypes.ErrZeroBlobSize
+		}
+		switch blob.ShareVersion {
+		case uint32(share.ShareVersionZero):
+		case uint32(share.ShareVersionOne):
+			if request.Signer == "" {
+				return nil, blobtypes.ErrInvalidBlobSigner.Wrap("share version 1 requires a signer")
+			}
+		default:
+			return nil, blobtypes.ErrUnsupportedShareVersion.Wrapf("share version %d", blob.ShareVersion)
+		}
+
+		msg.Namespaces[i] = ns.Bytes()
+		msg.BlobSizes[i] = blob.BlobSize
+		msg.ShareVersions[i] = blob.ShareVersion
+	}
+	return msg, nil
+}
+
+// sharesUsed returns the number of shares occupied by the blobs of msg.
+func sharesUsed(msg *blobtypes.MsgPayForBlobs) uint64 {
+	var shares uint64
+	for i, size := range msg.BlobSizes {
+		containsSigner := msg.ShareVersions[i] == uint32(share.ShareVersionOne)
+		shares += uint64(share.SparseSharesNeeded(size, containsSigner))
+	}
+	return shares
+}
@@ -0,0 +1,105 @@
+package gasestimation
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/celestiaorg/celestia-app/v7/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v7/x/blob/types"
+	"github.com/celestiaorg/go-square/v3/share"
+	"github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEstimateBlobGas(t *testing.T) {
+	const minGasPrice = 0.004
+	signer := sdk.AccAddress(make([]byte, 20)).String()
+	namespace := share.MustNewV0Namespace([]byte("estimate")).Bytes()
+
+	newServer := func(params BlobGasParams) *gasEstimatorServer {
+		return &gasEstimatorServer{
+			mempoolClient:       newMockMempoolClient([]types.Tx{}),
+			minGasPriceFn:       func() (float64, error) { return minGasPrice, nil },
+			govMaxSquareBytesFn: func() (uint64, error) { return 1_000_000, nil },
+			blobGasParamsFn: func(string) (BlobGasParams, error) {
+				return params, nil
+			},
+		}
+	}
+	defaultParams := BlobGasParams{
+		TxSizeCostPerByte:    appconsts.TxSizeCostPerByte,
+		SigVerifyCost:        1000,
+		DefaultSigVerifyCost: 1000,
+	}
+
+	t.Run("matches the blob module's estimate", func(t *testing.T) {
+		resp, err := newServer(defaultParams).EstimateBlobGas(context.Background(), &EstimateBlobGasRequest{
+			Blobs: []*BlobInfo{
+				{Namespace: namespace, BlobSize: 1000},
+				{Namespace: namespace, BlobSize: 100, ShareVersion: uint32(share.ShareVersionOne)},
+			},
+			Signer: signer,
+		})
+		require.NoError(t, err)
+
+		msg := &blobtypes.MsgPayForBlobs{BlobSizes: []uint32{1000, 100}, ShareVersions: []uint32{0, 1}}
+		wantGas := blobtypes.DefaultEstimateGas(msg)
+		require.Equal(t, wantGas, resp.EstimatedGasUsed)
+		require.Equal(t, minGasPrice, resp.EstimatedGasPrice)
+		require.Equal(t, uint64(math.Ceil(minGasPrice*float64(wantGas))), resp.EstimatedFee)
+		require.EqualValues(t, share.SparseSharesNeeded(1000, false)+share.SparseSharesNeeded(100, true), resp.SharesUsed)
+	})
+
+	t.Run("accounts for expensive signers", func(t *testing.T) {
+		params := defaultParams
+		params.SigVerifyCost = 3000
+		resp, err := newServer(params).EstimateBlobGas(context.Background(), &EstimateBlobGasRequest{
+			Blobs:  []*BlobInfo{{Namespace: namespace, BlobSize: 1000}},
+			Signer: signer,
+		})
+		require.NoError(t, err)
+
+		msg := &blobtypes.MsgPayForBlobs{BlobSizes: []uint32{1000}, ShareVersions: []uint32{0}}
+		require.Equal(t, blobtypes.DefaultEstimateGas(msg)+2000, resp.EstimatedGasUsed)
+	})
+
+	t.Run("applies namespace gas multipliers", func(t *testing.T) {
+		params := defaultParams
+		params.NamespaceParams = []blobtypes.NamespaceParams{
+			blobtypes.NewNamespaceParams(namespace[:20], sdkmath.LegacyNewDec(3), 0),
+		}
+		resp, err := newServer(params).EstimateBlobGas(context.Background(), &EstimateBlobGasRequest{
+			Blobs:  []*BlobInfo{{Namespace: namespace, BlobSize: 1000}},
+			Signer: signer,
+		})
+		require.NoError(t, err)
+
+		msg := &blobtypes.MsgPayForBlobs{BlobSizes: []uint32{1000}, ShareVersions: []uint32{0}}
+		blobGas := blobtypes.GasToConsume(msg, appconsts.GasPerBlobByte)
+		require.Equal(t, blobtypes.DefaultEstimateGas(msg)+2*blobGas, resp.EstimatedGasUsed)
+	})
+
+	invalid := map[string]*EstimateBlobGasRequest{
+		"nil request":  nil,
+		"no blobs":     {},
+		"zero size":    {Blobs: []*BlobInfo{{Namespace: namespace}}},
+		"bad signer":   {Blobs: []*BlobInfo{{Namespace: namespace, BlobSize: 1}}, Signer: "celestia1invalid"},
+		"no signer":    {Blobs: []*BlobInfo{{Namespace: namespace, BlobSize: 1, ShareVersion: 1}}},
+		"bad version":  {Blobs: []*BlobInfo{{Namespace: namespace, BlobSize: 1, ShareVersion: 2}}},
+		"bad ns":       {Blobs: []*BlobInfo{{Namespace: []byte{1, 2, 3}, BlobSize: 1}}},
+		"reserved ns":  {Blobs: []*BlobInfo{{Namespace: share.TxNamespace.Bytes(), BlobSize: 1}}},
+		"overflow ver": {Blobs: []*BlobInfo{{Namespace: namespace, BlobSize: 1, ShareVersion: 256}}},
+	}
+	for name, req := range invalid {
+		t.Run(name, func(t *testing.T) {
+			_, err := newServer(defaultParams).EstimateBlobGas(context.Background(), req)
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
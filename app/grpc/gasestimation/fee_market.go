@@ -0,0 +1,216 @@
+package gasestimation
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/celestiaorg/celestia-app/v7/pkg/appconsts"
+	blobtx "github.com/celestiaorg/go-square/v3/tx"
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultFeeMarketHistoryBlocks is the window used when the request does
+	// not specify the number of blocks.
+	defaultFeeMarketHistoryBlocks = 10
+	// maxFeeMarketHistoryBlocks bounds the number of blocks read per request.
+	maxFeeMarketHistoryBlocks = 100
+)
+
+// blockClient is the subset of the CometBFT RPC client used to read committed
+// blocks and their execution results.
+type blockClient interface {
+	Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error)
+	BlockResults(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error)
+}
+
+// FeeMarketHistory summarizes the gas prices paid by the transactions
+// committed in a window of recent blocks.
+func (s *gasEstimatorServer) FeeMarketHistory(ctx context.Context, request *FeeMarketHistoryRequest) (*FeeMarketHistoryResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	numBlocks := request.NumBlocks
+	if numBlocks == 0 {
+		numBlocks = defaultFeeMarketHistoryBlocks
+	}
+	if numBlocks > maxFeeMarketHistoryBlocks {
+		return nil, status.Errorf(codes.InvalidArgument, "maximum of %d blocks allowed", maxFeeMarketHistoryBlocks)
+	}
+
+	blocks, ok := s.mempoolClient.(blockClient)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "node does not support block queries")
+	}
+
+	endHeight := request.EndHeight
+	if endHeight <= 0 {
+		latest, err := blocks.Block(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		endHeight = latest.Block.Height
+	}
+	startHeight := max(endHeight-int64(numBlocks)+1, 1)
+
+	govMaxSquareBytes, err := s.govMaxSquareBytesFn()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &FeeMarketHistoryResponse{
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		PfbTxs:      &TxCounts{},
+		NonPfbTxs:   &TxCounts{},
+	}
+	var pfbGasPrices, nonPFBGasPrices []float64
+	for height := startHeight; height <= endHeight; height++ {
+		blockResp, err := blocks.Block(ctx, &height)
+		if err != nil {
+			return nil, err
+		}
+		resultsResp, err := blocks.BlockResults(ctx, &height)
+		if err != nil {
+			return nil, err
+		}
+
+		stats, err := s.blockFeeMarket(blockResp.Block, resultsResp.TxsResults, govMaxSquareBytes)
+		if err != nil {
+			return nil, err
+		}
+		pfbGasPrices = append(pfbGasPrices, stats.pfbGasPrices...)
+		nonPFBGasPrices = append(nonPFBGasPrices, stats.nonPFBGasPrices...)
+		resp.FillRatio += stats.summary.FillRatio
+		addTxCounts(resp.PfbTxs, stats.summary.PfbTxs)
+		addTxCounts(resp.NonPfbTxs, stats.summary.NonPfbTxs)
+		resp.Blocks = append(resp.Blocks, stats.summary)
+	}
+	resp.FillRatio /= float64(len(resp.Blocks))
+
+	sort.Float64s(pfbGasPrices)
+	sort.Float64s(nonPFBGasPrices)
+	allGasPrices := append(append(make([]float64, 0, len(pfbGasPrices)+len(nonPFBGasPrices)), pfbGasPrices...), nonPFBGasPrices...)
+	sort.Float64s(allGasPrices)
+
+	resp.GasPrices = NewGasPricePercentiles(allGasPrices)
+	resp.PfbGasPrices = NewGasPricePercentiles(pfbGasPrices)
+	resp.NonPfbGasPrices = NewGasPricePercentiles(nonPFBGasPrices)
+	return resp, nil
+}
+
+// blockFeeMarketStats holds the statistics of a block together with the raw
+// gas prices used to compute the window's distributions.
+type blockFeeMarketStats struct {
+	summary         *BlockFeeMarket
+	pfbGasPrices    []float64
+	nonPFBGasPrices []float64
+}
+
+func (s *gasEstimatorServer) blockFeeMarket(block *types.Block, results []*abci.ExecTxResult, govMaxSquareBytes uint64) (blockFeeMarketStats, error) {
+	stats := blockFeeMarketStats{
+		summary: &BlockFeeMarket{
+			Height:    block.Height,
+			PfbTxs:    &TxCounts{},
+			NonPfbTxs: &TxCounts{},
+		},
+	}
+
+	blockBytes := 0
+	for i, rawTx := range block.Data.Txs {
+		blockBytes += len(rawTx)
+		gasPrice, isPFB, err := extractGasPrice(s.txDecoder, rawTx)
+		if err != nil {
+			return blockFeeMarketStats{}, err
+		}
+		failed := i < len(results) && results[i].Code != abci.CodeTypeOK
+
+		counts := stats.summary.NonPfbTxs
+		if isPFB {
+			counts = stats.summary.PfbTxs
+			stats.pfbGasPrices = append(stats.pfbGasPrices, gasPrice)
+		} else {
+			stats.nonPFBGasPrices = append(stats.nonPFBGasPrices, gasPrice)
+		}
+		counts.Included++
+		if failed {
+			counts.ExecutionFailed++
+		}
+	}
+
+	if govMaxSquareBytes > 0 {
+		stats.summary.FillRatio = float64(blockBytes) / float64(govMaxSquareBytes)
+	}
+
+	gasPrices := append(append(make([]float64, 0, len(stats.pfbGasPrices)+len(stats.nonPFBGasPrices)), stats.pfbGasPrices...), stats.nonPFBGasPrices...)
+	sort.Float64s(gasPrices)
+	if median, err := Median(gasPrices); err == nil {
+		stats.summary.MedianGasPrice = median
+	}
+	return stats, nil
+}
+
+// extractGasPrice returns the gas price paid by a raw transaction and whether
+// it is a blob transaction. Transactions without a gas limit are reported with
+// a zero gas price.
+func extractGasPrice(txDecoder sdk.TxDecoder, rawTx types.Tx) (float64, bool, error) {
+	txBytes := []byte(rawTx)
+	bTx, isBlob, err := blobtx.UnmarshalBlobTx(rawTx)
+	if isBlob {
+		if err != nil {
+			return 0, false, err
+		}
+		txBytes = bTx.Tx
+	}
+	sdkTx, err := txDecoder(txBytes)
+	if err != nil {
+		return 0, false, err
+	}
+	feeTx, ok := sdkTx.(sdk.FeeTx)
+	if !ok {
+		return 0, false, errors.New("transaction does not implement FeeTx")
+	}
+	if feeTx.GetGas() == 0 {
+		return 0, isBlob, nil
+	}
+	return float64(feeTx.GetFee().AmountOf(appconsts.BondDenom).Uint64()) / float64(feeTx.GetGas()), isBlob, nil
+}
+
+func addTxCounts(total, block *TxCounts) {
+	total.Included += block.Included
+	total.ExecutionFailed += block.ExecutionFailed
+}
+
+// NewGasPricePercentiles computes the percentiles of the provided gas prices.
+// Expects a sorted slice.
+func NewGasPricePercentiles(gasPrices []float64) *GasPricePercentiles {
+	return &GasPricePercentiles{
+		P10: Percentile(gasPrices, 10),
+		P25: Percentile(gasPrices, 25),
+		P50: Percentile(gasPrices, 50),
+		P75: Percentile(gasPrices, 75),
+		P90: Percentile(gasPrices, 90),
+		P99: Percentile(gasPrices, 99),
+	}
+}
+
+// Percentile returns the value below which the given percentage of the
+// provided gas prices fall, using the nearest-rank method. It returns zero
+// for an empty slice.
+// Expects a sorted slice.
+func Percentile(gasPrices []float64, percent float64) float64 {
+	if len(gasPrices) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(percent / 100 * float64(len(gasPrices))))
+	rank = min(max(rank, 1), len(gasPrices))
+	return gasPrices[rank-1]
+}
@@ -0,0 +1,197 @@
+package gasestimation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v7/pkg/appconsts"
+	"github.com/celestiaorg/go-square/v3/share"
+	blobtx "github.com/celestiaorg/go-square/v3/tx"
+	abci "github.com/cometbft/cometbft/abci/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPercentile(t *testing.T) {
+	gasPrices := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	require.Zero(t, Percentile(nil, 50))
+	require.Equal(t, 1.0, Percentile(gasPrices, 0))
+	require.Equal(t, 1.0, Percentile(gasPrices, 10))
+	require.Equal(t, 3.0, Percentile(gasPrices, 25))
+	require.Equal(t, 5.0, Percentile(gasPrices, 50))
+	require.Equal(t, 10.0, Percentile(gasPrices, 99))
+	require.Equal(t, 10.0, Percentile(gasPrices, 100))
+}
+
+func TestFeeMarketHistory(t *testing.T) {
+	chain := newMockBlockClient()
+	// height 1: two non-PFB txs, one of which failed
+	chain.addBlock(t, []mockTx{{gasPrice: 0.002}, {gasPrice: 0.004, failed: true}})
+	// height 2: two PFBs
+	chain.addBlock(t, []mockTx{{gasPrice: 0.01, isPFB: true}, {gasPrice: 0.03, isPFB: true}})
+	// height 3: one of each
+	chain.addBlock(t, []mockTx{{gasPrice: 0.02, isPFB: true}, {gasPrice: 0.006}})
+
+	server := &gasEstimatorServer{
+		mempoolClient: chain,
+		txDecoder:     chain.decode,
+		govMaxSquareBytesFn: func() (uint64, error) {
+			return 1000, nil
+		},
+	}
+
+	t.Run("defaults to the latest blocks", func(t *testing.T) {
+		resp, err := server.FeeMarketHistory(context.Background(), &FeeMarketHistoryRequest{})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, resp.StartHeight)
+		require.EqualValues(t, 3, resp.EndHeight)
+		require.Len(t, resp.Blocks, 3)
+
+		require.Equal(t, &TxCounts{Included: 3}, resp.PfbTxs)
+		require.Equal(t, &TxCounts{Included: 3, ExecutionFailed: 1}, resp.NonPfbTxs)
+		require.Equal(t, 0.01, resp.PfbGasPrices.P10)
+		require.Equal(t, 0.02, resp.PfbGasPrices.P50)
+		require.Equal(t, 0.03, resp.PfbGasPrices.P99)
+		require.Equal(t, 0.004, resp.NonPfbGasPrices.P50)
+		require.Equal(t, 0.006, resp.GasPrices.P50)
+		require.Equal(t, 0.03, resp.GasPrices.P99)
+
+		require.Equal(t, 0.003, resp.Blocks[0].MedianGasPrice)
+		require.InDelta(t, float64(chain.blockBytes(1))/1000, resp.Blocks[0].FillRatio, 1e-12)
+		expectedFill := float64(chain.blockBytes(1)+chain.blockBytes(2)+chain.blockBytes(3)) / 3000
+		require.InDelta(t, expectedFill, resp.FillRatio, 1e-12)
+	})
+
+	t.Run("window ending at a height", func(t *testing.T) {
+		resp, err := server.FeeMarketHistory(context.Background(), &FeeMarketHistoryRequest{NumBlocks: 1, EndHeight: 2})
+		require.NoError(t, err)
+		require.EqualValues(t, 2, resp.StartHeight)
+		require.EqualValues(t, 2, resp.EndHeight)
+		require.Equal(t, &TxCounts{Included: 2}, resp.PfbTxs)
+		require.Equal(t, &TxCounts{}, resp.NonPfbTxs)
+		require.Zero(t, resp.NonPfbGasPrices.P50)
+	})
+
+	t.Run("window too large", func(t *testing.T) {
+		_, err := server.FeeMarketHistory(context.Background(), &FeeMarketHistoryRequest{NumBlocks: maxFeeMarketHistoryBlocks + 1})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("node without block queries", func(t *testing.T) {
+		server := &gasEstimatorServer{mempoolClient: newMockMempoolClient(nil)}
+		_, err := server.FeeMarketHistory(context.Background(), &FeeMarketHistoryRequest{})
+		require.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
+
+type mockTx struct {
+	gasPrice float64
+	isPFB    bool
+	blobSize int
+	failed   bool
+}
+
+// mockFeeTx is the decoded form of the transactions served by
+// mockBlockClient.
+type mockFeeTx struct {
+	sdk.Tx
+	gas uint64
+	fee sdk.Coins
+}
+
+func (tx mockFeeTx) GetGas() uint64    { return tx.gas }
+func (tx mockFeeTx) GetFee() sdk.Coins { return tx.fee }
+func (mockFeeTx) FeePayer() []byte     { return nil }
+func (mockFeeTx) FeeGranter() []byte   { return nil }
+
+// mockBlockClient serves blocks whose transactions are decoded by its decode
+// method.
+type mockBlockClient struct {
+	*mockMempoolClient
+	blocks  []*types.Block
+	results [][]*abci.ExecTxResult
+	txs     map[string]mockFeeTx
+}
+
+func newMockBlockClient() *mockBlockClient {
+	return &mockBlockClient{
+		mockMempoolClient: newMockMempoolClient(nil),
+		txs:               make(map[string]mockFeeTx),
+	}
+}
+
+func (c *mockBlockClient) addBlock(t *testing.T, txs []mockTx) {
+	height := int64(len(c.blocks) + 1)
+	block := &types.Block{Header: types.Header{Height: height}}
+	results := make([]*abci.ExecTxResult, 0, len(txs))
+	for i, tx := range txs {
+		block.Data.Txs = append(block.Data.Txs, c.newTx(t, fmt.Sprintf("tx-%d-%d", height, i), tx))
+
+		result := &abci.ExecTxResult{Code: abci.CodeTypeOK}
+		if tx.failed {
+			result.Code = 5
+		}
+		results = append(results, result)
+	}
+	c.blocks = append(c.blocks, block)
+	c.results = append(c.results, results)
+}
+
+// newTx returns a raw transaction paying tx.gasPrice that is decodable by
+// c.decode.
+func (c *mockBlockClient) newTx(t *testing.T, id string, tx mockTx) types.Tx {
+	const gas = 100_000
+	sdkTxBytes := []byte(id)
+	c.txs[id] = mockFeeTx{
+		gas: gas,
+		fee: sdk.NewCoins(sdk.NewCoin(appconsts.BondDenom, math.NewInt(int64(tx.gasPrice*gas)))),
+	}
+	if !tx.isPFB {
+		return sdkTxBytes
+	}
+
+	data := []byte("data")
+	if tx.blobSize > 0 {
+		data = make([]byte, tx.blobSize)
+	}
+	blob, err := share.NewV0Blob(share.RandomBlobNamespace(), data)
+	require.NoError(t, err)
+	rawTx, err := blobtx.MarshalBlobTx(sdkTxBytes, blob)
+	require.NoError(t, err)
+	return rawTx
+}
+
+func (c *mockBlockClient) blockBytes(height int64) int {
+	size := 0
+	for _, tx := range c.blocks[height-1].Data.Txs {
+		size += len(tx)
+	}
+	return size
+}
+
+func (c *mockBlockClient) decode(txBytes []byte) (sdk.Tx, error) {
+	tx, ok := c.txs[string(txBytes)]
+	if !ok {
+		return nil, fmt.Errorf("unknown tx %X", txBytes)
+	}
+	return tx, nil
+}
+
+func (c *mockBlockClient) Block(_ context.Context, height *int64) (*rpctypes.ResultBlock, error) {
+	h := int64(len(c.blocks))
+	if height != nil {
+		h = *height
+	}
+	return &rpctypes.ResultBlock{Block: c.blocks[h-1]}, nil
+}
+
+func (c *mockBlockClient) BlockResults(_ context.Context, height *int64) (*rpctypes.ResultBlockResults, error) {
+	return &rpctypes.ResultBlockResults{Height: *height, TxsResults: c.results[*height-1]}, nil
+}
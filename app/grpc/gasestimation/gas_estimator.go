@@ -32,10 +32,10 @@ type govMaxSquareBytesFn func() (uint64, error)
 type minGasPriceFn func() (float64, error)
 
 // RegisterGasEstimationService registers the gas estimation service on the gRPC router.
-func RegisterGasEstimationService(qrt gogogrpc.Server, clientCtx client.Context, txDecoder sdk.TxDecoder, govMaxSquareBytesFn govMaxSquareBytesFn, simulateFn baseAppSimulateFn, minGasPriceFn minGasPriceFn) {
+func RegisterGasEstimationService(qrt gogogrpc.Server, clientCtx client.Context, txDecoder sdk.TxDecoder, govMaxSquareBytesFn govMaxSquareBytesFn, simulateFn baseAppSimulateFn, minGasPriceFn minGasPriceFn, blobGasParamsFn blobGasParamsFn, squareFillFn squareFillFn) {
 	RegisterGasEstimatorServer(
 		qrt,
-		NewGasEstimatorServer(clientCtx.Client, txDecoder, govMaxSquareBytesFn, simulateFn, minGasPriceFn),
+		NewGasEstimatorServer(clientCtx.Client, txDecoder, govMaxSquareBytesFn, simulateFn, minGasPriceFn, blobGasParamsFn, squareFillFn),
 	)
 }
 
@@ -47,15 +47,19 @@ type gasEstimatorServer struct {
 	txDecoder           sdk.TxDecoder
 	govMaxSquareBytesFn govMaxSquareBytesFn
 	minGasPriceFn       minGasPriceFn
+	blobGasParamsFn     blobGasParamsFn
+	squareFillFn        squareFillFn
 }
 
-func NewGasEstimatorServer(mempoolClient cmtclient.MempoolClient, txDecoder sdk.TxDecoder, govMaxSquareBytesFn govMaxSquareBytesFn, simulateFn baseAppSimulateFn, minGasPriceFn minGasPriceFn) GasEstimatorServer {
+func NewGasEstimatorServer(mempoolClient cmtclient.MempoolClient, txDecoder sdk.TxDecoder, govMaxSquareBytesFn govMaxSquareBytesFn, simulateFn baseAppSimulateFn, minGasPriceFn minGasPriceFn, blobGasParamsFn blobGasParamsFn, squareFillFn squareFillFn) GasEstimatorServer {
 	return &gasEstimatorServer{
 		mempoolClient:       mempoolClient,
 		simulateFn:          simulateFn,
 		txDecoder:           txDecoder,
 		govMaxSquareBytesFn: govMaxSquareBytesFn,
 		minGasPriceFn:       minGasPriceFn,
+		blobGasParamsFn:     blobGasParamsFn,
+		squareFillFn:        squareFillFn,
 	}
 }
 
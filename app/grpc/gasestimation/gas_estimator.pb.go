@@ -273,390 +273,3220 @@ func (m *EstimateGasPriceAndUsageResponse) GetEstimatedGasUsed() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("celestia.core.v1.gas_estimation.TxPriority", TxPriority_name, TxPriority_value)
-	proto.RegisterType((*EstimateGasPriceRequest)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceRequest")
-	proto.RegisterType((*EstimateGasPriceResponse)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceResponse")
-	proto.RegisterType((*EstimateGasPriceAndUsageRequest)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceAndUsageRequest")
-	proto.RegisterType((*EstimateGasPriceAndUsageResponse)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceAndUsageResponse")
+// FeeMarketHistoryRequest the request to summarize the fee market over a window
+// of committed blocks.
+type FeeMarketHistoryRequest struct {
+	// num_blocks is the number of blocks in the window. Defaults to 10 if unset
+	// and may not exceed 100.
+	NumBlocks uint64 `protobuf:"varint,1,opt,name=num_blocks,json=numBlocks,proto3" json:"num_blocks,omitempty"`
+	// end_height is the last block of the window. Defaults to the latest height
+	// if unset.
+	EndHeight int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *FeeMarketHistoryRequest) Reset()         { *m = FeeMarketHistoryRequest{} }
+func (m *FeeMarketHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*FeeMarketHistoryRequest) ProtoMessage()    {}
+func (*FeeMarketHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{4}
+}
+func (m *FeeMarketHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func init() {
-	proto.RegisterFile("celestia/core/v1/gas_estimation/gas_estimator.proto", fileDescriptor_67d02876d749b9cc)
+func (m *FeeMarketHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeMarketHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-var fileDescriptor_67d02876d749b9cc = []byte{
-	// 444 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x4e, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0x4f, 0xce, 0x2f, 0x4a, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0x2c,
-	0x8e, 0x07, 0x89, 0xe4, 0x26, 0x96, 0x64, 0xe6, 0xe7, 0x21, 0x73, 0xf3, 0x8b, 0xf4, 0x0a, 0x8a,
-	0xf2, 0x4b, 0xf2, 0x85, 0xe4, 0x61, 0x9a, 0xf4, 0x40, 0x9a, 0xf4, 0xca, 0x0c, 0xf5, 0x50, 0x35,
-	0x29, 0xa5, 0x73, 0x89, 0xbb, 0x42, 0x78, 0xa9, 0xee, 0x89, 0xc5, 0x01, 0x45, 0x99, 0xc9, 0xa9,
-	0x41, 0xa9, 0x85, 0xa5, 0xa9, 0xc5, 0x25, 0x42, 0x3e, 0x5c, 0xdc, 0x25, 0x15, 0xf1, 0x05, 0x45,
-	0x99, 0xf9, 0x45, 0x99, 0x25, 0x95, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x7c, 0x46, 0xda, 0x7a, 0x04,
-	0x4c, 0xd4, 0x0b, 0xa9, 0x08, 0x80, 0x6a, 0x09, 0xe2, 0x2a, 0x81, 0xb3, 0x95, 0xbc, 0xb8, 0x24,
-	0x30, 0x2d, 0x2a, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x15, 0xd2, 0xe3, 0x12, 0x86, 0x1a, 0x90, 0x9a,
-	0x12, 0x0f, 0x32, 0xae, 0x00, 0x24, 0x0d, 0xb6, 0x91, 0x31, 0x48, 0x10, 0x2e, 0x05, 0xd3, 0xa7,
-	0xd4, 0xc5, 0xc8, 0x25, 0x8f, 0x6e, 0x98, 0x63, 0x5e, 0x4a, 0x68, 0x71, 0x62, 0x3a, 0x6d, 0x5c,
-	0x2f, 0x24, 0xc9, 0xc5, 0x51, 0x52, 0x11, 0x9f, 0x54, 0x59, 0x92, 0x5a, 0x2c, 0xc1, 0xa4, 0xc0,
-	0xa8, 0xc1, 0x13, 0xc4, 0x5e, 0x52, 0xe1, 0x04, 0xe2, 0x2a, 0x35, 0x30, 0x72, 0x29, 0xe0, 0x76,
-	0x0c, 0x79, 0x3e, 0x14, 0xd2, 0xe1, 0x12, 0x42, 0x55, 0x5f, 0x5a, 0x9c, 0x9a, 0x02, 0xb6, 0x99,
-	0x25, 0x48, 0x00, 0x59, 0x79, 0x68, 0x71, 0x6a, 0x8a, 0x56, 0x0e, 0x17, 0x17, 0xc2, 0xdd, 0x42,
-	0xd2, 0x5c, 0xe2, 0x21, 0x11, 0xf1, 0x01, 0x41, 0x9e, 0xfe, 0x41, 0x9e, 0x21, 0x91, 0xf1, 0xa1,
-	0x7e, 0xc1, 0x01, 0xae, 0xce, 0x9e, 0x6e, 0x9e, 0xae, 0x2e, 0x02, 0x0c, 0x42, 0xc2, 0x5c, 0xfc,
-	0xc8, 0x92, 0x3e, 0xfe, 0xe1, 0x02, 0x8c, 0x42, 0x62, 0x5c, 0x42, 0xc8, 0x82, 0xbe, 0xae, 0x2e,
-	0x9e, 0xa1, 0xbe, 0x02, 0x4c, 0x42, 0x22, 0x5c, 0x02, 0xc8, 0xe2, 0x1e, 0x9e, 0xee, 0x1e, 0x02,
-	0xcc, 0x46, 0xfb, 0x98, 0xb8, 0x78, 0xdc, 0x13, 0x8b, 0x5d, 0x61, 0x49, 0x4d, 0xa8, 0x93, 0x91,
-	0x4b, 0x00, 0x3d, 0x04, 0x84, 0x2c, 0x08, 0x06, 0x35, 0x8e, 0x74, 0x27, 0x65, 0x49, 0x86, 0x4e,
-	0x48, 0x30, 0x2b, 0x31, 0x08, 0x2d, 0x64, 0xc4, 0x4c, 0x67, 0xb0, 0xd8, 0x10, 0x72, 0x20, 0xd9,
-	0x64, 0xb4, 0x54, 0x25, 0xe5, 0x48, 0x81, 0x09, 0x30, 0x37, 0x3a, 0x85, 0x9c, 0x78, 0x24, 0xc7,
-	0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c,
-	0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x55, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72,
-	0x7e, 0xae, 0x3e, 0xcc, 0xa2, 0xfc, 0xa2, 0x74, 0x38, 0x5b, 0x37, 0xb1, 0xa0, 0x40, 0x1f, 0x84,
-	0xd3, 0x8b, 0x0a, 0x92, 0x41, 0x19, 0x1e, 0x61, 0x71, 0x12, 0x1b, 0x38, 0xc7, 0x1b, 0x03, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0x47, 0x63, 0x14, 0xb9, 0x28, 0x04, 0x00, 0x00,
+func (m *FeeMarketHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeMarketHistoryRequest.Merge(m, src)
+}
+func (m *FeeMarketHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeMarketHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeMarketHistoryRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_FeeMarketHistoryRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *FeeMarketHistoryRequest) GetNumBlocks() uint64 {
+	if m != nil {
+		return m.NumBlocks
+	}
+	return 0
+}
 
-// GasEstimatorClient is the client API for GasEstimator service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GasEstimatorClient interface {
-	// estimateGasPrice takes a transaction priority and estimates the gas price
-	// based on the gas prices of the transactions in the last five blocks. If no
-	// transaction is found in the last five blocks, return the network min gas
-	// price. It's up to the light client to set the gas price in this case to the
-	// minimum gas price set by that node.
-	EstimateGasPrice(ctx context.Context, in *EstimateGasPriceRequest, opts ...grpc.CallOption) (*EstimateGasPriceResponse, error)
-	// EstimateGasPriceAndUsage takes a transaction priority and a transaction
-	// bytes and estimates the gas price and the gas used for that transaction.
-	// The gas price estimation is based on the gas prices of the transactions in
-	// the last five blocks. If no transaction is found in the last five blocks,
-	// return the network min gas price. It's up to the light client to set the
-	// gas price in this case to the minimum gas price set by that node. The gas
-	// used is estimated using the state machine simulation.
-	EstimateGasPriceAndUsage(ctx context.Context, in *EstimateGasPriceAndUsageRequest, opts ...grpc.CallOption) (*EstimateGasPriceAndUsageResponse, error)
+func (m *FeeMarketHistoryRequest) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
 }
 
-type gasEstimatorClient struct {
-	cc grpc1.ClientConn
+// GasPricePercentiles the distribution of the gas prices of a set of
+// transactions. All values are in utia per gas unit and are zero if the set is
+// empty.
+type GasPricePercentiles struct {
+	P10 float64 `protobuf:"fixed64,1,opt,name=p10,proto3" json:"p10,omitempty"`
+	P25 float64 `protobuf:"fixed64,2,opt,name=p25,proto3" json:"p25,omitempty"`
+	P50 float64 `protobuf:"fixed64,3,opt,name=p50,proto3" json:"p50,omitempty"`
+	P75 float64 `protobuf:"fixed64,4,opt,name=p75,proto3" json:"p75,omitempty"`
+	P90 float64 `protobuf:"fixed64,5,opt,name=p90,proto3" json:"p90,omitempty"`
+	P99 float64 `protobuf:"fixed64,6,opt,name=p99,proto3" json:"p99,omitempty"`
 }
 
-func NewGasEstimatorClient(cc grpc1.ClientConn) GasEstimatorClient {
-	return &gasEstimatorClient{cc}
+func (m *GasPricePercentiles) Reset()         { *m = GasPricePercentiles{} }
+func (m *GasPricePercentiles) String() string { return proto.CompactTextString(m) }
+func (*GasPricePercentiles) ProtoMessage()    {}
+func (*GasPricePercentiles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{5}
+}
+func (m *GasPricePercentiles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPricePercentiles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPricePercentiles.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPricePercentiles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPricePercentiles.Merge(m, src)
+}
+func (m *GasPricePercentiles) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPricePercentiles) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPricePercentiles.DiscardUnknown(m)
 }
 
-func (c *gasEstimatorClient) EstimateGasPrice(ctx context.Context, in *EstimateGasPriceRequest, opts ...grpc.CallOption) (*EstimateGasPriceResponse, error) {
-	out := new(EstimateGasPriceResponse)
-	err := c.cc.Invoke(ctx, "/celestia.core.v1.gas_estimation.GasEstimator/EstimateGasPrice", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GasPricePercentiles proto.InternalMessageInfo
+
+func (m *GasPricePercentiles) GetP10() float64 {
+	if m != nil {
+		return m.P10
 	}
-	return out, nil
+	return 0
 }
 
-func (c *gasEstimatorClient) EstimateGasPriceAndUsage(ctx context.Context, in *EstimateGasPriceAndUsageRequest, opts ...grpc.CallOption) (*EstimateGasPriceAndUsageResponse, error) {
-	out := new(EstimateGasPriceAndUsageResponse)
-	err := c.cc.Invoke(ctx, "/celestia.core.v1.gas_estimation.GasEstimator/EstimateGasPriceAndUsage", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GasPricePercentiles) GetP25() float64 {
+	if m != nil {
+		return m.P25
 	}
-	return out, nil
+	return 0
 }
 
-// GasEstimatorServer is the server API for GasEstimator service.
-type GasEstimatorServer interface {
-	// estimateGasPrice takes a transaction priority and estimates the gas price
-	// based on the gas prices of the transactions in the last five blocks. If no
-	// transaction is found in the last five blocks, return the network min gas
-	// price. It's up to the light client to set the gas price in this case to the
-	// minimum gas price set by that node.
-	EstimateGasPrice(context.Context, *EstimateGasPriceRequest) (*EstimateGasPriceResponse, error)
-	// EstimateGasPriceAndUsage takes a transaction priority and a transaction
-	// bytes and estimates the gas price and the gas used for that transaction.
-	// The gas price estimation is based on the gas prices of the transactions in
-	// the last five blocks. If no transaction is found in the last five blocks,
-	// return the network min gas price. It's up to the light client to set the
-	// gas price in this case to the minimum gas price set by that node. The gas
-	// used is estimated using the state machine simulation.
-	EstimateGasPriceAndUsage(context.Context, *EstimateGasPriceAndUsageRequest) (*EstimateGasPriceAndUsageResponse, error)
+func (m *GasPricePercentiles) GetP50() float64 {
+	if m != nil {
+		return m.P50
+	}
+	return 0
 }
 
-// UnimplementedGasEstimatorServer can be embedded to have forward compatible implementations.
-type UnimplementedGasEstimatorServer struct {
+func (m *GasPricePercentiles) GetP75() float64 {
+	if m != nil {
+		return m.P75
+	}
+	return 0
 }
 
-func (*UnimplementedGasEstimatorServer) EstimateGasPrice(ctx context.Context, req *EstimateGasPriceRequest) (*EstimateGasPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateGasPrice not implemented")
+func (m *GasPricePercentiles) GetP90() float64 {
+	if m != nil {
+		return m.P90
+	}
+	return 0
 }
-func (*UnimplementedGasEstimatorServer) EstimateGasPriceAndUsage(ctx context.Context, req *EstimateGasPriceAndUsageRequest) (*EstimateGasPriceAndUsageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateGasPriceAndUsage not implemented")
+
+func (m *GasPricePercentiles) GetP99() float64 {
+	if m != nil {
+		return m.P99
+	}
+	return 0
 }
 
-func RegisterGasEstimatorServer(s grpc1.Server, srv GasEstimatorServer) {
-	s.RegisterService(&_GasEstimator_serviceDesc, srv)
+// TxCounts the number of committed transactions of a set, split by execution
+// result. Transactions evicted from the mempool are not counted.
+type TxCounts struct {
+	// included is the number of transactions included in the blocks.
+	Included uint64 `protobuf:"varint,1,opt,name=included,proto3" json:"included,omitempty"`
+	// execution_failed is the number of included transactions that failed
+	// during execution.
+	ExecutionFailed uint64 `protobuf:"varint,2,opt,name=execution_failed,json=executionFailed,proto3" json:"execution_failed,omitempty"`
 }
 
-func _GasEstimator_EstimateGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateGasPriceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GasEstimatorServer).EstimateGasPrice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celestia.core.v1.gas_estimation.GasEstimator/EstimateGasPrice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GasEstimatorServer).EstimateGasPrice(ctx, req.(*EstimateGasPriceRequest))
+func (m *TxCounts) Reset()         { *m = TxCounts{} }
+func (m *TxCounts) String() string { return proto.CompactTextString(m) }
+func (*TxCounts) ProtoMessage()    {}
+func (*TxCounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{6}
+}
+func (m *TxCounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxCounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxCounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *TxCounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxCounts.Merge(m, src)
+}
+func (m *TxCounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxCounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxCounts.DiscardUnknown(m)
 }
 
-func _GasEstimator_EstimateGasPriceAndUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateGasPriceAndUsageRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GasEstimatorServer).EstimateGasPriceAndUsage(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celestia.core.v1.gas_estimation.GasEstimator/EstimateGasPriceAndUsage",
+var xxx_messageInfo_TxCounts proto.InternalMessageInfo
+
+func (m *TxCounts) GetIncluded() uint64 {
+	if m != nil {
+		return m.Included
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GasEstimatorServer).EstimateGasPriceAndUsage(ctx, req.(*EstimateGasPriceAndUsageRequest))
+	return 0
+}
+
+func (m *TxCounts) GetExecutionFailed() uint64 {
+	if m != nil {
+		return m.ExecutionFailed
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var GasEstimator_serviceDesc = _GasEstimator_serviceDesc
-var _GasEstimator_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "celestia.core.v1.gas_estimation.GasEstimator",
-	HandlerType: (*GasEstimatorServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "EstimateGasPrice",
-			Handler:    _GasEstimator_EstimateGasPrice_Handler,
-		},
-		{
-			MethodName: "EstimateGasPriceAndUsage",
-			Handler:    _GasEstimator_EstimateGasPriceAndUsage_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "celestia/core/v1/gas_estimation/gas_estimator.proto",
+// BlockFeeMarket the fee market statistics of a single block.
+type BlockFeeMarket struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// fill_ratio is the size of the block's transactions relative to the
+	// current maximum square size in bytes.
+	FillRatio float64 `protobuf:"fixed64,2,opt,name=fill_ratio,json=fillRatio,proto3" json:"fill_ratio,omitempty"`
+	// median_gas_price is the median gas price of all transactions in the block.
+	MedianGasPrice float64   `protobuf:"fixed64,3,opt,name=median_gas_price,json=medianGasPrice,proto3" json:"median_gas_price,omitempty"`
+	PfbTxs         *TxCounts `protobuf:"bytes,4,opt,name=pfb_txs,json=pfbTxs,proto3" json:"pfb_txs,omitempty"`
+	NonPfbTxs      *TxCounts `protobuf:"bytes,5,opt,name=non_pfb_txs,json=nonPfbTxs,proto3" json:"non_pfb_txs,omitempty"`
 }
 
-func (m *EstimateGasPriceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BlockFeeMarket) Reset()         { *m = BlockFeeMarket{} }
+func (m *BlockFeeMarket) String() string { return proto.CompactTextString(m) }
+func (*BlockFeeMarket) ProtoMessage()    {}
+func (*BlockFeeMarket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{7}
+}
+func (m *BlockFeeMarket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockFeeMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockFeeMarket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *BlockFeeMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockFeeMarket.Merge(m, src)
+}
+func (m *BlockFeeMarket) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockFeeMarket) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockFeeMarket.DiscardUnknown(m)
 }
 
-func (m *EstimateGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_BlockFeeMarket proto.InternalMessageInfo
+
+func (m *BlockFeeMarket) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *EstimateGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TxPriority != 0 {
-		i = encodeVarintGasEstimator(dAtA, i, uint64(m.TxPriority))
-		i--
-		dAtA[i] = 0x8
+func (m *BlockFeeMarket) GetFillRatio() float64 {
+	if m != nil {
+		return m.FillRatio
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EstimateGasPriceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BlockFeeMarket) GetMedianGasPrice() float64 {
+	if m != nil {
+		return m.MedianGasPrice
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EstimateGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BlockFeeMarket) GetPfbTxs() *TxCounts {
+	if m != nil {
+		return m.PfbTxs
+	}
+	return nil
 }
 
-func (m *EstimateGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EstimatedGasPrice != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.EstimatedGasPrice))))
-		i--
-		dAtA[i] = 0x9
+func (m *BlockFeeMarket) GetNonPfbTxs() *TxCounts {
+	if m != nil {
+		return m.NonPfbTxs
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EstimateGasPriceAndUsageRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// FeeMarketHistoryResponse the fee market statistics over the requested window.
+type FeeMarketHistoryResponse struct {
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// gas_prices is the distribution of the gas prices of all transactions.
+	GasPrices *GasPricePercentiles `protobuf:"bytes,3,opt,name=gas_prices,json=gasPrices,proto3" json:"gas_prices,omitempty"`
+	// pfb_gas_prices is the distribution of the gas prices of PFB transactions.
+	PfbGasPrices *GasPricePercentiles `protobuf:"bytes,4,opt,name=pfb_gas_prices,json=pfbGasPrices,proto3" json:"pfb_gas_prices,omitempty"`
+	// non_pfb_gas_prices is the distribution of the gas prices of all other
+	// transactions.
+	NonPfbGasPrices *GasPricePercentiles `protobuf:"bytes,5,opt,name=non_pfb_gas_prices,json=nonPfbGasPrices,proto3" json:"non_pfb_gas_prices,omitempty"`
+	// fill_ratio is the average fill ratio of the blocks in the window.
+	FillRatio float64   `protobuf:"fixed64,6,opt,name=fill_ratio,json=fillRatio,proto3" json:"fill_ratio,omitempty"`
+	PfbTxs    *TxCounts `protobuf:"bytes,7,opt,name=pfb_txs,json=pfbTxs,proto3" json:"pfb_txs,omitempty"`
+	NonPfbTxs *TxCounts `protobuf:"bytes,8,opt,name=non_pfb_txs,json=nonPfbTxs,proto3" json:"non_pfb_txs,omitempty"`
+	// blocks holds the statistics of each block in the window, in ascending
+	// height order.
+	Blocks []*BlockFeeMarket `protobuf:"bytes,9,rep,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *FeeMarketHistoryResponse) Reset()         { *m = FeeMarketHistoryResponse{} }
+func (m *FeeMarketHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*FeeMarketHistoryResponse) ProtoMessage()    {}
+func (*FeeMarketHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{8}
+}
+func (m *FeeMarketHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeMarketHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeMarketHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *FeeMarketHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeMarketHistoryResponse.Merge(m, src)
+}
+func (m *FeeMarketHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeMarketHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeMarketHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *EstimateGasPriceAndUsageRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_FeeMarketHistoryResponse proto.InternalMessageInfo
+
+func (m *FeeMarketHistoryResponse) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
 }
 
-func (m *EstimateGasPriceAndUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TxBytes) > 0 {
-		i -= len(m.TxBytes)
-		copy(dAtA[i:], m.TxBytes)
-		i = encodeVarintGasEstimator(dAtA, i, uint64(len(m.TxBytes)))
-		i--
-		dAtA[i] = 0x12
+func (m *FeeMarketHistoryResponse) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
 	}
-	if m.TxPriority != 0 {
-		i = encodeVarintGasEstimator(dAtA, i, uint64(m.TxPriority))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *FeeMarketHistoryResponse) GetGasPrices() *GasPricePercentiles {
+	if m != nil {
+		return m.GasPrices
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EstimateGasPriceAndUsageResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FeeMarketHistoryResponse) GetPfbGasPrices() *GasPricePercentiles {
+	if m != nil {
+		return m.PfbGasPrices
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EstimateGasPriceAndUsageResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FeeMarketHistoryResponse) GetNonPfbGasPrices() *GasPricePercentiles {
+	if m != nil {
+		return m.NonPfbGasPrices
+	}
+	return nil
 }
 
-func (m *EstimateGasPriceAndUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EstimatedGasUsed != 0 {
-		i = encodeVarintGasEstimator(dAtA, i, uint64(m.EstimatedGasUsed))
-		i--
-		dAtA[i] = 0x10
+func (m *FeeMarketHistoryResponse) GetFillRatio() float64 {
+	if m != nil {
+		return m.FillRatio
 	}
-	if m.EstimatedGasPrice != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.EstimatedGasPrice))))
-		i--
-		dAtA[i] = 0x9
+	return 0
+}
+
+func (m *FeeMarketHistoryResponse) GetPfbTxs() *TxCounts {
+	if m != nil {
+		return m.PfbTxs
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintGasEstimator(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGasEstimator(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *FeeMarketHistoryResponse) GetNonPfbTxs() *TxCounts {
+	if m != nil {
+		return m.NonPfbTxs
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *EstimateGasPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *FeeMarketHistoryResponse) GetBlocks() []*BlockFeeMarket {
+	if m != nil {
+		return m.Blocks
 	}
-	var l int
-	_ = l
-	if m.TxPriority != 0 {
-		n += 1 + sovGasEstimator(uint64(m.TxPriority))
+	return nil
+}
+
+// BlobInfo describes a blob to be paid for without carrying its data.
+type BlobInfo struct {
+	// namespace is the 29 byte namespace of the blob.
+	Namespace []byte `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// blob_size is the size of the blob's data in bytes.
+	BlobSize uint32 `protobuf:"varint,2,opt,name=blob_size,json=blobSize,proto3" json:"blob_size,omitempty"`
+	// share_version is the share version the blob will be encoded with.
+	ShareVersion uint32 `protobuf:"varint,3,opt,name=share_version,json=shareVersion,proto3" json:"share_version,omitempty"`
+}
+
+func (m *BlobInfo) Reset()         { *m = BlobInfo{} }
+func (m *BlobInfo) String() string { return proto.CompactTextString(m) }
+func (*BlobInfo) ProtoMessage()    {}
+func (*BlobInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{9}
+}
+func (m *BlobInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *BlobInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobInfo.Merge(m, src)
+}
+func (m *BlobInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobInfo.DiscardUnknown(m)
 }
 
-func (m *EstimateGasPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_BlobInfo proto.InternalMessageInfo
+
+func (m *BlobInfo) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
 	}
-	var l int
-	_ = l
-	if m.EstimatedGasPrice != 0 {
-		n += 9
+	return nil
+}
+
+func (m *BlobInfo) GetBlobSize() uint32 {
+	if m != nil {
+		return m.BlobSize
 	}
-	return n
+	return 0
 }
 
-func (m *EstimateGasPriceAndUsageRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BlobInfo) GetShareVersion() uint32 {
+	if m != nil {
+		return m.ShareVersion
 	}
-	var l int
-	_ = l
-	if m.TxPriority != 0 {
-		n += 1 + sovGasEstimator(uint64(m.TxPriority))
+	return 0
+}
+
+// EstimateBlobGasRequest the request to estimate the gas and fee of a PFB
+// from the sizes of its blobs.
+type EstimateBlobGasRequest struct {
+	TxPriority TxPriority  `protobuf:"varint,1,opt,name=tx_priority,json=txPriority,proto3,enum=celestia.core.v1.gas_estimation.TxPriority" json:"tx_priority,omitempty"`
+	Blobs      []*BlobInfo `protobuf:"bytes,2,rep,name=blobs,proto3" json:"blobs,omitempty"`
+	// signer is the optional bech32 address of the account that will sign the
+	// PFB. It is required for blobs using share version 1 and allows the
+	// signature verification cost of its public key to be accounted for.
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *EstimateBlobGasRequest) Reset()         { *m = EstimateBlobGasRequest{} }
+func (m *EstimateBlobGasRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateBlobGasRequest) ProtoMessage()    {}
+func (*EstimateBlobGasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{10}
+}
+func (m *EstimateBlobGasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateBlobGasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateBlobGasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.TxBytes)
-	if l > 0 {
-		n += 1 + l + sovGasEstimator(uint64(l))
+}
+func (m *EstimateBlobGasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateBlobGasRequest.Merge(m, src)
+}
+func (m *EstimateBlobGasRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateBlobGasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateBlobGasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateBlobGasRequest proto.InternalMessageInfo
+
+func (m *EstimateBlobGasRequest) GetTxPriority() TxPriority {
+	if m != nil {
+		return m.TxPriority
+	}
+	return TxPriority_TX_PRIORITY_UNSPECIFIED
+}
+
+func (m *EstimateBlobGasRequest) GetBlobs() []*BlobInfo {
+	if m != nil {
+		return m.Blobs
+	}
+	return nil
+}
+
+func (m *EstimateBlobGasRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+// EstimateBlobGasResponse the response of the blob gas estimation.
+type EstimateBlobGasResponse struct {
+	EstimatedGasPrice float64 `protobuf:"fixed64,1,opt,name=estimated_gas_price,json=estimatedGasPrice,proto3" json:"estimated_gas_price,omitempty"`
+	EstimatedGasUsed  uint64  `protobuf:"varint,2,opt,name=estimated_gas_used,json=estimatedGasUsed,proto3" json:"estimated_gas_used,omitempty"`
+	// estimated_fee is the fee in utia for the estimated gas used at the
+	// estimated gas price, rounded up.
+	EstimatedFee uint64 `protobuf:"varint,3,opt,name=estimated_fee,json=estimatedFee,proto3" json:"estimated_fee,omitempty"`
+	// shares_used is the number of shares the blobs occupy in the square.
+	SharesUsed uint64 `protobuf:"varint,4,opt,name=shares_used,json=sharesUsed,proto3" json:"shares_used,omitempty"`
+}
+
+func (m *EstimateBlobGasResponse) Reset()         { *m = EstimateBlobGasResponse{} }
+func (m *EstimateBlobGasResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateBlobGasResponse) ProtoMessage()    {}
+func (*EstimateBlobGasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{11}
+}
+func (m *EstimateBlobGasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateBlobGasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateBlobGasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateBlobGasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateBlobGasResponse.Merge(m, src)
+}
+func (m *EstimateBlobGasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateBlobGasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateBlobGasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateBlobGasResponse proto.InternalMessageInfo
+
+func (m *EstimateBlobGasResponse) GetEstimatedGasPrice() float64 {
+	if m != nil {
+		return m.EstimatedGasPrice
+	}
+	return 0
+}
+
+func (m *EstimateBlobGasResponse) GetEstimatedGasUsed() uint64 {
+	if m != nil {
+		return m.EstimatedGasUsed
+	}
+	return 0
+}
+
+func (m *EstimateBlobGasResponse) GetEstimatedFee() uint64 {
+	if m != nil {
+		return m.EstimatedFee
+	}
+	return 0
+}
+
+func (m *EstimateBlobGasResponse) GetSharesUsed() uint64 {
+	if m != nil {
+		return m.SharesUsed
+	}
+	return 0
+}
+
+// EstimateInclusionRequest the request to predict when a PFB would be
+// included.
+type EstimateInclusionRequest struct {
+	// gas_price is the candidate gas price in utia per gas unit.
+	GasPrice float64 `protobuf:"fixed64,1,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	// blob_size is the size of the blob's data in bytes.
+	BlobSize uint32 `protobuf:"varint,2,opt,name=blob_size,json=blobSize,proto3" json:"blob_size,omitempty"`
+}
+
+func (m *EstimateInclusionRequest) Reset()         { *m = EstimateInclusionRequest{} }
+func (m *EstimateInclusionRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateInclusionRequest) ProtoMessage()    {}
+func (*EstimateInclusionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{12}
+}
+func (m *EstimateInclusionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateInclusionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateInclusionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateInclusionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateInclusionRequest.Merge(m, src)
+}
+func (m *EstimateInclusionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateInclusionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateInclusionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateInclusionRequest proto.InternalMessageInfo
+
+func (m *EstimateInclusionRequest) GetGasPrice() float64 {
+	if m != nil {
+		return m.GasPrice
+	}
+	return 0
+}
+
+func (m *EstimateInclusionRequest) GetBlobSize() uint32 {
+	if m != nil {
+		return m.BlobSize
+	}
+	return 0
+}
+
+// EstimateInclusionResponse the predicted inclusion of a PFB.
+type EstimateInclusionResponse struct {
+	// block_offset is the number of blocks until the PFB is included, where 1
+	// is the next block. It is zero if the PFB would not be included within the
+	// simulated blocks.
+	BlockOffset uint64 `protobuf:"varint,1,opt,name=block_offset,json=blockOffset,proto3" json:"block_offset,omitempty"`
+	// headroom is the fraction, between 0 and 1, of the shares of the including
+	// block that are left free once the PFB and every transaction paying at
+	// least as much are packed. It is a measure of how much higher paying
+	// traffic can arrive before the PFB is pushed to the following block. It is
+	// zero if block_offset is zero.
+	Headroom float64 `protobuf:"fixed64,2,opt,name=headroom,proto3" json:"headroom,omitempty"`
+}
+
+func (m *EstimateInclusionResponse) Reset()         { *m = EstimateInclusionResponse{} }
+func (m *EstimateInclusionResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateInclusionResponse) ProtoMessage()    {}
+func (*EstimateInclusionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{13}
+}
+func (m *EstimateInclusionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateInclusionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateInclusionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateInclusionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateInclusionResponse.Merge(m, src)
+}
+func (m *EstimateInclusionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateInclusionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateInclusionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateInclusionResponse proto.InternalMessageInfo
+
+func (m *EstimateInclusionResponse) GetBlockOffset() uint64 {
+	if m != nil {
+		return m.BlockOffset
+	}
+	return 0
+}
+
+func (m *EstimateInclusionResponse) GetHeadroom() float64 {
+	if m != nil {
+		return m.Headroom
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("celestia.core.v1.gas_estimation.TxPriority", TxPriority_name, TxPriority_value)
+	proto.RegisterType((*EstimateGasPriceRequest)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceRequest")
+	proto.RegisterType((*EstimateGasPriceResponse)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceResponse")
+	proto.RegisterType((*EstimateGasPriceAndUsageRequest)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceAndUsageRequest")
+	proto.RegisterType((*EstimateGasPriceAndUsageResponse)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceAndUsageResponse")
+	proto.RegisterType((*FeeMarketHistoryRequest)(nil), "celestia.core.v1.gas_estimation.FeeMarketHistoryRequest")
+	proto.RegisterType((*GasPricePercentiles)(nil), "celestia.core.v1.gas_estimation.GasPricePercentiles")
+	proto.RegisterType((*TxCounts)(nil), "celestia.core.v1.gas_estimation.TxCounts")
+	proto.RegisterType((*BlockFeeMarket)(nil), "celestia.core.v1.gas_estimation.BlockFeeMarket")
+	proto.RegisterType((*FeeMarketHistoryResponse)(nil), "celestia.core.v1.gas_estimation.FeeMarketHistoryResponse")
+	proto.RegisterType((*BlobInfo)(nil), "celestia.core.v1.gas_estimation.BlobInfo")
+	proto.RegisterType((*EstimateBlobGasRequest)(nil), "celestia.core.v1.gas_estimation.EstimateBlobGasRequest")
+	proto.RegisterType((*EstimateBlobGasResponse)(nil), "celestia.core.v1.gas_estimation.EstimateBlobGasResponse")
+	proto.RegisterType((*EstimateInclusionRequest)(nil), "celestia.core.v1.gas_estimation.EstimateInclusionRequest")
+	proto.RegisterType((*EstimateInclusionResponse)(nil), "celestia.core.v1.gas_estimation.EstimateInclusionResponse")
+}
+
+func init() {
+	proto.RegisterFile("celestia/core/v1/gas_estimation/gas_estimator.proto", fileDescriptor_67d02876d749b9cc)
+}
+
+var fileDescriptor_67d02876d749b9cc = []byte{
+	// 1089 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x53, 0xdb, 0x46,
+	0x14, 0x47, 0xd8, 0x18, 0xfb, 0xd9, 0x80, 0xb3, 0x74, 0xc0, 0x31, 0xad, 0xa1, 0xea, 0x85, 0xf4,
+	0x8f, 0x0d, 0x4e, 0x19, 0xe2, 0x5c, 0xda, 0x90, 0x80, 0x71, 0x27, 0x14, 0x57, 0x98, 0xa6, 0xe5,
+	0xa2, 0x91, 0xac, 0xb5, 0x51, 0x23, 0x6b, 0x55, 0xed, 0x9a, 0x31, 0x39, 0xa5, 0x97, 0xce, 0xb4,
+	0xd3, 0x43, 0xbf, 0x42, 0x3f, 0x48, 0xa7, 0xd7, 0x1e, 0x73, 0xec, 0xb1, 0x03, 0x87, 0x7e, 0x8d,
+	0xce, 0xae, 0x56, 0xf2, 0x1f, 0x42, 0x00, 0xd3, 0xf4, 0xe0, 0x19, 0xed, 0x6f, 0xf7, 0xfd, 0xf6,
+	0xbd, 0xdf, 0xbe, 0x7d, 0x6f, 0x0d, 0xf7, 0x9b, 0xd8, 0xc1, 0x94, 0xd9, 0x46, 0xa9, 0x49, 0x7c,
+	0x5c, 0x3a, 0x59, 0x2f, 0xb5, 0x0d, 0xaa, 0x73, 0xa4, 0x63, 0x30, 0x9b, 0xb8, 0x83, 0x43, 0xe2,
+	0x17, 0x3d, 0x9f, 0x30, 0x82, 0x96, 0x43, 0xa3, 0x22, 0x37, 0x2a, 0x9e, 0xac, 0x17, 0x87, 0x8d,
+	0xd4, 0x36, 0x2c, 0x6e, 0x07, 0x23, 0x5c, 0x35, 0x68, 0xdd, 0xb7, 0x9b, 0x58, 0xc3, 0xdf, 0x77,
+	0x31, 0x65, 0xe8, 0x29, 0xa4, 0x59, 0x4f, 0xf7, 0x7c, 0x9b, 0xf8, 0x36, 0x3b, 0xcd, 0x29, 0x2b,
+	0xca, 0xea, 0x6c, 0xf9, 0xa3, 0xe2, 0x15, 0x8c, 0xc5, 0x46, 0xaf, 0x2e, 0x4d, 0x34, 0x60, 0xd1,
+	0xb7, 0xfa, 0x05, 0xe4, 0x2e, 0x6e, 0x44, 0x3d, 0xe2, 0x52, 0x8c, 0x8a, 0x30, 0x2f, 0x09, 0xb0,
+	0xa5, 0x73, 0x3a, 0x8f, 0x4f, 0x8b, 0x1d, 0x15, 0xed, 0x4e, 0x34, 0x15, 0xda, 0xa9, 0x3f, 0x2b,
+	0xb0, 0x3c, 0x4a, 0xf6, 0xc8, 0xb5, 0x0e, 0xa9, 0xd1, 0x7e, 0x3b, 0xde, 0xa3, 0xbb, 0x90, 0x64,
+	0x3d, 0xdd, 0x3c, 0x65, 0x98, 0xe6, 0x26, 0x57, 0x94, 0xd5, 0x8c, 0x36, 0xcd, 0x7a, 0x5b, 0x7c,
+	0xa8, 0xbe, 0x54, 0x60, 0xe5, 0x72, 0x67, 0xc6, 0x8b, 0x10, 0x7d, 0x0c, 0x68, 0x78, 0x7d, 0x97,
+	0x62, 0x4b, 0xec, 0x1c, 0xd7, 0xb2, 0x83, 0xcb, 0x0f, 0x29, 0xb6, 0xd4, 0x67, 0xb0, 0xb8, 0x83,
+	0xf1, 0x9e, 0xe1, 0x3f, 0xc7, 0x6c, 0xd7, 0xa6, 0x8c, 0xf8, 0xa7, 0xa1, 0x0c, 0xef, 0x01, 0xb8,
+	0xdd, 0x8e, 0x6e, 0x3a, 0xa4, 0xf9, 0x9c, 0x8a, 0xfd, 0xe2, 0x5a, 0xca, 0xed, 0x76, 0xb6, 0x04,
+	0xc0, 0xa7, 0xb1, 0x6b, 0xe9, 0xc7, 0xd8, 0x6e, 0x1f, 0x33, 0xc1, 0x1f, 0xd3, 0x52, 0xd8, 0xb5,
+	0x76, 0x05, 0xa0, 0xfe, 0xa0, 0xc0, 0x7c, 0xe8, 0x53, 0x1d, 0xfb, 0x4d, 0xec, 0x32, 0xdb, 0xc1,
+	0x14, 0x65, 0x21, 0xe6, 0xad, 0xaf, 0x49, 0xf7, 0xf9, 0xa7, 0x40, 0xca, 0x1b, 0xb9, 0x49, 0x89,
+	0x94, 0x37, 0x04, 0xb2, 0xb1, 0x96, 0x8b, 0x49, 0x64, 0x23, 0x58, 0xb3, 0xb9, 0x91, 0x8b, 0x4b,
+	0x64, 0x33, 0x58, 0x53, 0x59, 0xcb, 0x4d, 0x49, 0xa4, 0x12, 0xac, 0xa9, 0x54, 0x72, 0x89, 0x10,
+	0xa9, 0xa8, 0x5f, 0x41, 0xb2, 0xd1, 0x7b, 0x4c, 0xba, 0x2e, 0xa3, 0x28, 0x0f, 0x49, 0xdb, 0x6d,
+	0x3a, 0x5d, 0x0b, 0x5b, 0x32, 0x96, 0x68, 0x8c, 0xee, 0x41, 0x16, 0xf7, 0x70, 0xb3, 0xcb, 0x8f,
+	0x51, 0x6f, 0x19, 0xb6, 0x13, 0x09, 0x36, 0x17, 0xe1, 0x3b, 0x02, 0x56, 0x5f, 0x4e, 0xc2, 0xac,
+	0x10, 0x20, 0x52, 0x0d, 0x2d, 0x40, 0x42, 0x8a, 0xa0, 0x08, 0x11, 0xe4, 0x88, 0x0b, 0xd4, 0xb2,
+	0x1d, 0x47, 0xf7, 0x79, 0x7a, 0xc8, 0xf0, 0x52, 0x1c, 0xd1, 0x38, 0x80, 0x56, 0x21, 0xdb, 0xc1,
+	0x96, 0x6d, 0xb8, 0x03, 0x87, 0x1a, 0x44, 0x3c, 0x1b, 0xe0, 0xd1, 0x89, 0x6e, 0xc1, 0xb4, 0xd7,
+	0x32, 0x75, 0xd6, 0xa3, 0x42, 0x80, 0x74, 0xf9, 0xde, 0x35, 0x72, 0x31, 0x08, 0x5b, 0x4b, 0x78,
+	0x2d, 0xb3, 0xd1, 0xa3, 0xa8, 0x06, 0x69, 0x97, 0xb8, 0x7a, 0xc8, 0x33, 0x75, 0x53, 0x9e, 0x94,
+	0x4b, 0xdc, 0xba, 0xa0, 0x52, 0xff, 0x89, 0x43, 0xee, 0x62, 0xce, 0xc8, 0x6c, 0x7d, 0x1f, 0x32,
+	0x94, 0x19, 0x3e, 0xd3, 0x87, 0x24, 0x49, 0x0b, 0x6c, 0x37, 0xd2, 0xe5, 0x0d, 0x89, 0x83, 0x0e,
+	0x00, 0x22, 0x41, 0xa8, 0x50, 0x24, 0x5d, 0xfe, 0xf4, 0x4a, 0x47, 0x5f, 0x93, 0x6a, 0x5a, 0xaa,
+	0x2d, 0x41, 0x8a, 0x8e, 0x60, 0x96, 0x87, 0x3e, 0x40, 0x1c, 0xbf, 0x05, 0x71, 0xc6, 0x6b, 0x99,
+	0xd5, 0x88, 0xdb, 0x00, 0x14, 0x4a, 0x3b, 0xc0, 0x3f, 0x75, 0x0b, 0xfe, 0xb9, 0x40, 0xec, 0xfe,
+	0x16, 0xc3, 0xa9, 0x94, 0x18, 0x4d, 0xa5, 0x81, 0x04, 0x99, 0xfe, 0x8f, 0x12, 0x24, 0x39, 0x7e,
+	0x82, 0xa0, 0x2a, 0x24, 0x64, 0xd1, 0x48, 0xad, 0xc4, 0x56, 0xd3, 0xe5, 0xd2, 0x95, 0x2c, 0xc3,
+	0x37, 0x4a, 0x93, 0xe6, 0xea, 0x77, 0x90, 0xdc, 0x72, 0x88, 0x59, 0x73, 0x5b, 0x04, 0xbd, 0x0b,
+	0x29, 0xd7, 0xe8, 0x60, 0xea, 0x19, 0xb2, 0xf8, 0x65, 0xb4, 0x3e, 0x80, 0x96, 0x20, 0x65, 0x3a,
+	0xc4, 0xd4, 0xa9, 0xfd, 0x02, 0x8b, 0x94, 0x9a, 0xd1, 0x92, 0x1c, 0x38, 0xb0, 0x5f, 0x60, 0xf4,
+	0x01, 0xcc, 0xd0, 0x63, 0xc3, 0xc7, 0xfa, 0x09, 0xf6, 0xa9, 0x4d, 0x5c, 0x91, 0x54, 0x33, 0x5a,
+	0x46, 0x80, 0x5f, 0x07, 0x98, 0xfa, 0x87, 0x02, 0x0b, 0x61, 0x2d, 0xe6, 0x9b, 0x56, 0x0d, 0xfa,
+	0x76, 0xfa, 0xc1, 0x67, 0x30, 0xc5, 0x3d, 0xe3, 0xcd, 0x20, 0x76, 0x2d, 0x89, 0x43, 0x09, 0xb4,
+	0xc0, 0x8e, 0xd7, 0x1b, 0x6a, 0xb7, 0x5d, 0xec, 0x8b, 0x38, 0x52, 0x9a, 0x1c, 0xa9, 0xbf, 0x2b,
+	0xfd, 0x86, 0x1c, 0x45, 0xf0, 0x7f, 0x34, 0x11, 0x2e, 0x70, 0x7f, 0x75, 0x0b, 0x07, 0x75, 0x2c,
+	0xae, 0x65, 0x22, 0x70, 0x07, 0x63, 0xb4, 0x0c, 0x69, 0x21, 0xb8, 0xe4, 0x8a, 0x8b, 0x25, 0x10,
+	0x40, 0xa2, 0x15, 0x35, 0xfa, 0x6d, 0xbe, 0xc6, 0x2b, 0x33, 0x3f, 0x96, 0xf0, 0x08, 0x96, 0x20,
+	0x35, 0xea, 0x75, 0x32, 0xbc, 0xdd, 0x6f, 0x3c, 0x7c, 0xf5, 0x08, 0xee, 0xbe, 0x86, 0xb5, 0x5f,
+	0xad, 0x44, 0xaa, 0xe9, 0xa4, 0xd5, 0xa2, 0x98, 0xc9, 0xc6, 0x90, 0x16, 0xd8, 0xbe, 0x80, 0x78,
+	0xdf, 0x38, 0xc6, 0x86, 0xe5, 0x13, 0xd2, 0x91, 0x35, 0x3c, 0x1a, 0x7f, 0xe8, 0x00, 0xf4, 0x0f,
+	0x19, 0x2d, 0xc1, 0x62, 0xe3, 0x1b, 0xbd, 0xae, 0xd5, 0xf6, 0xb5, 0x5a, 0xe3, 0x5b, 0xfd, 0xf0,
+	0xcb, 0x83, 0xfa, 0xf6, 0xe3, 0xda, 0x4e, 0x6d, 0xfb, 0x49, 0x76, 0x02, 0xcd, 0xc3, 0xdc, 0xe0,
+	0xe4, 0xd3, 0xfd, 0x67, 0x59, 0x05, 0x2d, 0x00, 0x1a, 0x04, 0xf7, 0xb6, 0x9f, 0xd4, 0x0e, 0xf7,
+	0xb2, 0x93, 0xe8, 0x1d, 0xc8, 0x0e, 0xe2, 0xbb, 0xb5, 0xea, 0x6e, 0x36, 0x56, 0x3e, 0x9b, 0x82,
+	0x4c, 0xd5, 0xa0, 0xdb, 0xe1, 0x3b, 0x0d, 0xfd, 0xa4, 0x40, 0x76, 0xf4, 0xf9, 0x80, 0x1e, 0x5c,
+	0x99, 0x4f, 0x97, 0x3c, 0xda, 0xf2, 0x95, 0x31, 0x2c, 0x03, 0x1d, 0xd5, 0x09, 0xf4, 0x9b, 0x02,
+	0xb9, 0xcb, 0x9e, 0x32, 0xe8, 0xf3, 0x1b, 0x33, 0x8f, 0x3c, 0xc9, 0xf2, 0x8f, 0x6e, 0xc1, 0x10,
+	0xf9, 0xc8, 0xf5, 0x1a, 0x6d, 0x5c, 0xd7, 0xd0, 0xeb, 0x92, 0xf7, 0x51, 0xbe, 0x32, 0x86, 0x65,
+	0xe4, 0xcb, 0x8f, 0x0a, 0xcc, 0x8d, 0x5c, 0x56, 0xb4, 0x79, 0xed, 0x20, 0x87, 0x0b, 0x54, 0xfe,
+	0xc1, 0xcd, 0x0d, 0x23, 0x47, 0x7e, 0x51, 0xe0, 0xce, 0x85, 0x0b, 0x82, 0xae, 0x9f, 0x0b, 0xa3,
+	0x57, 0x35, 0xff, 0x70, 0x1c, 0xd3, 0xd0, 0x9d, 0xad, 0xc6, 0x9f, 0x67, 0x05, 0xe5, 0xd5, 0x59,
+	0x41, 0xf9, 0xfb, 0xac, 0xa0, 0xfc, 0x7a, 0x5e, 0x98, 0x78, 0x75, 0x5e, 0x98, 0xf8, 0xeb, 0xbc,
+	0x30, 0x71, 0xf4, 0xb0, 0x6d, 0xb3, 0xe3, 0xae, 0x59, 0x6c, 0x92, 0x4e, 0x29, 0xdc, 0x81, 0xf8,
+	0xed, 0xe8, 0xfb, 0x13, 0xc3, 0xf3, 0x4a, 0xfc, 0xd7, 0xf6, 0xbd, 0x26, 0xff, 0x47, 0xd3, 0xdf,
+	0xd1, 0x4c, 0x88, 0xbf, 0x34, 0xf7, 0xff, 0x1d, 0x00, 0xe7, 0x74, 0xb1, 0x96, 0x09, 0x0d, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// GasEstimatorClient is the client API for GasEstimator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GasEstimatorClient interface {
+	// estimateGasPrice takes a transaction priority and estimates the gas price
+	// based on the gas prices of the transactions in the last five blocks. If no
+	// transaction is found in the last five blocks, return the network min gas
+	// price. It's up to the light client to set the gas price in this case to the
+	// minimum gas price set by that node.
+	EstimateGasPrice(ctx context.Context, in *EstimateGasPriceRequest, opts ...grpc.CallOption) (*EstimateGasPriceResponse, error)
+	// EstimateGasPriceAndUsage takes a transaction priority and a transaction
+	// bytes and estimates the gas price and the gas used for that transaction.
+	// The gas price estimation is based on the gas prices of the transactions in
+	// the last five blocks. If no transaction is found in the last five blocks,
+	// return the network min gas price. It's up to the light client to set the
+	// gas price in this case to the minimum gas price set by that node. The gas
+	// used is estimated using the state machine simulation.
+	EstimateGasPriceAndUsage(ctx context.Context, in *EstimateGasPriceAndUsageRequest, opts ...grpc.CallOption) (*EstimateGasPriceAndUsageResponse, error)
+	// FeeMarketHistory returns gas price percentiles, block fill ratios and
+	// transaction counts computed from the transactions committed in a window of
+	// recent blocks. PFB and non-PFB transactions are also reported separately.
+	// The counts only cover committed transactions: nodes do not retain which
+	// transactions were evicted from their mempool, so evictions are not
+	// reported.
+	FeeMarketHistory(ctx context.Context, in *FeeMarketHistoryRequest, opts ...grpc.CallOption) (*FeeMarketHistoryResponse, error)
+	// EstimateBlobGas estimates the gas used and the fee of a PFB paying for
+	// blobs of the provided sizes without requiring a signed transaction. The gas
+	// used is derived from the blob module's gas formula instead of a state
+	// machine simulation, and the gas price follows the same estimation as
+	// EstimateGasPrice.
+	EstimateBlobGas(ctx context.Context, in *EstimateBlobGasRequest, opts ...grpc.CallOption) (*EstimateBlobGasResponse, error)
+	// EstimateInclusion predicts in how many blocks a PFB paying the provided
+	// gas price for a blob of the provided size would be included. It simulates
+	// packing the current mempool, ordered by gas price, into consecutive
+	// squares of the current maximum size.
+	EstimateInclusion(ctx context.Context, in *EstimateInclusionRequest, opts ...grpc.CallOption) (*EstimateInclusionResponse, error)
+}
+
+type gasEstimatorClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewGasEstimatorClient(cc grpc1.ClientConn) GasEstimatorClient {
+	return &gasEstimatorClient{cc}
+}
+
+func (c *gasEstimatorClient) EstimateGasPrice(ctx context.Context, in *EstimateGasPriceRequest, opts ...grpc.CallOption) (*EstimateGasPriceResponse, error) {
+	out := new(EstimateGasPriceResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.gas_estimation.GasEstimator/EstimateGasPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gasEstimatorClient) EstimateGasPriceAndUsage(ctx context.Context, in *EstimateGasPriceAndUsageRequest, opts ...grpc.CallOption) (*EstimateGasPriceAndUsageResponse, error) {
+	out := new(EstimateGasPriceAndUsageResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.gas_estimation.GasEstimator/EstimateGasPriceAndUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gasEstimatorClient) FeeMarketHistory(ctx context.Context, in *FeeMarketHistoryRequest, opts ...grpc.CallOption) (*FeeMarketHistoryResponse, error) {
+	out := new(FeeMarketHistoryResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.gas_estimation.GasEstimator/FeeMarketHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gasEstimatorClient) EstimateBlobGas(ctx context.Context, in *EstimateBlobGasRequest, opts ...grpc.CallOption) (*EstimateBlobGasResponse, error) {
+	out := new(EstimateBlobGasResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.gas_estimation.GasEstimator/EstimateBlobGas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gasEstimatorClient) EstimateInclusion(ctx context.Context, in *EstimateInclusionRequest, opts ...grpc.CallOption) (*EstimateInclusionResponse, error) {
+	out := new(EstimateInclusionResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.gas_estimation.GasEstimator/EstimateInclusion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GasEstimatorServer is the server API for GasEstimator service.
+type GasEstimatorServer interface {
+	// estimateGasPrice takes a transaction priority and estimates the gas price
+	// based on the gas prices of the transactions in the last five blocks. If no
+	// transaction is found in the last five blocks, return the network min gas
+	// price. It's up to the light client to set the gas price in this case to the
+	// minimum gas price set by that node.
+	EstimateGasPrice(context.Context, *EstimateGasPriceRequest) (*EstimateGasPriceResponse, error)
+	// EstimateGasPriceAndUsage takes a transaction priority and a transaction
+	// bytes and estimates the gas price and the gas used for that transaction.
+	// The gas price estimation is based on the gas prices of the transactions in
+	// the last five blocks. If no transaction is found in the last five blocks,
+	// return the network min gas price. It's up to the light client to set the
+	// gas price in this case to the minimum gas price set by that node. The gas
+	// used is estimated using the state machine simulation.
+	EstimateGasPriceAndUsage(context.Context, *EstimateGasPriceAndUsageRequest) (*EstimateGasPriceAndUsageResponse, error)
+	// FeeMarketHistory returns gas price percentiles, block fill ratios and
+	// transaction counts computed from the transactions committed in a window of
+	// recent blocks. PFB and non-PFB transactions are also reported separately.
+	// The counts only cover committed transactions: nodes do not retain which
+	// transactions were evicted from their mempool, so evictions are not
+	// reported.
+	FeeMarketHistory(context.Context, *FeeMarketHistoryRequest) (*FeeMarketHistoryResponse, error)
+	// EstimateBlobGas estimates the gas used and the fee of a PFB paying for
+	// blobs of the provided sizes without requiring a signed transaction. The gas
+	// used is derived from the blob module's gas formula instead of a state
+	// machine simulation, and the gas price follows the same estimation as
+	// EstimateGasPrice.
+	EstimateBlobGas(context.Context, *EstimateBlobGasRequest) (*EstimateBlobGasResponse, error)
+	// EstimateInclusion predicts in how many blocks a PFB paying the provided
+	// gas price for a blob of the provided size would be included. It simulates
+	// packing the current mempool, ordered by gas price, into consecutive
+	// squares of the current maximum size.
+	EstimateInclusion(context.Context, *EstimateInclusionRequest) (*EstimateInclusionResponse, error)
+}
+
+// UnimplementedGasEstimatorServer can be embedded to have forward compatible implementations.
+type UnimplementedGasEstimatorServer struct {
+}
+
+func (*UnimplementedGasEstimatorServer) EstimateGasPrice(ctx context.Context, req *EstimateGasPriceRequest) (*EstimateGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateGasPrice not implemented")
+}
+func (*UnimplementedGasEstimatorServer) EstimateGasPriceAndUsage(ctx context.Context, req *EstimateGasPriceAndUsageRequest) (*EstimateGasPriceAndUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateGasPriceAndUsage not implemented")
+}
+func (*UnimplementedGasEstimatorServer) FeeMarketHistory(ctx context.Context, req *FeeMarketHistoryRequest) (*FeeMarketHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeMarketHistory not implemented")
+}
+func (*UnimplementedGasEstimatorServer) EstimateBlobGas(ctx context.Context, req *EstimateBlobGasRequest) (*EstimateBlobGasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateBlobGas not implemented")
+}
+func (*UnimplementedGasEstimatorServer) EstimateInclusion(ctx context.Context, req *EstimateInclusionRequest) (*EstimateInclusionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateInclusion not implemented")
+}
+
+func RegisterGasEstimatorServer(s grpc1.Server, srv GasEstimatorServer) {
+	s.RegisterService(&_GasEstimator_serviceDesc, srv)
+}
+
+func _GasEstimator_EstimateGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateGasPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GasEstimatorServer).EstimateGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.gas_estimation.GasEstimator/EstimateGasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GasEstimatorServer).EstimateGasPrice(ctx, req.(*EstimateGasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GasEstimator_EstimateGasPriceAndUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateGasPriceAndUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GasEstimatorServer).EstimateGasPriceAndUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.gas_estimation.GasEstimator/EstimateGasPriceAndUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GasEstimatorServer).EstimateGasPriceAndUsage(ctx, req.(*EstimateGasPriceAndUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GasEstimator_FeeMarketHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeeMarketHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GasEstimatorServer).FeeMarketHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.gas_estimation.GasEstimator/FeeMarketHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GasEstimatorServer).FeeMarketHistory(ctx, req.(*FeeMarketHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GasEstimator_EstimateBlobGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateBlobGasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GasEstimatorServer).EstimateBlobGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.gas_estimation.GasEstimator/EstimateBlobGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GasEstimatorServer).EstimateBlobGas(ctx, req.(*EstimateBlobGasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GasEstimator_EstimateInclusion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateInclusionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GasEstimatorServer).EstimateInclusion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.gas_estimation.GasEstimator/EstimateInclusion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GasEstimatorServer).EstimateInclusion(ctx, req.(*EstimateInclusionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var GasEstimator_serviceDesc = _GasEstimator_serviceDesc
+var _GasEstimator_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.core.v1.gas_estimation.GasEstimator",
+	HandlerType: (*GasEstimatorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EstimateGasPrice",
+			Handler:    _GasEstimator_EstimateGasPrice_Handler,
+		},
+		{
+			MethodName: "EstimateGasPriceAndUsage",
+			Handler:    _GasEstimator_EstimateGasPriceAndUsage_Handler,
+		},
+		{
+			MethodName: "FeeMarketHistory",
+			Handler:    _GasEstimator_FeeMarketHistory_Handler,
+		},
+		{
+			MethodName: "EstimateBlobGas",
+			Handler:    _GasEstimator_EstimateBlobGas_Handler,
+		},
+		{
+			MethodName: "EstimateInclusion",
+			Handler:    _GasEstimator_EstimateInclusion_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/core/v1/gas_estimation/gas_estimator.proto",
+}
+
+func (m *EstimateGasPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxPriority != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.TxPriority))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateGasPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EstimatedGasPrice != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.EstimatedGasPrice))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateGasPriceAndUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateGasPriceAndUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateGasPriceAndUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintGasEstimator(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TxPriority != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.TxPriority))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateGasPriceAndUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateGasPriceAndUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateGasPriceAndUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EstimatedGasUsed != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.EstimatedGasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EstimatedGasPrice != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.EstimatedGasPrice))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeMarketHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeMarketHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeMarketHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NumBlocks != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.NumBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPricePercentiles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPricePercentiles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPricePercentiles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.P99 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.P99))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.P90 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.P90))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.P75 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.P75))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.P50 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.P50))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.P25 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.P25))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.P10 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.P10))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxCounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxCounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxCounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecutionFailed != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.ExecutionFailed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Included != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.Included))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockFeeMarket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockFeeMarket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockFeeMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NonPfbTxs != nil {
+		{
+			size, err := m.NonPfbTxs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGasEstimator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PfbTxs != nil {
+		{
+			size, err := m.PfbTxs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGasEstimator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MedianGasPrice != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MedianGasPrice))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.FillRatio != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FillRatio))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.Height != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeMarketHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeMarketHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeMarketHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGasEstimator(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.NonPfbTxs != nil {
+		{
+			size, err := m.NonPfbTxs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGasEstimator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.PfbTxs != nil {
+		{
+			size, err := m.PfbTxs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGasEstimator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.FillRatio != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FillRatio))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.NonPfbGasPrices != nil {
+		{
+			size, err := m.NonPfbGasPrices.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGasEstimator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PfbGasPrices != nil {
+		{
+			size, err := m.PfbGasPrices.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGasEstimator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GasPrices != nil {
+		{
+			size, err := m.GasPrices.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGasEstimator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlobInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ShareVersion != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.ShareVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlobSize != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.BlobSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintGasEstimator(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateBlobGasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateBlobGasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateBlobGasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintGasEstimator(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Blobs) > 0 {
+		for iNdEx := len(m.Blobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGasEstimator(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TxPriority != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.TxPriority))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateBlobGasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateBlobGasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateBlobGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SharesUsed != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.SharesUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EstimatedFee != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.EstimatedFee))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EstimatedGasUsed != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.EstimatedGasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EstimatedGasPrice != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.EstimatedGasPrice))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateInclusionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateInclusionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateInclusionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlobSize != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.BlobSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GasPrice != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.GasPrice))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateInclusionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateInclusionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateInclusionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Headroom != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Headroom))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.BlockOffset != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.BlockOffset))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGasEstimator(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGasEstimator(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EstimateGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxPriority != 0 {
+		n += 1 + sovGasEstimator(uint64(m.TxPriority))
+	}
+	return n
+}
+
+func (m *EstimateGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EstimatedGasPrice != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *EstimateGasPriceAndUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxPriority != 0 {
+		n += 1 + sovGasEstimator(uint64(m.TxPriority))
+	}
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovGasEstimator(uint64(l))
+	}
+	return n
+}
+
+func (m *EstimateGasPriceAndUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EstimatedGasPrice != 0 {
+		n += 9
+	}
+	if m.EstimatedGasUsed != 0 {
+		n += 1 + sovGasEstimator(uint64(m.EstimatedGasUsed))
+	}
+	return n
+}
+
+func (m *FeeMarketHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumBlocks != 0 {
+		n += 1 + sovGasEstimator(uint64(m.NumBlocks))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovGasEstimator(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *GasPricePercentiles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.P10 != 0 {
+		n += 9
+	}
+	if m.P25 != 0 {
+		n += 9
+	}
+	if m.P50 != 0 {
+		n += 9
+	}
+	if m.P75 != 0 {
+		n += 9
+	}
+	if m.P90 != 0 {
+		n += 9
+	}
+	if m.P99 != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *TxCounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Included != 0 {
+		n += 1 + sovGasEstimator(uint64(m.Included))
+	}
+	if m.ExecutionFailed != 0 {
+		n += 1 + sovGasEstimator(uint64(m.ExecutionFailed))
+	}
+	return n
+}
+
+func (m *BlockFeeMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGasEstimator(uint64(m.Height))
+	}
+	if m.FillRatio != 0 {
+		n += 9
+	}
+	if m.MedianGasPrice != 0 {
+		n += 9
+	}
+	if m.PfbTxs != nil {
+		l = m.PfbTxs.Size()
+		n += 1 + l + sovGasEstimator(uint64(l))
+	}
+	if m.NonPfbTxs != nil {
+		l = m.NonPfbTxs.Size()
+		n += 1 + l + sovGasEstimator(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeMarketHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovGasEstimator(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovGasEstimator(uint64(m.EndHeight))
+	}
+	if m.GasPrices != nil {
+		l = m.GasPrices.Size()
+		n += 1 + l + sovGasEstimator(uint64(l))
+	}
+	if m.PfbGasPrices != nil {
+		l = m.PfbGasPrices.Size()
+		n += 1 + l + sovGasEstimator(uint64(l))
+	}
+	if m.NonPfbGasPrices != nil {
+		l = m.NonPfbGasPrices.Size()
+		n += 1 + l + sovGasEstimator(uint64(l))
+	}
+	if m.FillRatio != 0 {
+		n += 9
+	}
+	if m.PfbTxs != nil {
+		l = m.PfbTxs.Size()
+		n += 1 + l + sovGasEstimator(uint64(l))
+	}
+	if m.NonPfbTxs != nil {
+		l = m.NonPfbTxs.Size()
+		n += 1 + l + sovGasEstimator(uint64(l))
+	}
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovGasEstimator(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlobInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovGasEstimator(uint64(l))
+	}
+	if m.BlobSize != 0 {
+		n += 1 + sovGasEstimator(uint64(m.BlobSize))
+	}
+	if m.ShareVersion != 0 {
+		n += 1 + sovGasEstimator(uint64(m.ShareVersion))
+	}
+	return n
+}
+
+func (m *EstimateBlobGasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxPriority != 0 {
+		n += 1 + sovGasEstimator(uint64(m.TxPriority))
+	}
+	if len(m.Blobs) > 0 {
+		for _, e := range m.Blobs {
+			l = e.Size()
+			n += 1 + l + sovGasEstimator(uint64(l))
+		}
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovGasEstimator(uint64(l))
+	}
+	return n
+}
+
+func (m *EstimateBlobGasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EstimatedGasPrice != 0 {
+		n += 9
+	}
+	if m.EstimatedGasUsed != 0 {
+		n += 1 + sovGasEstimator(uint64(m.EstimatedGasUsed))
+	}
+	if m.EstimatedFee != 0 {
+		n += 1 + sovGasEstimator(uint64(m.EstimatedFee))
+	}
+	if m.SharesUsed != 0 {
+		n += 1 + sovGasEstimator(uint64(m.SharesUsed))
+	}
+	return n
+}
+
+func (m *EstimateInclusionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasPrice != 0 {
+		n += 9
+	}
+	if m.BlobSize != 0 {
+		n += 1 + sovGasEstimator(uint64(m.BlobSize))
+	}
+	return n
+}
+
+func (m *EstimateInclusionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockOffset != 0 {
+		n += 1 + sovGasEstimator(uint64(m.BlockOffset))
+	}
+	if m.Headroom != 0 {
+		n += 9
+	}
+	return n
+}
+
+func sovGasEstimator(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGasEstimator(x uint64) (n int) {
+	return sovGasEstimator(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EstimateGasPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateGasPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxPriority", wireType)
+			}
+			m.TxPriority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxPriority |= TxPriority(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateGasPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateGasPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedGasPrice", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.EstimatedGasPrice = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateGasPriceAndUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateGasPriceAndUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateGasPriceAndUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxPriority", wireType)
+			}
+			m.TxPriority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxPriority |= TxPriority(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateGasPriceAndUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateGasPriceAndUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateGasPriceAndUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedGasPrice", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.EstimatedGasPrice = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedGasUsed", wireType)
+			}
+			m.EstimatedGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeMarketHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeMarketHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeMarketHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBlocks", wireType)
+			}
+			m.NumBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPricePercentiles) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPricePercentiles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPricePercentiles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P10", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.P10 = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P25", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.P25 = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P50", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.P50 = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P75", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.P75 = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P90", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.P90 = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P99", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.P99 = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxCounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxCounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxCounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Included", wireType)
+			}
+			m.Included = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Included |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionFailed", wireType)
+			}
+			m.ExecutionFailed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionFailed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockFeeMarket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockFeeMarket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockFeeMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FillRatio", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FillRatio = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MedianGasPrice", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MedianGasPrice = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PfbTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PfbTxs == nil {
+				m.PfbTxs = &TxCounts{}
+			}
+			if err := m.PfbTxs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonPfbTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NonPfbTxs == nil {
+				m.NonPfbTxs = &TxCounts{}
+			}
+			if err := m.NonPfbTxs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeMarketHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeMarketHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeMarketHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasPrices == nil {
+				m.GasPrices = &GasPricePercentiles{}
+			}
+			if err := m.GasPrices.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PfbGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PfbGasPrices == nil {
+				m.PfbGasPrices = &GasPricePercentiles{}
+			}
+			if err := m.PfbGasPrices.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonPfbGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NonPfbGasPrices == nil {
+				m.NonPfbGasPrices = &GasPricePercentiles{}
+			}
+			if err := m.NonPfbGasPrices.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FillRatio", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FillRatio = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PfbTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PfbTxs == nil {
+				m.PfbTxs = &TxCounts{}
+			}
+			if err := m.PfbTxs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonPfbTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NonPfbTxs == nil {
+				m.NonPfbTxs = &TxCounts{}
+			}
+			if err := m.NonPfbTxs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks, &BlockFeeMarket{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobSize", wireType)
+			}
+			m.BlobSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlobSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareVersion", wireType)
+			}
+			m.ShareVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *EstimateGasPriceAndUsageResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EstimatedGasPrice != 0 {
-		n += 9
-	}
-	if m.EstimatedGasUsed != 0 {
-		n += 1 + sovGasEstimator(uint64(m.EstimatedGasUsed))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGasEstimator(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGasEstimator(x uint64) (n int) {
-	return sovGasEstimator(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EstimateGasPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *EstimateBlobGasRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -679,10 +3509,10 @@ func (m *EstimateGasPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateGasPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateBlobGasRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateBlobGasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -704,6 +3534,72 @@ func (m *EstimateGasPriceRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blobs = append(m.Blobs, &BlobInfo{})
+			if err := m.Blobs[len(m.Blobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGasEstimator(dAtA[iNdEx:])
@@ -725,7 +3621,7 @@ func (m *EstimateGasPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateGasPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *EstimateBlobGasResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -748,10 +3644,10 @@ func (m *EstimateGasPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateGasPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateBlobGasResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateBlobGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -765,6 +3661,63 @@ func (m *EstimateGasPriceResponse) Unmarshal(dAtA []byte) error {
 			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
 			m.EstimatedGasPrice = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedGasUsed", wireType)
+			}
+			m.EstimatedGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedFee", wireType)
+			}
+			m.EstimatedFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesUsed", wireType)
+			}
+			m.SharesUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SharesUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGasEstimator(dAtA[iNdEx:])
@@ -786,7 +3739,7 @@ func (m *EstimateGasPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateGasPriceAndUsageRequest) Unmarshal(dAtA []byte) error {
+func (m *EstimateInclusionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -809,36 +3762,28 @@ func (m *EstimateGasPriceAndUsageRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateGasPriceAndUsageRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateInclusionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateGasPriceAndUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateInclusionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxPriority", wireType)
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
 			}
-			m.TxPriority = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGasEstimator
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TxPriority |= TxPriority(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.GasPrice = float64(math.Float64frombits(v))
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobSize", wireType)
 			}
-			var byteLen int
+			m.BlobSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGasEstimator
@@ -848,26 +3793,11 @@ func (m *EstimateGasPriceAndUsageRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.BlobSize |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthGasEstimator
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGasEstimator
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.TxBytes == nil {
-				m.TxBytes = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGasEstimator(dAtA[iNdEx:])
@@ -889,7 +3819,7 @@ func (m *EstimateGasPriceAndUsageRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateGasPriceAndUsageResponse) Unmarshal(dAtA []byte) error {
+func (m *EstimateInclusionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -912,28 +3842,17 @@ func (m *EstimateGasPriceAndUsageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateGasPriceAndUsageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateInclusionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateGasPriceAndUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateInclusionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedGasPrice", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.EstimatedGasPrice = float64(math.Float64frombits(v))
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedGasUsed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOffset", wireType)
 			}
-			m.EstimatedGasUsed = 0
+			m.BlockOffset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGasEstimator
@@ -943,11 +3862,22 @@ func (m *EstimateGasPriceAndUsageResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EstimatedGasUsed |= uint64(b&0x7F) << shift
+				m.BlockOffset |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headroom", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Headroom = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGasEstimator(dAtA[iNdEx:])
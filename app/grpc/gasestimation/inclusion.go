@@ -0,0 +1,226 @@
+package gasestimation
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v7/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v7/x/blob/types"
+	"github.com/celestiaorg/go-square/v3/share"
+	blobtx "github.com/celestiaorg/go-square/v3/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxInclusionSimulationBlocks is the number of blocks simulated before
+	// giving up on predicting the inclusion of a PFB.
+	maxInclusionSimulationBlocks = 50
+	// estimatedPFBGas is the gas limit set on the candidate PFB. It only
+	// affects the size of the candidate transaction.
+	estimatedPFBGas = 1_000_000
+	// signatureSize is the size of a secp256k1 signature. A placeholder
+	// signature is added to the candidate so that its size matches a signed
+	// PFB.
+	signatureSize = 64
+)
+
+// candidateNamespace is the namespace of the blob used to represent the
+// candidate PFB in the simulation. It does not affect the packing.
+var candidateNamespace = share.MustNewV0Namespace(bytes.Repeat([]byte{0xEE}, share.NamespaceVersionZeroIDSize))
+
+// candidateSigner is the signer of the candidate PFB.
+var candidateSigner = sdk.AccAddress(make([]byte, 20)).String()
+
+// squareFillFn is the signature of a function that packs txs, in the provided
+// order, into a square of the current maximum size following the rules of
+// PrepareProposal, including its message count and blob size limits. It
+// returns the txs that were included and the fraction of the square's shares
+// they occupy. The txs are expected to have passed CheckTx.
+type squareFillFn func(txs [][]byte) (included [][]byte, usedRatio float64, err error)
+
+// EstimateInclusion predicts the number of blocks until a PFB paying the
+// requested gas price for a blob of the requested size is included. The
+// current mempool and the candidate are sorted by gas price, highest first,
+// and packed into consecutive squares by the same square builder that
+// PrepareProposal uses. On equal gas prices the candidate is packed last.
+func (s *gasEstimatorServer) EstimateInclusion(ctx context.Context, request *EstimateInclusionRequest) (*EstimateInclusionResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if request.BlobSize == 0 {
+		return nil, status.Error(codes.InvalidArgument, "blob size cannot be zero")
+	}
+	if request.BlobSize > uint32(appconsts.MaxTxSize) {
+		return nil, status.Errorf(codes.InvalidArgument, "blob size %d exceeds the max tx size %d", request.BlobSize, appconsts.MaxTxSize)
+	}
+
+	minGasPrice, err := s.minGasPriceFn()
+	if err != nil {
+		return nil, err
+	}
+	if request.GasPrice < minGasPrice {
+		return nil, status.Errorf(codes.InvalidArgument, "gas price %f is below the minimum gas price %f", request.GasPrice, minGasPrice)
+	}
+
+	candidate, err := newCandidatePFB(request)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// A candidate that is not included in an otherwise empty square never
+	// will be, whatever the state of the mempool.
+	included, _, err := s.squareFillFn([][]byte{candidate})
+	if err != nil {
+		return nil, err
+	}
+	if len(included) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "a PFB for a blob of %d bytes cannot be included in a block with the current max square size", request.BlobSize)
+	}
+
+	// Use -1 to query all the unconfirmed transactions.
+	limit := -1
+	txsResp, err := s.mempoolClient.UnconfirmedTxs(ctx, &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make([]pendingTx, 0, len(txsResp.Txs)+1)
+	for _, rawTx := range txsResp.Txs {
+		gasPrice, _, err := extractGasPrice(s.txDecoder, rawTx)
+		if err != nil {
+			return nil, err
+		}
+		pending = append(pending, pendingTx{rawTx: rawTx, gasPrice: gasPrice})
+	}
+	pending = append(pending, pendingTx{rawTx: candidate, gasPrice: request.GasPrice, isCandidate: true})
+	sort.SliceStable(pending, func(i, j int) bool {
+		return pending[i].gasPrice > pending[j].gasPrice
+	})
+
+	offset, headroom, err := s.simulateInclusion(pending)
+	if err != nil {
+		return nil, err
+	}
+	return &EstimateInclusionResponse{BlockOffset: offset, Headroom: headroom}, nil
+}
+
+// pendingTx is a transaction waiting to be packed in the inclusion
+// simulation.
+type pendingTx struct {
+	rawTx       []byte
+	gasPrice    float64
+	isCandidate bool
+}
+
+// simulateInclusion packs the pending transactions, sorted by priority, into
+// consecutive squares until the candidate is included. It returns the block
+// offset of the candidate and its headroom: the fraction of that square left
+// free once the candidate and every transaction packed before it are
+// accounted for.
+func (s *gasEstimatorServer) simulateInclusion(pending []pendingTx) (uint64, float64, error) {
+	for offset := uint64(1); offset <= maxInclusionSimulationBlocks; offset++ {
+		rawTxs := make([][]byte, len(pending))
+		for i, tx := range pending {
+			rawTxs[i] = tx.rawTx
+		}
+		included, _, err := s.squareFillFn(rawTxs)
+		if err != nil {
+			return 0, 0, err
+		}
+		if len(included) == 0 {
+			// None of the remaining transactions can ever be included.
+			return 0, 0, nil
+		}
+
+		includedKeys := make(map[string]struct{}, len(included))
+		for _, rawTx := range included {
+			includedKeys[txKey(rawTx)] = struct{}{}
+		}
+
+		remaining := make([]pendingTx, 0, len(pending)-len(included))
+		for i, tx := range pending {
+			if _, ok := includedKeys[txKey(tx.rawTx)]; !ok {
+				remaining = append(remaining, tx)
+				continue
+			}
+			if !tx.isCandidate {
+				continue
+			}
+			// Transactions packed after the candidate can not displace it
+			// so only those before it count towards the used space.
+			_, usedRatio, err := s.squareFillFn(rawTxs[:i+1])
+			if err != nil {
+				return 0, 0, err
+			}
+			return offset, max(1-usedRatio, 0), nil
+		}
+		pending = remaining
+	}
+	return 0, 0, nil
+}
+
+// txKey identifies a transaction among the ones returned by a squareFillFn,
+// which re-encodes blob txs.
+func txKey(rawTx []byte) string {
+	if blobTx, isBlob, err := blobtx.UnmarshalBlobTx(rawTx); isBlob && err == nil {
+		return string(blobTx.Tx)
+	}
+	return string(rawTx)
+}
+
+// newCandidatePFB returns an unsigned blob tx paying the requested gas price
+// for a blob of the requested size. Its size matches that of a signed PFB
+// from a single secp256k1 signer.
+func newCandidatePFB(request *EstimateInclusionRequest) ([]byte, error) {
+	blob, err := share.NewV0Blob(candidateNamespace, make([]byte, request.BlobSize))
+	if err != nil {
+		return nil, err
+	}
+	msg, err := blobtypes.NewMsgPayForBlobs(candidateSigner, 0, blob)
+	if err != nil {
+		return nil, err
+	}
+	anyMsg, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+	body, err := (&sdktx.TxBody{Messages: []*codectypes.Any{anyMsg}}).Marshal()
+	if err != nil {
+		return nil, err
+	}
+	fee := sdkmath.NewInt(int64(math.Ceil(request.GasPrice * estimatedPFBGas)))
+	pubKey, err := codectypes.NewAnyWithValue(&secp256k1.PubKey{Key: make([]byte, secp256k1.PubKeySize)})
+	if err != nil {
+		return nil, err
+	}
+	authInfo, err := (&sdktx.AuthInfo{
+		SignerInfos: []*sdktx.SignerInfo{{
+			PublicKey: pubKey,
+			ModeInfo:  &sdktx.ModeInfo{Sum: &sdktx.ModeInfo_Single_{Single: &sdktx.ModeInfo_Single{Mode: signing.SignMode_SIGN_MODE_DIRECT}}},
+		}},
+		Fee: &sdktx.Fee{
+			Amount:   sdk.NewCoins(sdk.NewCoin(appconsts.BondDenom, fee)),
+			GasLimit: estimatedPFBGas,
+		},
+	}).Marshal()
+	if err != nil {
+		return nil, err
+	}
+	txBytes, err := (&sdktx.TxRaw{
+		BodyBytes:     body,
+		AuthInfoBytes: authInfo,
+		Signatures:    [][]byte{make([]byte, signatureSize)},
+	}).Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return blobtx.MarshalBlobTx(txBytes, blob)
+}
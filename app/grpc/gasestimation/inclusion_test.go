@@ -0,0 +1,115 @@
+package gasestimation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v7/pkg/appconsts"
+	square "github.com/celestiaorg/go-square/v3"
+	"github.com/celestiaorg/go-square/v3/share"
+	blobtx "github.com/celestiaorg/go-square/v3/tx"
+	"github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEstimateInclusion(t *testing.T) {
+	const (
+		minGasPrice   = 0.002
+		maxSquareSize = 8
+		// a blob of this size takes about a third of the square's 64 shares
+		// so at most three of them fit in a square.
+		blobSize = 20 * share.ContinuationSparseShareContentSize
+	)
+
+	chain := newMockBlockClient()
+	var mempool []types.Tx
+	for i := range 6 {
+		mempool = append(mempool, chain.newTx(t, fmt.Sprintf("mempool-%d", i), mockTx{gasPrice: 0.1, isPFB: true, blobSize: blobSize}))
+	}
+	mempool = append(mempool, chain.newTx(t, "transfer", mockTx{gasPrice: 0.005}))
+
+	server := &gasEstimatorServer{
+		mempoolClient: newMockMempoolClient(mempool),
+		txDecoder:     chain.decode,
+		minGasPriceFn: func() (float64, error) { return minGasPrice, nil },
+		squareFillFn:  newMockSquareFill(t, maxSquareSize, appconsts.MaxPFBMessages),
+	}
+
+	outbid, err := server.EstimateInclusion(context.Background(), &EstimateInclusionRequest{GasPrice: 1, BlobSize: blobSize})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, outbid.BlockOffset)
+	require.Greater(t, outbid.Headroom, 0.6)
+
+	t.Run("waits for higher priced blobs", func(t *testing.T) {
+		resp, err := server.EstimateInclusion(context.Background(), &EstimateInclusionRequest{GasPrice: 0.01, BlobSize: blobSize})
+		require.NoError(t, err)
+		require.EqualValues(t, 3, resp.BlockOffset)
+	})
+
+	t.Run("fills the space left by larger blobs", func(t *testing.T) {
+		resp, err := server.EstimateInclusion(context.Background(), &EstimateInclusionRequest{GasPrice: 0.01, BlobSize: 100})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, resp.BlockOffset)
+		// packed after the higher priced blobs so more likely to be displaced
+		require.Less(t, resp.Headroom, outbid.Headroom)
+	})
+
+	t.Run("respects the PFB message limit", func(t *testing.T) {
+		limited := *server
+		limited.squareFillFn = newMockSquareFill(t, maxSquareSize, 2)
+		resp, err := limited.EstimateInclusion(context.Background(), &EstimateInclusionRequest{GasPrice: 0.01, BlobSize: 100})
+		require.NoError(t, err)
+		require.EqualValues(t, 4, resp.BlockOffset)
+	})
+
+	t.Run("does not fit in a square", func(t *testing.T) {
+		_, err := server.EstimateInclusion(context.Background(), &EstimateInclusionRequest{GasPrice: 1, BlobSize: 100 * share.ShareSize})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.ErrorContains(t, err, "cannot be included")
+	})
+
+	t.Run("below the min gas price", func(t *testing.T) {
+		_, err := server.EstimateInclusion(context.Background(), &EstimateInclusionRequest{GasPrice: minGasPrice / 2, BlobSize: 100})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("empty blob", func(t *testing.T) {
+		_, err := server.EstimateInclusion(context.Background(), &EstimateInclusionRequest{GasPrice: 1})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+// newMockSquareFill returns a squareFillFn that packs txs like
+// FilteredSquareBuilder, normal txs before blob txs, counting every blob tx as
+// a single PFB message.
+func newMockSquareFill(t *testing.T, maxSquareSize, maxPFBMessages int) squareFillFn {
+	return func(txs [][]byte) ([][]byte, float64, error) {
+		builder, err := square.NewBuilder(maxSquareSize, appconsts.SubtreeRootThreshold)
+		require.NoError(t, err)
+
+		var included, blobTxs [][]byte
+		for _, rawTx := range txs {
+			if _, isBlob, _ := blobtx.UnmarshalBlobTx(rawTx); isBlob {
+				blobTxs = append(blobTxs, rawTx)
+				continue
+			}
+			if builder.AppendTx(rawTx) {
+				included = append(included, rawTx)
+			}
+		}
+		pfbs := 0
+		for _, rawTx := range blobTxs {
+			blobTx, _, err := blobtx.UnmarshalBlobTx(rawTx)
+			require.NoError(t, err)
+			if pfbs == maxPFBMessages || !builder.AppendBlobTx(blobTx) {
+				continue
+			}
+			pfbs++
+			included = append(included, rawTx)
+		}
+		return included, float64(builder.CurrentSize()) / float64(maxSquareSize*maxSquareSize), nil
+	}
+}
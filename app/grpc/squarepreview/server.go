@@ -0,0 +1,44 @@
+package squarepreview
+
+import (
+	"context"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// dryRunSquareFn is the signature of a function that builds a square from the
+// provided txs without committing any state.
+type dryRunSquareFn func(txs [][]byte) (*DryRunSquareResponse, error)
+
+// RegisterSquarePreviewService registers the square preview service on the
+// gRPC router.
+func RegisterSquarePreviewService(qrt gogogrpc.Server, dryRunSquareFn dryRunSquareFn) {
+	RegisterSquarePreviewServer(qrt, NewSquarePreviewServer(dryRunSquareFn))
+}
+
+var _ SquarePreviewServer = &squarePreviewServer{}
+
+type squarePreviewServer struct {
+	dryRunSquareFn dryRunSquareFn
+}
+
+func NewSquarePreviewServer(dryRunSquareFn dryRunSquareFn) SquarePreviewServer {
+	return &squarePreviewServer{dryRunSquareFn: dryRunSquareFn}
+}
+
+// DryRunSquare returns the layout of the square built from the request txs.
+func (s *squarePreviewServer) DryRunSquare(_ context.Context, request *DryRunSquareRequest) (*DryRunSquareResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	resp, err := s.dryRunSquareFn(request.Txs)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
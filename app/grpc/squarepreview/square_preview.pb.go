@@ -0,0 +1,1499 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/core/v1/square_preview/square_preview.proto
+
+package squarepreview
+
+import (
+	context "context"
+	fmt "fmt"
+	tx "github.com/celestiaorg/celestia-app/v7/app/grpc/tx"
+	da "github.com/celestiaorg/celestia-app/v7/proto/celestia/core/v1/da"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DryRunSquareRequest is the request type for the DryRunSquare RPC method.
+type DryRunSquareRequest struct {
+	// txs are the raw transactions, including BlobTxs, in the order in which
+	// they would be proposed.
+	Txs [][]byte `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+}
+
+func (m *DryRunSquareRequest) Reset()         { *m = DryRunSquareRequest{} }
+func (m *DryRunSquareRequest) String() string { return proto.CompactTextString(m) }
+func (*DryRunSquareRequest) ProtoMessage()    {}
+func (*DryRunSquareRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81328d57f37a9306, []int{0}
+}
+func (m *DryRunSquareRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DryRunSquareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DryRunSquareRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DryRunSquareRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DryRunSquareRequest.Merge(m, src)
+}
+func (m *DryRunSquareRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DryRunSquareRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DryRunSquareRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DryRunSquareRequest proto.InternalMessageInfo
+
+func (m *DryRunSquareRequest) GetTxs() [][]byte {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+// DryRunSquareResponse is the response type for the DryRunSquare RPC method.
+type DryRunSquareResponse struct {
+	// square_size is the width of the resulting original data square.
+	SquareSize uint64 `protobuf:"varint,1,opt,name=square_size,json=squareSize,proto3" json:"square_size,omitempty"`
+	// max_square_size is the max effective square size the square was built
+	// with.
+	MaxSquareSize uint64 `protobuf:"varint,2,opt,name=max_square_size,json=maxSquareSize,proto3" json:"max_square_size,omitempty"`
+	// tx_hashes are the hashes of the included transactions, in the order in
+	// which they appear in the square. The hash of a BlobTx is the hash of its
+	// inner sdk.Tx.
+	TxHashes [][]byte `protobuf:"bytes,3,rep,name=tx_hashes,json=txHashes,proto3" json:"tx_hashes,omitempty"`
+	// blobs describes the position of each included blob in the square.
+	Blobs []*BlobLayout `protobuf:"bytes,4,rep,name=blobs,proto3" json:"blobs,omitempty"`
+	// dropped_txs are the transactions that were not included in the square.
+	DroppedTxs []*DroppedTx `protobuf:"bytes,5,rep,name=dropped_txs,json=droppedTxs,proto3" json:"dropped_txs,omitempty"`
+	// dah is the data availability header of the resulting square.
+	Dah *da.DataAvailabilityHeader `protobuf:"bytes,6,opt,name=dah,proto3" json:"dah,omitempty"`
+}
+
+func (m *DryRunSquareResponse) Reset()         { *m = DryRunSquareResponse{} }
+func (m *DryRunSquareResponse) String() string { return proto.CompactTextString(m) }
+func (*DryRunSquareResponse) ProtoMessage()    {}
+func (*DryRunSquareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81328d57f37a9306, []int{1}
+}
+func (m *DryRunSquareResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DryRunSquareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DryRunSquareResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DryRunSquareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DryRunSquareResponse.Merge(m, src)
+}
+func (m *DryRunSquareResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DryRunSquareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DryRunSquareResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DryRunSquareResponse proto.InternalMessageInfo
+
+func (m *DryRunSquareResponse) GetSquareSize() uint64 {
+	if m != nil {
+		return m.SquareSize
+	}
+	return 0
+}
+
+func (m *DryRunSquareResponse) GetMaxSquareSize() uint64 {
+	if m != nil {
+		return m.MaxSquareSize
+	}
+	return 0
+}
+
+func (m *DryRunSquareResponse) GetTxHashes() [][]byte {
+	if m != nil {
+		return m.TxHashes
+	}
+	return nil
+}
+
+func (m *DryRunSquareResponse) GetBlobs() []*BlobLayout {
+	if m != nil {
+		return m.Blobs
+	}
+	return nil
+}
+
+func (m *DryRunSquareResponse) GetDroppedTxs() []*DroppedTx {
+	if m != nil {
+		return m.DroppedTxs
+	}
+	return nil
+}
+
+func (m *DryRunSquareResponse) GetDah() *da.DataAvailabilityHeader {
+	if m != nil {
+		return m.Dah
+	}
+	return nil
+}
+
+// BlobLayout describes the position of a blob in the data square.
+type BlobLayout struct {
+	// tx_hash is the hash of the BlobTx that paid for the blob.
+	TxHash []byte `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// blob_index is the index of the blob in the BlobTx.
+	BlobIndex uint32 `protobuf:"varint,2,opt,name=blob_index,json=blobIndex,proto3" json:"blob_index,omitempty"`
+	// namespace is the namespace of the blob.
+	Namespace []byte `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// share_start_index is the index of the first share of the blob in the
+	// original data square.
+	ShareStartIndex uint32 `protobuf:"varint,4,opt,name=share_start_index,json=shareStartIndex,proto3" json:"share_start_index,omitempty"`
+	// share_length is the number of shares occupied by the blob.
+	ShareLength uint32 `protobuf:"varint,5,opt,name=share_length,json=shareLength,proto3" json:"share_length,omitempty"`
+	// padding is the number of padding shares inserted directly before the
+	// blob to satisfy the blob share commitment rules.
+	Padding uint32 `protobuf:"varint,6,opt,name=padding,proto3" json:"padding,omitempty"`
+}
+
+func (m *BlobLayout) Reset()         { *m = BlobLayout{} }
+func (m *BlobLayout) String() string { return proto.CompactTextString(m) }
+func (*BlobLayout) ProtoMessage()    {}
+func (*BlobLayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81328d57f37a9306, []int{2}
+}
+func (m *BlobLayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobLayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobLayout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlobLayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobLayout.Merge(m, src)
+}
+func (m *BlobLayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobLayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobLayout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlobLayout proto.InternalMessageInfo
+
+func (m *BlobLayout) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *BlobLayout) GetBlobIndex() uint32 {
+	if m != nil {
+		return m.BlobIndex
+	}
+	return 0
+}
+
+func (m *BlobLayout) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *BlobLayout) GetShareStartIndex() uint32 {
+	if m != nil {
+		return m.ShareStartIndex
+	}
+	return 0
+}
+
+func (m *BlobLayout) GetShareLength() uint32 {
+	if m != nil {
+		return m.ShareLength
+	}
+	return 0
+}
+
+func (m *BlobLayout) GetPadding() uint32 {
+	if m != nil {
+		return m.Padding
+	}
+	return 0
+}
+
+// DroppedTx is a transaction that was not included in the square.
+type DroppedTx struct {
+	// tx_hash is the hash of the transaction. The hash of a BlobTx is the hash
+	// of its inner sdk.Tx.
+	TxHash []byte `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// rejection describes why the transaction was dropped. Its height is the
+	// height of the previewed block.
+	Rejection *tx.TxRejection `protobuf:"bytes,2,opt,name=rejection,proto3" json:"rejection,omitempty"`
+}
+
+func (m *DroppedTx) Reset()         { *m = DroppedTx{} }
+func (m *DroppedTx) String() string { return proto.CompactTextString(m) }
+func (*DroppedTx) ProtoMessage()    {}
+func (*DroppedTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81328d57f37a9306, []int{3}
+}
+func (m *DroppedTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DroppedTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DroppedTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DroppedTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DroppedTx.Merge(m, src)
+}
+func (m *DroppedTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *DroppedTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_DroppedTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DroppedTx proto.InternalMessageInfo
+
+func (m *DroppedTx) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *DroppedTx) GetRejection() *tx.TxRejection {
+	if m != nil {
+		return m.Rejection
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DryRunSquareRequest)(nil), "celestia.core.v1.square_preview.DryRunSquareRequest")
+	proto.RegisterType((*DryRunSquareResponse)(nil), "celestia.core.v1.square_preview.DryRunSquareResponse")
+	proto.RegisterType((*BlobLayout)(nil), "celestia.core.v1.square_preview.BlobLayout")
+	proto.RegisterType((*DroppedTx)(nil), "celestia.core.v1.square_preview.DroppedTx")
+}
+
+func init() {
+	proto.RegisterFile("celestia/core/v1/square_preview/square_preview.proto", fileDescriptor_81328d57f37a9306)
+}
+
+var fileDescriptor_81328d57f37a9306 = []byte{
+	// 558 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x18, 0x8c, 0xeb, 0x26, 0x25, 0x5f, 0x12, 0x15, 0x16, 0x24, 0xac, 0x50, 0xdc, 0x90, 0x03, 0x44,
+	0x45, 0xd8, 0x6a, 0x28, 0x17, 0x24, 0x90, 0x5a, 0xe5, 0x50, 0x44, 0x0f, 0x68, 0x93, 0x13, 0x17,
+	0xeb, 0xb3, 0x77, 0x15, 0x1b, 0x39, 0xb6, 0xeb, 0xdd, 0x04, 0xa7, 0x12, 0xaf, 0x80, 0x78, 0x2c,
+	0xa4, 0x5e, 0x7a, 0xe4, 0x88, 0x92, 0x17, 0x41, 0x5e, 0x27, 0x4d, 0x4a, 0x40, 0x85, 0x43, 0xa4,
+	0xdd, 0xd9, 0x99, 0xf9, 0x7e, 0x63, 0x38, 0xf2, 0x78, 0xc8, 0x85, 0x0c, 0xd0, 0xf6, 0xe2, 0x94,
+	0xdb, 0x93, 0x43, 0x5b, 0x9c, 0x8f, 0x31, 0xe5, 0x4e, 0x92, 0xf2, 0x49, 0xc0, 0x3f, 0xff, 0x76,
+	0xb5, 0x92, 0x34, 0x96, 0x31, 0xd9, 0x5f, 0xaa, 0xac, 0x5c, 0x65, 0x4d, 0x0e, 0xad, 0x9b, 0xb4,
+	0x66, 0x77, 0xc3, 0x96, 0xa1, 0xcd, 0x50, 0xa2, 0x83, 0x13, 0x0c, 0x42, 0x74, 0x83, 0x30, 0x90,
+	0x53, 0xc7, 0xe7, 0xc8, 0x78, 0x5a, 0x98, 0x36, 0xf7, 0x36, 0x34, 0x32, 0xb3, 0x65, 0x56, 0xbc,
+	0xb6, 0x9f, 0xc1, 0xfd, 0x5e, 0x3a, 0xa5, 0xe3, 0xa8, 0xaf, 0x22, 0x51, 0x7e, 0x3e, 0xe6, 0x42,
+	0x92, 0xbb, 0xa0, 0xcb, 0x4c, 0x18, 0x5a, 0x4b, 0xef, 0xd4, 0x69, 0x7e, 0x6c, 0x5f, 0x6e, 0xc1,
+	0x83, 0x9b, 0x4c, 0x91, 0xc4, 0x91, 0xe0, 0x64, 0x1f, 0x6a, 0x8b, 0x2c, 0x45, 0x70, 0xc1, 0x0d,
+	0xad, 0xa5, 0x75, 0xb6, 0x29, 0x14, 0x50, 0x3f, 0xb8, 0xe0, 0xe4, 0x29, 0xec, 0x8e, 0x30, 0x73,
+	0xd6, 0x49, 0x5b, 0x8a, 0xd4, 0x18, 0x61, 0xd6, 0x5f, 0xf1, 0x1e, 0x41, 0x55, 0x66, 0x8e, 0x8f,
+	0xc2, 0xe7, 0xc2, 0xd0, 0x55, 0xe4, 0x3b, 0x32, 0x3b, 0x55, 0x77, 0x72, 0x0c, 0x65, 0x37, 0x8c,
+	0x5d, 0x61, 0x6c, 0xb7, 0xf4, 0x4e, 0xad, 0xfb, 0xdc, 0xba, 0xa5, 0x55, 0xd6, 0x49, 0x18, 0xbb,
+	0x67, 0x38, 0x8d, 0xc7, 0x92, 0x16, 0x4a, 0xf2, 0x1e, 0x6a, 0x2c, 0x8d, 0x93, 0x84, 0x33, 0x27,
+	0xaf, 0xad, 0xac, 0x8c, 0x0e, 0x6e, 0x35, 0xea, 0x15, 0x9a, 0x41, 0x46, 0x81, 0x2d, 0x8f, 0x82,
+	0xbc, 0x01, 0x9d, 0xa1, 0x6f, 0x54, 0x5a, 0xda, 0x9f, 0xb3, 0x61, 0x68, 0xf5, 0x50, 0xe2, 0xf1,
+	0xda, 0x58, 0x4e, 0xd5, 0x54, 0x68, 0xae, 0x6b, 0x5f, 0x6a, 0x00, 0xab, 0x0c, 0xc9, 0x43, 0xd8,
+	0x59, 0x94, 0xae, 0xfa, 0x57, 0xa7, 0x95, 0xa2, 0x70, 0xf2, 0x18, 0x20, 0x4f, 0xde, 0x09, 0x22,
+	0xc6, 0x33, 0xd5, 0xb6, 0x06, 0xad, 0xe6, 0xc8, 0xbb, 0x1c, 0x20, 0x7b, 0x50, 0x8d, 0x70, 0xc4,
+	0x45, 0x82, 0x1e, 0x37, 0x74, 0xa5, 0x5c, 0x01, 0xe4, 0x00, 0xee, 0x09, 0x5f, 0xf5, 0x5c, 0x62,
+	0x2a, 0x17, 0x1e, 0xdb, 0xca, 0x63, 0x57, 0x3d, 0xf4, 0x73, 0xbc, 0x70, 0x7a, 0x02, 0xf5, 0x82,
+	0x1b, 0xf2, 0x68, 0x28, 0x7d, 0xa3, 0xac, 0x68, 0x35, 0x85, 0x9d, 0x29, 0x88, 0x18, 0xb0, 0x93,
+	0x20, 0x63, 0x41, 0x34, 0x54, 0x65, 0x37, 0xe8, 0xf2, 0xda, 0x66, 0x50, 0xbd, 0xee, 0xd2, 0xdf,
+	0x6b, 0x79, 0x0b, 0xd5, 0x94, 0x7f, 0xe2, 0x9e, 0x0c, 0xe2, 0x48, 0x95, 0x52, 0xeb, 0xb6, 0x36,
+	0x1b, 0x27, 0x33, 0x6b, 0x90, 0xd1, 0x25, 0x8f, 0xae, 0x24, 0xdd, 0xaf, 0x1a, 0x34, 0x8a, 0x75,
+	0xf9, 0x50, 0x8c, 0x86, 0x7c, 0x81, 0xfa, 0xfa, 0x4a, 0x92, 0xa3, 0x7f, 0x18, 0xe6, 0xc6, 0xae,
+	0x37, 0x5f, 0xfd, 0xa7, 0xaa, 0xd8, 0xfb, 0x76, 0xe9, 0x64, 0xf0, 0x7d, 0x66, 0x6a, 0x57, 0x33,
+	0x53, 0xfb, 0x39, 0x33, 0xb5, 0x6f, 0x73, 0xb3, 0x74, 0x35, 0x37, 0x4b, 0x3f, 0xe6, 0x66, 0xe9,
+	0xe3, 0xeb, 0x61, 0x20, 0xfd, 0xb1, 0x6b, 0x79, 0xf1, 0xc8, 0x5e, 0x9a, 0xc7, 0xe9, 0xf0, 0xfa,
+	0xfc, 0x02, 0x93, 0xc4, 0xce, 0x7f, 0xc3, 0x34, 0xf1, 0x16, 0xdf, 0x82, 0x45, 0x30, 0xb7, 0xa2,
+	0xfe, 0x98, 0x2f, 0x7f, 0x0d, 0x00, 0x80, 0x7a, 0x8e, 0x27, 0x43, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SquarePreviewClient is the client API for SquarePreview service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SquarePreviewClient interface {
+	// DryRunSquare runs the provided transactions through the same filtering and
+	// square construction as PrepareProposal, at the current max effective
+	// square size, and returns the resulting square layout. It is read-only: the
+	// transactions are executed at the height and expected time of the next
+	// block against a cached branch of the latest committed state which is
+	// discarded afterwards. Requests with more transactions or bytes than a block
+	// can hold are rejected.
+	DryRunSquare(ctx context.Context, in *DryRunSquareRequest, opts ...grpc.CallOption) (*DryRunSquareResponse, error)
+}
+
+type squarePreviewClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewSquarePreviewClient(cc grpc1.ClientConn) SquarePreviewClient {
+	return &squarePreviewClient{cc}
+}
+
+func (c *squarePreviewClient) DryRunSquare(ctx context.Context, in *DryRunSquareRequest, opts ...grpc.CallOption) (*DryRunSquareResponse, error) {
+	out := new(DryRunSquareResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.square_preview.SquarePreview/DryRunSquare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SquarePreviewServer is the server API for SquarePreview service.
+type SquarePreviewServer interface {
+	// DryRunSquare runs the provided transactions through the same filtering and
+	// square construction as PrepareProposal, at the current max effective
+	// square size, and returns the resulting square layout. It is read-only: the
+	// transactions are executed at the height and expected time of the next
+	// block against a cached branch of the latest committed state which is
+	// discarded afterwards. Requests with more transactions or bytes than a block
+	// can hold are rejected.
+	DryRunSquare(context.Context, *DryRunSquareRequest) (*DryRunSquareResponse, error)
+}
+
+// UnimplementedSquarePreviewServer can be embedded to have forward compatible implementations.
+type UnimplementedSquarePreviewServer struct {
+}
+
+func (*UnimplementedSquarePreviewServer) DryRunSquare(ctx context.Context, req *DryRunSquareRequest) (*DryRunSquareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunSquare not implemented")
+}
+
+func RegisterSquarePreviewServer(s grpc1.Server, srv SquarePreviewServer) {
+	s.RegisterService(&_SquarePreview_serviceDesc, srv)
+}
+
+func _SquarePreview_DryRunSquare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DryRunSquareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SquarePreviewServer).DryRunSquare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.square_preview.SquarePreview/DryRunSquare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SquarePreviewServer).DryRunSquare(ctx, req.(*DryRunSquareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var SquarePreview_serviceDesc = _SquarePreview_serviceDesc
+var _SquarePreview_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.core.v1.square_preview.SquarePreview",
+	HandlerType: (*SquarePreviewServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DryRunSquare",
+			Handler:    _SquarePreview_DryRunSquare_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/core/v1/square_preview/square_preview.proto",
+}
+
+func (m *DryRunSquareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DryRunSquareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DryRunSquareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Txs[iNdEx])
+			copy(dAtA[i:], m.Txs[iNdEx])
+			i = encodeVarintSquarePreview(dAtA, i, uint64(len(m.Txs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DryRunSquareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DryRunSquareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DryRunSquareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Dah != nil {
+		{
+			size, err := m.Dah.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSquarePreview(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DroppedTxs) > 0 {
+		for iNdEx := len(m.DroppedTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DroppedTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSquarePreview(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Blobs) > 0 {
+		for iNdEx := len(m.Blobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSquarePreview(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TxHashes) > 0 {
+		for iNdEx := len(m.TxHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TxHashes[iNdEx])
+			copy(dAtA[i:], m.TxHashes[iNdEx])
+			i = encodeVarintSquarePreview(dAtA, i, uint64(len(m.TxHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaxSquareSize != 0 {
+		i = encodeVarintSquarePreview(dAtA, i, uint64(m.MaxSquareSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SquareSize != 0 {
+		i = encodeVarintSquarePreview(dAtA, i, uint64(m.SquareSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlobLayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobLayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobLayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Padding != 0 {
+		i = encodeVarintSquarePreview(dAtA, i, uint64(m.Padding))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ShareLength != 0 {
+		i = encodeVarintSquarePreview(dAtA, i, uint64(m.ShareLength))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ShareStartIndex != 0 {
+		i = encodeVarintSquarePreview(dAtA, i, uint64(m.ShareStartIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintSquarePreview(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlobIndex != 0 {
+		i = encodeVarintSquarePreview(dAtA, i, uint64(m.BlobIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintSquarePreview(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DroppedTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DroppedTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DroppedTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rejection != nil {
+		{
+			size, err := m.Rejection.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSquarePreview(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintSquarePreview(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSquarePreview(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSquarePreview(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DryRunSquareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, b := range m.Txs {
+			l = len(b)
+			n += 1 + l + sovSquarePreview(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DryRunSquareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SquareSize != 0 {
+		n += 1 + sovSquarePreview(uint64(m.SquareSize))
+	}
+	if m.MaxSquareSize != 0 {
+		n += 1 + sovSquarePreview(uint64(m.MaxSquareSize))
+	}
+	if len(m.TxHashes) > 0 {
+		for _, b := range m.TxHashes {
+			l = len(b)
+			n += 1 + l + sovSquarePreview(uint64(l))
+		}
+	}
+	if len(m.Blobs) > 0 {
+		for _, e := range m.Blobs {
+			l = e.Size()
+			n += 1 + l + sovSquarePreview(uint64(l))
+		}
+	}
+	if len(m.DroppedTxs) > 0 {
+		for _, e := range m.DroppedTxs {
+			l = e.Size()
+			n += 1 + l + sovSquarePreview(uint64(l))
+		}
+	}
+	if m.Dah != nil {
+		l = m.Dah.Size()
+		n += 1 + l + sovSquarePreview(uint64(l))
+	}
+	return n
+}
+
+func (m *BlobLayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovSquarePreview(uint64(l))
+	}
+	if m.BlobIndex != 0 {
+		n += 1 + sovSquarePreview(uint64(m.BlobIndex))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovSquarePreview(uint64(l))
+	}
+	if m.ShareStartIndex != 0 {
+		n += 1 + sovSquarePreview(uint64(m.ShareStartIndex))
+	}
+	if m.ShareLength != 0 {
+		n += 1 + sovSquarePreview(uint64(m.ShareLength))
+	}
+	if m.Padding != 0 {
+		n += 1 + sovSquarePreview(uint64(m.Padding))
+	}
+	return n
+}
+
+func (m *DroppedTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovSquarePreview(uint64(l))
+	}
+	if m.Rejection != nil {
+		l = m.Rejection.Size()
+		n += 1 + l + sovSquarePreview(uint64(l))
+	}
+	return n
+}
+
+func sovSquarePreview(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSquarePreview(x uint64) (n int) {
+	return sovSquarePreview(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DryRunSquareRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSquarePreview
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DryRunSquareRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DryRunSquareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSquarePreview
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSquarePreview
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSquarePreview
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, make([]byte, postIndex-iNdEx))
+			copy(m.Txs[len(m.Txs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSquarePreview(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSquarePreview
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DryRunSquareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSquarePreview
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DryRunSquareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DryRunSquareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SquareSize", wireType)
+			}
+			m.SquareSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSquarePreview
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SquareSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSquareSize", wireType)
+			}
+			m.MaxSquareSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSquarePreview
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSquareSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHashes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSquarePreview
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSquarePreview
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSquarePreview
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHashes = append(m.TxHashes, make([]byte, postIndex-iNdEx))
+			copy(m.TxHashes[len(m.TxHashes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSquarePreview
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSquarePreview
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSquarePreview
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blobs = append(m.Blobs, &BlobLayout{})
+			if err := m.Blobs[len(m.Blobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DroppedTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSquarePreview
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSquarePreview
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSquarePreview
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DroppedTxs = append(m.DroppedTxs, &DroppedTx{})
+			if err := m.DroppedTxs[len(m.DroppedTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dah", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSquarePreview
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSquarePreview
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSquarePreview
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Dah == nil {
+				m.Dah = &da.DataAvailabilityHeader{}
+			}
+			if err := m.Dah.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSquarePreview(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSquarePreview
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobLayout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSquarePreview
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobLayout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobLayout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSquarePreview
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSquarePreview
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSquarePreview
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobIndex", wireType)
+			}
+			m.BlobIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSquarePreview
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlobIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSquarePreview
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSquarePreview
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSquarePreview
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareStartIndex", wireType)
+			}
+			m.ShareStartIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSquarePreview
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareStartIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareLength", wireType)
+			}
+			m.ShareLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSquarePreview
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Padding", wireType)
+			}
+			m.Padding = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSquarePreview
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Padding |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSquarePreview(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSquarePreview
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DroppedTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSquarePreview
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DroppedTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DroppedTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSquarePreview
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSquarePreview
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSquarePreview
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rejection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSquarePreview
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSquarePreview
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSquarePreview
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rejection == nil {
+				m.Rejection = &tx.TxRejection{}
+			}
+			if err := m.Rejection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSquarePreview(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSquarePreview
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSquarePreview(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSquarePreview
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSquarePreview
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSquarePreview
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSquarePreview
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSquarePreview
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSquarePreview
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSquarePreview        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSquarePreview          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSquarePreview = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,120 @@
+package tx
+
+import (
+	"context"
+	"sync"
+
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxSubscriptions is the maximum number of concurrent SubscribeTxStatus
+	// calls served by a single txServer.
+	maxSubscriptions = 100
+	// blockFeedSubscriber is the subscriber name of the single event bus
+	// subscription shared by all SubscribeTxStatus calls of a txServer. The
+	// node limits the number of subscribers, so calls must not subscribe
+	// individually.
+	blockFeedSubscriber = "celestia-tx-status"
+)
+
+// blockFeed fans out the new block events of a single event bus subscription
+// to all SubscribeTxStatus calls of a txServer.
+type blockFeed struct {
+	mtx         sync.Mutex
+	subscribers map[chan struct{}]struct{}
+	// node and stop are set while the event bus subscription is active, that
+	// is while there are subscribers.
+	node rpcclient.EventsClient
+	stop context.CancelFunc
+}
+
+func newBlockFeed() *blockFeed {
+	return &blockFeed{subscribers: make(map[chan struct{}]struct{})}
+}
+
+// subscribe returns a channel that receives a value whenever the node
+// announces a new block and a function that must be called to unsubscribe.
+// Notifications are coalesced, so a slow subscriber only learns that at least
+// one block was produced. The channel is closed if the event bus subscription
+// is terminated by the node.
+func (f *blockFeed) subscribe(ctx context.Context, node rpcclient.EventsClient) (<-chan struct{}, func(), error) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	if len(f.subscribers) >= maxSubscriptions {
+		return nil, nil, status.Errorf(codes.ResourceExhausted, "maximum of %d tx status subscriptions reached", maxSubscriptions)
+	}
+
+	if f.stop == nil {
+		newBlocks, err := node.Subscribe(ctx, blockFeedSubscriber, cmttypes.EventQueryNewBlockHeader.String())
+		if err != nil {
+			return nil, nil, status.Errorf(codes.Unavailable, "subscribing to new blocks: %s", err)
+		}
+		forwardCtx, stop := context.WithCancel(context.Background())
+		f.node, f.stop = node, stop
+		go f.forward(forwardCtx, newBlocks)
+	}
+
+	notify := make(chan struct{}, 1)
+	f.subscribers[notify] = struct{}{}
+	return notify, func() { f.unsubscribe(notify) }, nil
+}
+
+// unsubscribe removes a subscriber and releases the event bus subscription
+// once the last one is gone.
+func (f *blockFeed) unsubscribe(notify chan struct{}) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	if _, ok := f.subscribers[notify]; !ok {
+		return
+	}
+	delete(f.subscribers, notify)
+	if len(f.subscribers) == 0 && f.stop != nil {
+		f.stop()
+		_ = f.node.UnsubscribeAll(context.Background(), blockFeedSubscriber)
+		f.node, f.stop = nil, nil
+	}
+}
+
+// forward notifies all subscribers of every new block until ctx is cancelled
+// or the node terminates the subscription.
+func (f *blockFeed) forward(ctx context.Context, newBlocks <-chan coretypes.ResultEvent) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case _, ok := <-newBlocks:
+			f.mtx.Lock()
+			if ctx.Err() != nil {
+				// The subscription was released while waiting for the lock.
+				f.mtx.Unlock()
+				return
+			}
+			if !ok {
+				// Close the subscribers' channels so that they fail instead
+				// of waiting forever and let the next call subscribe again.
+				for notify := range f.subscribers {
+					close(notify)
+					delete(f.subscribers, notify)
+				}
+				f.stop()
+				f.node, f.stop = nil, nil
+				f.mtx.Unlock()
+				return
+			}
+			for notify := range f.subscribers {
+				select {
+				case notify <- struct{}{}:
+				default:
+				}
+			}
+			f.mtx.Unlock()
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	"github.com/cometbft/cometbft/rpc/core"
 	"github.com/cosmos/cosmos-sdk/client"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
@@ -13,15 +14,20 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// rejectionFn is the signature of a function that returns the reason the node
+// dropped the tx with txHash while preparing a proposal, if it is retained.
+type rejectionFn func(txHash []byte) (*TxRejection, bool)
+
 // RegisterTxService registers the tx service on the gRPC router.
 func RegisterTxService(
 	qrt gogogrpc.Server,
 	clientCtx client.Context,
 	interfaceRegistry codectypes.InterfaceRegistry,
+	rejectionFn rejectionFn,
 ) {
 	RegisterTxServer(
 		qrt,
-		NewTxServer(clientCtx, interfaceRegistry),
+		NewTxServer(clientCtx, interfaceRegistry, rejectionFn),
 	)
 }
 
@@ -34,18 +40,39 @@ func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.Serv
 	}
 }
 
+// maxSubscribedTxs is the maximum number of transactions that a single
+// SubscribeTxStatus call can follow. It matches the limit of TxStatusBatch.
+const maxSubscribedTxs = 20
+
 var _ TxServer = &txServer{}
 
 type txServer struct {
 	clientCtx         client.Context
 	interfaceRegistry codectypes.InterfaceRegistry
+	rejectionFn       rejectionFn
+	blocks            *blockFeed
 }
 
-func NewTxServer(clientCtx client.Context, interfaceRegistry codectypes.InterfaceRegistry) TxServer {
+func NewTxServer(clientCtx client.Context, interfaceRegistry codectypes.InterfaceRegistry, rejectionFn rejectionFn) TxServer {
 	return &txServer{
 		clientCtx:         clientCtx,
 		interfaceRegistry: interfaceRegistry,
+		rejectionFn:       rejectionFn,
+		blocks:            newBlockFeed(),
+	}
+}
+
+// rejection returns the reason the node dropped the tx with txHash while
+// preparing a proposal. Rejections of committed txs are not returned.
+func (s *txServer) rejection(txHash []byte, txStatus string) *TxRejection {
+	if s.rejectionFn == nil || txStatus == core.TxStatusCommitted {
+		return nil
+	}
+	rejection, ok := s.rejectionFn(txHash)
+	if !ok {
+		return nil
 	}
+	return rejection
 }
 
 // TxStatus implements the TxServer.TxStatus method proxying to the underlying celestia-core RPC server
@@ -88,6 +115,7 @@ func (s *txServer) TxStatus(ctx context.Context, req *TxStatusRequest) (*TxStatu
 		GasUsed:       resTx.GasUsed,
 		Codespace:     resTx.Codespace,
 		Signers:       resTx.Signers,
+		Rejection:     s.rejection(txID, resTx.Status),
 	}, nil
 }
 
@@ -142,6 +170,7 @@ func (s *txServer) TxStatusBatch(ctx context.Context, req *TxStatusBatchRequest)
 				GasUsed:       status.Result.GasUsed,
 				Codespace:     status.Result.Codespace,
 				Signers:       status.Result.Signers,
+				Rejection:     s.rejection(txIDs[i], status.Result.Status),
 			},
 		}
 	}
@@ -150,3 +179,124 @@ func (s *txServer) TxStatusBatch(ctx context.Context, req *TxStatusBatchRequest)
 		Statuses: responses,
 	}, nil
 }
+
+// SubscribeTxStatus implements the TxServer.SubscribeTxStatus method. Instead
+// of requiring clients to poll, it re-checks the status of the requested
+// transactions whenever the node's event bus announces a new block.
+func (s *txServer) SubscribeTxStatus(req *SubscribeTxStatusRequest, stream Tx_SubscribeTxStatusServer) error {
+	return s.subscribeTxStatus(stream.Context(), req, stream.Send)
+}
+
+// subscribeTxStatus sends the status of each requested transaction and any
+// subsequent transition to send until all transactions are final or ctx is
+// done.
+func (s *txServer) subscribeTxStatus(ctx context.Context, req *SubscribeTxStatusRequest, send func(*TxStatusResult) error) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	if len(req.TxIds) == 0 {
+		return status.Error(codes.InvalidArgument, "tx ids cannot be empty")
+	}
+
+	if len(req.TxIds) > maxSubscribedTxs {
+		return status.Errorf(codes.InvalidArgument, "maximum of %d tx ids allowed", maxSubscribedTxs)
+	}
+
+	txIDs := make(map[string][]byte, len(req.TxIds))
+	// requested maps the hash of every transaction, as identified in the
+	// node's response, to its tx id as requested.
+	requested := make(map[string]string, len(req.TxIds))
+	for _, txId := range req.TxIds {
+		txID, err := hex.DecodeString(txId)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid tx id: %s", err)
+		}
+		if _, ok := requested[string(txID)]; ok {
+			continue
+		}
+		txIDs[txId] = txID
+		requested[string(txID)] = txId
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return err
+	}
+
+	nodeTxStatus, ok := node.(rpcclient.SignClient)
+	if !ok {
+		return status.Error(codes.Unimplemented, "node does not support tx status")
+	}
+
+	nodeEvents, ok := node.(rpcclient.EventsClient)
+	if !ok {
+		return status.Error(codes.Unimplemented, "node does not support event subscriptions")
+	}
+
+	newBlocks, unsubscribe, err := s.blocks.subscribe(ctx, nodeEvents)
+	if err != nil {
+		return err
+	}
+	defer unsubscribe()
+
+	lastStatus := make(map[string]string, len(txIDs))
+	for {
+		hashes := make([][]byte, 0, len(txIDs))
+		for _, txID := range txIDs {
+			hashes = append(hashes, txID)
+		}
+
+		statuses, err := nodeTxStatus.TxStatusBatch(ctx, hashes)
+		if err != nil {
+			return err
+		}
+
+		for _, txStatus := range statuses.Statuses {
+			txId, ok := requested[string(txStatus.Hash)]
+			if !ok {
+				continue
+			}
+			if lastStatus[txId] == txStatus.Result.Status {
+				continue
+			}
+			lastStatus[txId] = txStatus.Result.Status
+
+			err := send(&TxStatusResult{
+				TxHash: txId,
+				Status: &TxStatusResponse{
+					Height:        txStatus.Result.Height,
+					Index:         txStatus.Result.Index,
+					ExecutionCode: txStatus.Result.ExecutionCode,
+					Error:         txStatus.Result.Error,
+					Status:        txStatus.Result.Status,
+					GasWanted:     txStatus.Result.GasWanted,
+					GasUsed:       txStatus.Result.GasUsed,
+					Codespace:     txStatus.Result.Codespace,
+					Signers:       txStatus.Result.Signers,
+					Rejection:     s.rejection(txStatus.Hash, txStatus.Result.Status),
+				},
+			})
+			if err != nil {
+				return err
+			}
+
+			if txStatus.Result.Status == core.TxStatusCommitted || txStatus.Result.Status == core.TxStatusRejected {
+				delete(txIDs, txId)
+			}
+		}
+
+		if len(txIDs) == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case _, ok := <-newBlocks:
+			if !ok {
+				return status.Error(codes.Unavailable, "new block subscription terminated by the node")
+			}
+		}
+	}
+}
@@ -0,0 +1,289 @@
+package tx
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	"github.com/cometbft/cometbft/rpc/core"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/gogoproto/jsonpb"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeNode serves tx statuses from memory and lets the test trigger new block
+// events.
+type fakeNode struct {
+	rpcclient.Client
+
+	mtx      sync.Mutex
+	statuses map[string]coretypes.ResultTxStatus
+	// reversed makes TxStatusBatch answer in the reverse order of the request.
+	reversed      bool
+	blocks        chan coretypes.ResultEvent
+	subscriptions int
+	unsubscribed  bool
+}
+
+func newFakeNode() *fakeNode {
+	return &fakeNode{
+		statuses: make(map[string]coretypes.ResultTxStatus),
+		blocks:   make(chan coretypes.ResultEvent),
+	}
+}
+
+func (n *fakeNode) setStatus(txID string, result coretypes.ResultTxStatus) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	n.statuses[txID] = result
+}
+
+func (n *fakeNode) TxStatusBatch(_ context.Context, hashes [][]byte) (*coretypes.ResultTxStatusBatch, error) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	resp := &coretypes.ResultTxStatusBatch{}
+	for _, hash := range hashes {
+		result, ok := n.statuses[hex.EncodeToString(hash)]
+		if !ok {
+			result = coretypes.ResultTxStatus{Status: core.TxStatusUnknown}
+		}
+		resp.Statuses = append(resp.Statuses, coretypes.TxStatusResponse{Hash: hash, Result: result})
+	}
+	if n.reversed {
+		slices.Reverse(resp.Statuses)
+	}
+	return resp, nil
+}
+
+func (n *fakeNode) Subscribe(context.Context, string, string, ...int) (<-chan coretypes.ResultEvent, error) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	n.subscriptions++
+	n.unsubscribed = false
+	return n.blocks, nil
+}
+
+func (n *fakeNode) UnsubscribeAll(context.Context, string) error {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	n.unsubscribed = true
+	return nil
+}
+
+func TestSubscribeTxStatus(t *testing.T) {
+	node := newFakeNode()
+	node.reversed = true
+	server := &txServer{clientCtx: client.Context{}.WithClient(node), blocks: newBlockFeed()}
+
+	committed := hex.EncodeToString(make([]byte, 32))
+	rejected := hex.EncodeToString([]byte{1, 2, 3, 4})
+	node.setStatus(committed, coretypes.ResultTxStatus{Status: core.TxStatusPending})
+	node.setStatus(rejected, coretypes.ResultTxStatus{Status: core.TxStatusPending})
+
+	results := make(chan *TxStatusResult, 10)
+	errC := make(chan error, 1)
+	go func() {
+		errC <- server.subscribeTxStatus(context.Background(), &SubscribeTxStatusRequest{TxIds: []string{committed, rejected}}, func(result *TxStatusResult) error {
+			results <- result
+			return nil
+		})
+	}()
+
+	receive := func() *TxStatusResult {
+		select {
+		case result := <-results:
+			return result
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for status update")
+			return nil
+		}
+	}
+
+	initial := map[string]string{}
+	for range 2 {
+		result := receive()
+		initial[result.TxHash] = result.Status.Status
+	}
+	require.Equal(t, map[string]string{committed: core.TxStatusPending, rejected: core.TxStatusPending}, initial)
+
+	// a block without transitions produces no updates
+	node.blocks <- coretypes.ResultEvent{}
+	node.setStatus(committed, coretypes.ResultTxStatus{Status: core.TxStatusCommitted, Height: 7})
+	node.blocks <- coretypes.ResultEvent{}
+	result := receive()
+	require.Equal(t, committed, result.TxHash)
+	require.Equal(t, core.TxStatusCommitted, result.Status.Status)
+	require.EqualValues(t, 7, result.Status.Height)
+
+	node.setStatus(rejected, coretypes.ResultTxStatus{Status: core.TxStatusRejected, ExecutionCode: 11})
+	node.blocks <- coretypes.ResultEvent{}
+	result = receive()
+	require.Equal(t, rejected, result.TxHash)
+	require.EqualValues(t, 11, result.Status.ExecutionCode)
+
+	require.NoError(t, <-errC)
+	require.Empty(t, results)
+	node.mtx.Lock()
+	require.True(t, node.unsubscribed)
+	node.mtx.Unlock()
+}
+
+func TestSubscribeTxStatusValidation(t *testing.T) {
+	server := &txServer{clientCtx: client.Context{}.WithClient(newFakeNode()), blocks: newBlockFeed()}
+	noop := func(*TxStatusResult) error { return nil }
+
+	tooMany := make([]string, maxSubscribedTxs+1)
+	for i := range tooMany {
+		tooMany[i] = "00"
+	}
+
+	for _, req := range []*SubscribeTxStatusRequest{nil, {}, {TxIds: tooMany}, {TxIds: []string{"zz"}}} {
+		err := server.subscribeTxStatus(context.Background(), req, noop)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
+
+func TestBlockFeed(t *testing.T) {
+	node := newFakeNode()
+	feed := newBlockFeed()
+
+	notifications := make([]<-chan struct{}, 0, maxSubscriptions)
+	unsubscribes := make([]func(), 0, maxSubscriptions)
+	for range maxSubscriptions {
+		notify, unsubscribe, err := feed.subscribe(context.Background(), node)
+		require.NoError(t, err)
+		notifications = append(notifications, notify)
+		unsubscribes = append(unsubscribes, unsubscribe)
+	}
+	_, _, err := feed.subscribe(context.Background(), node)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	node.blocks <- coretypes.ResultEvent{}
+	for _, notify := range notifications {
+		select {
+		case <-notify:
+		case <-time.After(5 * time.Second):
+			t.Fatal("subscriber was not notified of the new block")
+		}
+	}
+
+	for _, unsubscribe := range unsubscribes {
+		unsubscribe()
+	}
+	node.mtx.Lock()
+	require.Equal(t, 1, node.subscriptions)
+	require.True(t, node.unsubscribed)
+	node.mtx.Unlock()
+
+	// the node's subscription is taken again by the next subscriber
+	_, unsubscribe, err := feed.subscribe(context.Background(), node)
+	require.NoError(t, err)
+	unsubscribe()
+	require.Equal(t, 2, node.subscriptions)
+}
+
+func TestSubscribeTxStatusWebsocket(t *testing.T) {
+	node := newFakeNode()
+	txID := hex.EncodeToString([]byte{1})
+	node.setStatus(txID, coretypes.ResultTxStatus{Status: core.TxStatusPending})
+
+	service := NewTxServer(client.Context{}.WithClient(node), nil, nil)
+	router := mux.NewRouter()
+	RegisterWebsocketRoutes(service, router, false)
+	server := httptest.NewServer(router)
+	defer server.Close()
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + SubscribeTxStatusRoute + "?tx_ids=" + txID
+
+	t.Run("cross origin connections are rejected", func(t *testing.T) {
+		_, resp, err := websocket.DefaultDialer.Dial(url, http.Header{"Origin": {"https://example.com"}})
+		require.Error(t, err)
+		require.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("closing the connection ends the subscription", func(t *testing.T) {
+		conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+		require.NoError(t, err)
+
+		var result TxStatusResult
+		_, bz, err := conn.ReadMessage()
+		require.NoError(t, err)
+		require.NoError(t, jsonpb.UnmarshalString(string(bz), &result))
+		require.Equal(t, core.TxStatusPending, result.Status.Status)
+
+		require.NoError(t, conn.Close())
+		require.Eventually(t, func() bool {
+			node.mtx.Lock()
+			defer node.mtx.Unlock()
+			return node.unsubscribed
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("shares the event bus subscription with the gRPC service", func(t *testing.T) {
+		node.mtx.Lock()
+		subscriptions := node.subscriptions
+		node.mtx.Unlock()
+
+		conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+		require.NoError(t, err)
+		defer conn.Close()
+		_, _, err = conn.ReadMessage()
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		results := make(chan *TxStatusResult, 1)
+		go func() {
+			_ = service.(*txServer).subscribeTxStatus(ctx, &SubscribeTxStatusRequest{TxIds: []string{txID}}, func(result *TxStatusResult) error {
+				results <- result
+				return nil
+			})
+		}()
+		select {
+		case <-results:
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for status update")
+		}
+
+		node.mtx.Lock()
+		defer node.mtx.Unlock()
+		require.Equal(t, subscriptions+1, node.subscriptions)
+	})
+}
+
+func TestTxStatusBatchRejection(t *testing.T) {
+	node := newFakeNode()
+	pending := hex.EncodeToString([]byte{1})
+	committed := hex.EncodeToString([]byte{2})
+	unknown := hex.EncodeToString([]byte{3})
+	node.setStatus(pending, coretypes.ResultTxStatus{Status: core.TxStatusPending})
+	node.setStatus(committed, coretypes.ResultTxStatus{Status: core.TxStatusCommitted})
+
+	rejection := &TxRejection{Reason: RejectionReason_REJECTION_REASON_SQUARE_FULL, Height: 5}
+	rejectionFn := func(txHash []byte) (*TxRejection, bool) {
+		if hex.EncodeToString(txHash) == unknown {
+			return nil, false
+		}
+		return rejection, true
+	}
+	server := &txServer{clientCtx: client.Context{}.WithClient(node), rejectionFn: rejectionFn}
+
+	resp, err := server.TxStatusBatch(context.Background(), &TxStatusBatchRequest{TxIds: []string{pending, committed, unknown}})
+	require.NoError(t, err)
+	require.Len(t, resp.Statuses, 3)
+	require.Equal(t, rejection, resp.Statuses[0].Status.Rejection)
+	// the rejection of a committed tx is stale so it is not returned
+	require.Nil(t, resp.Statuses[1].Status.Rejection)
+	require.Nil(t, resp.Statuses[2].Status.Rejection)
+}
@@ -28,6 +28,62 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RejectionReason is the reason a transaction was dropped while preparing a
+// proposal.
+type RejectionReason int32
+
+const (
+	// REJECTION_REASON_UNSPECIFIED is the default value.
+	RejectionReason_REJECTION_REASON_UNSPECIFIED RejectionReason = 0
+	// REJECTION_REASON_DECODE_ERROR the transaction could not be decoded.
+	RejectionReason_REJECTION_REASON_DECODE_ERROR RejectionReason = 1
+	// REJECTION_REASON_TX_TOO_LARGE the transaction exceeds the max tx size.
+	RejectionReason_REJECTION_REASON_TX_TOO_LARGE RejectionReason = 2
+	// REJECTION_REASON_SQUARE_FULL the transaction does not fit in the square.
+	RejectionReason_REJECTION_REASON_SQUARE_FULL RejectionReason = 3
+	// REJECTION_REASON_TOO_MANY_PFB_MESSAGES the max number of PFB messages per
+	// block was reached.
+	RejectionReason_REJECTION_REASON_TOO_MANY_PFB_MESSAGES RejectionReason = 4
+	// REJECTION_REASON_TOO_MANY_NON_PFB_MESSAGES the max number of non-PFB
+	// messages per block was reached.
+	RejectionReason_REJECTION_REASON_TOO_MANY_NON_PFB_MESSAGES RejectionReason = 5
+	// REJECTION_REASON_INVALID_BLOBS the blobs of the transaction are invalid.
+	RejectionReason_REJECTION_REASON_INVALID_BLOBS RejectionReason = 6
+	// REJECTION_REASON_ANTE_FAILURE the transaction failed the ante handler. The
+	// ABCI code and codespace of the failure are set on the rejection.
+	RejectionReason_REJECTION_REASON_ANTE_FAILURE RejectionReason = 7
+)
+
+var RejectionReason_name = map[int32]string{
+	0: "REJECTION_REASON_UNSPECIFIED",
+	1: "REJECTION_REASON_DECODE_ERROR",
+	2: "REJECTION_REASON_TX_TOO_LARGE",
+	3: "REJECTION_REASON_SQUARE_FULL",
+	4: "REJECTION_REASON_TOO_MANY_PFB_MESSAGES",
+	5: "REJECTION_REASON_TOO_MANY_NON_PFB_MESSAGES",
+	6: "REJECTION_REASON_INVALID_BLOBS",
+	7: "REJECTION_REASON_ANTE_FAILURE",
+}
+
+var RejectionReason_value = map[string]int32{
+	"REJECTION_REASON_UNSPECIFIED":               0,
+	"REJECTION_REASON_DECODE_ERROR":              1,
+	"REJECTION_REASON_TX_TOO_LARGE":              2,
+	"REJECTION_REASON_SQUARE_FULL":               3,
+	"REJECTION_REASON_TOO_MANY_PFB_MESSAGES":     4,
+	"REJECTION_REASON_TOO_MANY_NON_PFB_MESSAGES": 5,
+	"REJECTION_REASON_INVALID_BLOBS":             6,
+	"REJECTION_REASON_ANTE_FAILURE":              7,
+}
+
+func (x RejectionReason) String() string {
+	return proto.EnumName(RejectionReason_name, int32(x))
+}
+
+func (RejectionReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{0}
+}
+
 // TxStatusRequest is the request type for the TxStatus gRPC method.
 type TxStatusRequest struct {
 	// this is the hex encoded transaction hash (should be 64 characters long representing 32 bytes)
@@ -94,6 +150,12 @@ type TxStatusResponse struct {
 	GasUsed int64 `protobuf:"varint,8,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
 	// signers of the transaction.
 	Signers []string `protobuf:"bytes,9,rep,name=signers,proto3" json:"signers,omitempty"`
+	// rejection is the most recent reason this node dropped the transaction
+	// while preparing a proposal. It is only set if the transaction is not
+	// committed and the node still retains the record. Rejections are recorded
+	// in PrepareProposal, so only a node that proposed a block while the
+	// transaction was in its mempool, i.e. a validator, can set it.
+	Rejection *TxRejection `protobuf:"bytes,10,opt,name=rejection,proto3" json:"rejection,omitempty"`
 }
 
 func (m *TxStatusResponse) Reset()         { *m = TxStatusResponse{} }
@@ -192,6 +254,96 @@ func (m *TxStatusResponse) GetSigners() []string {
 	return nil
 }
 
+func (m *TxStatusResponse) GetRejection() *TxRejection {
+	if m != nil {
+		return m.Rejection
+	}
+	return nil
+}
+
+// TxRejection describes why a transaction was dropped while preparing a
+// proposal.
+type TxRejection struct {
+	// reason is the reason the transaction was dropped.
+	Reason RejectionReason `protobuf:"varint,1,opt,name=reason,proto3,enum=celestia.core.v1.tx.RejectionReason" json:"reason,omitempty"`
+	// height is the height of the proposal the transaction was dropped from.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// code is the ABCI error code of an ante handler failure.
+	Code uint32 `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	// codespace is the ABCI error codespace of an ante handler failure.
+	Codespace string `protobuf:"bytes,4,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	// log describes the failure.
+	Log string `protobuf:"bytes,5,opt,name=log,proto3" json:"log,omitempty"`
+}
+
+func (m *TxRejection) Reset()         { *m = TxRejection{} }
+func (m *TxRejection) String() string { return proto.CompactTextString(m) }
+func (*TxRejection) ProtoMessage()    {}
+func (*TxRejection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{2}
+}
+func (m *TxRejection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxRejection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxRejection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxRejection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxRejection.Merge(m, src)
+}
+func (m *TxRejection) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxRejection) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxRejection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxRejection proto.InternalMessageInfo
+
+func (m *TxRejection) GetReason() RejectionReason {
+	if m != nil {
+		return m.Reason
+	}
+	return RejectionReason_REJECTION_REASON_UNSPECIFIED
+}
+
+func (m *TxRejection) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TxRejection) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *TxRejection) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
+	}
+	return ""
+}
+
+func (m *TxRejection) GetLog() string {
+	if m != nil {
+		return m.Log
+	}
+	return ""
+}
+
 // TxStatusBatchRequest is the request type for the batch TxStatus gRPC method.
 type TxStatusBatchRequest struct {
 	// array of hex encoded tx hashes (each hash should be 64 characters long representing 32 bytes)
@@ -202,7 +354,7 @@ func (m *TxStatusBatchRequest) Reset()         { *m = TxStatusBatchRequest{} }
 func (m *TxStatusBatchRequest) String() string { return proto.CompactTextString(m) }
 func (*TxStatusBatchRequest) ProtoMessage()    {}
 func (*TxStatusBatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d8b070565b0dcb6, []int{2}
+	return fileDescriptor_7d8b070565b0dcb6, []int{3}
 }
 func (m *TxStatusBatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -248,7 +400,7 @@ func (m *TxStatusResult) Reset()         { *m = TxStatusResult{} }
 func (m *TxStatusResult) String() string { return proto.CompactTextString(m) }
 func (*TxStatusResult) ProtoMessage()    {}
 func (*TxStatusResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d8b070565b0dcb6, []int{3}
+	return fileDescriptor_7d8b070565b0dcb6, []int{4}
 }
 func (m *TxStatusResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -301,7 +453,7 @@ func (m *TxStatusBatchResponse) Reset()         { *m = TxStatusBatchResponse{} }
 func (m *TxStatusBatchResponse) String() string { return proto.CompactTextString(m) }
 func (*TxStatusBatchResponse) ProtoMessage()    {}
 func (*TxStatusBatchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d8b070565b0dcb6, []int{4}
+	return fileDescriptor_7d8b070565b0dcb6, []int{5}
 }
 func (m *TxStatusBatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -337,52 +489,119 @@ func (m *TxStatusBatchResponse) GetStatuses() []*TxStatusResult {
 	return nil
 }
 
+// SubscribeTxStatusRequest is the request type for the SubscribeTxStatus gRPC
+// method.
+type SubscribeTxStatusRequest struct {
+	// array of hex encoded tx hashes (each hash should be 64 characters long representing 32 bytes)
+	TxIds []string `protobuf:"bytes,1,rep,name=tx_ids,json=txIds,proto3" json:"tx_ids,omitempty"`
+}
+
+func (m *SubscribeTxStatusRequest) Reset()         { *m = SubscribeTxStatusRequest{} }
+func (m *SubscribeTxStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeTxStatusRequest) ProtoMessage()    {}
+func (*SubscribeTxStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{6}
+}
+func (m *SubscribeTxStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeTxStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeTxStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeTxStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeTxStatusRequest.Merge(m, src)
+}
+func (m *SubscribeTxStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeTxStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeTxStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeTxStatusRequest proto.InternalMessageInfo
+
+func (m *SubscribeTxStatusRequest) GetTxIds() []string {
+	if m != nil {
+		return m.TxIds
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("celestia.core.v1.tx.RejectionReason", RejectionReason_name, RejectionReason_value)
 	proto.RegisterType((*TxStatusRequest)(nil), "celestia.core.v1.tx.TxStatusRequest")
 	proto.RegisterType((*TxStatusResponse)(nil), "celestia.core.v1.tx.TxStatusResponse")
+	proto.RegisterType((*TxRejection)(nil), "celestia.core.v1.tx.TxRejection")
 	proto.RegisterType((*TxStatusBatchRequest)(nil), "celestia.core.v1.tx.TxStatusBatchRequest")
 	proto.RegisterType((*TxStatusResult)(nil), "celestia.core.v1.tx.TxStatusResult")
 	proto.RegisterType((*TxStatusBatchResponse)(nil), "celestia.core.v1.tx.TxStatusBatchResponse")
+	proto.RegisterType((*SubscribeTxStatusRequest)(nil), "celestia.core.v1.tx.SubscribeTxStatusRequest")
 }
 
 func init() { proto.RegisterFile("celestia/core/v1/tx/tx.proto", fileDescriptor_7d8b070565b0dcb6) }
 
 var fileDescriptor_7d8b070565b0dcb6 = []byte{
-	// 530 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4f, 0x6f, 0xd3, 0x4e,
-	0x10, 0x8d, 0x93, 0xe6, 0xdf, 0x54, 0xe9, 0xef, 0xa7, 0x6d, 0x0b, 0x4b, 0x14, 0x4c, 0x64, 0x1a,
-	0x14, 0x2a, 0xd5, 0x56, 0xc3, 0x0d, 0x09, 0x21, 0x95, 0x0b, 0xbd, 0x9a, 0x22, 0x10, 0x97, 0x68,
-	0x63, 0xaf, 0x6c, 0x4b, 0xc1, 0x6b, 0xbc, 0xe3, 0xb2, 0x12, 0xea, 0x85, 0x0b, 0x27, 0x24, 0xa4,
-	0x7e, 0x29, 0x8e, 0x95, 0xb8, 0x70, 0x44, 0x09, 0x1f, 0x04, 0xed, 0x26, 0x4e, 0x4b, 0x15, 0xd1,
-	0x1e, 0x2c, 0x79, 0xe6, 0xbd, 0x99, 0x79, 0xf3, 0xc6, 0x86, 0x5e, 0xc0, 0xa7, 0x5c, 0x62, 0xc2,
-	0xbc, 0x40, 0xe4, 0xdc, 0x3b, 0x3d, 0xf4, 0x50, 0x79, 0xa8, 0xdc, 0x2c, 0x17, 0x28, 0xc8, 0x76,
-	0x89, 0xba, 0x1a, 0x75, 0x4f, 0x0f, 0x5d, 0x54, 0xdd, 0x5e, 0x24, 0x44, 0x34, 0xe5, 0x1e, 0xcb,
-	0x12, 0x8f, 0xa5, 0xa9, 0x40, 0x86, 0x89, 0x48, 0xe5, 0xa2, 0xc4, 0x79, 0x04, 0xff, 0x9d, 0xa8,
-	0x57, 0xc8, 0xb0, 0x90, 0x3e, 0xff, 0x50, 0x70, 0x89, 0x64, 0x1b, 0xea, 0xa8, 0xc6, 0x49, 0x48,
-	0xad, 0xbe, 0x35, 0x6c, 0xfb, 0x1b, 0xa8, 0x8e, 0x43, 0xe7, 0x4b, 0x15, 0xfe, 0xbf, 0x24, 0xca,
-	0x4c, 0xa4, 0x92, 0x93, 0x3b, 0xd0, 0x88, 0x79, 0x12, 0xc5, 0x68, 0xa8, 0x35, 0x7f, 0x19, 0x91,
-	0x1d, 0xa8, 0x27, 0x69, 0xc8, 0x15, 0xad, 0xf6, 0xad, 0x61, 0xc7, 0x5f, 0x04, 0x64, 0x00, 0x5b,
-	0x5c, 0xf1, 0xa0, 0xd0, 0xe3, 0xc7, 0x81, 0x08, 0x39, 0xad, 0x19, 0xb8, 0xb3, 0xca, 0xbe, 0x10,
-	0x21, 0xd7, 0xc5, 0x3c, 0xcf, 0x45, 0x4e, 0x37, 0xcc, 0xf8, 0x45, 0xa0, 0x47, 0x49, 0x33, 0x9c,
-	0xd6, 0x4d, 0x7a, 0x19, 0x91, 0x1e, 0xb4, 0x75, 0x2b, 0x99, 0xb1, 0x80, 0xd3, 0x86, 0x81, 0x2e,
-	0x13, 0xe4, 0x3e, 0x40, 0xc4, 0xe4, 0xf8, 0x23, 0x4b, 0x91, 0x87, 0xb4, 0x69, 0x44, 0xb6, 0x23,
-	0x26, 0xdf, 0x98, 0x04, 0xb9, 0x07, 0x2d, 0x0d, 0x17, 0x92, 0x87, 0xb4, 0x65, 0xc0, 0x66, 0xc4,
-	0xe4, 0x6b, 0xc9, 0x43, 0x42, 0xa1, 0x29, 0x93, 0x28, 0xe5, 0xb9, 0xa4, 0xed, 0x7e, 0x6d, 0xd8,
-	0xf6, 0xcb, 0xd0, 0x39, 0x80, 0x9d, 0xd2, 0x88, 0x23, 0x86, 0x41, 0x5c, 0xda, 0xb6, 0x0b, 0x0d,
-	0x63, 0x9b, 0xa4, 0x96, 0x29, 0xa8, 0x6b, 0xdf, 0xa4, 0x13, 0xc3, 0xd6, 0x15, 0xdf, 0x8a, 0x29,
-	0x92, 0xbb, 0xd0, 0x44, 0x35, 0x8e, 0x99, 0x8c, 0x97, 0x0e, 0x37, 0x50, 0xbd, 0x64, 0x32, 0x26,
-	0xcf, 0x56, 0x3b, 0x6a, 0xdf, 0x36, 0x47, 0x03, 0x77, 0xcd, 0x3d, 0xdd, 0xeb, 0x57, 0x28, 0xad,
-	0x70, 0xde, 0xc2, 0xee, 0x35, 0x61, 0xcb, 0x33, 0x3d, 0x87, 0xd6, 0x82, 0xc2, 0x17, 0xda, 0x36,
-	0x47, 0x0f, 0x6f, 0xea, 0x5c, 0x4c, 0xd1, 0x5f, 0x15, 0x8d, 0xce, 0xab, 0x50, 0x3d, 0x51, 0xe4,
-	0x0c, 0x5a, 0x25, 0x85, 0xec, 0xdd, 0xd0, 0xc1, 0x78, 0xd2, 0xbd, 0xdd, 0x06, 0xce, 0xde, 0xe7,
-	0x1f, 0xbf, 0xcf, 0xab, 0x36, 0xe9, 0x79, 0xeb, 0x3e, 0xef, 0x4f, 0xc6, 0xd6, 0x33, 0xf2, 0xd5,
-	0x82, 0xce, 0x5f, 0x0b, 0x92, 0xc7, 0xff, 0x6c, 0x7f, 0xf5, 0x3a, 0xdd, 0xfd, 0xdb, 0x50, 0x97,
-	0x72, 0x06, 0x46, 0xce, 0x83, 0xa7, 0xd6, 0xbe, 0xd3, 0x5d, 0xab, 0x68, 0xa2, 0xe9, 0x47, 0xc7,
-	0xdf, 0x67, 0xb6, 0x75, 0x31, 0xb3, 0xad, 0x5f, 0x33, 0xdb, 0xfa, 0x36, 0xb7, 0x2b, 0x17, 0x73,
-	0xbb, 0xf2, 0x73, 0x6e, 0x57, 0xde, 0x79, 0x51, 0x82, 0x71, 0x31, 0x71, 0x03, 0xf1, 0x7e, 0x55,
-	0x2f, 0xf2, 0x68, 0xf5, 0x7e, 0xc0, 0xb2, 0xcc, 0xd3, 0x4f, 0x94, 0x67, 0x81, 0x87, 0x6a, 0xd2,
-	0x30, 0x3f, 0xe3, 0x93, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf9, 0xc7, 0x7f, 0xa8, 0xdf, 0x03,
-	0x00, 0x00,
+	// 805 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0xae, 0x9d, 0xaf, 0xe6, 0xad, 0xda, 0x35, 0xb3, 0xbb, 0x60, 0xa2, 0x6c, 0x08, 0xa6, 0x5d,
+	0x95, 0x48, 0x8d, 0x69, 0xb9, 0x21, 0x3e, 0x94, 0xb4, 0xee, 0x62, 0x94, 0xb5, 0x97, 0x71, 0x02,
+	0x0b, 0x17, 0xcb, 0xb1, 0x47, 0xb6, 0x51, 0xb0, 0x83, 0x67, 0xbc, 0x58, 0x42, 0x7b, 0xe1, 0x8e,
+	0x84, 0xc4, 0x8f, 0xe0, 0x5f, 0x70, 0x85, 0xe3, 0x4a, 0x5c, 0x38, 0xa2, 0x96, 0xdf, 0x81, 0x90,
+	0x27, 0x1f, 0x4d, 0x1a, 0x97, 0xf6, 0x10, 0x69, 0xde, 0x79, 0x9f, 0xf7, 0xc3, 0xcf, 0xf3, 0x38,
+	0x86, 0xa6, 0x4b, 0x26, 0x84, 0xb2, 0xd0, 0x51, 0xdd, 0x38, 0x21, 0xea, 0x8b, 0x63, 0x95, 0x65,
+	0x2a, 0xcb, 0xba, 0xd3, 0x24, 0x66, 0x31, 0xba, 0xbf, 0xc8, 0x76, 0xf3, 0x6c, 0xf7, 0xc5, 0x71,
+	0x97, 0x65, 0x8d, 0xa6, 0x1f, 0xc7, 0xfe, 0x84, 0xa8, 0xce, 0x34, 0x54, 0x9d, 0x28, 0x8a, 0x99,
+	0xc3, 0xc2, 0x38, 0xa2, 0xb3, 0x12, 0xe5, 0x31, 0xdc, 0x1b, 0x66, 0x16, 0x73, 0x58, 0x4a, 0x31,
+	0xf9, 0x2e, 0x25, 0x94, 0xa1, 0xfb, 0x50, 0x61, 0x99, 0x1d, 0x7a, 0xb2, 0xd0, 0x16, 0x0e, 0xeb,
+	0xb8, 0xcc, 0x32, 0xdd, 0x53, 0x7e, 0x17, 0x41, 0xba, 0x02, 0xd2, 0x69, 0x1c, 0x51, 0x82, 0x5e,
+	0x87, 0x6a, 0x40, 0x42, 0x3f, 0x60, 0x1c, 0x5a, 0xc2, 0xf3, 0x08, 0x3d, 0x80, 0x4a, 0x18, 0x79,
+	0x24, 0x93, 0xc5, 0xb6, 0x70, 0xb8, 0x8b, 0x67, 0x01, 0x3a, 0x80, 0x3d, 0x92, 0x11, 0x37, 0xcd,
+	0xc7, 0xdb, 0x6e, 0xec, 0x11, 0xb9, 0xc4, 0xd3, 0xbb, 0xcb, 0xdb, 0xd3, 0xd8, 0x23, 0x79, 0x31,
+	0x49, 0x92, 0x38, 0x91, 0xcb, 0x7c, 0xfc, 0x2c, 0xc8, 0x47, 0x51, 0x3e, 0x5c, 0xae, 0xf0, 0xeb,
+	0x79, 0x84, 0x9a, 0x50, 0xcf, 0x5b, 0xd1, 0xa9, 0xe3, 0x12, 0xb9, 0xca, 0x53, 0x57, 0x17, 0xe8,
+	0x11, 0x80, 0xef, 0x50, 0xfb, 0x7b, 0x27, 0x62, 0xc4, 0x93, 0x6b, 0x7c, 0xc9, 0xba, 0xef, 0xd0,
+	0x2f, 0xf9, 0x05, 0x7a, 0x13, 0xb6, 0xf3, 0x74, 0x4a, 0x89, 0x27, 0x6f, 0xf3, 0x64, 0xcd, 0x77,
+	0xe8, 0x88, 0x12, 0x0f, 0xc9, 0x50, 0xa3, 0xa1, 0x1f, 0x91, 0x84, 0xca, 0xf5, 0x76, 0xe9, 0xb0,
+	0x8e, 0x17, 0x21, 0xfa, 0x18, 0xea, 0x09, 0xf9, 0x86, 0xb8, 0xf9, 0xc2, 0x32, 0xb4, 0x85, 0xc3,
+	0x9d, 0x93, 0x76, 0xb7, 0x80, 0xf8, 0xee, 0x30, 0xc3, 0x0b, 0x1c, 0xbe, 0x2a, 0x51, 0x7e, 0x15,
+	0x60, 0x67, 0x25, 0x85, 0x3e, 0x84, 0x6a, 0x42, 0x1c, 0x1a, 0x47, 0x9c, 0xc4, 0xbd, 0x93, 0xfd,
+	0xc2, 0x66, 0x57, 0xad, 0x38, 0x16, 0xcf, 0x6b, 0x56, 0x24, 0x10, 0xd7, 0x24, 0x40, 0x50, 0x5e,
+	0xa1, 0x98, 0x9f, 0xd7, 0xb9, 0x2a, 0x5f, 0xe7, 0x4a, 0x82, 0xd2, 0x24, 0xf6, 0xe7, 0xf4, 0xe6,
+	0x47, 0xe5, 0x08, 0x1e, 0x2c, 0x24, 0xef, 0x3b, 0xcc, 0x0d, 0x16, 0x06, 0x79, 0x08, 0x55, 0x6e,
+	0x10, 0x2a, 0x0b, 0x9c, 0x9a, 0x4a, 0xee, 0x10, 0xaa, 0x04, 0xb0, 0xb7, 0xe2, 0x90, 0x74, 0xc2,
+	0xd0, 0x1b, 0x50, 0x63, 0x99, 0x1d, 0x38, 0x34, 0x98, 0x7b, 0xa9, 0xca, 0xb2, 0x4f, 0x1d, 0x1a,
+	0xa0, 0x8f, 0x96, 0x6a, 0x8a, 0x9c, 0xc0, 0x83, 0x1b, 0x08, 0x5c, 0xf7, 0xdb, 0x42, 0x74, 0xe5,
+	0x39, 0x3c, 0xbc, 0xb6, 0xd8, 0xdc, 0x90, 0x9f, 0xc0, 0xf6, 0x0c, 0x42, 0x66, 0xbb, 0xed, 0x9c,
+	0xbc, 0x73, 0x5b, 0xe7, 0x74, 0xc2, 0xf0, 0xb2, 0x48, 0x39, 0x06, 0xd9, 0x4a, 0xc7, 0xd4, 0x4d,
+	0xc2, 0x31, 0xb9, 0xfe, 0x5e, 0x14, 0x3f, 0x76, 0xe7, 0x37, 0x11, 0xee, 0x5d, 0x53, 0x07, 0xb5,
+	0xa1, 0x89, 0xb5, 0xcf, 0xb4, 0xd3, 0xa1, 0x6e, 0x1a, 0x36, 0xd6, 0x7a, 0x96, 0x69, 0xd8, 0x23,
+	0xc3, 0x7a, 0xa6, 0x9d, 0xea, 0xe7, 0xba, 0x76, 0x26, 0x6d, 0xa1, 0xb7, 0xe1, 0xd1, 0x06, 0xe2,
+	0x4c, 0x3b, 0x35, 0xcf, 0x34, 0x5b, 0xc3, 0xd8, 0xc4, 0x92, 0x50, 0x08, 0x19, 0x3e, 0xb7, 0x87,
+	0xa6, 0x69, 0x0f, 0x7a, 0xf8, 0x89, 0x26, 0x89, 0x85, 0x73, 0xac, 0xcf, 0x47, 0x3d, 0xac, 0xd9,
+	0xe7, 0xa3, 0xc1, 0x40, 0x2a, 0xa1, 0x0e, 0x3c, 0xde, 0x6c, 0x62, 0x9a, 0xf6, 0xd3, 0x9e, 0xf1,
+	0x95, 0xfd, 0xec, 0xbc, 0x6f, 0x3f, 0xd5, 0x2c, 0xab, 0xf7, 0x44, 0xb3, 0xa4, 0x32, 0xea, 0x42,
+	0xe7, 0x66, 0xac, 0x61, 0x1a, 0xeb, 0xf8, 0x0a, 0x52, 0xa0, 0xb5, 0x81, 0xd7, 0x8d, 0x2f, 0x7a,
+	0x03, 0xfd, 0xcc, 0xee, 0x0f, 0xcc, 0xbe, 0x25, 0x55, 0x0b, 0x1f, 0xa2, 0x67, 0x0c, 0x35, 0xfb,
+	0xbc, 0xa7, 0x0f, 0x46, 0x58, 0x93, 0x6a, 0x27, 0xff, 0x8a, 0x20, 0x0e, 0x33, 0xf4, 0x12, 0xb6,
+	0x17, 0x8c, 0xa3, 0xfd, 0x5b, 0x54, 0xe3, 0x82, 0x34, 0xee, 0xe6, 0x1a, 0x65, 0xff, 0xc7, 0x3f,
+	0xff, 0xf9, 0x45, 0x6c, 0xa1, 0xa6, 0x5a, 0xf4, 0xe7, 0xf9, 0x03, 0xd7, 0xf4, 0x25, 0xfa, 0x49,
+	0x80, 0xdd, 0x35, 0x53, 0xa1, 0x77, 0xff, 0xb7, 0xfd, 0xea, 0x1b, 0xd1, 0xe8, 0xdc, 0x05, 0x3a,
+	0x5f, 0xe7, 0x80, 0xaf, 0xf3, 0xd6, 0x07, 0x42, 0x47, 0x69, 0x14, 0x6e, 0x34, 0xe6, 0xd3, 0x43,
+	0x78, 0x6d, 0xc3, 0x89, 0xe8, 0xa8, 0x70, 0xce, 0x4d, 0x8e, 0x6d, 0xdc, 0xc5, 0xfc, 0xef, 0x09,
+	0x7d, 0xfd, 0x8f, 0x8b, 0x96, 0xf0, 0xea, 0xa2, 0x25, 0xfc, 0x7d, 0xd1, 0x12, 0x7e, 0xbe, 0x6c,
+	0x6d, 0xbd, 0xba, 0x6c, 0x6d, 0xfd, 0x75, 0xd9, 0xda, 0xfa, 0x5a, 0xf5, 0x43, 0x16, 0xa4, 0xe3,
+	0xae, 0x1b, 0x7f, 0xbb, 0x5c, 0x35, 0x4e, 0xfc, 0xe5, 0xf9, 0xc8, 0x99, 0x4e, 0xd5, 0xfc, 0xe7,
+	0x27, 0x53, 0x57, 0x65, 0xd9, 0xb8, 0xca, 0xbf, 0x2a, 0xef, 0xff, 0x37, 0x00, 0x15, 0xa3, 0xaa,
+	0x1a, 0xa8, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -406,6 +625,12 @@ type TxClient interface {
 	TxStatus(ctx context.Context, in *TxStatusRequest, opts ...grpc.CallOption) (*TxStatusResponse, error)
 	// TxStatusBatch for batch queries
 	TxStatusBatch(ctx context.Context, in *TxStatusBatchRequest, opts ...grpc.CallOption) (*TxStatusBatchResponse, error)
+	// SubscribeTxStatus streams the status of a set of transactions. The current
+	// status of each transaction is sent first, followed by every transition
+	// observed as new blocks are produced. The stream ends once all
+	// transactions are either committed or rejected. Over HTTP the same stream
+	// is served as a websocket at /celestia/core/v1/tx/subscribe.
+	SubscribeTxStatus(ctx context.Context, in *SubscribeTxStatusRequest, opts ...grpc.CallOption) (Tx_SubscribeTxStatusClient, error)
 }
 
 type txClient struct {
@@ -434,6 +659,38 @@ func (c *txClient) TxStatusBatch(ctx context.Context, in *TxStatusBatchRequest,
 	return out, nil
 }
 
+func (c *txClient) SubscribeTxStatus(ctx context.Context, in *SubscribeTxStatusRequest, opts ...grpc.CallOption) (Tx_SubscribeTxStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Tx_serviceDesc.Streams[0], "/celestia.core.v1.tx.Tx/SubscribeTxStatus", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &txSubscribeTxStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Tx_SubscribeTxStatusClient interface {
+	Recv() (*TxStatusResult, error)
+	grpc.ClientStream
+}
+
+type txSubscribeTxStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *txSubscribeTxStatusClient) Recv() (*TxStatusResult, error) {
+	m := new(TxStatusResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // TxServer is the server API for Tx service.
 type TxServer interface {
 	// TxStatus returns the status of a transaction. There are four possible
@@ -445,6 +702,12 @@ type TxServer interface {
 	TxStatus(context.Context, *TxStatusRequest) (*TxStatusResponse, error)
 	// TxStatusBatch for batch queries
 	TxStatusBatch(context.Context, *TxStatusBatchRequest) (*TxStatusBatchResponse, error)
+	// SubscribeTxStatus streams the status of a set of transactions. The current
+	// status of each transaction is sent first, followed by every transition
+	// observed as new blocks are produced. The stream ends once all
+	// transactions are either committed or rejected. Over HTTP the same stream
+	// is served as a websocket at /celestia/core/v1/tx/subscribe.
+	SubscribeTxStatus(*SubscribeTxStatusRequest, Tx_SubscribeTxStatusServer) error
 }
 
 // UnimplementedTxServer can be embedded to have forward compatible implementations.
@@ -457,6 +720,9 @@ func (*UnimplementedTxServer) TxStatus(ctx context.Context, req *TxStatusRequest
 func (*UnimplementedTxServer) TxStatusBatch(ctx context.Context, req *TxStatusBatchRequest) (*TxStatusBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TxStatusBatch not implemented")
 }
+func (*UnimplementedTxServer) SubscribeTxStatus(req *SubscribeTxStatusRequest, srv Tx_SubscribeTxStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeTxStatus not implemented")
+}
 
 func RegisterTxServer(s grpc1.Server, srv TxServer) {
 	s.RegisterService(&_Tx_serviceDesc, srv)
@@ -498,6 +764,27 @@ func _Tx_TxStatusBatch_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Tx_SubscribeTxStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeTxStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TxServer).SubscribeTxStatus(m, &txSubscribeTxStatusServer{stream})
+}
+
+type Tx_SubscribeTxStatusServer interface {
+	Send(*TxStatusResult) error
+	grpc.ServerStream
+}
+
+type txSubscribeTxStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *txSubscribeTxStatusServer) Send(m *TxStatusResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var Tx_serviceDesc = _Tx_serviceDesc
 var _Tx_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.core.v1.tx.Tx",
@@ -512,7 +799,13 @@ var _Tx_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Tx_TxStatusBatch_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeTxStatus",
+			Handler:       _Tx_SubscribeTxStatus_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "celestia/core/v1/tx/tx.proto",
 }
 
@@ -566,6 +859,18 @@ func (m *TxStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Rejection != nil {
+		{
+			size, err := m.Rejection.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if len(m.Signers) > 0 {
 		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Signers[iNdEx])
@@ -624,6 +929,58 @@ func (m *TxStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TxRejection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxRejection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxRejection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Log) > 0 {
+		i -= len(m.Log)
+		copy(dAtA[i:], m.Log)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Log)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Code != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Reason != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TxStatusBatchRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -735,6 +1092,38 @@ func (m *TxStatusBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SubscribeTxStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeTxStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeTxStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxIds) > 0 {
+		for iNdEx := len(m.TxIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TxIds[iNdEx])
+			copy(dAtA[i:], m.TxIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TxIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -798,6 +1187,36 @@ func (m *TxStatusResponse) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if m.Rejection != nil {
+		l = m.Rejection.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *TxRejection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		n += 1 + sovTx(uint64(m.Reason))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if m.Code != 0 {
+		n += 1 + sovTx(uint64(m.Code))
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Log)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -848,6 +1267,21 @@ func (m *TxStatusBatchResponse) Size() (n int) {
 	return n
 }
 
+func (m *SubscribeTxStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TxIds) > 0 {
+		for _, s := range m.TxIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1188,6 +1622,213 @@ func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rejection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rejection == nil {
+				m.Rejection = &TxRejection{}
+			}
+			if err := m.Rejection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxRejection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxRejection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxRejection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= RejectionReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Log = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1493,6 +2134,88 @@ func (m *TxStatusBatchResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SubscribeTxStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeTxStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeTxStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxIds = append(m.TxIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
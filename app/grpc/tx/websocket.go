@@ -0,0 +1,95 @@
+package tx
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cosmos/gogoproto/jsonpb"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"google.golang.org/grpc"
+)
+
+// SubscribeTxStatusRoute is the HTTP route on which SubscribeTxStatus is
+// served as a websocket.
+const SubscribeTxStatusRoute = "/celestia/core/v1/tx/subscribe"
+
+// maxWebsocketMessageSize is the largest message accepted from a websocket
+// client. Clients are not expected to send anything but control frames.
+const maxWebsocketMessageSize = 512
+
+// RegisterWebsocketRoutes mounts the websocket counterpart of the
+// SubscribeTxStatus stream of server on the given router. The tx hashes are
+// passed as repeated tx_ids query parameters and every TxStatusResult is
+// written as a JSON text message. The connection is closed once all
+// transactions are final or the client closes it. Cross-origin connections are
+// only accepted if allowAnyOrigin is set, which mirrors the API server's
+// enabled-unsafe-cors setting. grpc-gateway cannot proxy server-streaming RPCs
+// through a client.Context, which is why this route is not generated.
+//
+// server should be the one registered on the gRPC router so that both share a
+// single event bus subscription.
+func RegisterWebsocketRoutes(server TxServer, router *mux.Router, allowAnyOrigin bool) {
+	upgrader := &websocket.Upgrader{}
+	if allowAnyOrigin {
+		upgrader.CheckOrigin = func(*http.Request) bool { return true }
+	}
+	router.HandleFunc(SubscribeTxStatusRoute, func(w http.ResponseWriter, r *http.Request) {
+		serveSubscribeTxStatus(server, upgrader, w, r)
+	}).Methods(http.MethodGet)
+}
+
+// websocketStream adapts a websocket connection to the SubscribeTxStatus
+// stream. SubscribeTxStatus only uses the stream's Context and Send methods.
+type websocketStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	send func(*TxStatusResult) error
+}
+
+func (s *websocketStream) Context() context.Context { return s.ctx }
+
+func (s *websocketStream) Send(result *TxStatusResult) error { return s.send(result) }
+
+func serveSubscribeTxStatus(server TxServer, upgrader *websocket.Upgrader, w http.ResponseWriter, r *http.Request) {
+	req := &SubscribeTxStatusRequest{TxIds: r.URL.Query()["tx_ids"]}
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrade has already replied with an HTTP error.
+		return
+	}
+	defer conn.Close()
+
+	// The subscription ends as soon as the client goes away. Reading is also
+	// required for the connection to process the client's control frames.
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	conn.SetReadLimit(maxWebsocketMessageSize)
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	marshaler := jsonpb.Marshaler{OrigName: true, EmitDefaults: true}
+	err = server.SubscribeTxStatus(req, &websocketStream{
+		ctx: ctx,
+		send: func(result *TxStatusResult) error {
+			bz, err := marshaler.MarshalToString(result)
+			if err != nil {
+				return err
+			}
+			return conn.WriteMessage(websocket.TextMessage, []byte(bz))
+		},
+	})
+
+	closeMsg := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+	if err != nil {
+		closeMsg = websocket.FormatCloseMessage(websocket.CloseInternalServerErr, err.Error())
+	}
+	_ = conn.WriteMessage(websocket.CloseMessage, closeMsg)
+}
@@ -146,6 +146,7 @@ func (app *App) setModuleOrder() {
 		minfeetypes.ModuleName,
 		packetforwardtypes.ModuleName,
 		icatypes.ModuleName,
+		zkismtypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
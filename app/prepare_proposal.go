@@ -19,31 +19,13 @@ import (
 // are returned instead of panicking to improve error handling and reduce attack surface.
 func (app *App) PrepareProposalHandler(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
 	defer telemetry.MeasureSince(time.Now(), "prepare_proposal")
-	// Create a context using a branch of the state.
-	handler := ante.NewAnteHandler(
-		app.AccountKeeper,
-		app.BankKeeper,
-		app.BlobKeeper,
-		app.FeeGrantKeeper,
-		app.GetTxConfig().SignModeHandler(),
-		ante.DefaultSigVerificationGasConsumer,
-		app.IBCKeeper,
-		app.MinFeeKeeper,
-		&app.CircuitKeeper,
-		app.GovParamFilters(),
-	)
-
-	fsb, err := NewFilteredSquareBuilder(
-		handler,
-		app.encodingConfig.TxConfig,
-		app.MaxEffectiveSquareSize(ctx),
-		appconsts.SubtreeRootThreshold,
-	)
+	fsb, err := app.newFilteredSquareBuilder(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create FilteredSquareBuilder: %w", err)
+		return nil, err
 	}
 
 	txs := fsb.Fill(ctx, req.Txs)
+	app.rejectedTxs.Add(req.Height, fsb.DroppedTxs())
 
 	// Build the square from the set of valid and prioritised transactions.
 	dataSquare, err := fsb.Build()
@@ -75,3 +57,33 @@ func (app *App) PrepareProposalHandler(ctx sdk.Context, req *abci.RequestPrepare
 		DataRootHash: dah.Hash(), // also known as the data root
 	}, nil
 }
+
+// newFilteredSquareBuilder returns a FilteredSquareBuilder that filters txs
+// with the ante handler and builds a square of at most the max effective square
+// size for the provided context.
+func (app *App) newFilteredSquareBuilder(ctx sdk.Context) (*FilteredSquareBuilder, error) {
+	handler := ante.NewAnteHandler(
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.BlobKeeper,
+		app.FeeGrantKeeper,
+		app.GetTxConfig().SignModeHandler(),
+		ante.DefaultSigVerificationGasConsumer,
+		app.IBCKeeper,
+		app.MinFeeKeeper,
+		&app.CircuitKeeper,
+		app.GovParamFilters(),
+	)
+
+	fsb, err := NewFilteredSquareBuilder(
+		handler,
+		app.encodingConfig.TxConfig,
+		app.MaxEffectiveSquareSize(ctx),
+		appconsts.SubtreeRootThreshold,
+		app.BlobKeeper.GetNamespaceParams(ctx),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create FilteredSquareBuilder: %w", err)
+	}
+	return fsb, nil
+}
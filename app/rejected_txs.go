@@ -0,0 +1,80 @@
+package app
+
+import (
+	"sync"
+
+	celestiatx "github.com/celestiaorg/celestia-app/v7/app/grpc/tx"
+)
+
+// maxRejectedTxs is the number of dropped txs that a node retains so that
+// their rejection can be returned by the tx status service.
+const maxRejectedTxs = 10_000
+
+// RejectedTxs retains the most recent txs that this node dropped while
+// preparing a proposal. It is bounded: once full, the oldest records are
+// evicted first. Only PrepareProposal records rejections, so the records are
+// local to the proposer: nodes that don't propose blocks never retain any, and
+// a validator only knows about the txs it dropped from its own proposals.
+type RejectedTxs struct {
+	mtx      sync.Mutex
+	capacity int
+	records  map[string]*celestiatx.TxRejection
+	// order holds the keys of records in insertion order. It is used as a
+	// ring buffer with next pointing at the oldest key once it is full.
+	order []string
+	next  int
+}
+
+// NewRejectedTxs returns a RejectedTxs that retains at most capacity records.
+func NewRejectedTxs(capacity int) *RejectedTxs {
+	return &RejectedTxs{
+		capacity: capacity,
+		records:  make(map[string]*celestiatx.TxRejection, capacity),
+		order:    make([]string, 0, capacity),
+	}
+}
+
+// Add records the txs dropped from the proposal at height. A record for a tx
+// that is already retained is replaced.
+func (r *RejectedTxs) Add(height int64, dropped []DroppedTx) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.capacity <= 0 {
+		return
+	}
+
+	for _, d := range dropped {
+		key := string(d.TxHash)
+		if _, exists := r.records[key]; exists {
+			r.records[key] = d.Rejection(height)
+			continue
+		}
+
+		if len(r.order) < r.capacity {
+			r.order = append(r.order, key)
+		} else {
+			delete(r.records, r.order[r.next])
+			r.order[r.next] = key
+			r.next = (r.next + 1) % r.capacity
+		}
+		r.records[key] = d.Rejection(height)
+	}
+}
+
+// Get returns the rejection of the tx with txHash if it is retained.
+func (r *RejectedTxs) Get(txHash []byte) (*celestiatx.TxRejection, bool) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	rejection, ok := r.records[string(txHash)]
+	return rejection, ok
+}
+
+// Size returns the number of retained records.
+func (r *RejectedTxs) Size() int {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	return len(r.records)
+}
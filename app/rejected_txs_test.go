@@ -0,0 +1,48 @@
+package app
+
+import (
+	"testing"
+
+	celestiatx "github.com/celestiaorg/celestia-app/v7/app/grpc/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRejectedTxs(t *testing.T) {
+	squareFull := celestiatx.RejectionReason_REJECTION_REASON_SQUARE_FULL
+	anteFailure := celestiatx.RejectionReason_REJECTION_REASON_ANTE_FAILURE
+
+	t.Run("get returns the most recent rejection", func(t *testing.T) {
+		rejected := NewRejectedTxs(2)
+		rejected.Add(1, []DroppedTx{{TxHash: []byte{1}, Reason: squareFull}})
+		rejected.Add(2, []DroppedTx{{TxHash: []byte{1}, Reason: anteFailure, Code: 32, Codespace: "sdk"}})
+
+		rejection, ok := rejected.Get([]byte{1})
+		require.True(t, ok)
+		assert.Equal(t, anteFailure, rejection.Reason)
+		assert.EqualValues(t, 2, rejection.Height)
+		assert.EqualValues(t, 32, rejection.Code)
+		assert.Equal(t, "sdk", rejection.Codespace)
+		assert.Equal(t, 1, rejected.Size())
+
+		_, ok = rejected.Get([]byte{2})
+		assert.False(t, ok)
+	})
+
+	t.Run("evicts the oldest records once full", func(t *testing.T) {
+		rejected := NewRejectedTxs(2)
+		rejected.Add(1, []DroppedTx{{TxHash: []byte{1}}, {TxHash: []byte{2}}})
+		rejected.Add(2, []DroppedTx{{TxHash: []byte{3}}})
+		rejected.Add(3, []DroppedTx{{TxHash: []byte{4}}})
+
+		assert.Equal(t, 2, rejected.Size())
+		for _, evicted := range [][]byte{{1}, {2}} {
+			_, ok := rejected.Get(evicted)
+			assert.False(t, ok)
+		}
+		for _, retained := range [][]byte{{3}, {4}} {
+			_, ok := rejected.Get(retained)
+			assert.True(t, ok)
+		}
+	})
+}
@@ -0,0 +1,61 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+// unknownPubKey is a key type that the ante handler does not recognize.
+type unknownPubKey struct {
+	cryptotypes.PubKey
+}
+
+func TestSigVerifyCost(t *testing.T) {
+	params := authtypes.DefaultParams()
+	secp256r1Key, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+	secp256k1Key := secp256k1.GenPrivKey().PubKey()
+	ed25519Key := ed25519.GenPrivKey().PubKey()
+
+	testCases := []struct {
+		name    string
+		pubKey  cryptotypes.PubKey
+		want    uint64
+		wantErr bool
+	}{
+		{name: "secp256k1", pubKey: secp256k1Key, want: params.SigVerifyCostSecp256k1},
+		{name: "secp256r1", pubKey: secp256r1Key.PubKey(), want: params.SigVerifyCostSecp256r1()},
+		{name: "ed25519", pubKey: ed25519Key, wantErr: true},
+		{
+			name:   "multisig",
+			pubKey: multisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{secp256k1Key, secp256r1Key.PubKey(), secp256k1Key}),
+			want:   params.SigVerifyCostSecp256k1 + params.SigVerifyCostSecp256r1(),
+		},
+		{
+			name:    "multisig with an ed25519 key",
+			pubKey:  multisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{ed25519Key, secp256k1Key}),
+			wantErr: true,
+		},
+		{name: "unknown", pubKey: unknownPubKey{secp256k1Key}, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := sigVerifyCost(tc.pubKey, params)
+			if tc.wantErr {
+				require.ErrorIs(t, err, sdkerrors.ErrInvalidPubKey)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
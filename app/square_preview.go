@@ -0,0 +1,171 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/core/header"
+
+	"github.com/celestiaorg/celestia-app/v7/app/grpc/squarepreview"
+	celestiatx "github.com/celestiaorg/celestia-app/v7/app/grpc/tx"
+	"github.com/celestiaorg/celestia-app/v7/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v7/pkg/da"
+	square "github.com/celestiaorg/go-square/v3"
+	"github.com/celestiaorg/go-square/v3/share"
+	"github.com/celestiaorg/go-square/v3/tx"
+	coretypes "github.com/cometbft/cometbft/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxDryRunTxs is the maximum number of txs accepted by DryRunSquare. No
+// block can include more txs than it can include messages.
+const maxDryRunTxs = appconsts.MaxNonPFBMessages + appconsts.MaxPFBMessages
+
+// DryRunSquare runs txs through the same filtering and square construction as
+// PrepareProposal and returns the layout of the resulting square. The txs are
+// executed at the height of the next block, and at the time it is expected at,
+// against a cached branch of the latest committed state which is discarded
+// afterwards so no state is ever written. Requests with more txs, or more
+// bytes, than a block can hold are rejected.
+func (app *App) DryRunSquare(txs [][]byte) (*squarepreview.DryRunSquareResponse, error) {
+	if len(txs) > maxDryRunTxs {
+		return nil, status.Errorf(codes.InvalidArgument, "%d txs exceed the max of %d", len(txs), maxDryRunTxs)
+	}
+
+	ctx, err := app.CreateQueryContext(app.LastBlockHeight(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	maxBytes := app.GetConsensusParams(ctx).Block.MaxBytes
+	if maxBytes <= 0 {
+		maxBytes = int64(coretypes.MaxBlockSizeBytes)
+	}
+	var totalBytes int64
+	for _, rawTx := range txs {
+		totalBytes += int64(len(rawTx))
+	}
+	if totalBytes > maxBytes {
+		return nil, status.Errorf(codes.InvalidArgument, "%d bytes of txs exceed the max block size of %d bytes", totalBytes, maxBytes)
+	}
+
+	// Execute the txs as the proposer of the next block would.
+	height := app.LastBlockHeight() + 1
+	blockTime := ctx.BlockTime().Add(appconsts.GoalBlockTime)
+	ctx, _ = ctx.WithIsCheckTx(false).
+		WithBlockHeight(height).
+		WithBlockTime(blockTime).
+		WithHeaderInfo(header.Info{ChainID: ctx.ChainID(), Height: height, Time: blockTime}).
+		CacheContext()
+
+	fsb, err := app.newFilteredSquareBuilder(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// FilteredSquareBuilder expects txs that already passed CheckTx and panics
+	// on malformed blob txs so filter those out first.
+	wellFormed := make([][]byte, 0, len(txs))
+	for _, rawTx := range txs {
+		if _, isBlob, err := tx.UnmarshalBlobTx(rawTx); isBlob && err != nil {
+			fsb.drop(rawTx, celestiatx.RejectionReason_REJECTION_REASON_DECODE_ERROR, err.Error())
+			continue
+		}
+		wellFormed = append(wellFormed, rawTx)
+	}
+
+	included := fsb.Fill(ctx, wellFormed)
+	dataSquare, err := fsb.Build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build data square: %w", err)
+	}
+
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	if err != nil {
+		return nil, fmt.Errorf("failed to extend data square: %w", err)
+	}
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create data availability header: %w", err)
+	}
+	protoDAH, err := dah.ToProto()
+	if err != nil {
+		return nil, err
+	}
+
+	blobs, err := blobLayouts(fsb.Builder(), dataSquare, included)
+	if err != nil {
+		return nil, err
+	}
+
+	txHashes := make([][]byte, len(included))
+	for i, rawTx := range included {
+		txHashes[i] = coretypes.Tx(rawTx).Hash()
+	}
+
+	droppedTxs := make([]*squarepreview.DroppedTx, len(fsb.DroppedTxs()))
+	for i, dropped := range fsb.DroppedTxs() {
+		droppedTxs[i] = &squarepreview.DroppedTx{TxHash: dropped.TxHash, Rejection: dropped.Rejection(ctx.BlockHeight())}
+	}
+
+	return &squarepreview.DryRunSquareResponse{
+		SquareSize:    uint64(dataSquare.Size()),
+		MaxSquareSize: uint64(app.MaxEffectiveSquareSize(ctx)),
+		TxHashes:      txHashes,
+		Blobs:         blobs,
+		DroppedTxs:    droppedTxs,
+		Dah:           protoDAH,
+	}, nil
+}
+
+// blobLayouts returns the position of every blob in dataSquare ordered by
+// share start index. included must be the txs returned by Fill.
+func blobLayouts(builder *square.Builder, dataSquare square.Square, included [][]byte) ([]*squarepreview.BlobLayout, error) {
+	var (
+		blobs    []*squarepreview.BlobLayout
+		pfbIndex = len(builder.Txs)
+	)
+	for _, rawTx := range included {
+		blobTx, isBlob, err := tx.UnmarshalBlobTx(rawTx)
+		if !isBlob {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		txHash := coretypes.Tx(blobTx.Tx).Hash()
+		for blobIndex, blob := range blobTx.Blobs {
+			start, err := builder.FindBlobStartingIndex(pfbIndex, blobIndex)
+			if err != nil {
+				return nil, err
+			}
+			length, err := builder.BlobShareLength(pfbIndex, blobIndex)
+			if err != nil {
+				return nil, err
+			}
+			blobs = append(blobs, &squarepreview.BlobLayout{
+				TxHash:          txHash,
+				BlobIndex:       uint32(blobIndex),
+				Namespace:       blob.Namespace().Bytes(),
+				ShareStartIndex: uint32(start),
+				ShareLength:     uint32(length),
+				Padding:         uint32(paddingBefore(dataSquare, start)),
+			})
+		}
+		pfbIndex++
+	}
+	sort.SliceStable(blobs, func(i, j int) bool {
+		return blobs[i].ShareStartIndex < blobs[j].ShareStartIndex
+	})
+	return blobs, nil
+}
+
+// paddingBefore returns the number of padding shares directly before index.
+func paddingBefore(dataSquare square.Square, index int) int {
+	padding := 0
+	for i := index - 1; i >= 0 && dataSquare[i].IsPadding(); i-- {
+		padding++
+	}
+	return padding
+}
@@ -0,0 +1,43 @@
+package app_test
+
+import (
+	"bytes"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v7/app"
+	"github.com/celestiaorg/celestia-app/v7/app/encoding"
+	"github.com/celestiaorg/celestia-app/v7/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v7/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v7/test/util/testnode"
+	blobtypes "github.com/celestiaorg/celestia-app/v7/x/blob/types"
+	"github.com/celestiaorg/go-square/v3/share"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	coretypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilteredSquareBuilderNamespaceMaxBlobSize(t *testing.T) {
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+
+	unbounded := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	bounded := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+	txs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, []share.Namespace{unbounded, bounded, bounded}, []int{2000, 1000, 1001})
+
+	namespaceParams := []blobtypes.NamespaceParams{
+		blobtypes.NewNamespaceParams(bounded.Bytes(), math.LegacyOneDec(), 1000),
+	}
+	handler := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	fsb, err := app.NewFilteredSquareBuilder(handler, enc.TxConfig, appconsts.DefaultGovMaxSquareSize, appconsts.SubtreeRootThreshold, namespaceParams)
+	require.NoError(t, err)
+
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	kept := fsb.Fill(ctx, coretypes.Txs(txs).ToSliceOfBytes())
+	require.Len(t, kept, 2)
+	require.Contains(t, kept, []byte(txs[0]))
+	require.Contains(t, kept, []byte(txs[1]))
+}
@@ -0,0 +1,137 @@
+package app_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v7/app"
+	"github.com/celestiaorg/celestia-app/v7/app/encoding"
+	celestiatx "github.com/celestiaorg/celestia-app/v7/app/grpc/tx"
+	"github.com/celestiaorg/celestia-app/v7/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v7/pkg/da"
+	testutil "github.com/celestiaorg/celestia-app/v7/test/util"
+	"github.com/celestiaorg/celestia-app/v7/test/util/testfactory"
+	"github.com/celestiaorg/go-square/v3/share"
+	blobtx "github.com/celestiaorg/go-square/v3/tx"
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDryRunSquare(t *testing.T) {
+	encConf := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := testfactory.GenerateAccounts(1)
+	testApp, kr := testutil.SetupTestAppWithGenesisValSetAndMaxSquareSize(app.DefaultConsensusParams(), 128, accounts...)
+
+	txs := createBlobTxs(t, testApp, encConf, kr, accounts)
+	oversizedTx := bytes.Repeat([]byte{1}, appconsts.MaxTxSize+1)
+	// a blob tx without any blobs
+	malformedBlobTx := []byte{0x0a, 0x01, 0x01, 0x1a, 0x04, 'B', 'L', 'O', 'B'}
+	oversizedBlob, err := share.NewV0Blob(share.RandomBlobNamespace(), bytes.Repeat([]byte{1}, appconsts.MaxTxSize))
+	require.NoError(t, err)
+	oversizedBlobTx, err := blobtx.MarshalBlobTx([]byte("inner tx"), oversizedBlob)
+	require.NoError(t, err)
+	txs = append(txs, oversizedTx, malformedBlobTx, oversizedBlobTx)
+
+	address := testfactory.GetAddress(kr, accounts[0])
+	sequenceBefore := testutil.DirectQueryAccount(testApp, address).GetSequence()
+
+	resp, err := testApp.DryRunSquare(txs)
+	require.NoError(t, err)
+
+	t.Run("matches prepare proposal", func(t *testing.T) {
+		prepareResponse, err := testApp.PrepareProposal(&abci.RequestPrepareProposal{
+			Txs:    txs[:9],
+			Height: testApp.LastBlockHeight() + 1,
+			Time:   time.Now(),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, prepareResponse.SquareSize, resp.SquareSize)
+		assert.EqualValues(t, 128, resp.MaxSquareSize)
+		dah, err := da.DataAvailabilityHeaderFromProto(resp.Dah)
+		require.NoError(t, err)
+		assert.Equal(t, prepareResponse.DataRootHash, dah.Hash())
+		require.Len(t, resp.TxHashes, len(prepareResponse.Txs))
+		for i, tx := range prepareResponse.Txs {
+			assert.Equal(t, coretypes.Tx(tx).Hash(), resp.TxHashes[i])
+		}
+	})
+
+	t.Run("prepare proposal retains the rejections", func(t *testing.T) {
+		// The second to last blob tx does not fit in the square and the last
+		// one then fails the ante handler because of its sequence.
+		rejection, ok := testApp.RejectedTxs().Get(blobTxHash(t, txs[7]))
+		require.True(t, ok)
+		assert.Equal(t, celestiatx.RejectionReason_REJECTION_REASON_SQUARE_FULL, rejection.Reason)
+
+		rejection, ok = testApp.RejectedTxs().Get(blobTxHash(t, txs[8]))
+		require.True(t, ok)
+		assert.Equal(t, celestiatx.RejectionReason_REJECTION_REASON_ANTE_FAILURE, rejection.Reason)
+		assert.Equal(t, sdkerrors.ErrWrongSequence.ABCICode(), rejection.Code)
+		assert.Equal(t, sdkerrors.ErrWrongSequence.Codespace(), rejection.Codespace)
+	})
+
+	t.Run("reports the blob layout", func(t *testing.T) {
+		require.Len(t, resp.Blobs, 7)
+		for i, blob := range resp.Blobs {
+			assert.EqualValues(t, 0, blob.BlobIndex)
+			assert.Contains(t, resp.TxHashes, blob.TxHash)
+			assert.NotZero(t, blob.ShareLength)
+			if i > 0 {
+				prev := resp.Blobs[i-1]
+				assert.Equal(t, prev.ShareStartIndex+prev.ShareLength+blob.Padding, blob.ShareStartIndex)
+			}
+		}
+	})
+
+	t.Run("reports the dropped txs", func(t *testing.T) {
+		require.Len(t, resp.DroppedTxs, 5)
+		reasons := make(map[string]celestiatx.RejectionReason, len(resp.DroppedTxs))
+		for _, dropped := range resp.DroppedTxs {
+			assert.NotEmpty(t, dropped.Rejection.Log)
+			reasons[string(dropped.TxHash)] = dropped.Rejection.Reason
+		}
+		assert.Equal(t, celestiatx.RejectionReason_REJECTION_REASON_SQUARE_FULL, reasons[string(blobTxHash(t, txs[7]))])
+		assert.Equal(t, celestiatx.RejectionReason_REJECTION_REASON_ANTE_FAILURE, reasons[string(blobTxHash(t, txs[8]))])
+		assert.Equal(t, celestiatx.RejectionReason_REJECTION_REASON_TX_TOO_LARGE, reasons[string(coretypes.Tx(oversizedTx).Hash())])
+		assert.Equal(t, celestiatx.RejectionReason_REJECTION_REASON_DECODE_ERROR, reasons[string(coretypes.Tx(malformedBlobTx).Hash())])
+		// oversized blob txs are tracked by the hash of their inner tx.
+		assert.Equal(t, celestiatx.RejectionReason_REJECTION_REASON_TX_TOO_LARGE, reasons[string(coretypes.Tx("inner tx").Hash())])
+	})
+
+	t.Run("does not modify state", func(t *testing.T) {
+		// only the proposal above retains rejections
+		assert.Equal(t, 2, testApp.RejectedTxs().Size())
+		sequenceAfter := testutil.DirectQueryAccount(testApp, address).GetSequence()
+		assert.Equal(t, sequenceBefore, sequenceAfter)
+	})
+
+	t.Run("rejects more than a block can hold", func(t *testing.T) {
+		tooManyTxs := make([][]byte, appconsts.MaxNonPFBMessages+appconsts.MaxPFBMessages+1)
+		for i := range tooManyTxs {
+			tooManyTxs[i] = []byte{1}
+		}
+		_, err := testApp.DryRunSquare(tooManyTxs)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		maxBytes := testApp.GetConsensusParams(testApp.NewContext(true)).Block.MaxBytes
+		quarterBlock := bytes.Repeat([]byte{1}, int(maxBytes/4))
+		tooManyBytes := [][]byte{quarterBlock, quarterBlock, quarterBlock, quarterBlock, {1}}
+		_, err = testApp.DryRunSquare(tooManyBytes)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+// blobTxHash returns the hash of the sdk.Tx of a blob tx.
+func blobTxHash(t *testing.T, rawTx []byte) []byte {
+	blobTx, isBlob, err := blobtx.UnmarshalBlobTx(rawTx)
+	require.True(t, isBlob)
+	require.NoError(t, err)
+	return coretypes.Tx(blobTx.Tx).Hash()
+}
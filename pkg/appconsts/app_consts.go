@@ -60,6 +60,15 @@ const (
 
 )
 
+// SignalThresholdNumerator and SignalThresholdDenominator define the fraction
+// of voting power that must signal for a version for the network to upgrade to
+// it. It is set to 5/6 as the middle point between 2/3 and 3/3 providing 1/6
+// fault tolerance to halting the network during an upgrade period.
+const (
+	SignalThresholdNumerator   int64 = 5
+	SignalThresholdDenominator int64 = 6
+)
+
 // MinCommissionRate is 20%.
 // TODO(@rootulp): link to CIP.
 var MinCommissionRate = math.LegacyNewDecWithPrec(2, 1)
@@ -9,6 +9,13 @@ const (
 	// through static timeouts (i.e. TimeoutPropose, TimeoutCommit).
 	GoalBlockTime = time.Second * 15
 
+	// EstimatedBlockInterval is the block interval assumed when converting
+	// between a number of blocks and a duration, for example to estimate when
+	// a pending upgrade takes place. Blocks are produced roughly every
+	// DelayedPrecommitTimeout plus the time to reach consensus, which is well
+	// below GoalBlockTime.
+	EstimatedBlockInterval = time.Second * 6
+
 	// MaxAgeDuration is the maximum age of evidence that can be submitted for
 	// slashing. See CIP-037.
 	MaxAgeDuration = 337 * time.Hour // (14 days + 1 hour)
@@ -13,4 +13,9 @@ const (
 	//
 	// The rationale for this value is described in more detail in ADR-013.
 	SubtreeRootThreshold int = 64
+	// SignalThresholdNumerator and SignalThresholdDenominator define the
+	// fraction of voting power that must signal for a version for the network
+	// to upgrade to it.
+	SignalThresholdNumerator   int64 = 5
+	SignalThresholdDenominator int64 = 6
 )
@@ -2,12 +2,27 @@ package appconsts
 
 import (
 	"time"
+
+	"cosmossdk.io/math"
+	v5 "github.com/celestiaorg/celestia-app/v7/pkg/appconsts/v5"
 )
 
 func GetTimeoutCommit(_ uint64) time.Duration {
 	return TimeoutCommit
 }
 
+// GetSignalThreshold returns the fraction of voting power that must signal for
+// a version for the network to upgrade away from appVersion. It can only be
+// modified through a hard fork change that modifies the app version.
+func GetSignalThreshold(appVersion uint64) math.LegacyDec {
+	switch {
+	case appVersion <= v5.Version:
+		return math.LegacyNewDec(v5.SignalThresholdNumerator).QuoInt64(v5.SignalThresholdDenominator)
+	default:
+		return math.LegacyNewDec(SignalThresholdNumerator).QuoInt64(SignalThresholdDenominator)
+	}
+}
+
 // GetSquareSizeUpperBound return the upper bound (consensus critical) square
 // size given the chain-id. As of app version 5, all networks including
 // testnetworks will have the same size, however in the past (and presumably
@@ -1,9 +1,12 @@
 package appconsts_test
 
 import (
+	"fmt"
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/celestiaorg/celestia-app/v7/pkg/appconsts"
+	v5 "github.com/celestiaorg/celestia-app/v7/pkg/appconsts/v5"
 	"github.com/stretchr/testify/require"
 )
 
@@ -42,3 +45,25 @@ func TestGetUpgradeHeightDelay(t *testing.T) {
 		})
 	}
 }
+
+func TestGetSignalThreshold(t *testing.T) {
+	fiveSixths := math.LegacyNewDec(5).Quo(math.LegacyNewDec(6))
+	tests := []struct {
+		appVersion uint64
+		want       math.LegacyDec
+	}{
+		{appVersion: 1, want: fiveSixths},
+		{appVersion: v5.Version, want: fiveSixths},
+		{appVersion: v5.Version + 1, want: fiveSixths},
+		{appVersion: appconsts.Version, want: fiveSixths},
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("app version %d", tc.appVersion), func(t *testing.T) {
+			got := appconsts.GetSignalThreshold(tc.appVersion)
+			// the threshold must provide 1/6 fault tolerance to halting the
+			// network during an upgrade.
+			require.True(t, tc.want.Equal(got), "got %s", got)
+		})
+	}
+}
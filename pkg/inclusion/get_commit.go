@@ -0,0 +1,67 @@
+package inclusion
+
+import (
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v7/pkg/da"
+	"github.com/cometbft/cometbft/crypto/merkle"
+)
+
+// SubtreeRoot is one of the subtree roots that a blob's share commitment is
+// computed over, along with the hashes needed to recompute its row root.
+type SubtreeRoot struct {
+	// Row is the index of the row holding the subtree.
+	Row int
+	// Path holds the walk instructions from the row root to the subtree root.
+	Path []WalkInstruction
+	// Root is the subtree root.
+	Root []byte
+	// Siblings are the siblings of the nodes on Path, ordered from the subtree
+	// root up to the row root.
+	Siblings [][]byte
+}
+
+// GetSubtreeRoots returns the subtree roots of the share commitment of the
+// blob occupying blobShareLen shares from the share index start of the
+// original data square. The cacher must have been used to compute the
+// extended data square that dah commits to.
+func GetSubtreeRoots(cacher *EDSSubTreeRootCacher, dah da.DataAvailabilityHeader, start, blobShareLen, subtreeRootThreshold int) ([]SubtreeRoot, error) {
+	squareSize := dah.SquareSize()
+	if start < 0 || blobShareLen <= 0 || start+blobShareLen > squareSize*squareSize {
+		return nil, fmt.Errorf("blob of %d shares starting at share %d does not fit in a square of size %d", blobShareLen, start, squareSize)
+	}
+
+	paths := calculateCommitmentPaths(squareSize, start, blobShareLen, subtreeRootThreshold)
+	subtreeRoots := make([]SubtreeRoot, len(paths))
+	for i, path := range paths {
+		// here we prepend a left walk because the subtree roots are all in the
+		// original data square's half of the row.
+		instructions := append([]WalkInstruction{WalkLeft}, path.instructions...)
+		root, siblings, err := cacher.getSubTreeRootWithSiblings(dah, path.row, instructions)
+		if err != nil {
+			return nil, err
+		}
+		subtreeRoots[i] = SubtreeRoot{
+			Row:      path.row,
+			Path:     instructions,
+			Root:     root,
+			Siblings: siblings,
+		}
+	}
+	return subtreeRoots, nil
+}
+
+// GetCommitment returns the share commitment of the blob occupying
+// blobShareLen shares from the share index start of the original data square
+// using the subtree roots cached while computing the extended data square.
+func GetCommitment(cacher *EDSSubTreeRootCacher, dah da.DataAvailabilityHeader, start, blobShareLen, subtreeRootThreshold int) ([]byte, error) {
+	subtreeRoots, err := GetSubtreeRoots(cacher, dah, start, blobShareLen, subtreeRootThreshold)
+	if err != nil {
+		return nil, err
+	}
+	roots := make([][]byte, len(subtreeRoots))
+	for i, subtreeRoot := range subtreeRoots {
+		roots[i] = subtreeRoot.Root
+	}
+	return merkle.HashFromByteSlices(roots), nil
+}
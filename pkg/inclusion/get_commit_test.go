@@ -0,0 +1,69 @@
+package inclusion
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v7/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v7/pkg/da"
+	"github.com/celestiaorg/celestia-app/v7/test/util/random"
+	square "github.com/celestiaorg/go-square/v3"
+	squareinclusion "github.com/celestiaorg/go-square/v3/inclusion"
+	"github.com/celestiaorg/go-square/v3/share"
+	blobtx "github.com/celestiaorg/go-square/v3/tx"
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCommitment(t *testing.T) {
+	sizes := []int{1, 478, 3000, 20_000, 64 * share.AvailableBytesFromSparseShares(1), 100_000}
+	blobs := make([]*share.Blob, len(sizes))
+	txs := make([][]byte, len(sizes))
+	for i, size := range sizes {
+		blob, err := share.NewV0Blob(share.RandomBlobNamespace(), random.Bytes(size))
+		require.NoError(t, err)
+		blobs[i] = blob
+		txs[i], err = blobtx.MarshalBlobTx([]byte{byte(i)}, blob)
+		require.NoError(t, err)
+	}
+
+	builder, err := square.NewBuilder(appconsts.SquareSizeUpperBound, appconsts.SubtreeRootThreshold, txs...)
+	require.NoError(t, err)
+	dataSquare, err := builder.Export()
+	require.NoError(t, err)
+
+	cacher := NewSubtreeCacher(uint64(dataSquare.Size()))
+	eds, err := rsmt2d.ComputeExtendedDataSquare(share.ToBytes(dataSquare), appconsts.DefaultCodec(), cacher.Constructor)
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	for i, blob := range blobs {
+		start, err := builder.FindBlobStartingIndex(i, 0)
+		require.NoError(t, err)
+		length, err := builder.BlobShareLength(i, 0)
+		require.NoError(t, err)
+
+		expected, err := squareinclusion.CreateCommitment(blob, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold)
+		require.NoError(t, err)
+		commitment, err := GetCommitment(cacher, dah, start, length, appconsts.SubtreeRootThreshold)
+		require.NoError(t, err)
+		require.Equal(t, expected, commitment, "blob of size %d", sizes[i])
+
+		subtreeRoots, err := GetSubtreeRoots(cacher, dah, start, length, appconsts.SubtreeRootThreshold)
+		require.NoError(t, err)
+		for _, subtreeRoot := range subtreeRoots {
+			require.Len(t, subtreeRoot.Siblings, len(subtreeRoot.Path))
+			require.Equal(t, WalkInstruction(WalkLeft), subtreeRoot.Path[0])
+		}
+	}
+
+	_, err = GetCommitment(cacher, dah, len(dataSquare)-1, 2, appconsts.SubtreeRootThreshold)
+	require.Error(t, err)
+}
+
+func TestGenSubTreeRootPath(t *testing.T) {
+	require.Empty(t, genSubTreeRootPath(0, 0))
+	require.Equal(t, []WalkInstruction{WalkLeft, WalkRight, WalkRight}, genSubTreeRootPath(3, 3))
+	require.Equal(t, []WalkInstruction{WalkRight, WalkLeft}, genSubTreeRootPath(2, 2))
+}
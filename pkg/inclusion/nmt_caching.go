@@ -73,6 +73,27 @@ func (strc subTreeRootCacher) walk(root []byte, path []WalkInstruction) ([]byte,
 	}
 }
 
+// walkWithSiblings traverses the tree like walk and also returns the sibling
+// of every node on the path, ordered from the subtree root up to the children
+// of root.
+func (strc subTreeRootCacher) walkWithSiblings(root []byte, path []WalkInstruction) ([]byte, [][]byte, error) {
+	siblings := make([][]byte, len(path))
+	node := root
+	for i, instruction := range path {
+		children, has := strc.cache[string(node)]
+		if !has {
+			return nil, nil, fmt.Errorf("did not find sub tree root: %v", node)
+		}
+		next, sibling := children[0], children[1]
+		if instruction == WalkRight {
+			next, sibling = children[1], children[0]
+		}
+		node = []byte(next)
+		siblings[len(path)-1-i] = []byte(sibling)
+	}
+	return node, siblings, nil
+}
+
 // EDSSubTreeRootCacher caches the inner nodes for each row so that we can
 // traverse it later to check for blob inclusion. NOTE: Currently this is not
 // threadsafe, but with a future refactor, we could simply read from rsmt2d and
@@ -111,14 +132,21 @@ func (stc *EDSSubTreeRootCacher) Constructor(axis rsmt2d.Axis, axisIndex uint) r
 // getSubTreeRoot traverses the nmt of the selected row and returns the
 // subtree root. An error is thrown if the subtree cannot be found.
 func (stc *EDSSubTreeRootCacher) getSubTreeRoot(dah da.DataAvailabilityHeader, row int, path []WalkInstruction) ([]byte, error) {
+	sbt, _, err := stc.getSubTreeRootWithSiblings(dah, row, path)
+	return sbt, err
+}
+
+// getSubTreeRootWithSiblings is like getSubTreeRoot but also returns the
+// siblings of the nodes on the path, ordered from the subtree root up to the
+// row root.
+func (stc *EDSSubTreeRootCacher) getSubTreeRootWithSiblings(dah da.DataAvailabilityHeader, row int, path []WalkInstruction) ([]byte, [][]byte, error) {
 	if len(stc.caches) != len(dah.RowRoots) {
-		return nil, fmt.Errorf("data availability header has unexpected number of row roots: expected %d got %d", len(stc.caches), len(dah.RowRoots))
+		return nil, nil, fmt.Errorf("data availability header has unexpected number of row roots: expected %d got %d", len(stc.caches), len(dah.RowRoots))
 	}
 	if row >= len(stc.caches) {
-		return nil, fmt.Errorf("row exceeds range of cache: max %d got %d", len(stc.caches), row)
+		return nil, nil, fmt.Errorf("row exceeds range of cache: max %d got %d", len(stc.caches), row)
 	}
 	stc.mut.RLock()
-	sbt, err := stc.caches[uint(row)].walk(dah.RowRoots[row], path)
-	stc.mut.RUnlock()
-	return sbt, err
+	defer stc.mut.RUnlock()
+	return stc.caches[uint(row)].walkWithSiblings(dah.RowRoots[row], path)
 }
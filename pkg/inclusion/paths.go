@@ -1,5 +1,11 @@
 package inclusion
 
+import (
+	"math"
+
+	squareinclusion "github.com/celestiaorg/go-square/v3/inclusion"
+)
+
 // coord identifies a tree node using the depth and position
 //
 //	Depth       Position
@@ -106,3 +112,51 @@ func calculateSubTreeRootCoordinates(maxDepth, minDepth, start, end int) []coord
 		}
 	}
 }
+
+// path is the row and the walk instructions from the root of that row to a
+// subtree root.
+type path struct {
+	row          int
+	instructions []WalkInstruction
+}
+
+// calculateCommitmentPaths calculates the paths to the subtree roots of a
+// blob's share commitment in the original data square. The instructions of
+// each path start at the root of the original data square's half of the row.
+func calculateCommitmentPaths(squareSize, start, blobShareLen, subtreeRootThreshold int) []path {
+	startRow, endRow := start/squareSize, (start+blobShareLen-1)/squareSize
+	normalizedStartIndex := start % squareSize
+	normalizedEndIndex := (start + blobShareLen) - endRow*squareSize
+	paths := []path{}
+	maxDepth := int(math.Log2(float64(squareSize)))
+	// the subtree width defines the min depth of the subtree roots
+	subtreeWidth := squareinclusion.SubTreeWidth(blobShareLen, subtreeRootThreshold)
+	minDepth := maxDepth - int(math.Log2(float64(subtreeWidth)))
+	for row := startRow; row <= endRow; row++ {
+		start, end := 0, squareSize
+		if row == startRow {
+			start = normalizedStartIndex
+		}
+		if row == endRow {
+			end = normalizedEndIndex
+		}
+		coords := calculateSubTreeRootCoordinates(maxDepth, minDepth, start, end)
+		for _, c := range coords {
+			paths = append(paths, path{
+				row:          row,
+				instructions: genSubTreeRootPath(c.depth, c.position),
+			})
+		}
+	}
+	return paths
+}
+
+// genSubTreeRootPath calculates the path to the node at the given depth and
+// position, the root of the tree being at depth 0.
+func genSubTreeRootPath(depth, position int) []WalkInstruction {
+	path := make([]WalkInstruction, depth)
+	for i := range depth {
+		path[i] = position&(1<<(depth-1-i)) != 0
+	}
+	return path
+}
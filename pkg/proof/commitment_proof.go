@@ -0,0 +1,192 @@
+package proof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/celestiaorg/celestia-app/v7/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v7/pkg/da"
+	"github.com/celestiaorg/celestia-app/v7/pkg/inclusion"
+	"github.com/celestiaorg/go-square/v3"
+	"github.com/celestiaorg/go-square/v3/share"
+	blobtx "github.com/celestiaorg/go-square/v3/tx"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/cometbft/cometbft/crypto/merkle"
+)
+
+// ErrCommitmentNotFound is returned when no blob of the requested namespace
+// has the requested share commitment.
+var ErrCommitmentNotFound = errors.New("share commitment not found")
+
+// NewCommitmentInclusionProof rebuilds the data square of txs, locates the
+// blob of the given namespace whose share commitment is equal to commitment
+// and returns the proof of its subtree roots to the row roots of the square.
+// The data availability header the proof is against is returned alongside it.
+func NewCommitmentInclusionProof(
+	txs [][]byte,
+	namespace share.Namespace,
+	commitment []byte,
+) (CommitmentProof, da.DataAvailabilityHeader, error) {
+	builder, err := square.NewBuilder(appconsts.SquareSizeUpperBound, appconsts.SubtreeRootThreshold, txs...)
+	if err != nil {
+		return CommitmentProof{}, da.DataAvailabilityHeader{}, err
+	}
+	dataSquare, err := builder.Export()
+	if err != nil {
+		return CommitmentProof{}, da.DataAvailabilityHeader{}, err
+	}
+
+	// the cacher keeps the inner nodes of the row trees so that the subtree
+	// roots can be walked to after the square is extended.
+	cacher := inclusion.NewSubtreeCacher(uint64(dataSquare.Size()))
+	eds, err := rsmt2d.ComputeExtendedDataSquare(share.ToBytes(dataSquare), appconsts.DefaultCodec(), cacher.Constructor)
+	if err != nil {
+		return CommitmentProof{}, da.DataAvailabilityHeader{}, err
+	}
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return CommitmentProof{}, da.DataAvailabilityHeader{}, err
+	}
+
+	for txIndex, rawTx := range txs {
+		blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(rawTx)
+		if !isBlobTx {
+			continue
+		}
+		if err != nil {
+			return CommitmentProof{}, da.DataAvailabilityHeader{}, err
+		}
+		for blobIndex, blob := range blobTx.Blobs {
+			if !blob.Namespace().Equals(namespace) {
+				continue
+			}
+			start, err := builder.FindBlobStartingIndex(txIndex, blobIndex)
+			if err != nil {
+				return CommitmentProof{}, da.DataAvailabilityHeader{}, err
+			}
+			length, err := builder.BlobShareLength(txIndex, blobIndex)
+			if err != nil {
+				return CommitmentProof{}, da.DataAvailabilityHeader{}, err
+			}
+			subtreeRoots, err := inclusion.GetSubtreeRoots(cacher, dah, start, length, appconsts.SubtreeRootThreshold)
+			if err != nil {
+				return CommitmentProof{}, da.DataAvailabilityHeader{}, err
+			}
+			if !bytes.Equal(hashSubtreeRoots(subtreeRoots), commitment) {
+				continue
+			}
+			return newCommitmentProof(namespace, subtreeRoots), dah, nil
+		}
+	}
+	return CommitmentProof{}, da.DataAvailabilityHeader{}, ErrCommitmentNotFound
+}
+
+func hashSubtreeRoots(subtreeRoots []inclusion.SubtreeRoot) []byte {
+	roots := make([][]byte, len(subtreeRoots))
+	for i, subtreeRoot := range subtreeRoots {
+		roots[i] = subtreeRoot.Root
+	}
+	return merkle.HashFromByteSlices(roots)
+}
+
+func newCommitmentProof(namespace share.Namespace, subtreeRoots []inclusion.SubtreeRoot) CommitmentProof {
+	proofs := make([]*SubtreeRootProof, len(subtreeRoots))
+	for i, subtreeRoot := range subtreeRoots {
+		path := make([]bool, len(subtreeRoot.Path))
+		for j, instruction := range subtreeRoot.Path {
+			path[j] = bool(instruction)
+		}
+		proofs[i] = &SubtreeRootProof{
+			SubtreeRoot: subtreeRoot.Root,
+			Row:         uint32(subtreeRoot.Row),
+			Path:        path,
+			Siblings:    subtreeRoot.Siblings,
+		}
+	}
+	return CommitmentProof{
+		NamespaceId:       namespace.ID(),
+		NamespaceVersion:  uint32(namespace.Version()),
+		SubtreeRootProofs: proofs,
+	}
+}
+
+// Verify checks that the share commitment is the Merkle root of the subtree
+// roots of the proof and that each of them belongs to the namespace of the
+// proof and is included in a row root of the original data square of dah.
+// It returns nil if the proof is valid.
+func (cp CommitmentProof) Verify(dah da.DataAvailabilityHeader, commitment []byte) error {
+	if len(cp.SubtreeRootProofs) == 0 {
+		return errors.New("empty commitment proof")
+	}
+	if cp.NamespaceVersion > math.MaxUint8 {
+		return fmt.Errorf("namespace version %d exceeds %d", cp.NamespaceVersion, math.MaxUint8)
+	}
+	namespace, err := share.NewNamespace(uint8(cp.NamespaceVersion), cp.NamespaceId)
+	if err != nil {
+		return err
+	}
+	if err := dah.ValidateBasic(); err != nil {
+		return err
+	}
+
+	hasher := nmt.NewNmtHasher(appconsts.NewBaseHashFunc(), share.NamespaceSize, true)
+	maxDepth := int(math.Log2(float64(len(dah.RowRoots))))
+	roots := make([][]byte, len(cp.SubtreeRootProofs))
+	for i, proof := range cp.SubtreeRootProofs {
+		if proof == nil {
+			return fmt.Errorf("subtree root proof %d is nil", i)
+		}
+		if err := proof.verify(hasher, dah, namespace, maxDepth); err != nil {
+			return fmt.Errorf("subtree root proof %d: %w", i, err)
+		}
+		roots[i] = proof.SubtreeRoot
+	}
+
+	if !bytes.Equal(merkle.HashFromByteSlices(roots), commitment) {
+		return errors.New("subtree roots do not hash to the share commitment")
+	}
+	return nil
+}
+
+// verify hashes the subtree root up to the root of its row and compares it to
+// the row root of dah.
+func (p SubtreeRootProof) verify(hasher *nmt.NmtHasher, dah da.DataAvailabilityHeader, namespace share.Namespace, maxDepth int) error {
+	if int(p.Row) >= dah.SquareSize() {
+		return fmt.Errorf("row %d is outside of the original data square of size %d", p.Row, dah.SquareSize())
+	}
+	if len(p.Path) == 0 || len(p.Path) > maxDepth {
+		return fmt.Errorf("path length %d must be between 1 and %d", len(p.Path), maxDepth)
+	}
+	// the first half of an extended row holds the original data.
+	if p.Path[0] != bool(inclusion.WalkLeft) {
+		return errors.New("subtree root is outside of the original data square")
+	}
+	if len(p.Siblings) != len(p.Path) {
+		return fmt.Errorf("the number of siblings %d must equal the path length %d", len(p.Siblings), len(p.Path))
+	}
+	if len(p.SubtreeRoot) < 2*share.NamespaceSize ||
+		!bytes.Equal(nmt.MinNamespace(p.SubtreeRoot, share.NamespaceSize), namespace.Bytes()) ||
+		!bytes.Equal(nmt.MaxNamespace(p.SubtreeRoot, share.NamespaceSize), namespace.Bytes()) {
+		return fmt.Errorf("subtree root does not belong to namespace %X", namespace.Bytes())
+	}
+
+	node := p.SubtreeRoot
+	for i, sibling := range p.Siblings {
+		var err error
+		if p.Path[len(p.Path)-1-i] == bool(inclusion.WalkRight) {
+			node, err = hasher.HashNode(sibling, node)
+		} else {
+			node, err = hasher.HashNode(node, sibling)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if !bytes.Equal(node, dah.RowRoots[p.Row]) {
+		return fmt.Errorf("subtree root does not hash to the root of row %d", p.Row)
+	}
+	return nil
+}
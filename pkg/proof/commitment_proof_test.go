@@ -0,0 +1,101 @@
+package proof_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v7/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v7/pkg/da"
+	"github.com/celestiaorg/celestia-app/v7/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v7/test/util/random"
+	"github.com/celestiaorg/celestia-app/v7/test/util/testfactory"
+	squareinclusion "github.com/celestiaorg/go-square/v3/inclusion"
+	"github.com/celestiaorg/go-square/v3/share"
+	blobtx "github.com/celestiaorg/go-square/v3/tx"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommitmentInclusionProof(t *testing.T) {
+	rollup := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	other := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+
+	txs := testfactory.GenerateRandomTxs(10, 200).ToSliceOfBytes()
+	var blobs []*share.Blob
+	for i, size := range []int{100, 30_000, 2_000, 70_000} {
+		ns := rollup
+		if i%2 == 1 {
+			ns = other
+		}
+		blob, err := share.NewV0Blob(ns, random.Bytes(size))
+		require.NoError(t, err)
+		blobs = append(blobs, blob)
+		rawTx, err := blobtx.MarshalBlobTx([]byte{byte(i)}, blob)
+		require.NoError(t, err)
+		txs = append(txs, rawTx)
+	}
+
+	eds, err := da.ConstructEDS(txs, appconsts.Version, -1)
+	require.NoError(t, err)
+	wantDAH, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	for _, blob := range blobs {
+		commitment, err := squareinclusion.CreateCommitment(blob, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold)
+		require.NoError(t, err)
+
+		commitmentProof, dah, err := proof.NewCommitmentInclusionProof(txs, blob.Namespace(), commitment)
+		require.NoError(t, err)
+		require.True(t, wantDAH.Equals(&dah))
+		require.NoError(t, commitmentProof.Verify(dah, commitment))
+
+		require.Error(t, commitmentProof.Verify(dah, bytes.Repeat([]byte{1}, len(commitment))))
+	}
+
+	commitment, err := squareinclusion.CreateCommitment(blobs[1], merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold)
+	require.NoError(t, err)
+
+	t.Run("commitment in another namespace", func(t *testing.T) {
+		_, _, err := proof.NewCommitmentInclusionProof(txs, rollup, commitment)
+		require.ErrorIs(t, err, proof.ErrCommitmentNotFound)
+	})
+
+	t.Run("tampered proofs", func(t *testing.T) {
+		commitmentProof, dah, err := proof.NewCommitmentInclusionProof(txs, other, commitment)
+		require.NoError(t, err)
+
+		tamper := func(fn func(p *proof.CommitmentProof)) proof.CommitmentProof {
+			var tampered proof.CommitmentProof
+			bz, err := commitmentProof.Marshal()
+			require.NoError(t, err)
+			require.NoError(t, tampered.Unmarshal(bz))
+			fn(&tampered)
+			return tampered
+		}
+
+		tests := map[string]proof.CommitmentProof{
+			"wrong namespace": tamper(func(p *proof.CommitmentProof) { p.NamespaceId = rollup.ID() }),
+			"wrong row": tamper(func(p *proof.CommitmentProof) {
+				p.SubtreeRootProofs[0].Row++
+			}),
+			"parity row": tamper(func(p *proof.CommitmentProof) {
+				p.SubtreeRootProofs[0].Row = uint32(dah.SquareSize())
+			}),
+			"wrong sibling": tamper(func(p *proof.CommitmentProof) {
+				p.SubtreeRootProofs[0].Siblings[0] = p.SubtreeRootProofs[0].SubtreeRoot
+			}),
+			"missing sibling": tamper(func(p *proof.CommitmentProof) {
+				p.SubtreeRootProofs[0].Siblings = p.SubtreeRootProofs[0].Siblings[1:]
+			}),
+			"missing subtree root": tamper(func(p *proof.CommitmentProof) {
+				p.SubtreeRootProofs = p.SubtreeRootProofs[1:]
+			}),
+			"empty": {},
+		}
+		for name, tampered := range tests {
+			t.Run(name, func(t *testing.T) {
+				require.Error(t, tampered.Verify(dah, commitment))
+			})
+		}
+	})
+}
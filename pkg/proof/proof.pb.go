@@ -330,11 +330,150 @@ func (m *Proof) GetAunts() [][]byte {
 	return nil
 }
 
+// CommitmentProof is a proof that the subtree roots a blob's share commitment
+// is computed over exist in the row roots of a data availability header.
+type CommitmentProof struct {
+	NamespaceId       []byte              `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	NamespaceVersion  uint32              `protobuf:"varint,2,opt,name=namespace_version,json=namespaceVersion,proto3" json:"namespace_version,omitempty"`
+	SubtreeRootProofs []*SubtreeRootProof `protobuf:"bytes,3,rep,name=subtree_root_proofs,json=subtreeRootProofs,proto3" json:"subtree_root_proofs,omitempty"`
+}
+
+func (m *CommitmentProof) Reset()         { *m = CommitmentProof{} }
+func (m *CommitmentProof) String() string { return proto.CompactTextString(m) }
+func (*CommitmentProof) ProtoMessage()    {}
+func (*CommitmentProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e53d87d8fb5ec353, []int{4}
+}
+func (m *CommitmentProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitmentProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitmentProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitmentProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitmentProof.Merge(m, src)
+}
+func (m *CommitmentProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitmentProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitmentProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitmentProof proto.InternalMessageInfo
+
+func (m *CommitmentProof) GetNamespaceId() []byte {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return nil
+}
+
+func (m *CommitmentProof) GetNamespaceVersion() uint32 {
+	if m != nil {
+		return m.NamespaceVersion
+	}
+	return 0
+}
+
+func (m *CommitmentProof) GetSubtreeRootProofs() []*SubtreeRootProof {
+	if m != nil {
+		return m.SubtreeRootProofs
+	}
+	return nil
+}
+
+// SubtreeRootProof is a Merkle proof of a subtree root to the row root of the
+// row holding it.
+type SubtreeRootProof struct {
+	SubtreeRoot []byte `protobuf:"bytes,1,opt,name=subtree_root,json=subtreeRoot,proto3" json:"subtree_root,omitempty"`
+	// row is the index of the row holding the subtree.
+	Row uint32 `protobuf:"varint,2,opt,name=row,proto3" json:"row,omitempty"`
+	// path holds the walk instructions from the row root down to the subtree
+	// root, false meaning left and true meaning right.
+	Path []bool `protobuf:"varint,3,rep,packed,name=path,proto3" json:"path,omitempty"`
+	// siblings are the siblings of the nodes on path, ordered from the subtree
+	// root up to the row root.
+	Siblings [][]byte `protobuf:"bytes,4,rep,name=siblings,proto3" json:"siblings,omitempty"`
+}
+
+func (m *SubtreeRootProof) Reset()         { *m = SubtreeRootProof{} }
+func (m *SubtreeRootProof) String() string { return proto.CompactTextString(m) }
+func (*SubtreeRootProof) ProtoMessage()    {}
+func (*SubtreeRootProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e53d87d8fb5ec353, []int{5}
+}
+func (m *SubtreeRootProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubtreeRootProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubtreeRootProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubtreeRootProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubtreeRootProof.Merge(m, src)
+}
+func (m *SubtreeRootProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubtreeRootProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubtreeRootProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubtreeRootProof proto.InternalMessageInfo
+
+func (m *SubtreeRootProof) GetSubtreeRoot() []byte {
+	if m != nil {
+		return m.SubtreeRoot
+	}
+	return nil
+}
+
+func (m *SubtreeRootProof) GetRow() uint32 {
+	if m != nil {
+		return m.Row
+	}
+	return 0
+}
+
+func (m *SubtreeRootProof) GetPath() []bool {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *SubtreeRootProof) GetSiblings() [][]byte {
+	if m != nil {
+		return m.Siblings
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ShareProof)(nil), "celestia.core.v1.proof.ShareProof")
 	proto.RegisterType((*RowProof)(nil), "celestia.core.v1.proof.RowProof")
 	proto.RegisterType((*NMTProof)(nil), "celestia.core.v1.proof.NMTProof")
 	proto.RegisterType((*Proof)(nil), "celestia.core.v1.proof.Proof")
+	proto.RegisterType((*CommitmentProof)(nil), "celestia.core.v1.proof.CommitmentProof")
+	proto.RegisterType((*SubtreeRootProof)(nil), "celestia.core.v1.proof.SubtreeRootProof")
 }
 
 func init() {
@@ -342,35 +481,42 @@ func init() {
 }
 
 var fileDescriptor_e53d87d8fb5ec353 = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xc1, 0x8e, 0xd3, 0x30,
-	0x10, 0xad, 0x37, 0x6d, 0x09, 0x6e, 0x56, 0x5a, 0x2c, 0x04, 0x96, 0x10, 0x51, 0xc8, 0x29, 0x12,
-	0xda, 0x44, 0x0b, 0xe2, 0xc8, 0x05, 0x0e, 0xc0, 0x01, 0x84, 0x0c, 0xe2, 0xc0, 0xa5, 0xf2, 0x36,
-	0x6e, 0x13, 0xd1, 0xcd, 0x44, 0xb6, 0xb7, 0xe1, 0x33, 0xf8, 0x0c, 0x3e, 0x85, 0x63, 0x8f, 0x1c,
-	0x51, 0xfb, 0x0b, 0x7c, 0x00, 0xf2, 0xb8, 0x09, 0xaa, 0x04, 0x7b, 0xb1, 0xe6, 0xbd, 0x99, 0x79,
-	0x6f, 0x66, 0x64, 0x9a, 0x2e, 0xd4, 0x5a, 0x19, 0x5b, 0xcb, 0x62, 0x01, 0x5a, 0x15, 0x9b, 0x8b,
-	0xa2, 0xd5, 0x00, 0x4b, 0xff, 0xe6, 0xad, 0x06, 0x0b, 0xec, 0x5e, 0x5f, 0x93, 0xbb, 0x9a, 0x7c,
-	0x73, 0x91, 0x63, 0x36, 0xfd, 0x4d, 0x28, 0xfd, 0x50, 0x49, 0xad, 0xde, 0x3b, 0xc8, 0x18, 0x1d,
-	0x97, 0xd2, 0x4a, 0x4e, 0x92, 0x20, 0x8b, 0x04, 0xc6, 0xec, 0x25, 0x8d, 0x8c, 0xab, 0x98, 0x63,
-	0x87, 0xe1, 0x27, 0x49, 0x90, 0xcd, 0x9e, 0x24, 0xf9, 0xbf, 0x15, 0xf3, 0x77, 0x6f, 0x3f, 0xa2,
-	0x96, 0x98, 0x99, 0x41, 0xd7, 0xb0, 0x47, 0x34, 0x6a, 0xe4, 0x95, 0x32, 0xad, 0x5c, 0xa8, 0x79,
-	0x5d, 0xf2, 0x20, 0x21, 0x59, 0x24, 0x66, 0x03, 0xf7, 0xa6, 0x64, 0xcf, 0xe9, 0x6d, 0x0d, 0x9d,
-	0x77, 0xe1, 0xe3, 0x84, 0xdc, 0x64, 0x22, 0xa0, 0xf3, 0x26, 0xa1, 0x3e, 0x44, 0xec, 0x31, 0xbd,
-	0xf3, 0xd7, 0x61, 0xa3, 0xb4, 0xa9, 0xa1, 0xe1, 0x93, 0x84, 0x64, 0xa7, 0xe2, 0x6c, 0x48, 0x7c,
-	0xf2, 0x7c, 0xfa, 0x9d, 0xd0, 0xb0, 0xd7, 0x60, 0x0f, 0xbc, 0xb1, 0x06, 0xb0, 0xe6, 0xb0, 0xb9,
-	0x93, 0x15, 0x0e, 0xb3, 0x67, 0x74, 0x7a, 0xb4, 0xf7, 0xc3, 0xff, 0x8d, 0xe4, 0xe7, 0x39, 0x14,
-	0xbb, 0x43, 0x3a, 0xbd, 0xc3, 0x9e, 0x18, 0x3b, 0x1f, 0x63, 0xa5, 0xb6, 0x73, 0x0d, 0x1d, 0x2e,
-	0x78, 0x2a, 0x42, 0x24, 0x04, 0x74, 0xec, 0x3e, 0xbd, 0xa5, 0x9a, 0x12, 0x53, 0x7e, 0xe8, 0xa9,
-	0x6a, 0x4a, 0x01, 0x5d, 0xaa, 0x68, 0xd8, 0x9f, 0x94, 0xdd, 0xa5, 0x13, 0x6c, 0xe0, 0x24, 0x21,
-	0xd9, 0x44, 0x78, 0xc0, 0xce, 0x68, 0xa0, 0x9a, 0x92, 0x9f, 0x20, 0xe7, 0x42, 0x57, 0xd7, 0x40,
-	0xa9, 0x0c, 0x0f, 0x70, 0x1b, 0x0f, 0x9c, 0xff, 0x5a, 0xc9, 0xe5, 0xbc, 0x92, 0xa6, 0x42, 0xff,
-	0x48, 0x84, 0x8e, 0x78, 0x2d, 0x4d, 0x95, 0x2e, 0xe9, 0x64, 0xf0, 0xb0, 0x60, 0xe5, 0x1a, 0x3d,
-	0x02, 0xe1, 0x81, 0x63, 0xeb, 0xa6, 0x54, 0x5f, 0xd1, 0x25, 0x10, 0x1e, 0x1c, 0x2b, 0x06, 0xc7,
-	0x8a, 0xae, 0x45, 0x5e, 0x37, 0xd6, 0xf0, 0xb1, 0x1f, 0x02, 0xc1, 0x8b, 0x57, 0x3f, 0x76, 0x31,
-	0xd9, 0xee, 0x62, 0xf2, 0x6b, 0x17, 0x93, 0x6f, 0xfb, 0x78, 0xb4, 0xdd, 0xc7, 0xa3, 0x9f, 0xfb,
-	0x78, 0xf4, 0xf9, 0x7c, 0x55, 0xdb, 0xea, 0xfa, 0x32, 0x5f, 0xc0, 0x55, 0xd1, 0xdf, 0x18, 0xf4,
-	0x6a, 0x88, 0xcf, 0x65, 0xdb, 0x16, 0xed, 0x97, 0x95, 0xff, 0xd7, 0x97, 0x53, 0xfc, 0xd8, 0x4f,
-	0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x27, 0x8a, 0x77, 0xfe, 0x02, 0x00, 0x00,
+	// 545 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xc1, 0x8e, 0xd3, 0x30,
+	0x10, 0x5d, 0x37, 0x6d, 0x09, 0x6e, 0x57, 0x74, 0x0d, 0x82, 0x08, 0x44, 0x14, 0x72, 0x8a, 0x84,
+	0x36, 0xd5, 0x82, 0x38, 0x72, 0x61, 0x0f, 0xc0, 0x01, 0x84, 0xbc, 0x08, 0x21, 0x2e, 0x95, 0xdb,
+	0xb8, 0x4d, 0x44, 0x6b, 0x47, 0xb6, 0xdb, 0xf0, 0x19, 0x7c, 0x06, 0xff, 0xc0, 0x0f, 0x70, 0xdc,
+	0x23, 0x47, 0xd4, 0xfe, 0x02, 0x1f, 0x80, 0x3c, 0x6e, 0xc2, 0xb6, 0x74, 0xb9, 0x44, 0x33, 0xe3,
+	0x99, 0xf7, 0xde, 0xf8, 0x59, 0xc1, 0xf1, 0x84, 0xcf, 0xb9, 0x36, 0x05, 0x1b, 0x4e, 0xa4, 0xe2,
+	0xc3, 0xd5, 0xd9, 0xb0, 0x54, 0x52, 0x4e, 0xdd, 0x37, 0x2d, 0x95, 0x34, 0x92, 0xdc, 0xad, 0x7b,
+	0x52, 0xdb, 0x93, 0xae, 0xce, 0x52, 0x38, 0x8d, 0x7f, 0x23, 0x8c, 0x2f, 0x72, 0xa6, 0xf8, 0x3b,
+	0x9b, 0x12, 0x82, 0xdb, 0x19, 0x33, 0x2c, 0x40, 0x91, 0x97, 0xf4, 0x29, 0xc4, 0xe4, 0x1c, 0xf7,
+	0xb5, 0xed, 0x18, 0xc1, 0x84, 0x0e, 0x5a, 0x91, 0x97, 0xf4, 0x9e, 0x44, 0xe9, 0x61, 0xc4, 0xf4,
+	0xed, 0x9b, 0xf7, 0x80, 0x45, 0x7b, 0xba, 0xc1, 0xd5, 0xe4, 0x11, 0xee, 0x0b, 0xb6, 0xe0, 0xba,
+	0x64, 0x13, 0x3e, 0x2a, 0xb2, 0xc0, 0x8b, 0x50, 0xd2, 0xa7, 0xbd, 0xa6, 0xf6, 0x3a, 0x23, 0xcf,
+	0xf1, 0x4d, 0x25, 0x2b, 0xc7, 0x12, 0xb4, 0x23, 0xf4, 0x3f, 0x12, 0x2a, 0x2b, 0x47, 0xe2, 0xab,
+	0x6d, 0x44, 0x1e, 0xe3, 0x93, 0xbf, 0x0c, 0x2b, 0xae, 0x74, 0x21, 0x45, 0xd0, 0x89, 0x50, 0x72,
+	0x4c, 0x07, 0xcd, 0xc1, 0x07, 0x57, 0x8f, 0xbf, 0x21, 0xec, 0xd7, 0x18, 0xe4, 0x81, 0x23, 0x56,
+	0x52, 0x1a, 0xbd, 0xdd, 0xdc, 0xc2, 0x52, 0x9b, 0x93, 0x67, 0xb8, 0xbb, 0xb3, 0xf7, 0xc3, 0xeb,
+	0x24, 0x39, 0x3d, 0xdb, 0x66, 0x7b, 0x91, 0x16, 0x6f, 0xbb, 0x27, 0xc4, 0x96, 0x47, 0x1b, 0xa6,
+	0xcc, 0x48, 0xc9, 0x0a, 0x16, 0x3c, 0xa6, 0x3e, 0x14, 0xa8, 0xac, 0xc8, 0x3d, 0x7c, 0x83, 0x8b,
+	0x0c, 0x8e, 0x9c, 0xe8, 0x2e, 0x17, 0x19, 0x95, 0x55, 0xcc, 0xb1, 0x5f, 0x5f, 0x29, 0xb9, 0x83,
+	0x3b, 0x30, 0x10, 0xa0, 0x08, 0x25, 0x1d, 0xea, 0x12, 0x32, 0xc0, 0x1e, 0x17, 0x59, 0xd0, 0x82,
+	0x9a, 0x0d, 0x6d, 0x9f, 0x90, 0x19, 0xd7, 0x81, 0x07, 0xdb, 0xb8, 0xc4, 0xf2, 0xcf, 0x39, 0x9b,
+	0x8e, 0x72, 0xa6, 0x73, 0xe0, 0xef, 0x53, 0xdf, 0x16, 0x5e, 0x31, 0x9d, 0xc7, 0x53, 0xdc, 0x69,
+	0x38, 0x8c, 0x34, 0x6c, 0x0e, 0x1c, 0x1e, 0x75, 0x89, 0xad, 0x16, 0x22, 0xe3, 0x5f, 0x80, 0xc5,
+	0xa3, 0x2e, 0xd9, 0x45, 0xf4, 0x76, 0x11, 0xed, 0x08, 0x5b, 0x0a, 0xa3, 0x83, 0xb6, 0x13, 0x01,
+	0x49, 0xfc, 0x1d, 0xe1, 0x5b, 0xe7, 0x72, 0xb1, 0x28, 0xcc, 0x82, 0x0b, 0xe3, 0x28, 0xf7, 0x1f,
+	0x07, 0xfa, 0xf7, 0x71, 0x1c, 0x74, 0xb7, 0x75, 0xd8, 0x5d, 0xf2, 0x11, 0xdf, 0xd6, 0xcb, 0xb1,
+	0x51, 0x9c, 0x83, 0xa9, 0xf5, 0xc3, 0xf5, 0xc0, 0xc0, 0xe4, 0x3a, 0x03, 0x2f, 0xdc, 0x88, 0xb5,
+	0xdd, 0x79, 0x79, 0xa2, 0xf7, 0x2a, 0x3a, 0xae, 0xf0, 0x60, 0xbf, 0xcd, 0xaa, 0xbf, 0xca, 0x56,
+	0xab, 0xbf, 0x32, 0x6c, 0x1d, 0xb2, 0xc6, 0x3a, 0xbd, 0x36, 0xb4, 0xef, 0xa3, 0x64, 0x26, 0x07,
+	0x4d, 0x3e, 0x85, 0x98, 0xdc, 0xc7, 0xbe, 0x2e, 0xc6, 0xf3, 0x42, 0xcc, 0xea, 0x3b, 0x6b, 0xf2,
+	0x17, 0x2f, 0x7f, 0xac, 0x43, 0x74, 0xb9, 0x0e, 0xd1, 0xaf, 0x75, 0x88, 0xbe, 0x6e, 0xc2, 0xa3,
+	0xcb, 0x4d, 0x78, 0xf4, 0x73, 0x13, 0x1e, 0x7d, 0x3a, 0x9d, 0x15, 0x26, 0x5f, 0x8e, 0xd3, 0x89,
+	0x5c, 0x0c, 0xeb, 0xcd, 0xa4, 0x9a, 0x35, 0xf1, 0x29, 0x2b, 0xcb, 0x61, 0xf9, 0x79, 0xe6, 0x7e,
+	0x07, 0xe3, 0x2e, 0xfc, 0x0f, 0x9e, 0xfe, 0x19, 0x00, 0x66, 0x6e, 0x78, 0x63, 0x35, 0x04, 0x00,
+	0x00,
 }
 
 func (m *ShareProof) Marshal() (dAtA []byte, err error) {
@@ -604,6 +750,112 @@ func (m *Proof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CommitmentProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitmentProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitmentProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubtreeRootProofs) > 0 {
+		for iNdEx := len(m.SubtreeRootProofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubtreeRootProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.NamespaceVersion != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.NamespaceVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubtreeRootProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubtreeRootProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubtreeRootProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Siblings) > 0 {
+		for iNdEx := len(m.Siblings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Siblings[iNdEx])
+			copy(dAtA[i:], m.Siblings[iNdEx])
+			i = encodeVarintProof(dAtA, i, uint64(len(m.Siblings[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Path) > 0 {
+		for iNdEx := len(m.Path) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.Path[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintProof(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Row != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Row))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SubtreeRoot) > 0 {
+		i -= len(m.SubtreeRoot)
+		copy(dAtA[i:], m.SubtreeRoot)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.SubtreeRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintProof(dAtA []byte, offset int, v uint64) int {
 	offset -= sovProof(v)
 	base := offset
@@ -728,6 +980,53 @@ func (m *Proof) Size() (n int) {
 	return n
 }
 
+func (m *CommitmentProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if m.NamespaceVersion != 0 {
+		n += 1 + sovProof(uint64(m.NamespaceVersion))
+	}
+	if len(m.SubtreeRootProofs) > 0 {
+		for _, e := range m.SubtreeRootProofs {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SubtreeRootProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubtreeRoot)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if m.Row != 0 {
+		n += 1 + sovProof(uint64(m.Row))
+	}
+	if len(m.Path) > 0 {
+		n += 1 + sovProof(uint64(len(m.Path))) + len(m.Path)*1
+	}
+	if len(m.Siblings) > 0 {
+		for _, b := range m.Siblings {
+			l = len(b)
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovProof(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1435,6 +1734,348 @@ func (m *Proof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CommitmentProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitmentProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitmentProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = append(m.NamespaceId[:0], dAtA[iNdEx:postIndex]...)
+			if m.NamespaceId == nil {
+				m.NamespaceId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceVersion", wireType)
+			}
+			m.NamespaceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NamespaceVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubtreeRootProofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubtreeRootProofs = append(m.SubtreeRootProofs, &SubtreeRootProof{})
+			if err := m.SubtreeRootProofs[len(m.SubtreeRootProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubtreeRootProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubtreeRootProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubtreeRootProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubtreeRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubtreeRoot = append(m.SubtreeRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.SubtreeRoot == nil {
+				m.SubtreeRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Row", wireType)
+			}
+			m.Row = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Row |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProof
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Path = append(m.Path, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProof
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProof
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProof
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.Path) == 0 {
+					m.Path = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProof
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Path = append(m.Path, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Siblings", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Siblings = append(m.Siblings, make([]byte, postIndex-iNdEx))
+			copy(m.Siblings[len(m.Siblings)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipProof(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,243 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/celestiaorg/celestia-app/v7/pkg/proof"
+	"github.com/celestiaorg/go-square/v3/share"
+)
+
+const (
+	// DefaultMaxChunkSize is the default maximum number of payload bytes
+	// carried by a single chunk blob. It leaves ample room below the max blob
+	// size accepted with the default governance max square size.
+	DefaultMaxChunkSize = 2 * 1024 * 1024
+	// MaxPayloadSize is the maximum size in bytes of a chunked payload. It
+	// bounds the memory allocated when reassembling untrusted chunks.
+	MaxPayloadSize = 1024 * 1024 * 1024
+
+	// chunkManifestVersion is the version of the manifest encoding.
+	chunkManifestVersion = 1
+	// ChunkManifestSize is the size in bytes of the manifest prefixed to the
+	// data of every chunk blob.
+	ChunkManifestSize = len(chunkMagic) + 1 + 4 + 4 + 8 + sha256.Size
+
+	// chunkMagic marks the start of a chunk manifest.
+	chunkMagic = "CHNK"
+)
+
+var (
+	ErrNotAChunk        = errors.New("blob is not a payload chunk")
+	ErrChunkMismatch    = errors.New("chunks belong to different payloads")
+	ErrMissingChunk     = errors.New("missing chunk")
+	ErrPayloadCorrupted = errors.New("reassembled payload does not match its content hash")
+)
+
+// ChunkManifest is the self-describing header of a chunk blob. It allows a
+// reader to group the chunks of a payload, order them and verify the
+// reassembled payload.
+type ChunkManifest struct {
+	// Index is the position of the chunk in the payload, starting at zero.
+	Index uint32
+	// Total is the number of chunks the payload was split into.
+	Total uint32
+	// PayloadSize is the size in bytes of the whole payload.
+	PayloadSize uint64
+	// ContentHash is the sha256 hash of the whole payload.
+	ContentHash [sha256.Size]byte
+}
+
+// Marshal encodes the manifest as a fixed size header of ChunkManifestSize
+// bytes.
+func (m ChunkManifest) Marshal() []byte {
+	buf := make([]byte, 0, ChunkManifestSize)
+	buf = append(buf, chunkMagic...)
+	buf = append(buf, chunkManifestVersion)
+	buf = binary.BigEndian.AppendUint32(buf, m.Index)
+	buf = binary.BigEndian.AppendUint32(buf, m.Total)
+	buf = binary.BigEndian.AppendUint64(buf, m.PayloadSize)
+	return append(buf, m.ContentHash[:]...)
+}
+
+// UnmarshalChunk splits the data of a chunk blob into its manifest and the
+// payload bytes it carries.
+func UnmarshalChunk(data []byte) (ChunkManifest, []byte, error) {
+	if len(data) < ChunkManifestSize || !bytes.HasPrefix(data, []byte(chunkMagic)) {
+		return ChunkManifest{}, nil, ErrNotAChunk
+	}
+	data = data[len(chunkMagic):]
+	if version := data[0]; version != chunkManifestVersion {
+		return ChunkManifest{}, nil, fmt.Errorf("unsupported chunk manifest version %d", version)
+	}
+	data = data[1:]
+
+	var m ChunkManifest
+	m.Index = binary.BigEndian.Uint32(data[0:4])
+	m.Total = binary.BigEndian.Uint32(data[4:8])
+	m.PayloadSize = binary.BigEndian.Uint64(data[8:16])
+	copy(m.ContentHash[:], data[16:16+sha256.Size])
+	if m.Total == 0 || m.Index >= m.Total {
+		return ChunkManifest{}, nil, fmt.Errorf("invalid chunk index %d of %d", m.Index, m.Total)
+	}
+	return m, data[16+sha256.Size:], nil
+}
+
+// SplitPayload splits payload into blobs under namespace, each carrying a
+// ChunkManifest followed by at most maxChunkSize bytes of the payload.
+func SplitPayload(namespace share.Namespace, payload []byte, maxChunkSize int) ([]*share.Blob, error) {
+	if len(payload) == 0 {
+		return nil, errors.New("payload cannot be empty")
+	}
+	if len(payload) > MaxPayloadSize {
+		return nil, fmt.Errorf("payload size %d exceeds the max of %d", len(payload), MaxPayloadSize)
+	}
+	if maxChunkSize <= 0 {
+		return nil, fmt.Errorf("max chunk size must be positive, got %d", maxChunkSize)
+	}
+	total := (len(payload) + maxChunkSize - 1) / maxChunkSize
+	if total > math.MaxUint32 {
+		return nil, fmt.Errorf("payload requires %d chunks, more than the max of %d", total, uint32(math.MaxUint32))
+	}
+
+	manifest := ChunkManifest{
+		Total:       uint32(total),
+		PayloadSize: uint64(len(payload)),
+		ContentHash: sha256.Sum256(payload),
+	}
+	blobs := make([]*share.Blob, total)
+	for i := range blobs {
+		manifest.Index = uint32(i)
+		chunk := payload[i*maxChunkSize : min((i+1)*maxChunkSize, len(payload))]
+		data := append(manifest.Marshal(), chunk...)
+
+		blob, err := share.NewV0Blob(namespace, data)
+		if err != nil {
+			return nil, err
+		}
+		blobs[i] = blob
+	}
+	return blobs, nil
+}
+
+// ReassemblePayload orders the chunk blobs of a single payload by their
+// manifest, concatenates them and verifies the result against the content
+// hash. Duplicate chunks are ignored.
+func ReassemblePayload(blobs []*share.Blob) ([]byte, error) {
+	if len(blobs) == 0 {
+		return nil, ErrMissingChunk
+	}
+
+	var (
+		first  ChunkManifest
+		chunks = make(map[uint32][]byte)
+	)
+	for i, blob := range blobs {
+		manifest, chunk, err := UnmarshalChunk(blob.Data())
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			first = manifest
+		} else if manifest.Total != first.Total || manifest.PayloadSize != first.PayloadSize || manifest.ContentHash != first.ContentHash {
+			return nil, ErrChunkMismatch
+		}
+		chunks[manifest.Index] = chunk
+	}
+
+	indexes := make([]uint32, 0, len(chunks))
+	for index := range chunks {
+		indexes = append(indexes, index)
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+	if uint32(len(indexes)) != first.Total {
+		return nil, fmt.Errorf("%w: got %d of %d chunks", ErrMissingChunk, len(indexes), first.Total)
+	}
+
+	// The manifest is untrusted so check the payload size before allocating.
+	var size uint64
+	for _, chunk := range chunks {
+		size += uint64(len(chunk))
+	}
+	if first.PayloadSize > MaxPayloadSize {
+		return nil, fmt.Errorf("%w: payload size %d exceeds the max of %d", ErrPayloadCorrupted, first.PayloadSize, MaxPayloadSize)
+	}
+	if size != first.PayloadSize {
+		return nil, fmt.Errorf("%w: chunks hold %d bytes but the payload size is %d", ErrPayloadCorrupted, size, first.PayloadSize)
+	}
+
+	payload := make([]byte, 0, first.PayloadSize)
+	for _, index := range indexes {
+		payload = append(payload, chunks[index]...)
+	}
+	if sha256.Sum256(payload) != first.ContentHash {
+		return nil, ErrPayloadCorrupted
+	}
+	return payload, nil
+}
+
+// ChunkProof is the proof that the shares holding a chunk blob were included
+// in the block with the given data root.
+type ChunkProof struct {
+	DataRoot []byte
+	Proof    proof.ShareProof
+}
+
+// ReassembleVerifiedPayload verifies every share proof against its data root,
+// parses the chunk blobs from the proven shares and reassembles the payload.
+// The proofs may be provided in any order. Every blob in the proven share
+// ranges must be a chunk of the payload.
+func ReassembleVerifiedPayload(proofs []ChunkProof) ([]byte, error) {
+	var blobs []*share.Blob
+	for i, p := range proofs {
+		if err := p.Proof.Validate(p.DataRoot); err != nil {
+			return nil, fmt.Errorf("chunk proof %d: %w", i, err)
+		}
+		shares, err := share.FromBytes(p.Proof.Data)
+		if err != nil {
+			return nil, fmt.Errorf("chunk proof %d: %w", i, err)
+		}
+		parsed, err := share.ParseBlobs(shares)
+		if err != nil {
+			return nil, fmt.Errorf("chunk proof %d: parsing blobs: %w", i, err)
+		}
+		blobs = append(blobs, parsed...)
+	}
+	return ReassemblePayload(blobs)
+}
+
+// SubmitChunkedPayload splits payload into chunks of at most
+// DefaultMaxChunkSize bytes under namespace, broadcasts one PFB per chunk using
+// the default account and waits for all of them to be confirmed. The
+// responses are returned in chunk order.
+func (client *TxClient) SubmitChunkedPayload(ctx context.Context, namespace share.Namespace, payload []byte, opts ...TxOption) ([]*TxResponse, error) {
+	blobs, err := SplitPayload(namespace, payload, DefaultMaxChunkSize)
+	if err != nil {
+		return nil, err
+	}
+
+	txHashes := make([]string, len(blobs))
+	for i, blob := range blobs {
+		resp, err := client.BroadcastPayForBlob(ctx, []*share.Blob{blob}, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("broadcasting chunk %d of %d: %w", i, len(blobs), err)
+		}
+		txHashes[i] = resp.TxHash
+	}
+
+	results := client.ConfirmTxs(ctx, txHashes...)
+	responses := make([]*TxResponse, len(results))
+	for i, result := range results {
+		if result.Error != nil {
+			return responses, fmt.Errorf("confirming chunk %d of %d: %w", i, len(blobs), result.Error)
+		}
+		responses[i] = result.TxResponse
+	}
+	return responses, nil
+}
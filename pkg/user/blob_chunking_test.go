@@ -0,0 +1,131 @@
+package user_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v7/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v7/pkg/da"
+	"github.com/celestiaorg/celestia-app/v7/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v7/pkg/user"
+	square "github.com/celestiaorg/go-square/v3"
+	"github.com/celestiaorg/go-square/v3/share"
+	blobtx "github.com/celestiaorg/go-square/v3/tx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitAndReassemblePayload(t *testing.T) {
+	ns := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	payload := make([]byte, 2500)
+	_, err := rand.Read(payload)
+	require.NoError(t, err)
+
+	blobs, err := user.SplitPayload(ns, payload, 1000)
+	require.NoError(t, err)
+	require.Len(t, blobs, 3)
+	for i, blob := range blobs {
+		require.Equal(t, ns, blob.Namespace())
+		manifest, _, err := user.UnmarshalChunk(blob.Data())
+		require.NoError(t, err)
+		require.EqualValues(t, i, manifest.Index)
+		require.EqualValues(t, 3, manifest.Total)
+		require.EqualValues(t, len(payload), manifest.PayloadSize)
+	}
+	require.Len(t, blobs[2].Data(), user.ChunkManifestSize+500)
+
+	t.Run("any order with duplicates", func(t *testing.T) {
+		got, err := user.ReassemblePayload([]*share.Blob{blobs[2], blobs[0], blobs[1], blobs[0]})
+		require.NoError(t, err)
+		require.Equal(t, payload, got)
+	})
+
+	t.Run("missing chunk", func(t *testing.T) {
+		_, err := user.ReassemblePayload(blobs[:2])
+		require.ErrorIs(t, err, user.ErrMissingChunk)
+	})
+
+	t.Run("chunk of another payload", func(t *testing.T) {
+		other, err := user.SplitPayload(ns, []byte("other payload"), 1000)
+		require.NoError(t, err)
+		_, err = user.ReassemblePayload([]*share.Blob{blobs[0], other[0]})
+		require.ErrorIs(t, err, user.ErrChunkMismatch)
+	})
+
+	t.Run("tampered chunk", func(t *testing.T) {
+		data := bytes.Clone(blobs[1].Data())
+		data[len(data)-1] ^= 0xFF
+		tampered, err := share.NewV0Blob(ns, data)
+		require.NoError(t, err)
+		_, err = user.ReassemblePayload([]*share.Blob{blobs[0], tampered, blobs[2]})
+		require.ErrorIs(t, err, user.ErrPayloadCorrupted)
+	})
+
+	t.Run("forged payload size", func(t *testing.T) {
+		for _, payloadSize := range []uint64{uint64(len(payload)) + 1, user.MaxPayloadSize + 1, ^uint64(0)} {
+			forged := make([]*share.Blob, len(blobs))
+			for i, blob := range blobs {
+				manifest, chunk, err := user.UnmarshalChunk(blob.Data())
+				require.NoError(t, err)
+				manifest.PayloadSize = payloadSize
+				forged[i], err = share.NewV0Blob(ns, append(manifest.Marshal(), chunk...))
+				require.NoError(t, err)
+			}
+			_, err = user.ReassemblePayload(forged)
+			require.ErrorIs(t, err, user.ErrPayloadCorrupted)
+		}
+	})
+
+	t.Run("not a chunk", func(t *testing.T) {
+		blob, err := share.NewV0Blob(ns, []byte("not a chunk"))
+		require.NoError(t, err)
+		_, err = user.ReassemblePayload([]*share.Blob{blob})
+		require.ErrorIs(t, err, user.ErrNotAChunk)
+	})
+}
+
+func TestReassembleVerifiedPayload(t *testing.T) {
+	ns := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+	payload := make([]byte, 3000)
+	_, err := rand.Read(payload)
+	require.NoError(t, err)
+
+	blobs, err := user.SplitPayload(ns, payload, 1200)
+	require.NoError(t, err)
+
+	// every chunk is paid for by its own PFB, as SubmitChunkedPayload does.
+	txs := make([][]byte, len(blobs))
+	for i, blob := range blobs {
+		txs[i], err = blobtx.MarshalBlobTx([]byte{byte(i)}, blob)
+		require.NoError(t, err)
+	}
+	builder, err := square.NewBuilder(appconsts.SquareSizeUpperBound, appconsts.SubtreeRootThreshold, txs...)
+	require.NoError(t, err)
+	dataSquare, err := builder.Export()
+	require.NoError(t, err)
+
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	dataRoot := dah.Hash()
+
+	proofs := make([]user.ChunkProof, len(blobs))
+	for i := range blobs {
+		start, err := builder.FindBlobStartingIndex(i, 0)
+		require.NoError(t, err)
+		length, err := builder.BlobShareLength(i, 0)
+		require.NoError(t, err)
+		shareProof, err := proof.NewShareInclusionProofFromEDS(eds, ns, share.NewRange(start, start+length))
+		require.NoError(t, err)
+		proofs[len(blobs)-1-i] = user.ChunkProof{DataRoot: dataRoot, Proof: shareProof}
+	}
+
+	got, err := user.ReassembleVerifiedPayload(proofs)
+	require.NoError(t, err)
+	require.Equal(t, payload, got)
+
+	proofs[0].DataRoot = bytes.Repeat([]byte{0xFF}, len(dataRoot))
+	_, err = user.ReassembleVerifiedPayload(proofs)
+	require.Error(t, err)
+}
@@ -0,0 +1,49 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	blobtypes "github.com/celestiaorg/celestia-app/v7/x/blob/types"
+	"github.com/celestiaorg/go-square/v3/share"
+)
+
+// CompressBlobs returns copies of blobs whose data is compressed with the
+// standard blob compression encoding. See blobtypes.CompressBlob.
+func CompressBlobs(blobs []*share.Blob) ([]*share.Blob, error) {
+	compressed := make([]*share.Blob, len(blobs))
+	for i, blob := range blobs {
+		var err error
+		compressed[i], err = blobtypes.CompressBlob(blob)
+		if err != nil {
+			return nil, fmt.Errorf("compressing blob %d: %w", i, err)
+		}
+	}
+	return compressed, nil
+}
+
+// DecompressBlobs returns copies of blobs whose data is decompressed. Blobs
+// that are not compressed are returned as is, so it can be used on all the
+// blobs read from a namespace.
+func DecompressBlobs(blobs []*share.Blob) ([]*share.Blob, error) {
+	decompressed := make([]*share.Blob, len(blobs))
+	for i, blob := range blobs {
+		var err error
+		decompressed[i], err = blobtypes.DecompressBlob(blob)
+		if err != nil {
+			return nil, fmt.Errorf("decompressing blob %d: %w", i, err)
+		}
+	}
+	return decompressed, nil
+}
+
+// SubmitCompressedPayForBlob compresses the data of blobs and submits them
+// like SubmitPayForBlob. Gas is charged on the compressed bytes that are
+// posted.
+func (client *TxClient) SubmitCompressedPayForBlob(ctx context.Context, blobs []*share.Blob, opts ...TxOption) (*TxResponse, error) {
+	compressed, err := CompressBlobs(blobs)
+	if err != nil {
+		return nil, err
+	}
+	return client.SubmitPayForBlob(ctx, compressed, opts...)
+}
@@ -0,0 +1,41 @@
+package user_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v7/pkg/user"
+	"github.com/celestiaorg/go-square/v3/share"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressAndDecompressBlobs(t *testing.T) {
+	ns := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	random := make([]byte, 100)
+	_, err := rand.Read(random)
+	require.NoError(t, err)
+
+	blobs := []*share.Blob{
+		mustNewBlob(t, ns, bytes.Repeat([]byte("rollup batch "), 1000)),
+		mustNewBlob(t, ns, random),
+	}
+	compressed, err := user.CompressBlobs(blobs)
+	require.NoError(t, err)
+	require.Len(t, compressed, 2)
+	require.Less(t, len(compressed[0].Data()), len(blobs[0].Data()))
+
+	decompressed, err := user.DecompressBlobs(append(compressed, blobs[1]))
+	require.NoError(t, err)
+	require.Equal(t, append(blobs, blobs[1]), decompressed)
+
+	_, err = user.CompressBlobs(compressed)
+	require.Error(t, err)
+}
+
+func mustNewBlob(t *testing.T, ns share.Namespace, data []byte) *share.Blob {
+	t.Helper()
+	blob, err := share.NewV0Blob(ns, data)
+	require.NoError(t, err)
+	return blob
+}
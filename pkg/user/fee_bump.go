@@ -0,0 +1,127 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v7/pkg/appconsts"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGasPriceMultiplier is the factor by which the gas price of an evicted
+// transaction is increased on every resubmission if none is specified.
+const DefaultGasPriceMultiplier = 1.25
+
+var errMaxGasPriceReached = errors.New("gas price already at the configured maximum")
+
+// FeeBumpPolicy configures how evicted transactions are resubmitted. The CAT
+// mempool does not replace a pending transaction with one of the same
+// sequence, so the gas price is only raised once a transaction has left the
+// mempool: instead of resubmitting the evicted bytes, the transaction is
+// signed again at its original sequence with a higher gas price.
+type FeeBumpPolicy struct {
+	// GasPriceMultiplier is applied to the gas price of the previous attempt.
+	// It must be greater than 1.
+	GasPriceMultiplier float64
+	// MaxGasPrice is the highest gas price, in utia per gas unit, that a
+	// resubmission may pay.
+	MaxGasPrice float64
+}
+
+// DefaultFeeBumpPolicy returns a policy that raises the gas price by
+// DefaultGasPriceMultiplier on every eviction up to maxGasPrice.
+func DefaultFeeBumpPolicy(maxGasPrice float64) FeeBumpPolicy {
+	return FeeBumpPolicy{
+		GasPriceMultiplier: DefaultGasPriceMultiplier,
+		MaxGasPrice:        maxGasPrice,
+	}
+}
+
+// ValidateBasic checks that the policy is usable.
+func (p FeeBumpPolicy) ValidateBasic() error {
+	if p.GasPriceMultiplier <= 1 {
+		return fmt.Errorf("gas price multiplier must be greater than 1, got %f", p.GasPriceMultiplier)
+	}
+	if p.MaxGasPrice <= 0 {
+		return fmt.Errorf("max gas price must be positive, got %f", p.MaxGasPrice)
+	}
+	return nil
+}
+
+// nextGasPrice returns the gas price of the next attempt or
+// errMaxGasPriceReached if the current gas price is already at the cap.
+func (p FeeBumpPolicy) nextGasPrice(current float64) (float64, error) {
+	if current >= p.MaxGasPrice {
+		return 0, errMaxGasPriceReached
+	}
+	return math.Min(current*p.GasPriceMultiplier, p.MaxGasPrice), nil
+}
+
+// WithFeeBumpPolicy makes ConfirmTx resubmit evicted transactions at a higher
+// gas price. It panics if the policy is invalid.
+func WithFeeBumpPolicy(policy FeeBumpPolicy) Option {
+	if err := policy.ValidateBasic(); err != nil {
+		panic(fmt.Sprintf("invalid fee bump policy: %v", err))
+	}
+	return func(c *TxClient) {
+		c.feeBumpPolicy = &policy
+	}
+}
+
+// resubmitWithHigherGasPrice signs the evicted transaction again at its
+// original sequence with a higher gas price, broadcasts it and tracks it in
+// place of the evicted attempt. It returns the hash of the new attempt.
+func (client *TxClient) resubmitWithHigherGasPrice(ctx context.Context, txHash string) (string, error) {
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+
+	info, exists := client.txTracker[txHash]
+	if !exists {
+		return "", fmt.Errorf("tx: %s not found in txTracker", txHash)
+	}
+	account := client.signer.Account(info.signer)
+	if account == nil {
+		return "", fmt.Errorf("account %s not found", info.signer)
+	}
+
+	txBuilder, blobTx, err := client.rebuildTransaction(info.txBytes)
+	if err != nil {
+		return "", err
+	}
+
+	gasLimit := txBuilder.GetTx().GetGas()
+	if gasLimit == 0 {
+		return "", errors.New("transaction has no gas limit")
+	}
+	currentFee := txBuilder.GetTx().GetFee().AmountOf(appconsts.BondDenom)
+	gasPrice, err := client.feeBumpPolicy.nextGasPrice(float64(currentFee.Uint64()) / float64(gasLimit))
+	if err != nil {
+		return "", err
+	}
+	fee := int64(math.Ceil(gasPrice * float64(gasLimit)))
+	if fee <= currentFee.Int64() {
+		return "", errMaxGasPriceReached
+	}
+	txBuilder.SetFeeAmount(sdktypes.NewCoins(sdktypes.NewCoin(appconsts.BondDenom, sdkmath.NewInt(fee))))
+
+	if _, _, err := client.signer.signTransactionWithSequence(txBuilder, account, info.sequence); err != nil {
+		return "", fmt.Errorf("re-signing transaction: %w", err)
+	}
+	txBytes, err := client.encodeRebuiltTransaction(txBuilder, blobTx)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := client.sendTxToConnection(ctx, client.conns[0], txBytes)
+	if err != nil {
+		return "", err
+	}
+
+	delete(client.txTracker, txHash)
+	client.deleteFromJournal(txHash)
+	client.trackTransactionWithSequence(info.signer, info.sequence, resp.TxHash, txBytes)
+	return resp.TxHash, nil
+}
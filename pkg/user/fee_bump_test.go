@@ -0,0 +1,175 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v7/app/grpc/tx"
+	"github.com/celestiaorg/celestia-app/v7/pkg/appconsts"
+	"github.com/cometbft/cometbft/rpc/core"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestFeeBumpPolicy(t *testing.T) {
+	require.Error(t, FeeBumpPolicy{GasPriceMultiplier: 1, MaxGasPrice: 1}.ValidateBasic())
+	require.Error(t, FeeBumpPolicy{GasPriceMultiplier: 2}.ValidateBasic())
+	require.NoError(t, DefaultFeeBumpPolicy(1).ValidateBasic())
+
+	policy := FeeBumpPolicy{GasPriceMultiplier: 2, MaxGasPrice: 0.5}
+	gasPrice, err := policy.nextGasPrice(0.1)
+	require.NoError(t, err)
+	require.InDelta(t, 0.2, gasPrice, 1e-9)
+	gasPrice, err = policy.nextGasPrice(0.4)
+	require.NoError(t, err)
+	require.InDelta(t, 0.5, gasPrice, 1e-9)
+	_, err = policy.nextGasPrice(0.5)
+	require.ErrorIs(t, err, errMaxGasPriceReached)
+}
+
+// feeBumpServer names every broadcast attempt-<n> and records its bytes.
+// Attempts are answered with the scripted statuses, defaulting to pending.
+type feeBumpServer struct {
+	tx.UnimplementedTxServer
+	sdktx.UnimplementedServiceServer
+
+	mtx      sync.Mutex
+	statuses map[string]*tx.TxStatusResponse
+	attempts [][]byte
+}
+
+func (s *feeBumpServer) BroadcastTx(_ context.Context, req *sdktx.BroadcastTxRequest) (*sdktx.BroadcastTxResponse, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.attempts = append(s.attempts, req.TxBytes)
+	return &sdktx.BroadcastTxResponse{TxResponse: &sdktypes.TxResponse{TxHash: fmt.Sprintf("attempt-%d", len(s.attempts))}}, nil
+}
+
+func (s *feeBumpServer) TxStatus(_ context.Context, req *tx.TxStatusRequest) (*tx.TxStatusResponse, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if status, ok := s.statuses[req.TxId]; ok {
+		return status, nil
+	}
+	return &tx.TxStatusResponse{Status: core.TxStatusPending}, nil
+}
+
+func newFeeBumpConn(t *testing.T, server *feeBumpServer) *grpc.ClientConn {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	tx.RegisterTxServer(s, server)
+	sdktx.RegisterServiceServer(s, server)
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func TestConfirmTxBumpsFeeOfEvictedTx(t *testing.T) {
+	encCfg := newPartialTxEncodingConfig()
+	signer := newPartialTxSigner(t, encCfg, "alice", 7)
+	server := &feeBumpServer{statuses: map[string]*tx.TxStatusResponse{
+		"attempt-1": {Status: core.TxStatusEvicted},
+		"attempt-2": {Status: core.TxStatusEvicted},
+		"attempt-3": {Status: core.TxStatusCommitted, Height: 10},
+	}}
+	conn := newFeeBumpConn(t, server)
+	client, err := NewTxClient(encCfg.Codec, signer, conn, encCfg.InterfaceRegistry,
+		WithPollTime(10*time.Millisecond),
+		WithFeeBumpPolicy(FeeBumpPolicy{GasPriceMultiplier: 2, MaxGasPrice: 0.3}),
+	)
+	require.NoError(t, err)
+
+	addr := signer.Account("alice").Address()
+	msg := banktypes.NewMsgSend(addr, addr, sdktypes.NewCoins(sdktypes.NewInt64Coin(appconsts.BondDenom, 1)))
+	resp, err := client.BroadcastTx(context.Background(), []sdktypes.Msg{msg}, SetGasLimit(1000), SetFee(100))
+	require.NoError(t, err)
+	require.Equal(t, "attempt-1", resp.TxHash)
+
+	confirmed, err := client.ConfirmTx(context.Background(), resp.TxHash)
+	require.NoError(t, err)
+	require.Equal(t, "attempt-3", confirmed.TxHash)
+	require.EqualValues(t, 10, confirmed.Height)
+
+	// every attempt reuses the original sequence while the fee doubles up to
+	// the maximum gas price.
+	require.Len(t, server.attempts, 3)
+	for i, wantFee := range []int64{100, 200, 300} {
+		decoded, err := signer.DecodeTx(server.attempts[i])
+		require.NoError(t, err)
+		require.EqualValues(t, wantFee, decoded.GetFee().AmountOf(appconsts.BondDenom).Int64())
+		sigs, err := decoded.(authsigning.SigVerifiableTx).GetSignaturesV2()
+		require.NoError(t, err)
+		require.Len(t, sigs, 1)
+		require.EqualValues(t, 0, sigs[0].Sequence)
+	}
+	require.EqualValues(t, 1, signer.Account("alice").Sequence())
+	for _, txHash := range []string{"attempt-1", "attempt-2", "attempt-3"} {
+		_, _, _, exists := client.GetTxFromTxTracker(txHash)
+		require.False(t, exists)
+	}
+}
+
+func TestConfirmTxReturnsIncludedReplacedAttempt(t *testing.T) {
+	encCfg := newPartialTxEncodingConfig()
+	signer := newPartialTxSigner(t, encCfg, "alice", 7)
+	server := &feeBumpServer{statuses: map[string]*tx.TxStatusResponse{
+		"attempt-1": {Status: core.TxStatusEvicted},
+	}}
+	conn := newFeeBumpConn(t, server)
+	client, err := NewTxClient(encCfg.Codec, signer, conn, encCfg.InterfaceRegistry,
+		WithPollTime(10*time.Millisecond),
+		WithFeeBumpPolicy(DefaultFeeBumpPolicy(1)),
+	)
+	require.NoError(t, err)
+
+	addr := signer.Account("alice").Address()
+	msg := banktypes.NewMsgSend(addr, addr, sdktypes.NewCoins(sdktypes.NewInt64Coin(appconsts.BondDenom, 1)))
+	resp, err := client.BroadcastTx(context.Background(), []sdktypes.Msg{msg}, SetGasLimit(1000), SetFee(100))
+	require.NoError(t, err)
+
+	go func() {
+		// another node kept the evicted attempt and included it, so the
+		// replacement fails with a sequence mismatch.
+		for {
+			server.mtx.Lock()
+			if len(server.attempts) == 2 {
+				break
+			}
+			server.mtx.Unlock()
+			time.Sleep(5 * time.Millisecond)
+		}
+		server.statuses["attempt-1"] = &tx.TxStatusResponse{Status: core.TxStatusCommitted, Height: 11}
+		server.statuses["attempt-2"] = &tx.TxStatusResponse{Status: core.TxStatusRejected, ExecutionCode: 32}
+		server.mtx.Unlock()
+	}()
+
+	confirmed, err := client.ConfirmTx(context.Background(), resp.TxHash)
+	require.NoError(t, err)
+	require.Equal(t, "attempt-1", confirmed.TxHash)
+	require.EqualValues(t, 11, confirmed.Height)
+	require.EqualValues(t, 1, signer.Account("alice").Sequence())
+	_, _, _, exists := client.GetTxFromTxTracker("attempt-2")
+	require.False(t, exists)
+}
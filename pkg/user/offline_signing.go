@@ -0,0 +1,133 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v7/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v7/x/blob/types"
+	"github.com/celestiaorg/go-square/v3/share"
+	blobtx "github.com/celestiaorg/go-square/v3/tx"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// NewUnsignedPayForBlobs builds an unsigned PFB paying for blobs on behalf of
+// the account described by signer. It does not require a connection to the
+// chain: the account number and sequence are taken from signer and, unless
+// overwritten through opts, the gas limit is estimated with the default gas
+// parameters and the fee is computed with the provided gas price.
+//
+// The returned PartialTx can be exported to an offline machine, signed there
+// with SignPartialTx using any keyring backend, including a ledger, and
+// broadcast from an online host with BroadcastPartialTx or, once finalized,
+// BroadcastSignedTx.
+func (s *Signer) NewUnsignedPayForBlobs(signer TxSigner, blobs []*share.Blob, gasPrice float64, opts ...TxOption) (*PartialTx, error) {
+	if gasPrice <= 0 {
+		return nil, fmt.Errorf("gas price must be positive, got %f", gasPrice)
+	}
+	addr, err := s.addressCodec.BytesToString(signer.PubKey.Address())
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := blobtypes.NewMsgPayForBlobs(addr, appconsts.Version, blobs...)
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// prepend the defaults so that they can be overwritten by the user.
+	opts = append([]TxOption{SetGasLimitAndGasPrice(blobtypes.DefaultEstimateGas(msg), gasPrice)}, opts...)
+
+	return s.NewPartialTx([]sdktypes.Msg{msg}, []TxSigner{signer}, blobs, opts...)
+}
+
+// ValidateSignedTx checks that txBytes hold a transaction that is signed by
+// all of its signers and returns its signatures. Blob txs are additionally
+// validated with blobtypes.ValidateBlobTx. Signatures are verified by the
+// chain, not by ValidateSignedTx.
+func (s *Signer) ValidateSignedTx(txBytes []byte) ([]signing.SignatureV2, error) {
+	blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(txBytes)
+	if isBlobTx && err != nil {
+		return nil, err
+	}
+	if isBlobTx {
+		if err := blobtypes.ValidateBlobTx(s.enc, blobTx, appconsts.SubtreeRootThreshold, appconsts.Version); err != nil {
+			return nil, fmt.Errorf("invalid blob tx: %w", err)
+		}
+		txBytes = blobTx.Tx
+	}
+
+	tx, err := s.DecodeTx(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	sigs, err := tx.GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+	fullySigned, err := isFullySigned(sigs)
+	if err != nil {
+		return nil, err
+	}
+	if !fullySigned {
+		return nil, errors.New("transaction is missing signatures")
+	}
+	return sigs, nil
+}
+
+// BroadcastSignedTx validates and broadcasts a transaction signed elsewhere,
+// e.g. on an offline machine. It does not confirm that the transaction has
+// been committed on chain. The sequences of the signers managed by the client
+// are advanced past the transaction's, never moved back, and the transaction
+// is tracked under the first of them so that ConfirmTx can resubmit it if it
+// is evicted.
+func (client *TxClient) BroadcastSignedTx(ctx context.Context, txBytes []byte) (*sdktypes.TxResponse, error) {
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+
+	sigs, err := client.signer.ValidateSignedTx(txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.sendTxToConnection(ctx, client.conns[0], txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	tracked := false
+	for _, sig := range sigs {
+		name := client.signer.accountNameByAddress(sdktypes.AccAddress(sig.PubKey.Address()))
+		account := client.signer.Account(name)
+		if account == nil {
+			continue
+		}
+		if !tracked {
+			client.trackTransactionWithSequence(name, sig.Sequence, resp.TxHash, txBytes)
+			tracked = true
+		}
+		// Only advance the sequence: a tx signed elsewhere with an older
+		// sequence must not make the client reuse sequences it already used.
+		if sig.Sequence >= account.Sequence() {
+			if err := client.signer.SetSequence(name, sig.Sequence+1); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return resp, nil
+}
+
+// SubmitSignedTx broadcasts a transaction signed elsewhere and waits for it to
+// be confirmed.
+func (client *TxClient) SubmitSignedTx(ctx context.Context, txBytes []byte) (*TxResponse, error) {
+	resp, err := client.BroadcastSignedTx(ctx, txBytes)
+	if err != nil {
+		return nil, err
+	}
+	return client.ConfirmTx(ctx, resp.TxHash)
+}
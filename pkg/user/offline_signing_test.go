@@ -0,0 +1,150 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v7/app/encoding"
+	"github.com/celestiaorg/celestia-app/v7/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v7/x/blob/types"
+	"github.com/celestiaorg/go-square/v3/share"
+	blobtx "github.com/celestiaorg/go-square/v3/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/stretchr/testify/require"
+)
+
+// newPartialTxEncodingConfig returns an encoding config that registers the
+// messages used by the internal signing tests. app.ModuleEncodingRegisters can
+// not be used since the blob module imports this package.
+func newPartialTxEncodingConfig() encoding.Config {
+	encCfg := encoding.MakeConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{})
+	blobtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+	blobtypes.RegisterLegacyAminoCodec(encCfg.Amino)
+	return encCfg
+}
+
+// newPartialTxSigner returns a Signer holding a single freshly generated key,
+// as if it ran in its own process.
+func newPartialTxSigner(t *testing.T, encCfg encoding.Config, name string, accountNumber uint64) *Signer {
+	t.Helper()
+	kr := keyring.NewInMemory(encCfg.Codec)
+	path := hd.CreateHDPath(sdktypes.CoinType, 0, 0).String()
+	_, _, err := kr.NewMnemonic(name, keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	signer, err := NewSigner(kr, encCfg.TxConfig, "test-chain", NewAccount(name, accountNumber, 0))
+	require.NoError(t, err)
+	return signer
+}
+
+// roundTrip simulates exporting ptx to another process.
+func roundTrip(t *testing.T, ptx *PartialTx) *PartialTx {
+	t.Helper()
+	bz, err := json.Marshal(ptx)
+	require.NoError(t, err)
+	var out PartialTx
+	require.NoError(t, json.Unmarshal(bz, &out))
+	return &out
+}
+
+func TestOfflinePayForBlobs(t *testing.T) {
+	encCfg := newPartialTxEncodingConfig()
+	offline := newPartialTxSigner(t, encCfg, "treasury", 7)
+	pubKey := offline.Account("treasury").PubKey()
+
+	// the online host only knows the public key of the treasury account.
+	watchOnly := keyring.NewInMemory(encCfg.Codec)
+	_, err := watchOnly.SaveOfflineKey("treasury", pubKey)
+	require.NoError(t, err)
+	online, err := NewSigner(watchOnly, encCfg.TxConfig, "test-chain")
+	require.NoError(t, err)
+
+	ns := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	blob, err := share.NewV0Blob(ns, []byte("signed offline"))
+	require.NoError(t, err)
+
+	const gasPrice = 0.1
+	ptx, err := online.NewUnsignedPayForBlobs(TxSigner{PubKey: pubKey, AccountNumber: 7, Sequence: 3}, []*share.Blob{blob}, gasPrice)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{7}, ptx.AccountNumbers)
+
+	tx, err := online.DecodeTx(ptx.Tx)
+	require.NoError(t, err)
+	require.NotZero(t, tx.GetGas())
+	require.EqualValues(t, math.Ceil(gasPrice*float64(tx.GetGas())), tx.GetFee().AmountOf("utia").Uint64())
+
+	_, err = online.NewUnsignedPayForBlobs(TxSigner{PubKey: pubKey, AccountNumber: 7, Sequence: 3}, []*share.Blob{blob}, 0)
+	require.Error(t, err)
+
+	unsigned, err := blobtx.MarshalBlobTx(ptx.Tx, blob)
+	require.NoError(t, err)
+	_, err = online.ValidateSignedTx(unsigned)
+	require.Error(t, err)
+	require.Error(t, online.SignPartialTx(roundTrip(t, ptx), "treasury"))
+
+	bz, err := json.Marshal(ptx)
+	require.NoError(t, err)
+	var signed PartialTx
+	require.NoError(t, json.Unmarshal(bz, &signed))
+	require.NoError(t, offline.SignPartialTx(&signed, "treasury"))
+
+	txBytes, err := online.FinalizePartialTx(&signed)
+	require.NoError(t, err)
+	sigs, err := online.ValidateSignedTx(txBytes)
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.EqualValues(t, 3, sigs[0].Sequence)
+
+	t.Run("blob does not match the commitment", func(t *testing.T) {
+		other, err := share.NewV0Blob(ns, []byte("swapped blob"))
+		require.NoError(t, err)
+		tampered, err := blobtx.MarshalBlobTx(signed.Tx, other)
+		require.NoError(t, err)
+		_, err = online.ValidateSignedTx(tampered)
+		require.Error(t, err)
+	})
+
+	t.Run("signed for another chain", func(t *testing.T) {
+		otherChain, err := NewSigner(offline.Keyring(), encCfg.TxConfig, "other-chain", NewAccount("treasury", 7, 0))
+		require.NoError(t, err)
+		require.Error(t, otherChain.SignPartialTx(roundTrip(t, ptx), "treasury"))
+	})
+}
+
+func TestBroadcastSignedTxSequence(t *testing.T) {
+	encCfg := newPartialTxEncodingConfig()
+	signer := newPartialTxSigner(t, encCfg, "treasury", 7)
+	require.NoError(t, signer.SetSequence("treasury", 5))
+	client, err := NewTxClient(encCfg.Codec, signer, newRecoveryConn(t, &recoveryServer{}), encCfg.InterfaceRegistry)
+	require.NoError(t, err)
+
+	ns := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	blob, err := share.NewV0Blob(ns, []byte("signed offline"))
+	require.NoError(t, err)
+	signedTx := func(sequence uint64) []byte {
+		ptx, err := signer.NewUnsignedPayForBlobs(TxSigner{PubKey: signer.Account("treasury").PubKey(), AccountNumber: 7, Sequence: sequence}, []*share.Blob{blob}, appconsts.DefaultMinGasPrice)
+		require.NoError(t, err)
+		require.NoError(t, signer.SignPartialTx(ptx, "treasury"))
+		txBytes, err := signer.FinalizePartialTx(ptx)
+		require.NoError(t, err)
+		return txBytes
+	}
+
+	// an older sequence must not move the client's sequence back
+	resp, err := client.BroadcastSignedTx(context.Background(), signedTx(3))
+	require.NoError(t, err)
+	require.EqualValues(t, 5, signer.Account("treasury").Sequence())
+	sequence, _, _, exists := client.GetTxFromTxTracker(resp.TxHash)
+	require.True(t, exists)
+	require.EqualValues(t, 3, sequence)
+
+	_, err = client.BroadcastSignedTx(context.Background(), signedTx(8))
+	require.NoError(t, err)
+	require.EqualValues(t, 9, signer.Account("treasury").Sequence())
+}
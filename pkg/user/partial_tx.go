@@ -0,0 +1,458 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/go-square/v3/share"
+	blobtx "github.com/celestiaorg/go-square/v3/tx"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	multisigtypes "github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// partialSignMode is the sign mode used for transactions signed by several
+// parties. Unlike SIGN_MODE_DIRECT, the bytes signed in this mode do not
+// depend on the signer infos, so the members of a multisig and distinct
+// signers can sign independently and in any order.
+var partialSignMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+
+// TxSigner describes an account that must sign a multi-party transaction.
+type TxSigner struct {
+	// PubKey is the public key of the account. It is a
+	// *multisig.LegacyAminoPubKey for multisig accounts.
+	PubKey        cryptotypes.PubKey
+	AccountNumber uint64
+	Sequence      uint64
+}
+
+// PartialTx is a transaction that must be signed by several accounts, by a
+// multisig account or both. It can be exported as JSON, signed in separate
+// processes and merged until every signer has provided enough signatures.
+type PartialTx struct {
+	// ChainID is the chain the transaction is signed for.
+	ChainID string `json:"chain_id"`
+	// Tx is the encoded transaction carrying the signatures collected so far.
+	Tx []byte `json:"tx"`
+	// AccountNumbers holds the account number of each signer, in the order of
+	// the transaction's signers. They are part of the signed bytes.
+	AccountNumbers []uint64 `json:"account_numbers"`
+	// Blobs are the blobs paid for by the transaction, if any.
+	Blobs []*share.Blob `json:"blobs,omitempty"`
+}
+
+// NewPartialTx builds an unsigned transaction from msgs that must be signed by
+// the provided signers, e.g. an authz granter and a fee payer set with
+// SetFeePayer. Every signer required by the transaction must be provided
+// exactly once. Blobs must be provided if msgs contain a MsgPayForBlobs.
+func (s *Signer) NewPartialTx(msgs []sdktypes.Msg, signers []TxSigner, blobs []*share.Blob, opts ...TxOption) (*PartialTx, error) {
+	builder, err := s.txBuilder(msgs, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	txSigners, err := builder.GetTx().GetSigners()
+	if err != nil {
+		return nil, fmt.Errorf("error getting signers: %w", err)
+	}
+	if len(txSigners) != len(signers) {
+		return nil, fmt.Errorf("transaction requires %d signers, got %d", len(txSigners), len(signers))
+	}
+
+	sigs := make([]signing.SignatureV2, len(txSigners))
+	accountNumbers := make([]uint64, len(txSigners))
+	for i, addr := range txSigners {
+		idx := -1
+		for j, signer := range signers {
+			if bytes.Equal(signer.PubKey.Address(), addr) {
+				idx = j
+				break
+			}
+		}
+		if idx < 0 {
+			addrStr, _ := s.addressCodec.BytesToString(addr)
+			return nil, fmt.Errorf("missing signer %s", addrStr)
+		}
+
+		signer := signers[idx]
+		sigs[i] = signing.SignatureV2{
+			PubKey:   signer.PubKey,
+			Data:     emptySignatureData(signer.PubKey),
+			Sequence: signer.Sequence,
+		}
+		accountNumbers[i] = signer.AccountNumber
+	}
+	if err := builder.SetSignatures(sigs...); err != nil {
+		return nil, fmt.Errorf("error setting draft signatures: %w", err)
+	}
+
+	txBytes, err := s.EncodeTx(builder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+	return &PartialTx{ChainID: s.ChainID(), Tx: txBytes, AccountNumbers: accountNumbers, Blobs: blobs}, nil
+}
+
+// SignPartialTx adds the signature of the account with the provided name to
+// ptx. The account must either be one of the transaction's signers or a
+// member of one of its multisig signers.
+func (s *Signer) SignPartialTx(ptx *PartialTx, accountName string) error {
+	account, exists := s.accounts[accountName]
+	if !exists {
+		return fmt.Errorf("account %s not found", accountName)
+	}
+
+	builder, sigs, err := s.decodePartialTx(ptx)
+	if err != nil {
+		return err
+	}
+
+	signed := false
+	for i, sig := range sigs {
+		signBytes, err := s.partialSignBytes(builder, sig, ptx.AccountNumbers[i])
+		if err != nil {
+			return err
+		}
+
+		switch data := sig.Data.(type) {
+		case *signing.SingleSignatureData:
+			if _, isMultisig := sig.PubKey.(*multisig.LegacyAminoPubKey); isMultisig {
+				return errors.New("multisig signer has a single signature")
+			}
+			if !bytes.Equal(sig.PubKey.Address(), account.address) {
+				continue
+			}
+			if data.Signature, _, err = s.keys.Sign(account.name, signBytes, partialSignMode); err != nil {
+				return fmt.Errorf("error signing bytes: %w", err)
+			}
+		case *signing.MultiSignatureData:
+			multisigKey, _, err := multisigSignatureData(sig)
+			if err != nil {
+				return err
+			}
+			if !isMultisigMember(multisigKey, account.pubKey) {
+				continue
+			}
+			signature, _, err := s.keys.Sign(account.name, signBytes, partialSignMode)
+			if err != nil {
+				return fmt.Errorf("error signing bytes: %w", err)
+			}
+			memberSig := &signing.SingleSignatureData{SignMode: partialSignMode, Signature: signature}
+			if err := multisigtypes.AddSignatureFromPubKey(data, memberSig, account.pubKey, multisigKey.GetPubKeys()); err != nil {
+				return err
+			}
+		}
+		signed = true
+	}
+	if !signed {
+		return fmt.Errorf("account %s is not a signer of the transaction", accountName)
+	}
+
+	return s.encodePartialTx(ptx, builder, sigs)
+}
+
+// MergePartialTxs combines the signatures collected in several copies of the
+// same partial transaction. Every signature taken from the copies is verified
+// before being merged.
+func (s *Signer) MergePartialTxs(ptxs ...*PartialTx) (*PartialTx, error) {
+	if len(ptxs) == 0 {
+		return nil, errors.New("no partial transactions to merge")
+	}
+
+	builder, sigs, err := s.decodePartialTx(ptxs[0])
+	if err != nil {
+		return nil, err
+	}
+	signBytes := make([][]byte, len(sigs))
+	for i, sig := range sigs {
+		if signBytes[i], err = s.partialSignBytes(builder, sig, ptxs[0].AccountNumbers[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	for n, ptx := range ptxs[1:] {
+		otherBuilder, otherSigs, err := s.decodePartialTx(ptx)
+		if err != nil {
+			return nil, fmt.Errorf("partial tx %d: %w", n+1, err)
+		}
+		if len(otherSigs) != len(sigs) || !sameBlobs(ptxs[0].Blobs, ptx.Blobs) {
+			return nil, fmt.Errorf("partial tx %d is a different transaction", n+1)
+		}
+
+		for i, sig := range sigs {
+			other := otherSigs[i]
+			otherSignBytes, err := s.partialSignBytes(otherBuilder, other, ptx.AccountNumbers[i])
+			if err != nil {
+				return nil, err
+			}
+			if !sig.PubKey.Equals(other.PubKey) || !bytes.Equal(signBytes[i], otherSignBytes) {
+				return nil, fmt.Errorf("partial tx %d is a different transaction", n+1)
+			}
+			if err := mergeSignatureData(sig, other, signBytes[i]); err != nil {
+				return nil, fmt.Errorf("partial tx %d: %w", n+1, err)
+			}
+		}
+	}
+
+	merged := &PartialTx{ChainID: ptxs[0].ChainID, AccountNumbers: ptxs[0].AccountNumbers, Blobs: ptxs[0].Blobs}
+	if err := s.encodePartialTx(merged, builder, sigs); err != nil {
+		return nil, err
+	}
+	return merged, nil
+}
+
+// IsFullySigned reports whether every signer of ptx has signed it and every
+// multisig signer has reached its threshold.
+func (s *Signer) IsFullySigned(ptx *PartialTx) (bool, error) {
+	_, sigs, err := s.decodePartialTx(ptx)
+	if err != nil {
+		return false, err
+	}
+	return isFullySigned(sigs)
+}
+
+// FinalizePartialTx returns the bytes to broadcast for a fully signed partial
+// transaction, wrapping it in a blob tx if it pays for blobs.
+func (s *Signer) FinalizePartialTx(ptx *PartialTx) ([]byte, error) {
+	fullySigned, err := s.IsFullySigned(ptx)
+	if err != nil {
+		return nil, err
+	}
+	if !fullySigned {
+		return nil, errors.New("transaction is missing signatures")
+	}
+	if len(ptx.Blobs) == 0 {
+		return ptx.Tx, nil
+	}
+	return blobtx.MarshalBlobTx(ptx.Tx, ptx.Blobs...)
+}
+
+// decodePartialTx returns a builder for the transaction of ptx along with its
+// signatures, in the order of the transaction's signers.
+func (s *Signer) decodePartialTx(ptx *PartialTx) (client.TxBuilder, []signing.SignatureV2, error) {
+	if ptx.ChainID != s.ChainID() {
+		return nil, nil, fmt.Errorf("transaction is for chain %q, signer is for chain %q", ptx.ChainID, s.ChainID())
+	}
+	tx, err := s.DecodeTx(ptx.Tx)
+	if err != nil {
+		return nil, nil, err
+	}
+	builder, err := s.enc.WrapTxBuilder(tx)
+	if err != nil {
+		return nil, nil, err
+	}
+	sigs, err := tx.GetSignaturesV2()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(sigs) != len(ptx.AccountNumbers) {
+		return nil, nil, fmt.Errorf("got %d account numbers for %d signers", len(ptx.AccountNumbers), len(sigs))
+	}
+	return builder, sigs, nil
+}
+
+// encodePartialTx sets sigs on builder and stores the encoded transaction in
+// ptx.
+func (s *Signer) encodePartialTx(ptx *PartialTx, builder client.TxBuilder, sigs []signing.SignatureV2) error {
+	if err := builder.SetSignatures(sigs...); err != nil {
+		return fmt.Errorf("error setting signatures: %w", err)
+	}
+	txBytes, err := s.EncodeTx(builder.GetTx())
+	if err != nil {
+		return err
+	}
+	ptx.Tx = txBytes
+	return nil
+}
+
+// partialSignBytes returns the bytes that the signer described by sig signs.
+func (s *Signer) partialSignBytes(builder client.TxBuilder, sig signing.SignatureV2, accountNumber uint64) ([]byte, error) {
+	addrStr, err := s.addressCodec.BytesToString(sig.PubKey.Address())
+	if err != nil {
+		return nil, fmt.Errorf("error converting address to string: %w", err)
+	}
+	signerData := authsigning.SignerData{
+		Address:       addrStr,
+		ChainID:       s.ChainID(),
+		AccountNumber: accountNumber,
+		Sequence:      sig.Sequence,
+		PubKey:        sig.PubKey,
+	}
+	signBytes, err := authsigning.GetSignBytesAdapter(context.Background(), s.enc.SignModeHandler(), partialSignMode, signerData, builder.GetTx())
+	if err != nil {
+		return nil, fmt.Errorf("error getting sign bytes: %w", err)
+	}
+	return signBytes, nil
+}
+
+// emptySignatureData returns the signature data of a signer that has not
+// signed yet.
+func emptySignatureData(pubKey cryptotypes.PubKey) signing.SignatureData {
+	if multisigKey, ok := pubKey.(*multisig.LegacyAminoPubKey); ok {
+		return multisigtypes.NewMultisig(len(multisigKey.GetPubKeys()))
+	}
+	return &signing.SingleSignatureData{SignMode: partialSignMode}
+}
+
+// mergeSignatureData adds the verified signatures of other missing from sig.
+func mergeSignatureData(sig, other signing.SignatureV2, signBytes []byte) error {
+	switch data := sig.Data.(type) {
+	case *signing.SingleSignatureData:
+		otherData, ok := other.Data.(*signing.SingleSignatureData)
+		if !ok {
+			return fmt.Errorf("expected a single signature, got %T", other.Data)
+		}
+		if len(data.Signature) > 0 || len(otherData.Signature) == 0 {
+			return nil
+		}
+		if !sig.PubKey.VerifySignature(signBytes, otherData.Signature) {
+			return errors.New("invalid signature")
+		}
+		data.Signature = otherData.Signature
+	case *signing.MultiSignatureData:
+		multisigKey, _, err := multisigSignatureData(sig)
+		if err != nil {
+			return err
+		}
+		_, otherData, err := multisigSignatureData(other)
+		if err != nil {
+			return err
+		}
+		members := multisigKey.GetPubKeys()
+		next := 0
+		for i, member := range members {
+			if !otherData.BitArray.GetIndex(i) {
+				continue
+			}
+			// multisigSignatureData checked that the signatures match the
+			// bit array and are all single signatures.
+			memberSig := otherData.Signatures[next].(*signing.SingleSignatureData)
+			next++
+			if data.BitArray.GetIndex(i) {
+				continue
+			}
+			if !member.VerifySignature(signBytes, memberSig.Signature) {
+				return fmt.Errorf("invalid signature of multisig member %d", i)
+			}
+			if err := multisigtypes.AddSignatureFromPubKey(data, memberSig, member, members); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported signature data %T", sig.Data)
+	}
+	return nil
+}
+
+// multisigSignatureData returns the multisig key and the signature data of
+// sig after checking that the signatures match the key and the bit array. The
+// transaction is untrusted so this must be checked before indexing the
+// signatures.
+func multisigSignatureData(sig signing.SignatureV2) (*multisig.LegacyAminoPubKey, *signing.MultiSignatureData, error) {
+	multisigKey, ok := sig.PubKey.(*multisig.LegacyAminoPubKey)
+	if !ok {
+		return nil, nil, fmt.Errorf("expected a multisig public key, got %T", sig.PubKey)
+	}
+	data, ok := sig.Data.(*signing.MultiSignatureData)
+	if !ok {
+		return nil, nil, fmt.Errorf("expected multisig signature data, got %T", sig.Data)
+	}
+	if data.BitArray == nil || data.BitArray.Count() != len(multisigKey.GetPubKeys()) {
+		return nil, nil, fmt.Errorf("multisig bit array does not match the %d keys of the multisig", len(multisigKey.GetPubKeys()))
+	}
+	if signed := data.BitArray.NumTrueBitsBefore(data.BitArray.Count()); signed != len(data.Signatures) {
+		return nil, nil, fmt.Errorf("multisig bit array marks %d signatures, got %d", signed, len(data.Signatures))
+	}
+	for i, memberSig := range data.Signatures {
+		if _, ok := memberSig.(*signing.SingleSignatureData); !ok {
+			return nil, nil, fmt.Errorf("multisig signature %d: expected a single signature, got %T", i, memberSig)
+		}
+	}
+	return multisigKey, data, nil
+}
+
+// isFullySigned reports whether sigs hold a signature for every signer and
+// enough signatures to meet the threshold of every multisig signer. It returns
+// an error if any of the signatures is malformed.
+func isFullySigned(sigs []signing.SignatureV2) (bool, error) {
+	fullySigned := len(sigs) > 0
+	for _, sig := range sigs {
+		switch data := sig.Data.(type) {
+		case *signing.SingleSignatureData:
+			if _, isMultisig := sig.PubKey.(*multisig.LegacyAminoPubKey); isMultisig {
+				return false, errors.New("multisig signer has a single signature")
+			}
+			if len(data.Signature) == 0 {
+				fullySigned = false
+			}
+		case *signing.MultiSignatureData:
+			multisigKey, _, err := multisigSignatureData(sig)
+			if err != nil {
+				return false, err
+			}
+			if uint32(len(data.Signatures)) < multisigKey.Threshold {
+				fullySigned = false
+			}
+		default:
+			return false, fmt.Errorf("unsupported signature data %T", sig.Data)
+		}
+	}
+	return fullySigned, nil
+}
+
+func isMultisigMember(multisigKey *multisig.LegacyAminoPubKey, pubKey cryptotypes.PubKey) bool {
+	for _, member := range multisigKey.GetPubKeys() {
+		if member.Equals(pubKey) {
+			return true
+		}
+	}
+	return false
+}
+
+func sameBlobs(a, b []*share.Blob) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Compare(b[i]) != 0 || !bytes.Equal(a[i].Data(), b[i].Data()) {
+			return false
+		}
+	}
+	return true
+}
+
+// QueryTxSigner returns the TxSigner of the account with the provided public
+// key using its current account number and sequence on chain.
+func (client *TxClient) QueryTxSigner(ctx context.Context, pubKey cryptotypes.PubKey) (TxSigner, error) {
+	accNum, sequence, err := QueryAccount(ctx, client.conns[0], client.registry, sdktypes.AccAddress(pubKey.Address()))
+	if err != nil {
+		return TxSigner{}, err
+	}
+	return TxSigner{PubKey: pubKey, AccountNumber: accNum, Sequence: sequence}, nil
+}
+
+// BroadcastPartialTx broadcasts a fully signed partial transaction. See
+// BroadcastSignedTx.
+func (client *TxClient) BroadcastPartialTx(ctx context.Context, ptx *PartialTx) (*sdktypes.TxResponse, error) {
+	txBytes, err := client.signer.FinalizePartialTx(ptx)
+	if err != nil {
+		return nil, err
+	}
+	return client.BroadcastSignedTx(ctx, txBytes)
+}
+
+// SubmitPartialTx broadcasts a fully signed partial transaction and waits for
+// it to be confirmed.
+func (client *TxClient) SubmitPartialTx(ctx context.Context, ptx *PartialTx) (*TxResponse, error) {
+	resp, err := client.BroadcastPartialTx(ctx, ptx)
+	if err != nil {
+		return nil, err
+	}
+	return client.ConfirmTx(ctx, resp.TxHash)
+}
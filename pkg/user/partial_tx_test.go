@@ -0,0 +1,239 @@
+package user_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v7/app"
+	"github.com/celestiaorg/celestia-app/v7/app/encoding"
+	"github.com/celestiaorg/celestia-app/v7/pkg/user"
+	blobtypes "github.com/celestiaorg/celestia-app/v7/x/blob/types"
+	"github.com/celestiaorg/go-square/v3/share"
+	blobtx "github.com/celestiaorg/go-square/v3/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+// newPartialTxSigner returns a user.Signer holding a single freshly generated key,
+// as if it ran in its own process.
+func newPartialTxSigner(t *testing.T, encCfg encoding.Config, name string, accountNumber uint64) *user.Signer {
+	t.Helper()
+	kr := keyring.NewInMemory(encCfg.Codec)
+	path := hd.CreateHDPath(sdktypes.CoinType, 0, 0).String()
+	_, _, err := kr.NewMnemonic(name, keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	signer, err := user.NewSigner(kr, encCfg.TxConfig, "test-chain", user.NewAccount(name, accountNumber, 0))
+	require.NoError(t, err)
+	return signer
+}
+
+// roundTrip simulates exporting ptx to another process.
+func roundTrip(t *testing.T, ptx *user.PartialTx) *user.PartialTx {
+	t.Helper()
+	bz, err := json.Marshal(ptx)
+	require.NoError(t, err)
+	var out user.PartialTx
+	require.NoError(t, json.Unmarshal(bz, &out))
+	return &out
+}
+
+func TestPartialTxMultisigWithFeePayer(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	members := []*user.Signer{
+		newPartialTxSigner(t, encCfg, "member-0", 1),
+		newPartialTxSigner(t, encCfg, "member-1", 2),
+		newPartialTxSigner(t, encCfg, "member-2", 3),
+	}
+	feePayer := newPartialTxSigner(t, encCfg, "fee-payer", 4)
+
+	memberKeys := make([]cryptotypes.PubKey, len(members))
+	for i, member := range members {
+		memberKeys[i] = member.Accounts()[0].PubKey()
+	}
+	multisigKey := multisig.NewLegacyAminoPubKey(2, memberKeys)
+	multisigAddr := sdktypes.AccAddress(multisigKey.Address())
+
+	msg := banktypes.NewMsgSend(multisigAddr, feePayer.Account("fee-payer").Address(), sdktypes.NewCoins(sdktypes.NewInt64Coin("utia", 10)))
+	ptx, err := feePayer.NewPartialTx(
+		[]sdktypes.Msg{msg},
+		[]user.TxSigner{
+			{PubKey: feePayer.Account("fee-payer").PubKey(), AccountNumber: 4, Sequence: 2},
+			{PubKey: multisigKey, AccountNumber: 10, Sequence: 5},
+		},
+		nil,
+		user.SetFeePayer(feePayer.Account("fee-payer").Address()),
+		user.SetGasLimit(200_000),
+		user.SetFee(2_000),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{10, 4}, ptx.AccountNumbers)
+
+	fullySigned, err := feePayer.IsFullySigned(ptx)
+	require.NoError(t, err)
+	require.False(t, fullySigned)
+
+	// every party signs its own copy of the transaction
+	copies := []*user.PartialTx{roundTrip(t, ptx), roundTrip(t, ptx), roundTrip(t, ptx)}
+	require.NoError(t, members[0].SignPartialTx(copies[0], "member-0"))
+	require.NoError(t, members[2].SignPartialTx(copies[1], "member-2"))
+	require.NoError(t, feePayer.SignPartialTx(copies[2], "fee-payer"))
+	require.Error(t, members[1].SignPartialTx(roundTrip(t, ptx), "fee-payer"))
+
+	t.Run("below the multisig threshold", func(t *testing.T) {
+		merged, err := feePayer.MergePartialTxs(copies[0], copies[2])
+		require.NoError(t, err)
+		fullySigned, err := feePayer.IsFullySigned(merged)
+		require.NoError(t, err)
+		require.False(t, fullySigned)
+		_, err = feePayer.FinalizePartialTx(merged)
+		require.Error(t, err)
+	})
+
+	t.Run("threshold met", func(t *testing.T) {
+		merged, err := feePayer.MergePartialTxs(roundTrip(t, ptx), copies[1], copies[2], copies[0])
+		require.NoError(t, err)
+		fullySigned, err := feePayer.IsFullySigned(merged)
+		require.NoError(t, err)
+		require.True(t, fullySigned)
+
+		txBytes, err := feePayer.FinalizePartialTx(merged)
+		require.NoError(t, err)
+		require.Equal(t, merged.Tx, txBytes)
+
+		tx, err := encCfg.TxConfig.TxDecoder()(merged.Tx)
+		require.NoError(t, err)
+		sigTx := tx.(authsigning.Tx)
+		sigs, err := sigTx.GetSignaturesV2()
+		require.NoError(t, err)
+		require.Len(t, sigs, 2)
+		for i, sig := range sigs {
+			signerData := authsigning.SignerData{
+				Address:       sdktypes.AccAddress(sig.PubKey.Address()).String(),
+				ChainID:       "test-chain",
+				AccountNumber: merged.AccountNumbers[i],
+				Sequence:      sig.Sequence,
+				PubKey:        sig.PubKey,
+			}
+			signBytes, err := authsigning.GetSignBytesAdapter(context.Background(), encCfg.TxConfig.SignModeHandler(), signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData, sigTx)
+			require.NoError(t, err)
+			switch data := sig.Data.(type) {
+			case *signing.SingleSignatureData:
+				require.True(t, sig.PubKey.VerifySignature(signBytes, data.Signature))
+			case *signing.MultiSignatureData:
+				getSignBytes := func(signing.SignMode) ([]byte, error) { return signBytes, nil }
+				require.NoError(t, multisigKey.VerifyMultisignature(getSignBytes, data))
+			}
+		}
+	})
+
+	t.Run("rejects a forged signature", func(t *testing.T) {
+		forged := roundTrip(t, copies[2])
+		tx, err := encCfg.TxConfig.TxDecoder()(forged.Tx)
+		require.NoError(t, err)
+		builder, err := encCfg.TxConfig.WrapTxBuilder(tx)
+		require.NoError(t, err)
+		sigs, err := builder.GetTx().GetSignaturesV2()
+		require.NoError(t, err)
+		sigs[1].Data.(*signing.SingleSignatureData).Signature[0] ^= 0xFF
+		require.NoError(t, builder.SetSignatures(sigs...))
+		forged.Tx, err = encCfg.TxConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+
+		_, err = feePayer.MergePartialTxs(roundTrip(t, ptx), forged)
+		require.Error(t, err)
+	})
+
+	t.Run("rejects malformed signatures", func(t *testing.T) {
+		tamper := func(ptx *user.PartialTx, tamper func(sigs []signing.SignatureV2)) *user.PartialTx {
+			tampered := roundTrip(t, ptx)
+			tx, err := encCfg.TxConfig.TxDecoder()(tampered.Tx)
+			require.NoError(t, err)
+			builder, err := encCfg.TxConfig.WrapTxBuilder(tx)
+			require.NoError(t, err)
+			sigs, err := builder.GetTx().GetSignaturesV2()
+			require.NoError(t, err)
+			tamper(sigs)
+			require.NoError(t, builder.SetSignatures(sigs...))
+			tampered.Tx, err = encCfg.TxConfig.TxEncoder()(builder.GetTx())
+			require.NoError(t, err)
+			return tampered
+		}
+
+		// the bit array marks more signatures than the multisig data holds
+		missingSignature := tamper(copies[0], func(sigs []signing.SignatureV2) {
+			sigs[0].Data.(*signing.MultiSignatureData).BitArray.SetIndex(1, true)
+		})
+		// a single key signer with multisig signature data
+		wrongDataType := tamper(copies[2], func(sigs []signing.SignatureV2) {
+			sigs[1].Data = sigs[0].Data
+		})
+		for _, malformed := range []*user.PartialTx{missingSignature, wrongDataType} {
+			_, err := feePayer.MergePartialTxs(roundTrip(t, ptx), malformed)
+			require.Error(t, err)
+			_, err = feePayer.IsFullySigned(malformed)
+			require.Error(t, err)
+			require.Error(t, members[1].SignPartialTx(roundTrip(t, malformed), "member-1"))
+		}
+	})
+
+	t.Run("rejects a different transaction", func(t *testing.T) {
+		other := roundTrip(t, ptx)
+		other.AccountNumbers = []uint64{11, 4}
+		_, err := feePayer.MergePartialTxs(ptx, other)
+		require.Error(t, err)
+	})
+
+	t.Run("missing signer", func(t *testing.T) {
+		_, err := feePayer.NewPartialTx(
+			[]sdktypes.Msg{msg},
+			[]user.TxSigner{{PubKey: multisigKey, AccountNumber: 10, Sequence: 5}},
+			nil,
+			user.SetFeePayer(feePayer.Account("fee-payer").Address()),
+		)
+		require.Error(t, err)
+	})
+
+	t.Run("CreateTx rejects several signers", func(t *testing.T) {
+		send := banktypes.NewMsgSend(feePayer.Account("fee-payer").Address(), multisigAddr, sdktypes.NewCoins(sdktypes.NewInt64Coin("utia", 10)))
+		_, _, err := members[0].CreateTx([]sdktypes.Msg{send}, user.SetFeePayer(members[0].Account("member-0").Address()))
+		require.Error(t, err)
+	})
+}
+
+func TestPartialTxPayForBlobs(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	signer := newPartialTxSigner(t, encCfg, "alice", 1)
+	account := signer.Account("alice")
+
+	ns := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	blob, err := share.NewV0Blob(ns, []byte("partially signed blob"))
+	require.NoError(t, err)
+	msg, err := blobtypes.NewMsgPayForBlobs(account.Address().String(), 0, blob)
+	require.NoError(t, err)
+
+	ptx, err := signer.NewPartialTx(
+		[]sdktypes.Msg{msg},
+		[]user.TxSigner{{PubKey: account.PubKey(), AccountNumber: 1, Sequence: 0}},
+		[]*share.Blob{blob},
+	)
+	require.NoError(t, err)
+	ptx = roundTrip(t, ptx)
+	require.NoError(t, signer.SignPartialTx(ptx, "alice"))
+
+	txBytes, err := signer.FinalizePartialTx(ptx)
+	require.NoError(t, err)
+	blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(txBytes)
+	require.NoError(t, err)
+	require.True(t, isBlobTx)
+	require.Len(t, blobTx.Blobs, 1)
+	require.Equal(t, blob.Data(), blobTx.Blobs[0].Data())
+}
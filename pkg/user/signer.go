@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
@@ -23,7 +24,9 @@ var defaultSignMode = signing.SignMode_SIGN_MODE_DIRECT
 
 // Signer is struct for building and signing Celestia transactions
 // It supports multiple accounts wrapping a Keyring.
-// NOTE: All transactions may only have a single signer
+// NOTE: Transactions built with CreateTx and SignTx may only have a single
+// signer. Transactions with several signers or a multisig signer are built
+// with NewPartialTx instead.
 // Signer is not thread-safe.
 type Signer struct {
 	keys         keyring.Keyring
@@ -288,21 +291,37 @@ func (s *Signer) signTransaction(builder client.TxBuilder) (string, uint64, erro
 	if err != nil {
 		return "", 0, err
 	}
+	signers, err := builder.GetTx().GetSigners()
+	if err != nil {
+		return "", 0, fmt.Errorf("error getting signers: %w", err)
+	}
+	if len(signers) > 1 {
+		return "", 0, fmt.Errorf("transaction has %d signers: use NewPartialTx", len(signers))
+	}
+	if _, ok := account.pubKey.(*multisig.LegacyAminoPubKey); ok {
+		return "", 0, fmt.Errorf("account %s is a multisig account: use NewPartialTx", account.name)
+	}
+	return s.signTransactionWithSequence(builder, account, account.sequence)
+}
 
+// signTransactionWithSequence signs the transaction for the provided account
+// using an explicit sequence instead of the locally tracked one. It does not
+// modify the account's sequence.
+func (s *Signer) signTransactionWithSequence(builder client.TxBuilder, account *Account, sequence uint64) (string, uint64, error) {
 	// a dry run of the signing data
-	err = builder.SetSignatures(signing.SignatureV2{
+	err := builder.SetSignatures(signing.SignatureV2{
 		Data: &signing.SingleSignatureData{
 			SignMode:  s.signMode,
 			Signature: nil,
 		},
 		PubKey:   account.pubKey,
-		Sequence: account.sequence,
+		Sequence: sequence,
 	})
 	if err != nil {
 		return "", 0, fmt.Errorf("error setting draft signatures: %w", err)
 	}
 
-	signature, err := s.createSignature(builder, account, account.sequence)
+	signature, err := s.createSignature(builder, account, sequence)
 	if err != nil {
 		return "", 0, fmt.Errorf("error creating signature: %w", err)
 	}
@@ -313,13 +332,13 @@ func (s *Signer) signTransaction(builder client.TxBuilder) (string, uint64, erro
 			Signature: signature,
 		},
 		PubKey:   account.pubKey,
-		Sequence: account.sequence,
+		Sequence: sequence,
 	})
 	if err != nil {
 		return "", 0, fmt.Errorf("error setting signatures: %w", err)
 	}
 
-	return account.name, account.sequence, nil
+	return account.name, sequence, nil
 }
 
 func (s *Signer) createSignature(builder client.TxBuilder, account *Account, sequence uint64) ([]byte, error) {
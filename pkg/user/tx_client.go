@@ -11,6 +11,7 @@ import (
 	"sync"
 	"time"
 
+	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
 	"github.com/celestiaorg/celestia-app/v7/app/encoding"
 	apperrors "github.com/celestiaorg/celestia-app/v7/app/errors"
@@ -67,6 +68,17 @@ type txInfo struct {
 	txBytes   []byte
 }
 
+func (info txInfo) journalEntry(txHash string, state JournalTxState) JournalEntry {
+	return JournalEntry{
+		TxHash:      txHash,
+		Signer:      info.signer,
+		Sequence:    info.sequence,
+		TxBytes:     info.txBytes,
+		State:       state,
+		SubmittedAt: info.timestamp,
+	}
+}
+
 // TxResponse is a response from the chain after
 // a transaction has been submitted.
 type TxResponse struct {
@@ -180,6 +192,25 @@ func WithEstimatorService(conn *grpc.ClientConn) Option {
 	}
 }
 
+// WithTxJournal persists every tracked transaction to the provided journal.
+// When used with SetupTxClient, transactions left in the journal by a previous
+// process are reloaded, rebroadcast if the network no longer knows about them
+// and confirmed in the background.
+func WithTxJournal(journal TxJournal) Option {
+	return func(c *TxClient) {
+		c.journal = journal
+	}
+}
+
+// WithLogger sets the logger used to report failures that do not affect the
+// outcome of a call, such as failing to persist a broadcast transaction to the
+// journal.
+func WithLogger(logger log.Logger) Option {
+	return func(c *TxClient) {
+		c.logger = logger
+	}
+}
+
 // WithAdditionalCoreEndpoints adds additional core endpoints to the TxClient.
 // For transaction submission, the client will attempt to use the primary endpoint
 // and the first two additional endpoints provided via this option.
@@ -230,7 +261,19 @@ type TxClient struct {
 	defaultAddress sdktypes.AccAddress
 	// txTracker maps the tx hash to the Sequence and signer of the transaction
 	// that was submitted to the chain
-	txTracker           map[string]txInfo
+	txTracker map[string]txInfo
+	// journal optionally persists the txTracker so that it survives restarts
+	journal TxJournal
+	// feeBumpPolicy optionally raises the gas price of evicted transactions
+	// when they are resubmitted
+	feeBumpPolicy *FeeBumpPolicy
+	// recovery confirms the transactions reloaded from the journal
+	recovery *journalRecovery
+	// logger reports failures that do not affect the outcome of a call
+	logger log.Logger
+	// confirmer batches the confirmation of transactions awaited through
+	// AwaitTxs, ConfirmTxs and OnConfirmed
+	confirmer           *txConfirmer
 	gasEstimationClient gasestimation.GasEstimatorClient
 	// txQueue manages parallel transaction submission when enabled
 	txQueue *txQueue
@@ -268,8 +311,11 @@ func NewTxClient(
 		txTracker:           make(map[string]txInfo),
 		cdc:                 cdc,
 		gasEstimationClient: gasestimation.NewGasEstimatorClient(conn),
+		logger:              log.NewNopLogger(),
 	}
 
+	txClient.confirmer = newTxConfirmer(txClient)
+
 	for _, opt := range options {
 		opt(txClient)
 	}
@@ -335,6 +381,10 @@ func SetupTxClient(
 		return nil, err
 	}
 
+	if err := txClient.recoverFromJournal(ctx); err != nil {
+		return nil, fmt.Errorf("failed to recover transactions from journal: %w", err)
+	}
+
 	if err := txClient.txQueue.start(ctx); err != nil {
 		return nil, fmt.Errorf("failed to start tx queue: %w", err)
 	}
@@ -635,23 +685,42 @@ func (client *TxClient) sendTxToConnection(ctx context.Context, conn *grpc.Clien
 
 // resignTransactionWithNewSequence creates a new transaction with updated sequence from existing tx bytes
 func (client *TxClient) resignTransactionWithNewSequence(txBytes []byte) ([]byte, error) {
+	txBuilder, blobTx, err := client.rebuildTransaction(txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	_, _, err = client.signer.signTransaction(txBuilder)
+	if err != nil {
+		return nil, fmt.Errorf("resigning transaction: %w", err)
+	}
+
+	return client.encodeRebuiltTransaction(txBuilder, blobTx)
+}
+
+// rebuildTransaction decodes existing tx bytes into a tx builder carrying the
+// same messages, fee, gas limit and metadata so that it can be signed again.
+// If the tx was a blob tx, the unwrapped blob tx is returned as well.
+func (client *TxClient) rebuildTransaction(txBytes []byte) (client.TxBuilder, *blobtx.BlobTx, error) {
 	blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(txBytes)
 	if isBlobTx && err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if isBlobTx {
 		txBytes = blobTx.Tx
+	} else {
+		blobTx = nil
 	}
 	tx, err := client.signer.DecodeTx(txBytes)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	txBuilder, err := client.signer.txBuilder(tx.GetMsgs(), []TxOption{}...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if err := txBuilder.SetMsgs(tx.GetMsgs()...); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if granter := tx.FeeGranter(); granter != nil {
 		txBuilder.SetFeeGranter(granter)
@@ -668,19 +737,19 @@ func (client *TxClient) resignTransactionWithNewSequence(txBytes []byte) ([]byte
 	if gas := tx.GetGas(); gas > 0 {
 		txBuilder.SetGasLimit(gas)
 	}
+	return txBuilder, blobTx, nil
+}
 
-	_, _, err = client.signer.signTransaction(txBuilder)
-	if err != nil {
-		return nil, fmt.Errorf("resigning transaction: %w", err)
-	}
-
+// encodeRebuiltTransaction encodes a signed tx builder returned by
+// rebuildTransaction, rewrapping it as a blob tx if it originally was one.
+func (client *TxClient) encodeRebuiltTransaction(txBuilder client.TxBuilder, blobTx *blobtx.BlobTx) ([]byte, error) {
 	newTxBytes, err := client.signer.EncodeTx(txBuilder.GetTx())
 	if err != nil {
 		return nil, err
 	}
 
 	// Rewrap the blob tx if it was originally a blob tx
-	if isBlobTx {
+	if blobTx != nil {
 		newTxBytes, err = blobtx.MarshalBlobTx(newTxBytes, blobTx.Blobs...)
 		if err != nil {
 			return nil, err
@@ -754,13 +823,15 @@ func (client *TxClient) pruneTxTracker() {
 	for hash, txInfo := range client.txTracker {
 		if time.Since(txInfo.timestamp) >= txTrackerPruningInterval {
 			delete(client.txTracker, hash)
+			client.deleteFromJournal(hash)
 		}
 	}
 }
 
 // ConfirmTx periodically pings the provided node for the commitment of a transaction by its
 // hash. It will continually loop until the context is cancelled, the tx is found or an error
-// is encountered.
+// is encountered. If a FeeBumpPolicy is configured, evicted transactions are resubmitted at a
+// higher gas price and the returned TxResponse refers to whichever attempt was included.
 func (client *TxClient) ConfirmTx(ctx context.Context, txHash string) (*TxResponse, error) {
 	span := trace.SpanFromContext(ctx)
 
@@ -769,6 +840,9 @@ func (client *TxClient) ConfirmTx(ctx context.Context, txHash string) (*TxRespon
 	pollTicker := time.NewTicker(client.pollTime)
 	defer pollTicker.Stop()
 	var evictionPollTimeStart *time.Time
+	// replaced holds the hashes of evicted attempts that were resubmitted at a
+	// higher gas price. They may still be included if another node kept them.
+	var replaced []string
 
 	for {
 		span.AddEvent("txclient/ConfirmTx: polling for TxStatus")
@@ -777,6 +851,17 @@ func (client *TxClient) ConfirmTx(ctx context.Context, txHash string) (*TxRespon
 			return nil, err
 		}
 
+		if len(replaced) > 0 && resp.Status != core.TxStatusPending && resp.Status != core.TxStatusCommitted {
+			includedHash, includedResp, err := includedAttempt(ctx, txClient, replaced)
+			if err != nil {
+				return nil, err
+			}
+			if includedResp != nil {
+				client.deleteFromTxTracker(txHash)
+				txHash, resp = includedHash, includedResp
+			}
+		}
+
 		if evictionPollTimeStart != nil {
 			if time.Since(*evictionPollTimeStart) > evictionPollTimeOut {
 				return nil, fmt.Errorf("eviction poll timeout: transaction %s was evicted ", txHash)
@@ -816,6 +901,21 @@ func (client *TxClient) ConfirmTx(ctx context.Context, txHash string) (*TxRespon
 				attribute.String("tx_hash", txHash),
 			))
 
+			if client.feeBumpPolicy != nil {
+				newHash, err := client.resubmitWithHigherGasPrice(ctx, txHash)
+				if err == nil {
+					span.AddEvent("txclient/ConfirmTx: transaction resubmitted at a higher gas price", trace.WithAttributes(
+						attribute.String("replaced_tx_hash", txHash),
+						attribute.String("tx_hash", newHash),
+					))
+					replaced = append(replaced, txHash)
+					txHash = newHash
+					break
+				}
+				// Resubmit the evicted bytes unchanged if the gas price cannot be raised.
+				span.RecordError(fmt.Errorf("txclient/ConfirmTx: raising gas price of %s: %w", txHash, err))
+			}
+
 			// If we're not already tracking eviction timeout, try to resubmit
 			_, err := client.sendTxToConnection(ctx, client.conns[0], txBytes)
 			if err != nil {
@@ -828,21 +928,13 @@ func (client *TxClient) ConfirmTx(ctx context.Context, txHash string) (*TxRespon
 				span.AddEvent("txclient/ConfirmTx: starting eviction timer for broadcast error")
 				now := time.Now()
 				evictionPollTimeStart = &now
+			} else {
+				client.markResubmittedInJournal(txHash)
 			}
 			span.AddEvent("txclient/ConfirmTx: transaction resubmitted successfully after eviction")
 		case core.TxStatusRejected:
 			span.RecordError(fmt.Errorf("txclient/ConfirmTx: transaction rejected: %s", resp.Error))
-			sequence, signer, _, exists := client.GetTxFromTxTracker(txHash)
-			if !exists {
-				return nil, fmt.Errorf("tx: %s not found in tx client txTracker; likely failed during broadcast", txHash)
-			}
-			// Reset sequence to the rejected tx's sequence to enable resubmission
-			// of subsequent transactions.
-			if err := client.signer.SetSequence(signer, sequence); err != nil {
-				return nil, fmt.Errorf("setting sequence: %w", err)
-			}
-			client.deleteFromTxTracker(txHash)
-			return nil, fmt.Errorf("tx with hash %s was rejected by the node with execution code: %d and log: %s", txHash, resp.ExecutionCode, resp.Error)
+			return nil, client.handleRejectedTx(txHash, resp)
 		default:
 			span.RecordError(fmt.Errorf("txclient/ConfirmTx: unknown tx status for tx: %s", txHash))
 			client.deleteFromTxTracker(txHash)
@@ -862,6 +954,39 @@ func (client *TxClient) ConfirmTx(ctx context.Context, txHash string) (*TxRespon
 	}
 }
 
+// includedAttempt returns the hash and status of the first of the replaced
+// attempts that was committed, if any.
+func includedAttempt(ctx context.Context, txClient tx.TxClient, replaced []string) (string, *tx.TxStatusResponse, error) {
+	for _, txHash := range replaced {
+		resp, err := txClient.TxStatus(ctx, &tx.TxStatusRequest{TxId: txHash})
+		if err != nil {
+			return "", nil, err
+		}
+		if resp.Status == core.TxStatusCommitted {
+			return txHash, resp, nil
+		}
+	}
+	return "", nil, nil
+}
+
+// handleRejectedTx resets the signer's sequence to that of the rejected
+// transaction, so that subsequent transactions can be resubmitted, and returns
+// the error describing the rejection.
+func (client *TxClient) handleRejectedTx(txHash string, resp *tx.TxStatusResponse) error {
+	sequence, signer, _, exists := client.GetTxFromTxTracker(txHash)
+	if !exists {
+		return fmt.Errorf("tx: %s not found in tx client txTracker; likely failed during broadcast", txHash)
+	}
+	client.mtx.Lock()
+	err := client.signer.SetSequence(signer, sequence)
+	client.mtx.Unlock()
+	if err != nil {
+		return fmt.Errorf("setting sequence: %w", err)
+	}
+	client.deleteFromTxTracker(txHash)
+	return fmt.Errorf("tx with hash %s was rejected by the node with execution code: %d and log: %s", txHash, resp.ExecutionCode, resp.Error)
+}
+
 func extractSequenceError(fullError string) string {
 	start := strings.Index(fullError, "account sequence mismatch")
 	if start == -1 {
@@ -879,6 +1004,17 @@ func (client *TxClient) deleteFromTxTracker(txHash string) {
 	client.mtx.Lock()
 	defer client.mtx.Unlock()
 	delete(client.txTracker, txHash)
+	client.deleteFromJournal(txHash)
+}
+
+// deleteFromJournal removes a transaction from the journal, if one is
+// configured. Failures are ignored: a stale entry is reconciled against the
+// chain the next time the journal is recovered.
+func (client *TxClient) deleteFromJournal(txHash string) {
+	if client.journal == nil {
+		return
+	}
+	_ = client.journal.Delete(txHash)
 }
 
 // EstimateGasPriceAndUsage returns the estimated gas price based on the provided priority,
@@ -962,6 +1098,39 @@ func (client *TxClient) EstimateGasPrice(ctx context.Context, priority gasestima
 	return resp.EstimatedGasPrice, nil
 }
 
+// FeeMarketHistory returns the gas price percentiles, fill ratio and
+// transaction counts of the last numBlocks committed blocks. A numBlocks of
+// zero uses the node's default window.
+func (client *TxClient) FeeMarketHistory(ctx context.Context, numBlocks uint64) (*gasestimation.FeeMarketHistoryResponse, error) {
+	return client.gasEstimationClient.FeeMarketHistory(ctx, &gasestimation.FeeMarketHistoryRequest{
+		NumBlocks: numBlocks,
+	})
+}
+
+// EstimateInclusion predicts in how many blocks a PFB paying gasPrice for a
+// blob of blobSize bytes would be included given the node's current mempool.
+// It returns the block offset, where 1 is the next block, and the headroom: the
+// fraction of the including block left free once the PFB and every tx paying
+// at least as much are packed. A zero offset means the PFB would not be
+// included within the blocks simulated by the node.
+func (client *TxClient) EstimateInclusion(ctx context.Context, gasPrice float64, blobSize uint32) (blockOffset uint64, headroom float64, err error) {
+	resp, err := client.gasEstimationClient.EstimateInclusion(ctx, &gasestimation.EstimateInclusionRequest{
+		GasPrice: gasPrice,
+		BlobSize: blobSize,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	span := trace.SpanFromContext(ctx)
+	span.AddEvent("txclient/EstimateInclusion: estimation successful", trace.WithAttributes(
+		attribute.Int64("block_offset", int64(resp.BlockOffset)),
+		attribute.Float64("headroom", resp.Headroom),
+	))
+
+	return resp.BlockOffset, resp.Headroom, nil
+}
+
 // estimateGas returns an estimate for the gas used by this tx.
 func (client *TxClient) estimateGas(ctx context.Context, txBuilder client.TxBuilder) (uint64, error) {
 	_, _, err := client.signer.signTransaction(txBuilder)
@@ -1073,16 +1242,40 @@ func (client *TxClient) getAccountNameFromMsgs(msgs []sdktypes.Msg) (string, err
 	return record.Name, nil
 }
 
-// trackTransaction tracks a transaction without acquiring the mutex.
+// trackTransaction tracks a transaction without acquiring the mutex and
+// persists it to the journal if one is configured. The transaction has
+// already been broadcast, so a failure to persist it is logged rather than
+// returned: the transaction is still tracked in memory and confirmed as usual.
 // This should only be called when the caller already holds the mutex.
 func (client *TxClient) trackTransaction(signer, txHash string, txBytes []byte) {
-	sequence := client.signer.Account(signer).Sequence()
-	client.txTracker[txHash] = txInfo{
+	client.trackTransactionWithSequence(signer, client.signer.Account(signer).Sequence(), txHash, txBytes)
+}
+
+// trackTransactionWithSequence tracks a transaction signed by signer with the
+// provided sequence.
+func (client *TxClient) trackTransactionWithSequence(signer string, sequence uint64, txHash string, txBytes []byte) {
+	info := txInfo{
 		sequence:  sequence,
 		signer:    signer,
 		timestamp: time.Now(),
 		txBytes:   txBytes,
 	}
+	client.txTracker[txHash] = info
+
+	if client.journal == nil {
+		return
+	}
+	if err := client.journal.Put(info.journalEntry(txHash, JournalTxStateBroadcast)); err != nil {
+		client.getLogger().Error("tx was broadcast but could not be persisted to the journal", "tx_hash", txHash, "err", err)
+	}
+}
+
+// getLogger returns the configured logger or a no-op logger if none is set.
+func (client *TxClient) getLogger() log.Logger {
+	if client.logger == nil {
+		return log.NewNopLogger()
+	}
+	return client.logger
 }
 
 // GetTxFromTxTracker gets transaction info from the tx client's local tx tracker by its hash
@@ -0,0 +1,407 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v7/app/grpc/tx"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/rpc/core"
+	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxTxStatusBatchSize is the maximum number of hashes the tx service
+	// accepts in a single TxStatusBatch request.
+	maxTxStatusBatchSize = 20
+	// maxTransientStatusErrors is the number of consecutive transient errors
+	// tolerated while querying the status of a transaction before its waiters
+	// are failed.
+	maxTransientStatusErrors = 5
+)
+
+// ConfirmationResult is delivered once an awaited transaction reaches a final
+// state. Exactly one of TxResponse and Error is set.
+type ConfirmationResult struct {
+	TxHash     string
+	TxResponse *TxResponse
+	Error      error
+}
+
+// ConfirmationCallback is invoked with the result of an awaited transaction.
+// Callbacks are run on the confirmer's goroutine and should not block.
+type ConfirmationCallback func(ConfirmationResult)
+
+// confirmationWaiter is a single registration for a transaction hash.
+type confirmationWaiter struct {
+	ctx      context.Context
+	callback ConfirmationCallback
+}
+
+// txConfirmer confirms all awaited transactions together. Statuses only
+// change when a block is produced, so every pollTime it checks the latest
+// height and, once per new block, queries the statuses of all awaited
+// transactions with TxStatusBatch instead of issuing one TxStatus request per
+// transaction.
+type txConfirmer struct {
+	client *TxClient
+
+	mtx     sync.Mutex
+	waiters map[string][]*confirmationWaiter
+	// evictedAt records when resubmitting an evicted transaction failed so
+	// that it can be given up after evictionPollTimeOut.
+	evictedAt map[string]time.Time
+	// failures counts the consecutive transient errors returned while
+	// querying the status of a transaction.
+	failures map[string]int
+	// unpolled holds the transactions whose status has not been queried yet.
+	// They are queried on the next tick without waiting for a new block.
+	unpolled map[string]struct{}
+	running  bool
+
+	// lastHeight is the height at which statuses were last queried. It is
+	// only accessed by the polling goroutine.
+	lastHeight uint64
+	// heightUnsupported is set when the node does not serve the latest
+	// height, in which case statuses are queried on every tick.
+	heightUnsupported bool
+}
+
+func newTxConfirmer(client *TxClient) *txConfirmer {
+	return &txConfirmer{
+		client:    client,
+		waiters:   make(map[string][]*confirmationWaiter),
+		evictedAt: make(map[string]time.Time),
+		failures:  make(map[string]int),
+		unpolled:  make(map[string]struct{}),
+	}
+}
+
+// OnConfirmed registers callback to be called once the transaction with the
+// provided hash is committed, rejected or can no longer be found. If ctx is
+// cancelled first, the callback receives the context error instead.
+func (client *TxClient) OnConfirmed(ctx context.Context, txHash string, callback ConfirmationCallback) {
+	client.confirmer.add(ctx, txHash, callback)
+}
+
+// AwaitTxs awaits the confirmation of many transactions at once. The returned
+// channel receives one result per hash in the order in which they are
+// finalized and is closed after the last one.
+func (client *TxClient) AwaitTxs(ctx context.Context, txHashes ...string) <-chan ConfirmationResult {
+	resultsC := make(chan ConfirmationResult, len(txHashes))
+	if len(txHashes) == 0 {
+		close(resultsC)
+		return resultsC
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(len(txHashes))
+	for _, txHash := range txHashes {
+		client.confirmer.add(ctx, txHash, func(result ConfirmationResult) {
+			resultsC <- result
+			wg.Done()
+		})
+	}
+	go func() {
+		wg.Wait()
+		close(resultsC)
+	}()
+	return resultsC
+}
+
+// ConfirmTxs blocks until all provided transactions are finalized and returns
+// their results in the same order as the hashes.
+func (client *TxClient) ConfirmTxs(ctx context.Context, txHashes ...string) []ConfirmationResult {
+	byHash := make(map[string]ConfirmationResult, len(txHashes))
+	for result := range client.AwaitTxs(ctx, txHashes...) {
+		byHash[result.TxHash] = result
+	}
+	results := make([]ConfirmationResult, len(txHashes))
+	for i, txHash := range txHashes {
+		results[i] = byHash[txHash]
+	}
+	return results
+}
+
+// add registers a waiter and starts the polling loop if it is not running.
+func (c *txConfirmer) add(ctx context.Context, txHash string, callback ConfirmationCallback) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.waiters[txHash] = append(c.waiters[txHash], &confirmationWaiter{ctx: ctx, callback: callback})
+	c.unpolled[txHash] = struct{}{}
+	if !c.running {
+		c.running = true
+		go c.run()
+	}
+}
+
+// run polls the statuses of all awaited transactions until there are none
+// left.
+func (c *txConfirmer) run() {
+	ticker := time.NewTicker(c.client.pollTime)
+	defer ticker.Stop()
+
+	for {
+		if !c.poll() {
+			return
+		}
+		<-ticker.C
+	}
+}
+
+// poll performs a single round of status queries if a new block was produced
+// since the previous round. It returns false once no transactions are awaited
+// anymore, in which case the loop has been marked as stopped.
+func (c *txConfirmer) poll() bool {
+	txHashes := c.pendingHashes()
+	if len(txHashes) == 0 {
+		return false
+	}
+	unpolled := c.takeUnpolled(txHashes)
+	if !c.newBlock() {
+		txHashes = unpolled
+	}
+
+	txClient := tx.NewTxClient(c.client.conns[0])
+	for start := 0; start < len(txHashes); start += maxTxStatusBatchSize {
+		end := min(start+maxTxStatusBatchSize, len(txHashes))
+		c.pollBatch(txClient, txHashes[start:end])
+	}
+	return true
+}
+
+// pollBatch queries and handles the statuses of up to maxTxStatusBatchSize
+// transactions. A request that fails is retried on the next poll if the error
+// is transient. Otherwise the transactions are queried one by one so that only
+// the waiters of the transactions that caused the error are failed.
+func (c *txConfirmer) pollBatch(txClient tx.TxClient, txHashes []string) {
+	// The request is not bound to any single waiter's context.
+	ctx, cancel := c.requestContext()
+	defer cancel()
+
+	resp, err := txClient.TxStatusBatch(ctx, &tx.TxStatusBatchRequest{TxIds: txHashes})
+	if err == nil {
+		for _, result := range resp.Statuses {
+			c.resetFailures(result.TxHash)
+			c.handleStatus(ctx, result.TxHash, result.Status)
+		}
+		return
+	}
+	if isTransientStatusError(err) {
+		c.recordFailures(txHashes, err)
+		return
+	}
+
+	for _, txHash := range txHashes {
+		resp, err := txClient.TxStatus(ctx, &tx.TxStatusRequest{TxId: txHash})
+		switch {
+		case err == nil:
+			c.resetFailures(txHash)
+			c.handleStatus(ctx, txHash, resp)
+		case isTransientStatusError(err):
+			c.recordFailures([]string{txHash}, err)
+		default:
+			c.deliver(txHash, ConfirmationResult{TxHash: txHash, Error: err})
+		}
+	}
+}
+
+// takeUnpolled returns the transactions among txHashes whose status has not
+// been queried yet and marks them as queried.
+func (c *txConfirmer) takeUnpolled(txHashes []string) []string {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	unpolled := make([]string, 0, len(c.unpolled))
+	for _, txHash := range txHashes {
+		if _, ok := c.unpolled[txHash]; ok {
+			unpolled = append(unpolled, txHash)
+			delete(c.unpolled, txHash)
+		}
+	}
+	return unpolled
+}
+
+// newBlock reports whether the chain has advanced since statuses were last
+// queried. If the latest height cannot be determined statuses are queried
+// anyway.
+func (c *txConfirmer) newBlock() bool {
+	if c.heightUnsupported {
+		return true
+	}
+
+	ctx, cancel := c.requestContext()
+	defer cancel()
+	resp, err := nodeservice.NewServiceClient(c.client.conns[0]).Status(ctx, &nodeservice.StatusRequest{})
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			c.heightUnsupported = true
+		}
+		return true
+	}
+	if resp.Height <= c.lastHeight {
+		return false
+	}
+	c.lastHeight = resp.Height
+	return true
+}
+
+// requestContext returns the context used for a single request of a polling
+// round.
+func (c *txConfirmer) requestContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), max(c.client.pollTime, 10*time.Second))
+}
+
+// recordFailures counts a transient error for each transaction and fails the
+// waiters of those that have exceeded maxTransientStatusErrors.
+func (c *txConfirmer) recordFailures(txHashes []string, err error) {
+	var failed []string
+	c.mtx.Lock()
+	for _, txHash := range txHashes {
+		c.failures[txHash]++
+		if c.failures[txHash] >= maxTransientStatusErrors {
+			failed = append(failed, txHash)
+		}
+	}
+	c.mtx.Unlock()
+
+	for _, txHash := range failed {
+		c.deliver(txHash, ConfirmationResult{TxHash: txHash, Error: fmt.Errorf("querying status of tx %s: %w", txHash, err)})
+	}
+	if len(failed) < len(txHashes) {
+		// Query again on the next tick even if no new block was produced.
+		c.lastHeight = 0
+	}
+}
+
+func (c *txConfirmer) resetFailures(txHash string) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	delete(c.failures, txHash)
+}
+
+// isTransientStatusError reports whether a status query may succeed when
+// retried.
+func isTransientStatusError(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
+
+// pendingHashes drops waiters whose context is done, notifying them, and
+// returns the hashes that are still awaited. It marks the loop as stopped
+// when nothing is left.
+func (c *txConfirmer) pendingHashes() []string {
+	c.mtx.Lock()
+	var cancelled []func()
+	txHashes := make([]string, 0, len(c.waiters))
+	for txHash, waiters := range c.waiters {
+		active := waiters[:0]
+		for _, w := range waiters {
+			if err := w.ctx.Err(); err != nil {
+				cancelled = append(cancelled, func() {
+					w.callback(ConfirmationResult{TxHash: txHash, Error: err})
+				})
+				continue
+			}
+			active = append(active, w)
+		}
+		if len(active) == 0 {
+			delete(c.waiters, txHash)
+			delete(c.evictedAt, txHash)
+			delete(c.failures, txHash)
+			delete(c.unpolled, txHash)
+			continue
+		}
+		c.waiters[txHash] = active
+		txHashes = append(txHashes, txHash)
+	}
+	if len(txHashes) == 0 {
+		c.running = false
+	}
+	c.mtx.Unlock()
+
+	for _, notify := range cancelled {
+		notify()
+	}
+	return txHashes
+}
+
+// handleStatus acts on the status of a single awaited transaction, mirroring
+// the state handling of ConfirmTx.
+func (c *txConfirmer) handleStatus(ctx context.Context, txHash string, resp *tx.TxStatusResponse) {
+	client := c.client
+	switch resp.Status {
+	case core.TxStatusPending:
+	case core.TxStatusCommitted:
+		client.deleteFromTxTracker(txHash)
+		if resp.ExecutionCode != abci.CodeTypeOK {
+			c.deliver(txHash, ConfirmationResult{TxHash: txHash, Error: client.buildExecutionError(txHash, resp)})
+			return
+		}
+		c.deliver(txHash, ConfirmationResult{TxHash: txHash, TxResponse: client.buildTxResponse(txHash, resp)})
+	case core.TxStatusEvicted:
+		c.handleEviction(ctx, txHash)
+	case core.TxStatusRejected:
+		c.deliver(txHash, ConfirmationResult{TxHash: txHash, Error: client.handleRejectedTx(txHash, resp)})
+	default:
+		client.deleteFromTxTracker(txHash)
+		c.deliver(txHash, ConfirmationResult{TxHash: txHash, Error: fmt.Errorf("transaction with hash %s not found", txHash)})
+	}
+}
+
+// handleEviction resubmits an evicted transaction once. If resubmission fails
+// the transaction is given up after evictionPollTimeOut.
+func (c *txConfirmer) handleEviction(ctx context.Context, txHash string) {
+	c.mtx.Lock()
+	evictedAt, timerRunning := c.evictedAt[txHash]
+	c.mtx.Unlock()
+
+	if timerRunning {
+		if time.Since(evictedAt) > evictionPollTimeOut {
+			c.deliver(txHash, ConfirmationResult{TxHash: txHash, Error: fmt.Errorf("eviction poll timeout: transaction %s was evicted ", txHash)})
+		}
+		return
+	}
+
+	_, _, txBytes, exists := c.client.GetTxFromTxTracker(txHash)
+	if !exists {
+		c.deliver(txHash, ConfirmationResult{TxHash: txHash, Error: fmt.Errorf("tx: %s not found in txTracker; likely failed during broadcast", txHash)})
+		return
+	}
+
+	if _, err := c.client.sendTxToConnection(ctx, c.client.conns[0], txBytes); err != nil {
+		var broadcastErr *BroadcastTxError
+		if !errors.As(err, &broadcastErr) {
+			c.deliver(txHash, ConfirmationResult{TxHash: txHash, Error: err})
+			return
+		}
+		c.mtx.Lock()
+		c.evictedAt[txHash] = time.Now()
+		c.mtx.Unlock()
+		return
+	}
+	c.client.markResubmittedInJournal(txHash)
+}
+
+// deliver passes the result to every waiter of the hash and stops awaiting it.
+func (c *txConfirmer) deliver(txHash string, result ConfirmationResult) {
+	c.mtx.Lock()
+	waiters := c.waiters[txHash]
+	delete(c.waiters, txHash)
+	delete(c.evictedAt, txHash)
+	delete(c.failures, txHash)
+	delete(c.unpolled, txHash)
+	c.mtx.Unlock()
+
+	for _, w := range waiters {
+		w.callback(result)
+	}
+}
@@ -0,0 +1,258 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v7/app/grpc/tx"
+	"github.com/cometbft/cometbft/rpc/core"
+	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// batchStatusServer serves TxStatusBatch from a fixed set of statuses and
+// counts the requests it receives. Requests for a hash in invalid fail with
+// InvalidArgument and the next batchErrors batch requests fail with
+// Unavailable.
+type batchStatusServer struct {
+	tx.UnimplementedTxServer
+
+	mtx         sync.Mutex
+	statuses    map[string]*tx.TxStatusResponse
+	invalid     map[string]bool
+	batchErrors int
+	requests    int
+}
+
+func (s *batchStatusServer) TxStatusBatch(_ context.Context, req *tx.TxStatusBatchRequest) (*tx.TxStatusBatchResponse, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.requests++
+	if s.batchErrors > 0 {
+		s.batchErrors--
+		return nil, status.Error(codes.Unavailable, "node unavailable")
+	}
+	resp := &tx.TxStatusBatchResponse{}
+	for _, txHash := range req.TxIds {
+		if s.invalid[txHash] {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid tx id %s", txHash)
+		}
+		resp.Statuses = append(resp.Statuses, &tx.TxStatusResult{TxHash: txHash, Status: s.status(txHash)})
+	}
+	return resp, nil
+}
+
+func (s *batchStatusServer) TxStatus(_ context.Context, req *tx.TxStatusRequest) (*tx.TxStatusResponse, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.invalid[req.TxId] {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx id %s", req.TxId)
+	}
+	return s.status(req.TxId), nil
+}
+
+func (s *batchStatusServer) status(txHash string) *tx.TxStatusResponse {
+	txStatus, ok := s.statuses[txHash]
+	if !ok {
+		return &tx.TxStatusResponse{Status: core.TxStatusUnknown}
+	}
+	return txStatus
+}
+
+func (s *batchStatusServer) requestCount() int {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.requests
+}
+
+// heightServer serves the latest height through the node status.
+type heightServer struct {
+	nodeservice.UnimplementedServiceServer
+
+	height atomic.Uint64
+}
+
+func (s *heightServer) Status(context.Context, *nodeservice.StatusRequest) (*nodeservice.StatusResponse, error) {
+	return &nodeservice.StatusResponse{Height: s.height.Load()}, nil
+}
+
+func (s *batchStatusServer) setStatus(txHash string, status *tx.TxStatusResponse) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.statuses[txHash] = status
+}
+
+func newBatchStatusClient(t *testing.T, server *batchStatusServer) *TxClient {
+	return newBatchStatusClientWithHeight(t, server, nil)
+}
+
+// newBatchStatusClientWithHeight returns a client whose node also serves the
+// latest height if heights is not nil.
+func newBatchStatusClientWithHeight(t *testing.T, server *batchStatusServer, heights *heightServer) *TxClient {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	tx.RegisterTxServer(s, server)
+	if heights != nil {
+		nodeservice.RegisterServiceServer(s, heights)
+	}
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+
+	client := &TxClient{
+		conns:     []*grpc.ClientConn{conn},
+		pollTime:  10 * time.Millisecond,
+		txTracker: make(map[string]txInfo),
+	}
+	client.confirmer = newTxConfirmer(client)
+	return client
+}
+
+func TestAwaitTxs(t *testing.T) {
+	server := &batchStatusServer{statuses: make(map[string]*tx.TxStatusResponse)}
+	client := newBatchStatusClient(t, server)
+
+	// more hashes than fit in a single batch request
+	hashes := make([]string, 0, maxTxStatusBatchSize+5)
+	for i := range maxTxStatusBatchSize + 5 {
+		txHash := fmt.Sprintf("tx%d", i)
+		hashes = append(hashes, txHash)
+		server.setStatus(txHash, &tx.TxStatusResponse{Status: core.TxStatusPending})
+	}
+
+	resultsC := client.AwaitTxs(context.Background(), hashes...)
+
+	for i, txHash := range hashes {
+		status := &tx.TxStatusResponse{Status: core.TxStatusCommitted, Height: int64(i + 1)}
+		if i == 0 {
+			status.ExecutionCode = 5
+			status.Error = "insufficient funds"
+		}
+		server.setStatus(txHash, status)
+	}
+
+	results := make(map[string]ConfirmationResult)
+	for result := range resultsC {
+		results[result.TxHash] = result
+	}
+	require.Len(t, results, len(hashes))
+
+	var execErr *ExecutionError
+	require.ErrorAs(t, results[hashes[0]].Error, &execErr)
+	require.EqualValues(t, 5, execErr.Code)
+	for i, txHash := range hashes[1:] {
+		require.NoError(t, results[txHash].Error)
+		require.EqualValues(t, i+2, results[txHash].TxResponse.Height)
+	}
+}
+
+func TestConfirmTxsPerTxContext(t *testing.T) {
+	server := &batchStatusServer{statuses: make(map[string]*tx.TxStatusResponse)}
+	client := newBatchStatusClient(t, server)
+	server.setStatus("pending", &tx.TxStatusResponse{Status: core.TxStatusPending})
+	server.setStatus("committed", &tx.TxStatusResponse{Status: core.TxStatusCommitted, Height: 3})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancelled := make(chan ConfirmationResult, 1)
+	client.OnConfirmed(ctx, "pending", func(result ConfirmationResult) {
+		cancelled <- result
+	})
+	cancel()
+
+	results := client.ConfirmTxs(context.Background(), "committed", "missing")
+	require.NoError(t, results[0].Error)
+	require.EqualValues(t, 3, results[0].TxResponse.Height)
+	require.ErrorContains(t, results[1].Error, "not found")
+
+	select {
+	case result := <-cancelled:
+		require.ErrorIs(t, result.Error, context.Canceled)
+	case <-time.After(5 * time.Second):
+		t.Fatal("cancelled waiter was not notified")
+	}
+}
+
+func TestConfirmerPollsOncePerBlock(t *testing.T) {
+	server := &batchStatusServer{statuses: make(map[string]*tx.TxStatusResponse)}
+	heights := &heightServer{}
+	heights.height.Store(1)
+	client := newBatchStatusClientWithHeight(t, server, heights)
+	server.setStatus("pending", &tx.TxStatusResponse{Status: core.TxStatusPending})
+
+	resultsC := client.AwaitTxs(context.Background(), "pending")
+	require.Eventually(t, func() bool { return server.requestCount() == 1 }, time.Second, time.Millisecond)
+
+	// a tx awaited between blocks is queried without waiting for the next one
+	server.setStatus("committed", &tx.TxStatusResponse{Status: core.TxStatusCommitted, Height: 1})
+	require.NoError(t, client.ConfirmTxs(context.Background(), "committed")[0].Error)
+	requests := server.requestCount()
+
+	// no new block, no new status queries
+	time.Sleep(10 * client.pollTime)
+	require.Equal(t, requests, server.requestCount())
+
+	server.setStatus("pending", &tx.TxStatusResponse{Status: core.TxStatusCommitted, Height: 2})
+	heights.height.Store(2)
+	result := <-resultsC
+	require.NoError(t, result.Error)
+	require.EqualValues(t, 2, result.TxResponse.Height)
+	require.Equal(t, requests+1, server.requestCount())
+}
+
+func TestConfirmerStatusErrors(t *testing.T) {
+	t.Run("transient errors are retried", func(t *testing.T) {
+		server := &batchStatusServer{
+			statuses:    map[string]*tx.TxStatusResponse{"committed": {Status: core.TxStatusCommitted, Height: 3}},
+			batchErrors: maxTransientStatusErrors - 1,
+		}
+		client := newBatchStatusClient(t, server)
+
+		results := client.ConfirmTxs(context.Background(), "committed")
+		require.NoError(t, results[0].Error)
+		require.EqualValues(t, 3, results[0].TxResponse.Height)
+	})
+
+	t.Run("persistent transient errors fail the waiters", func(t *testing.T) {
+		server := &batchStatusServer{
+			statuses:    make(map[string]*tx.TxStatusResponse),
+			batchErrors: maxTransientStatusErrors,
+		}
+		client := newBatchStatusClient(t, server)
+
+		results := client.ConfirmTxs(context.Background(), "pending")
+		require.Equal(t, codes.Unavailable, status.Code(errors.Unwrap(results[0].Error)))
+	})
+
+	t.Run("only the waiters of an invalid tx fail", func(t *testing.T) {
+		server := &batchStatusServer{
+			statuses: map[string]*tx.TxStatusResponse{"committed": {Status: core.TxStatusCommitted, Height: 3}},
+			invalid:  map[string]bool{"invalid": true},
+		}
+		client := newBatchStatusClient(t, server)
+
+		results := client.ConfirmTxs(context.Background(), "committed", "invalid")
+		require.NoError(t, results[0].Error)
+		require.Equal(t, codes.InvalidArgument, status.Code(results[1].Error))
+	})
+}
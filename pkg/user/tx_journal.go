@@ -0,0 +1,432 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v7/app/grpc/tx"
+	"github.com/cometbft/cometbft/rpc/core"
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// JournalTxState describes how far a journaled transaction has progressed.
+type JournalTxState string
+
+const (
+	// JournalTxStateBroadcast indicates that the transaction was accepted by
+	// the mempool of at least one node.
+	JournalTxStateBroadcast JournalTxState = "broadcast"
+	// JournalTxStateResubmitted indicates that the transaction was evicted and
+	// has been broadcast again.
+	JournalTxStateResubmitted JournalTxState = "resubmitted"
+)
+
+// journalKeyPrefix is the prefix under which entries are stored in a DB
+// backed journal.
+var journalKeyPrefix = []byte("txjournal/")
+
+// JournalEntry is the persisted form of an in-flight transaction.
+type JournalEntry struct {
+	TxHash      string         `json:"tx_hash"`
+	Signer      string         `json:"signer"`
+	Sequence    uint64         `json:"sequence"`
+	TxBytes     []byte         `json:"tx_bytes"`
+	State       JournalTxState `json:"state"`
+	SubmittedAt time.Time      `json:"submitted_at"`
+}
+
+// TxJournal persists the transactions tracked by the TxClient so that they
+// can be recovered after a restart. Implementations must be safe for
+// concurrent use and must have written an entry durably before Put returns.
+type TxJournal interface {
+	// Put inserts or replaces the entry with the same hash.
+	Put(entry JournalEntry) error
+	// Delete removes the entry for the provided hash. Deleting an unknown hash
+	// is not an error.
+	Delete(txHash string) error
+	// List returns all entries ordered by signer and sequence.
+	List() ([]JournalEntry, error)
+	// Close releases any resources held by the journal.
+	Close() error
+}
+
+// FileTxJournal is a TxJournal that keeps all entries in a single JSON file.
+// Every write replaces the file atomically, so a crash leaves either the old
+// or the new contents on disk, never a partial write.
+type FileTxJournal struct {
+	mtx     sync.Mutex
+	path    string
+	entries map[string]JournalEntry
+}
+
+var _ TxJournal = (*FileTxJournal)(nil)
+
+// NewFileTxJournal opens the journal at path, creating it if it does not
+// exist.
+func NewFileTxJournal(path string) (*FileTxJournal, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("creating journal directory: %w", err)
+	}
+
+	j := &FileTxJournal{
+		path:    path,
+		entries: make(map[string]JournalEntry),
+	}
+
+	bz, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return j, nil
+	case err != nil:
+		return nil, fmt.Errorf("reading journal %s: %w", path, err)
+	}
+
+	var entries []JournalEntry
+	if err := json.Unmarshal(bz, &entries); err != nil {
+		return nil, fmt.Errorf("decoding journal %s: %w", path, err)
+	}
+	for _, entry := range entries {
+		j.entries[entry.TxHash] = entry
+	}
+	return j, nil
+}
+
+func (j *FileTxJournal) Put(entry JournalEntry) error {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+	prev, existed := j.entries[entry.TxHash]
+	j.entries[entry.TxHash] = entry
+	if err := j.flush(); err != nil {
+		if existed {
+			j.entries[entry.TxHash] = prev
+		} else {
+			delete(j.entries, entry.TxHash)
+		}
+		return err
+	}
+	return nil
+}
+
+func (j *FileTxJournal) Delete(txHash string) error {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+	prev, existed := j.entries[txHash]
+	if !existed {
+		return nil
+	}
+	delete(j.entries, txHash)
+	if err := j.flush(); err != nil {
+		j.entries[txHash] = prev
+		return err
+	}
+	return nil
+}
+
+func (j *FileTxJournal) List() ([]JournalEntry, error) {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+	entries := make([]JournalEntry, 0, len(j.entries))
+	for _, entry := range j.entries {
+		entries = append(entries, entry)
+	}
+	sortJournalEntries(entries)
+	return entries, nil
+}
+
+func (j *FileTxJournal) Close() error {
+	return nil
+}
+
+// flush writes all entries to a temporary file, syncs it and renames it over
+// the journal file. It must be called with the mutex held.
+func (j *FileTxJournal) flush() error {
+	entries := make([]JournalEntry, 0, len(j.entries))
+	for _, entry := range j.entries {
+		entries = append(entries, entry)
+	}
+	sortJournalEntries(entries)
+
+	bz, err := json.Marshal(entries)
+	if err != nil {
+		return fmt.Errorf("encoding journal: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(j.path), filepath.Base(j.path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("creating temporary journal file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(bz); err != nil {
+		tmp.Close()
+		return fmt.Errorf("writing journal: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("syncing journal: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("closing journal: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), j.path); err != nil {
+		return fmt.Errorf("replacing journal: %w", err)
+	}
+	return nil
+}
+
+// DBTxJournal is a TxJournal backed by an embedded key-value database such as
+// goleveldb or pebble. Entries are written with synchronous writes.
+type DBTxJournal struct {
+	db dbm.DB
+}
+
+var _ TxJournal = (*DBTxJournal)(nil)
+
+// NewDBTxJournal returns a journal that stores its entries in db. The journal
+// takes ownership of db and closes it on Close.
+func NewDBTxJournal(db dbm.DB) *DBTxJournal {
+	return &DBTxJournal{db: db}
+}
+
+func (j *DBTxJournal) Put(entry JournalEntry) error {
+	bz, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("encoding journal entry: %w", err)
+	}
+	return j.db.SetSync(journalKey(entry.TxHash), bz)
+}
+
+func (j *DBTxJournal) Delete(txHash string) error {
+	return j.db.DeleteSync(journalKey(txHash))
+}
+
+func (j *DBTxJournal) List() ([]JournalEntry, error) {
+	it, err := dbm.IteratePrefix(j.db, journalKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var entries []JournalEntry
+	for ; it.Valid(); it.Next() {
+		var entry JournalEntry
+		if err := json.Unmarshal(it.Value(), &entry); err != nil {
+			return nil, fmt.Errorf("decoding journal entry %s: %w", it.Key(), err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+	sortJournalEntries(entries)
+	return entries, nil
+}
+
+func (j *DBTxJournal) Close() error {
+	return j.db.Close()
+}
+
+func journalKey(txHash string) []byte {
+	return append(append([]byte{}, journalKeyPrefix...), txHash...)
+}
+
+func sortJournalEntries(entries []JournalEntry) {
+	sort.Slice(entries, func(i, k int) bool {
+		if entries[i].Signer != entries[k].Signer {
+			return entries[i].Signer < entries[k].Signer
+		}
+		return entries[i].Sequence < entries[k].Sequence
+	})
+}
+
+// RecoveredTx is the outcome of confirming a transaction that was reloaded
+// from the journal.
+type RecoveredTx struct {
+	TxHash   string
+	Response *TxResponse
+	Err      error
+}
+
+// journalRecovery tracks the background confirmation of the transactions
+// reloaded from the journal. It is independent of the context passed to
+// SetupTxClient so that confirmation continues once setup has returned.
+type journalRecovery struct {
+	cancel  context.CancelFunc
+	results chan RecoveredTx
+}
+
+// RecoveredTxs returns a channel that receives the outcome of confirming every
+// transaction recovered from the journal by SetupTxClient. The channel is
+// buffered for all recovered transactions, so reading it is optional, and it
+// is closed once every recovered transaction has been confirmed or recovery
+// has been stopped.
+func (client *TxClient) RecoveredTxs() <-chan RecoveredTx {
+	if client.recovery == nil {
+		results := make(chan RecoveredTx)
+		close(results)
+		return results
+	}
+	return client.recovery.results
+}
+
+// StopRecovery stops confirming the transactions recovered from the journal.
+// Transactions that have not reached a final state remain in the journal and
+// are recovered again by the next SetupTxClient.
+func (client *TxClient) StopRecovery() {
+	if client.recovery != nil {
+		client.recovery.cancel()
+	}
+}
+
+// recoverFromJournal reloads the transactions persisted by a previous process.
+// Local sequences are reconciled with the chain, transactions that the network
+// no longer knows about are rebroadcast and every recovered transaction is
+// confirmed in the background through ConfirmTx, which removes it from the
+// journal once it reaches a final state. The outcomes are reported through
+// RecoveredTxs.
+func (client *TxClient) recoverFromJournal(ctx context.Context) error {
+	if client.journal == nil {
+		return nil
+	}
+
+	entries, err := client.journal.List()
+	if err != nil {
+		return fmt.Errorf("listing journal: %w", err)
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+
+	recovered, err := client.reloadJournalEntries(ctx, entries)
+	if err != nil {
+		return err
+	}
+
+	client.startRecovery(recovered)
+	return nil
+}
+
+// startRecovery confirms the recovered transactions in the background and
+// reports their outcomes through RecoveredTxs.
+func (client *TxClient) startRecovery(txHashes []string) {
+	ctx, cancel := context.WithCancel(context.Background())
+	recovery := &journalRecovery{
+		cancel:  cancel,
+		results: make(chan RecoveredTx, len(txHashes)),
+	}
+	client.recovery = recovery
+
+	var wg sync.WaitGroup
+	for _, txHash := range txHashes {
+		wg.Add(1)
+		go func(txHash string) {
+			defer wg.Done()
+			resp, err := client.ConfirmTx(ctx, txHash)
+			if err != nil {
+				client.getLogger().Error("failed to confirm tx recovered from journal", "tx_hash", txHash, "err", err)
+			}
+			recovery.results <- RecoveredTx{TxHash: txHash, Response: resp, Err: err}
+		}(txHash)
+	}
+
+	go func() {
+		wg.Wait()
+		cancel()
+		close(recovery.results)
+	}()
+}
+
+// reloadJournalEntries adds the journaled transactions that can still be
+// included to the txTracker and returns their hashes. Entries are expected to
+// be ordered by signer and sequence.
+func (client *TxClient) reloadJournalEntries(ctx context.Context, entries []JournalEntry) ([]string, error) {
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+
+	txClient := tx.NewTxClient(client.conns[0])
+	onChainSequences := make(map[string]uint64)
+	recovered := make([]string, 0, len(entries))
+
+	for _, entry := range entries {
+		if err := client.checkAccountLoaded(ctx, entry.Signer); err != nil {
+			return nil, err
+		}
+		account := client.signer.Account(entry.Signer)
+
+		onChainSequence, ok := onChainSequences[entry.Signer]
+		if !ok {
+			_, seq, err := QueryAccount(ctx, client.conns[0], client.registry, account.Address())
+			if err != nil {
+				return nil, fmt.Errorf("querying account %s: %w", entry.Signer, err)
+			}
+			onChainSequence = seq
+			onChainSequences[entry.Signer] = seq
+			if err := client.signer.SetSequence(entry.Signer, seq); err != nil {
+				return nil, fmt.Errorf("setting sequence: %w", err)
+			}
+		}
+
+		resp, err := txClient.TxStatus(ctx, &tx.TxStatusRequest{TxId: entry.TxHash})
+		if err != nil {
+			return nil, fmt.Errorf("querying status of journaled tx %s: %w", entry.TxHash, err)
+		}
+
+		switch resp.Status {
+		case core.TxStatusUnknown, core.TxStatusEvicted:
+			if entry.Sequence < onChainSequence {
+				// The sequence has been used by another transaction so this
+				// one can never be included.
+				client.deleteFromJournal(entry.TxHash)
+				continue
+			}
+			if _, err := client.sendTxToConnection(ctx, client.conns[0], entry.TxBytes); err != nil {
+				if _, ok := err.(*BroadcastTxError); !ok {
+					return nil, err
+				}
+				client.deleteFromJournal(entry.TxHash)
+				continue
+			}
+			entry.State = JournalTxStateResubmitted
+			if err := client.journal.Put(entry); err != nil {
+				return nil, fmt.Errorf("updating journal entry %s: %w", entry.TxHash, err)
+			}
+		}
+
+		client.txTracker[entry.TxHash] = txInfo{
+			sequence:  entry.Sequence,
+			signer:    entry.Signer,
+			timestamp: time.Now(),
+			txBytes:   entry.TxBytes,
+		}
+		if entry.Sequence >= account.Sequence() {
+			if err := client.signer.SetSequence(entry.Signer, entry.Sequence+1); err != nil {
+				return nil, fmt.Errorf("setting sequence: %w", err)
+			}
+		}
+		recovered = append(recovered, entry.TxHash)
+	}
+
+	return recovered, nil
+}
+
+// markResubmittedInJournal records that an evicted transaction was broadcast
+// again.
+func (client *TxClient) markResubmittedInJournal(txHash string) {
+	if client.journal == nil {
+		return
+	}
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	info, exists := client.txTracker[txHash]
+	if !exists {
+		return
+	}
+	_ = client.journal.Put(info.journalEntry(txHash, JournalTxStateResubmitted))
+}
@@ -0,0 +1,273 @@
+package user
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v7/app/encoding"
+	"github.com/celestiaorg/celestia-app/v7/app/grpc/tx"
+	"github.com/cometbft/cometbft/rpc/core"
+	dbm "github.com/cosmos/cosmos-db"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestTxJournals(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal", "txs.json")
+
+	testCases := []struct {
+		name    string
+		open    func(t *testing.T) TxJournal
+		reopens bool
+	}{
+		{
+			name: "file journal",
+			open: func(t *testing.T) TxJournal {
+				j, err := NewFileTxJournal(path)
+				require.NoError(t, err)
+				return j
+			},
+			reopens: true,
+		},
+		{
+			name: "db journal",
+			open: func(*testing.T) TxJournal {
+				return NewDBTxJournal(dbm.NewMemDB())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			j := tc.open(t)
+
+			entries := []JournalEntry{
+				{TxHash: "c", Signer: "bob", Sequence: 1, TxBytes: []byte{3}, State: JournalTxStateBroadcast},
+				{TxHash: "b", Signer: "alice", Sequence: 2, TxBytes: []byte{2}, State: JournalTxStateBroadcast},
+				{TxHash: "a", Signer: "alice", Sequence: 1, TxBytes: []byte{1}, State: JournalTxStateBroadcast},
+			}
+			for _, entry := range entries {
+				require.NoError(t, j.Put(entry))
+			}
+
+			entries[0].State = JournalTxStateResubmitted
+			require.NoError(t, j.Put(entries[0]))
+			require.NoError(t, j.Delete("b"))
+			require.NoError(t, j.Delete("unknown"))
+
+			if tc.reopens {
+				require.NoError(t, j.Close())
+				j = tc.open(t)
+			}
+
+			got, err := j.List()
+			require.NoError(t, err)
+			require.Len(t, got, 2)
+			require.Equal(t, "a", got[0].TxHash)
+			require.Equal(t, []byte{1}, got[0].TxBytes)
+			require.Equal(t, "c", got[1].TxHash)
+			require.Equal(t, JournalTxStateResubmitted, got[1].State)
+			require.NoError(t, j.Close())
+		})
+	}
+}
+
+func TestTxTrackerUpdatesJournal(t *testing.T) {
+	journal := NewDBTxJournal(dbm.NewMemDB())
+	txClient := &TxClient{
+		txTracker: make(map[string]txInfo),
+		journal:   journal,
+	}
+
+	txClient.txTracker["old"] = txInfo{signer: "alice", sequence: 1, timestamp: time.Now().Add(-txTrackerPruningInterval)}
+	txClient.txTracker["new"] = txInfo{signer: "alice", sequence: 2, timestamp: time.Now()}
+	for hash, info := range txClient.txTracker {
+		require.NoError(t, journal.Put(info.journalEntry(hash, JournalTxStateBroadcast)))
+	}
+
+	txClient.pruneTxTracker()
+	entries, err := journal.List()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "new", entries[0].TxHash)
+
+	txClient.markResubmittedInJournal("new")
+	entries, err = journal.List()
+	require.NoError(t, err)
+	require.Equal(t, JournalTxStateResubmitted, entries[0].State)
+
+	txClient.deleteFromTxTracker("new")
+	entries, err = journal.List()
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+// recoveryServer serves the account, tx status and broadcast endpoints used
+// while recovering the journal. Each tx hash is answered with a scripted
+// sequence of statuses, the last one being repeated.
+type recoveryServer struct {
+	authtypes.UnimplementedQueryServer
+	tx.UnimplementedTxServer
+	sdktx.UnimplementedServiceServer
+
+	account *authtypes.BaseAccount
+
+	mtx        sync.Mutex
+	statuses   map[string][]*tx.TxStatusResponse
+	broadcasts int
+}
+
+func (s *recoveryServer) Account(context.Context, *authtypes.QueryAccountRequest) (*authtypes.QueryAccountResponse, error) {
+	account, err := codectypes.NewAnyWithValue(s.account)
+	if err != nil {
+		return nil, err
+	}
+	return &authtypes.QueryAccountResponse{Account: account}, nil
+}
+
+func (s *recoveryServer) TxStatus(_ context.Context, req *tx.TxStatusRequest) (*tx.TxStatusResponse, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	statuses, ok := s.statuses[req.TxId]
+	if !ok {
+		return &tx.TxStatusResponse{Status: core.TxStatusUnknown}, nil
+	}
+	if len(statuses) > 1 {
+		s.statuses[req.TxId] = statuses[1:]
+	}
+	return statuses[0], nil
+}
+
+func (s *recoveryServer) BroadcastTx(context.Context, *sdktx.BroadcastTxRequest) (*sdktx.BroadcastTxResponse, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.broadcasts++
+	return &sdktx.BroadcastTxResponse{TxResponse: &sdktypes.TxResponse{}}, nil
+}
+
+func TestRecoverFromJournal(t *testing.T) {
+	encCfg := encoding.MakeConfig()
+	authtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	kr := keyring.NewInMemory(encCfg.Codec)
+	record, _, err := kr.NewMnemonic("alice", keyring.English, hd.CreateHDPath(sdktypes.CoinType, 0, 0).String(), keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	addr, err := record.GetAddress()
+	require.NoError(t, err)
+
+	server := &recoveryServer{
+		account: authtypes.NewBaseAccount(addr, nil, 1, 5),
+		statuses: map[string][]*tx.TxStatusResponse{
+			"pending": {
+				{Status: core.TxStatusPending},
+				{Status: core.TxStatusCommitted, Height: 10},
+			},
+			"evicted": {
+				{Status: core.TxStatusEvicted},
+				{Status: core.TxStatusCommitted, Height: 11},
+			},
+		},
+	}
+	conn := newRecoveryConn(t, server)
+
+	signer, err := NewSigner(kr, encCfg.TxConfig, "test-chain", NewAccount("alice", 1, 0))
+	require.NoError(t, err)
+	journal := NewDBTxJournal(dbm.NewMemDB())
+	for _, entry := range []JournalEntry{
+		{TxHash: "stale", Signer: "alice", Sequence: 4, TxBytes: []byte{4}, State: JournalTxStateBroadcast},
+		{TxHash: "pending", Signer: "alice", Sequence: 5, TxBytes: []byte{5}, State: JournalTxStateBroadcast},
+		{TxHash: "evicted", Signer: "alice", Sequence: 6, TxBytes: []byte{6}, State: JournalTxStateBroadcast},
+	} {
+		require.NoError(t, journal.Put(entry))
+	}
+
+	client, err := NewTxClient(encCfg.Codec, signer, conn, encCfg.InterfaceRegistry, WithTxJournal(journal), WithPollTime(10*time.Millisecond))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	require.NoError(t, client.recoverFromJournal(ctx))
+	// Confirmation must outlive the context used for setup.
+	cancel()
+
+	require.EqualValues(t, 7, client.Signer().Account("alice").Sequence())
+	require.Equal(t, 1, server.broadcasts)
+
+	results := make(map[string]RecoveredTx)
+	timeout := time.After(5 * time.Second)
+	for len(results) < 2 {
+		select {
+		case result, ok := <-client.RecoveredTxs():
+			require.True(t, ok, "results closed early")
+			results[result.TxHash] = result
+		case <-timeout:
+			t.Fatal("recovered txs were not confirmed")
+		}
+	}
+	require.NotContains(t, results, "stale")
+	require.NoError(t, results["pending"].Err)
+	require.EqualValues(t, 10, results["pending"].Response.Height)
+	require.NoError(t, results["evicted"].Err)
+	require.EqualValues(t, 11, results["evicted"].Response.Height)
+
+	_, ok := <-client.RecoveredTxs()
+	require.False(t, ok)
+
+	entries, err := journal.List()
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestStopRecovery(t *testing.T) {
+	client := &TxClient{
+		conns:     []*grpc.ClientConn{newRecoveryConn(t, &recoveryServer{})},
+		pollTime:  10 * time.Millisecond,
+		txTracker: map[string]txInfo{"pending": {signer: "alice", sequence: 1, timestamp: time.Now()}},
+	}
+
+	_, ok := <-client.RecoveredTxs()
+	require.False(t, ok, "no recovery should report no results")
+
+	client.startRecovery([]string{"pending"})
+	client.StopRecovery()
+
+	result := <-client.RecoveredTxs()
+	require.Equal(t, "pending", result.TxHash)
+	require.Error(t, result.Err)
+	_, ok = <-client.RecoveredTxs()
+	require.False(t, ok)
+}
+
+func newRecoveryConn(t *testing.T, server *recoveryServer) *grpc.ClientConn {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	authtypes.RegisterQueryServer(s, server)
+	tx.RegisterTxServer(s, server)
+	sdktx.RegisterServiceServer(s, server)
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
@@ -49,6 +49,7 @@ func (a *App) OutOfOrderPrepareProposal(req *abci.RequestPrepareProposal) (*abci
 		a.GetEncodingConfig().TxConfig,
 		a.MaxEffectiveSquareSize(sdkCtx),
 		appconsts.SubtreeRootThreshold,
+		a.BlobKeeper.GetNamespaceParams(sdkCtx),
 	)
 	if err != nil {
 		panic(err)
@@ -10,7 +10,11 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/celestiaorg/celestia-app/v7/test/util/testnode"
+	"github.com/celestiaorg/celestia-app/v7/app"
+	"github.com/celestiaorg/celestia-app/v7/app/encoding"
+	blobtypes "github.com/celestiaorg/celestia-app/v7/x/blob/types"
+	"github.com/celestiaorg/go-square/v3/share"
+	blobtx "github.com/celestiaorg/go-square/v3/tx"
 	"github.com/cometbft/cometbft/rpc/client/http"
 	"github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -65,9 +69,10 @@ func Scan(ctx context.Context, rpcAddress string, fromHeight, toHeight int64) er
 		return err
 	}
 
+	decoder := encoding.MakeConfig(app.ModuleEncodingRegisters...).TxConfig.TxDecoder()
 	if fromHeight == 0 && toHeight == 0 {
 		fmt.Printf("Trailing chain %s...\n", status.NodeInfo.Network)
-		return Trail(ctx, client)
+		return Trail(ctx, client, decoder)
 	}
 
 	if toHeight == 0 {
@@ -85,7 +90,7 @@ func Scan(ctx context.Context, rpcAddress string, fromHeight, toHeight int64) er
 		if err != nil {
 			return err
 		}
-		if err := PrintBlock(block.Block); err != nil {
+		if err := PrintBlock(decoder, block.Block); err != nil {
 			return err
 		}
 	}
@@ -93,7 +98,7 @@ func Scan(ctx context.Context, rpcAddress string, fromHeight, toHeight int64) er
 	return nil
 }
 
-func Trail(ctx context.Context, client *http.HTTP) error {
+func Trail(ctx context.Context, client *http.HTTP, decoder sdk.TxDecoder) error {
 	if err := client.Start(); err != nil {
 		return err
 	}
@@ -121,30 +126,38 @@ func Trail(ctx context.Context, client *http.HTTP) error {
 			if !ok {
 				return fmt.Errorf("unexpected result type: %T", result.Data)
 			}
-			if err := PrintBlock(blockResult.Block); err != nil {
+			if err := PrintBlock(decoder, blockResult.Block); err != nil {
 				return err
 			}
 		}
 	}
 }
 
-func PrintBlock(block *types.Block) error {
+func PrintBlock(decoder sdk.TxDecoder, block *types.Block) error {
 	fmt.Println("Height:", block.Height)
-	txs, err := testnode.DecodeBlockData(block.Data)
-	if err != nil {
-		return err
-	}
-	for _, tx := range txs {
+	for _, txBytes := range block.Data.Txs {
+		var blobs []*share.Blob
+		blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(txBytes)
+		if isBlobTx {
+			if err != nil {
+				return fmt.Errorf("decoding blob tx: %w", err)
+			}
+			txBytes, blobs = blobTx.Tx, blobTx.Blobs
+		}
+		tx, err := decoder(txBytes)
+		if err != nil {
+			return fmt.Errorf("decoding tx: %w", err)
+		}
 		authTx, ok := tx.(authsigning.Tx)
 		if !ok {
 			return fmt.Errorf("tx is not an auth.Tx")
 		}
-		PrintTx(authTx)
+		PrintTx(authTx, blobs)
 	}
 	return nil
 }
 
-func PrintTx(tx authsigning.Tx) {
+func PrintTx(tx authsigning.Tx, blobs []*share.Blob) {
 	msgs := tx.GetMsgs()
 	signers, err := tx.GetSigners()
 	if err != nil {
@@ -152,9 +165,8 @@ func PrintTx(tx authsigning.Tx) {
 	}
 
 	fmt.Printf(`Tx - Signer: %s, Fee: %s {
-%s
-}
-`, signers, tx.GetFee(), printMessages(msgs))
+%s%s}
+`, signers, tx.GetFee(), printMessages(msgs), printBlobs(blobs))
 }
 
 func printMessages(msgs []sdk.Msg) string {
@@ -164,3 +176,17 @@ func printMessages(msgs []sdk.Msg) string {
 	}
 	return output.String()
 }
+
+// printBlobs prints the namespace and the size of each blob. The size of
+// compressed blobs is followed by their decompressed size.
+func printBlobs(blobs []*share.Blob) string {
+	var output strings.Builder
+	for _, blob := range blobs {
+		output.WriteString(fmt.Sprintf("  - Blob %X: %d bytes", blob.Namespace().ID(), len(blob.Data())))
+		if size, compressed := blobtypes.DecompressedBlobSize(blob); compressed {
+			output.WriteString(fmt.Sprintf(" (compressed, %d bytes decompressed)", size))
+		}
+		output.WriteString("\n")
+	}
+	return output.String()
+}
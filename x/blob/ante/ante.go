@@ -2,6 +2,7 @@ package ante
 
 import (
 	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 	"github.com/celestiaorg/celestia-app/v7/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v7/x/blob/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -35,7 +36,8 @@ func (d MinGasPFBDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool
 
 	gasPerByte := appconsts.GasPerBlobByte
 	txGas := ctx.GasMeter().GasRemaining()
-	err := d.validatePFBHasEnoughGas(tx.GetMsgs(), gasPerByte, txGas)
+	namespaceParams := getNamespaceParams(ctx, d.k)
+	err := d.validatePFBHasEnoughGas(tx.GetMsgs(), gasPerByte, txGas, namespaceParams)
 	if err != nil {
 		return ctx, err
 	}
@@ -46,7 +48,7 @@ func (d MinGasPFBDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool
 // validatePFBHasEnoughGas iterates through all the msgs and nested msgs to find
 // a MsgPayForBlobs. If found, it validates that the txGas is enough to pay for
 // the blobs.
-func (d MinGasPFBDecorator) validatePFBHasEnoughGas(msgs []sdk.Msg, gasPerByte uint32, txGas uint64) error {
+func (d MinGasPFBDecorator) validatePFBHasEnoughGas(msgs []sdk.Msg, gasPerByte uint32, txGas uint64, namespaceParams []types.NamespaceParams) error {
 	for _, m := range msgs {
 		if execMsg, ok := m.(*authz.MsgExec); ok {
 			// Recursively look for PFBs in nested authz messages.
@@ -54,13 +56,16 @@ func (d MinGasPFBDecorator) validatePFBHasEnoughGas(msgs []sdk.Msg, gasPerByte u
 			if err != nil {
 				return err
 			}
-			err = d.validatePFBHasEnoughGas(nestedMsgs, gasPerByte, txGas)
+			err = d.validatePFBHasEnoughGas(nestedMsgs, gasPerByte, txGas, namespaceParams)
 			if err != nil {
 				return err
 			}
 		}
 		if pfb, ok := m.(*types.MsgPayForBlobs); ok {
-			err := validateEnoughGas(pfb, gasPerByte, txGas)
+			if err := types.ValidateBlobSizesForNamespaces(pfb, namespaceParams); err != nil {
+				return err
+			}
+			err := validateEnoughGas(pfb, gasPerByte, txGas, namespaceParams)
 			if err != nil {
 				return err
 			}
@@ -69,10 +74,10 @@ func (d MinGasPFBDecorator) validatePFBHasEnoughGas(msgs []sdk.Msg, gasPerByte u
 	return nil
 }
 
-// validateEnoughGas returns an error if the gas needed to pay for the blobs is
-// greater than the txGas.
-func validateEnoughGas(msg *types.MsgPayForBlobs, gasPerByte uint32, txGas uint64) error {
-	gasToConsume := msg.Gas(gasPerByte)
+// validateEnoughGas returns an error if the gas needed to pay for the blobs,
+// scaled by the gas multipliers of their namespaces, is greater than the txGas.
+func validateEnoughGas(msg *types.MsgPayForBlobs, gasPerByte uint32, txGas uint64, namespaceParams []types.NamespaceParams) error {
+	gasToConsume := types.GasToConsumeWithNamespaceParams(msg, gasPerByte, namespaceParams)
 	if gasToConsume > txGas {
 		return errors.Wrapf(sdkerrors.ErrInsufficientFee, "not enough gas to pay for blobs (minimum: %d, got: %d)", gasToConsume, txGas)
 	}
@@ -81,4 +86,11 @@ func validateEnoughGas(msg *types.MsgPayForBlobs, gasPerByte uint32, txGas uint6
 
 type BlobKeeper interface {
 	GetParams(ctx sdk.Context) types.Params
+	GetNamespaceParams(ctx sdk.Context) []types.NamespaceParams
+}
+
+// getNamespaceParams reads the per namespace blob parameters without charging
+// gas to the transaction.
+func getNamespaceParams(ctx sdk.Context, k BlobKeeper) []types.NamespaceParams {
+	return k.GetNamespaceParams(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()))
 }
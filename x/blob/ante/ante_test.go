@@ -1,10 +1,12 @@
 package ante_test
 
 import (
+	"bytes"
 	"math"
 	"testing"
 
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/celestiaorg/celestia-app/v7/app"
 	"github.com/celestiaorg/celestia-app/v7/app/encoding"
@@ -183,7 +185,13 @@ func TestMinGasPFBDecoratorWithMsgExec(t *testing.T) {
 	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
 }
 
-type mockBlobKeeper struct{}
+type mockBlobKeeper struct {
+	namespaceParams []blob.NamespaceParams
+}
+
+func (k mockBlobKeeper) GetNamespaceParams(sdk.Context) []blob.NamespaceParams {
+	return k.namespaceParams
+}
 
 func (mockBlobKeeper) GetParams(sdk.Context) blob.Params {
 	return blob.Params{
@@ -191,3 +199,68 @@ func (mockBlobKeeper) GetParams(sdk.Context) blob.Params {
 		GovMaxSquareSize: testGovMaxSquareSize,
 	}
 }
+
+func TestMinGasPFBDecoratorWithNamespaceParams(t *testing.T) {
+	txConfig := encoding.MakeConfig(app.ModuleEncodingRegisters...).TxConfig
+	subsidized := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	throttled := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+	keeper := mockBlobKeeper{namespaceParams: []blob.NamespaceParams{
+		blob.NewNamespaceParams(subsidized.Bytes()[:20], sdkmath.LegacyNewDecWithPrec(5, 1), 0),
+		blob.NewNamespaceParams(throttled.Bytes(), sdkmath.LegacyNewDec(2), 1000),
+	}}
+	oneShareGas := uint64(share.ShareSize) * uint64(appconsts.GasPerBlobByte)
+
+	testCases := []struct {
+		name      string
+		namespace share.Namespace
+		blobSize  uint32
+		txGas     uint64
+		wantErr   error
+	}{
+		{
+			name:      "discounted namespace",
+			namespace: subsidized,
+			blobSize:  uint32(share.AvailableBytesFromSparseShares(2)),
+			txGas:     oneShareGas,
+		},
+		{
+			name:      "premium namespace",
+			namespace: throttled,
+			blobSize:  uint32(share.AvailableBytesFromSparseShares(1)),
+			txGas:     2 * oneShareGas,
+		},
+		{
+			name:      "premium namespace not enough gas",
+			namespace: throttled,
+			blobSize:  uint32(share.AvailableBytesFromSparseShares(1)),
+			txGas:     2*oneShareGas - 1,
+			wantErr:   sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:      "blob exceeds namespace max blob size",
+			namespace: throttled,
+			blobSize:  1001,
+			txGas:     100 * oneShareGas,
+			wantErr:   blob.ErrBlobTooLargeForNamespace,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := sdk.NewContext(nil, tmproto.Header{Height: 1}, true, log.NewNopLogger()).
+				WithGasMeter(storetypes.NewGasMeter(tc.txGas)).
+				WithIsCheckTx(true)
+			txBuilder := txConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(&blob.MsgPayForBlobs{
+				Namespaces:    [][]byte{tc.namespace.Bytes()},
+				BlobSizes:     []uint32{tc.blobSize},
+				ShareVersions: []uint32{uint32(share.ShareVersionZero)},
+			}))
+			_, err := ante.NewMinGasPFBDecorator(keeper).AnteHandle(ctx, txBuilder.GetTx(), false, mockNext)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
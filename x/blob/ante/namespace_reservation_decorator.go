@@ -0,0 +1,59 @@
+package ante
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/celestiaorg/celestia-app/v7/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// NamespaceReservationDecorator rejects a PFB that publishes blobs to a
+// namespace reserved by another account unless its signer is one of the
+// allowed signers of the reservation.
+type NamespaceReservationDecorator struct {
+	k NamespaceReservationKeeper
+}
+
+func NewNamespaceReservationDecorator(k NamespaceReservationKeeper) NamespaceReservationDecorator {
+	return NamespaceReservationDecorator{k}
+}
+
+// AnteHandle implements the AnteHandler interface. It checks the signer of
+// every MsgPayForBlobs against the reservations of its namespaces. The
+// reservations are read without charging gas to the transaction.
+func (d NamespaceReservationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	gasFreeCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	if err := d.validateMsgs(gasFreeCtx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (d NamespaceReservationDecorator) validateMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, m := range msgs {
+		if execMsg, ok := m.(*authz.MsgExec); ok {
+			// Recursively look for PFBs in nested authz messages.
+			nestedMsgs, err := execMsg.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := d.validateMsgs(ctx, nestedMsgs); err != nil {
+				return err
+			}
+		}
+		if pfb, ok := m.(*types.MsgPayForBlobs); ok {
+			err := types.ValidateBlobSigner(pfb, func(namespace []byte) (types.NamespaceReservation, bool) {
+				return d.k.GetActiveNamespaceReservation(ctx, namespace)
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type NamespaceReservationKeeper interface {
+	GetActiveNamespaceReservation(ctx sdk.Context, namespace []byte) (types.NamespaceReservation, bool)
+}
@@ -0,0 +1,81 @@
+package ante_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v7/app"
+	"github.com/celestiaorg/celestia-app/v7/app/encoding"
+	"github.com/celestiaorg/celestia-app/v7/x/blob/ante"
+	blob "github.com/celestiaorg/celestia-app/v7/x/blob/types"
+	"github.com/celestiaorg/go-square/v3/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamespaceReservationDecorator(t *testing.T) {
+	owner := sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+	allowed := sdk.AccAddress(bytes.Repeat([]byte{2}, 20)).String()
+	other := sdk.AccAddress(bytes.Repeat([]byte{3}, 20)).String()
+	reserved := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	open := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+
+	decorator := ante.NewNamespaceReservationDecorator(mockReservationKeeper{
+		string(reserved.Bytes()): blob.NewNamespaceReservation(reserved.Bytes(), owner, []string{allowed}, blob.DefaultReservationDeposit, time.Unix(100, 0)),
+	})
+	ctx := sdk.Context{}.WithBlockTime(time.Unix(50, 0))
+	txConfig := encoding.MakeConfig(app.ModuleEncodingRegisters...).TxConfig
+
+	pfb := func(signer string, namespaces ...share.Namespace) *blob.MsgPayForBlobs {
+		msg := &blob.MsgPayForBlobs{Signer: signer}
+		for _, ns := range namespaces {
+			msg.Namespaces = append(msg.Namespaces, ns.Bytes())
+		}
+		return msg
+	}
+
+	testCases := []struct {
+		name    string
+		msg     sdk.Msg
+		ctx     sdk.Context
+		wantErr bool
+	}{
+		{"owner", pfb(owner, reserved), ctx, false},
+		{"allowed signer", pfb(allowed, open, reserved), ctx, false},
+		{"other signer to an open namespace", pfb(other, open), ctx, false},
+		{"other signer to a reserved namespace", pfb(other, open, reserved), ctx, true},
+		{"other signer to an expired reservation", pfb(other, reserved), ctx.WithBlockTime(time.Unix(100, 0)), false},
+		{"other signer nested in a MsgExec", ptr(authz.NewMsgExec(sdk.AccAddress{}, []sdk.Msg{pfb(other, reserved)})), ctx, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txBuilder := txConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msg))
+
+			_, err := decorator.AnteHandle(tc.ctx, txBuilder.GetTx(), false, mockNext)
+			if tc.wantErr {
+				require.ErrorIs(t, err, blob.ErrNamespaceSignerNotAllowed)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+// mockReservationKeeper holds the namespace reservations by namespace.
+type mockReservationKeeper map[string]blob.NamespaceReservation
+
+func (k mockReservationKeeper) GetActiveNamespaceReservation(ctx sdk.Context, namespace []byte) (blob.NamespaceReservation, bool) {
+	reservation, ok := k[string(namespace)]
+	if !ok || reservation.IsExpired(ctx.BlockTime()) {
+		return blob.NamespaceReservation{}, false
+	}
+	return reservation, true
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,313 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/celestiaorg/celestia-app/v7/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v7/pkg/user"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+// CmdGeneratePayForBlob returns the command that generates an unsigned
+// PayForBlobs transaction to be signed offline with CmdSignPayForBlob.
+func CmdGeneratePayForBlob() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "generate-pay-for-blob [namespaceID blob]",
+		Example: "celestia-appd tx blob generate-pay-for-blob 0x00010203040506070809 0x48656c6c6f2c20576f726c6421 \\\n" +
+			"\t--chain-id private \\\n" +
+			"\t--from treasury \\\n" +
+			"\t--account-number 12 \\\n" +
+			"\t--sequence 3 \\\n" +
+			"\t--fees 21000utia > unsigned.json\n",
+		Short: "Generate an unsigned PayForBlobs transaction to be signed offline.",
+		Long: `Generate an unsigned PayForBlobs transaction along with its blobs.
+No connection to a node is required: the account number and sequence of the
+signer must be provided with --account-number and --sequence. The public key of
+the --from account must be in the keyring, e.g. added with "keys add --pubkey".
+If --gas is not provided it is estimated with the default gas parameters. If
+--fees is not provided the fee is computed from the gas and --gas-prices, or the
+default min gas price if --gas-prices is not provided either.
+
+The output is a JSON envelope to be signed with sign-pay-for-blob and broadcast
+with broadcast-pay-for-blob. Blobs are read from the arguments or from
+--input-file as for pay-for-blob.
+		`,
+		Args: payForBlobArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if clientCtx.FromName == "" {
+				return fmt.Errorf("the public key of the --%s account must be in the keyring", flags.FlagFrom)
+			}
+
+			blobs, err := getBlobs(cmd, args, clientCtx.FromAddress)
+			if err != nil {
+				return err
+			}
+
+			record, err := clientCtx.Keyring.Key(clientCtx.FromName)
+			if err != nil {
+				return err
+			}
+			pubKey, err := record.GetPubKey()
+			if err != nil {
+				return err
+			}
+			accountNumber, err := cmd.Flags().GetUint64(flags.FlagAccountNumber)
+			if err != nil {
+				return err
+			}
+			sequence, err := cmd.Flags().GetUint64(flags.FlagSequence)
+			if err != nil {
+				return err
+			}
+
+			gasPrice, err := offlineGasPrice(cmd)
+			if err != nil {
+				return err
+			}
+			opts, err := offlineTxOptions(cmd, gasPrice)
+			if err != nil {
+				return err
+			}
+
+			signer, err := user.NewSigner(clientCtx.Keyring, clientCtx.TxConfig, clientCtx.ChainID)
+			if err != nil {
+				return err
+			}
+			ptx, err := signer.NewUnsignedPayForBlobs(user.TxSigner{
+				PubKey:        pubKey,
+				AccountNumber: accountNumber,
+				Sequence:      sequence,
+			}, blobs, gasPrice, opts...)
+			if err != nil {
+				return err
+			}
+
+			return printPartialTx(clientCtx, ptx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.PersistentFlags().Uint8(FlagNamespaceVersion, 0, "Specify the namespace version (default 0)")
+	cmd.PersistentFlags().Uint8(FlagShareVersion, 0, "Specify the share version (default 0)")
+	cmd.PersistentFlags().String(FlagFileInput, "", "Specify the file input")
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+	_ = cmd.MarkFlagRequired(flags.FlagAccountNumber)
+	_ = cmd.MarkFlagRequired(flags.FlagSequence)
+	return cmd
+}
+
+// CmdSignPayForBlob returns the command that signs a transaction generated by
+// CmdGeneratePayForBlob without connecting to a node.
+func CmdSignPayForBlob() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "sign-pay-for-blob [file]",
+		Example: "celestia-appd tx blob sign-pay-for-blob unsigned.json \\\n" +
+			"\t--chain-id private \\\n" +
+			"\t--from treasury \\\n" +
+			"\t--ledger > signed.json\n",
+		Short: "Sign a PayForBlobs transaction generated with generate-pay-for-blob.",
+		Long: `Sign a PayForBlobs transaction generated with generate-pay-for-blob with the
+--from key. No connection to a node is required, so this command can be run on
+an air-gapped machine. Ledger keys are supported. The output is the signed JSON
+envelope to be broadcast with broadcast-pay-for-blob.
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ptx, err := readPartialTx(args[0])
+			if err != nil {
+				return err
+			}
+
+			signer, err := user.NewSigner(clientCtx.Keyring, clientCtx.TxConfig, clientCtx.ChainID, user.NewAccount(clientCtx.FromName, 0, 0))
+			if err != nil {
+				return err
+			}
+			if err := signer.SignPartialTx(ptx, clientCtx.FromName); err != nil {
+				return err
+			}
+
+			return printPartialTx(clientCtx, ptx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+	return cmd
+}
+
+// CmdBroadcastPayForBlob returns the command that validates and broadcasts a
+// transaction signed with CmdSignPayForBlob.
+func CmdBroadcastPayForBlob() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "broadcast-pay-for-blob [file]",
+		Example: "celestia-appd tx blob broadcast-pay-for-blob signed.json \\\n" +
+			"\t--chain-id private \\\n" +
+			"\t--node tcp://localhost:26657\n",
+		Short: "Broadcast a PayForBlobs transaction signed with sign-pay-for-blob.",
+		Long: `Broadcast a PayForBlobs transaction signed with sign-pay-for-blob. The
+transaction is validated before being sent to the node.
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ptx, err := readPartialTx(args[0])
+			if err != nil {
+				return err
+			}
+
+			// no keys are needed to finalize a signed transaction.
+			signer, err := user.NewSigner(keyring.NewInMemory(clientCtx.Codec), clientCtx.TxConfig, clientCtx.ChainID)
+			if err != nil {
+				return err
+			}
+			txBytes, err := signer.FinalizePartialTx(ptx)
+			if err != nil {
+				return err
+			}
+			if _, err := signer.ValidateSignedTx(txBytes); err != nil {
+				return err
+			}
+
+			res, err := clientCtx.BroadcastTx(txBytes)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// offlineGasPrice returns the gas price set by the gas prices flag or the
+// default min gas price if it is not set.
+func offlineGasPrice(cmd *cobra.Command) (float64, error) {
+	gasPricesStr, err := cmd.Flags().GetString(flags.FlagGasPrices)
+	if err != nil {
+		return 0, err
+	}
+	if gasPricesStr == "" {
+		return appconsts.DefaultMinGasPrice, nil
+	}
+	gasPrices, err := sdk.ParseDecCoins(gasPricesStr)
+	if err != nil {
+		return 0, err
+	}
+	if len(gasPrices) != 1 || gasPrices[0].Denom != appconsts.BondDenom {
+		return 0, fmt.Errorf("gas prices must be in %s only, got %s", appconsts.BondDenom, gasPrices)
+	}
+	return gasPrices[0].Amount.Float64()
+}
+
+// offlineTxOptions returns the tx options set by the gas, fee, memo, timeout
+// height and fee granter flags. A gas limit set with the gas flag is paid for
+// at gasPrice unless the fee flag is set.
+func offlineTxOptions(cmd *cobra.Command, gasPrice float64) ([]user.TxOption, error) {
+	var opts []user.TxOption
+
+	if cmd.Flags().Changed(flags.FlagGas) {
+		gasStr, err := cmd.Flags().GetString(flags.FlagGas)
+		if err != nil {
+			return nil, err
+		}
+		gasSetting, err := flags.ParseGasSetting(gasStr)
+		if err != nil {
+			return nil, err
+		}
+		if gasSetting.Simulate {
+			return nil, errors.New("gas cannot be simulated offline")
+		}
+		opts = append(opts, user.SetGasLimitAndGasPrice(gasSetting.Gas, gasPrice))
+	}
+
+	feesStr, err := cmd.Flags().GetString(flags.FlagFees)
+	if err != nil {
+		return nil, err
+	}
+	if feesStr != "" {
+		if cmd.Flags().Changed(flags.FlagGasPrices) {
+			return nil, errors.New("cannot provide both fees and gas prices")
+		}
+		fees, err := sdk.ParseCoinsNormalized(feesStr)
+		if err != nil {
+			return nil, err
+		}
+		if len(fees) != 1 || fees[0].Denom != appconsts.BondDenom {
+			return nil, fmt.Errorf("fees must be paid in %s only, got %s", appconsts.BondDenom, fees)
+		}
+		opts = append(opts, user.SetFee(fees[0].Amount.Uint64()))
+	}
+
+	memo, err := cmd.Flags().GetString(flags.FlagNote)
+	if err != nil {
+		return nil, err
+	}
+	if memo != "" {
+		opts = append(opts, user.SetMemo(memo))
+	}
+
+	timeoutHeight, err := cmd.Flags().GetUint64(flags.FlagTimeoutHeight)
+	if err != nil {
+		return nil, err
+	}
+	if timeoutHeight != 0 {
+		opts = append(opts, user.SetTimeoutHeight(timeoutHeight))
+	}
+
+	feeGranter, err := cmd.Flags().GetString(flags.FlagFeeGranter)
+	if err != nil {
+		return nil, err
+	}
+	if feeGranter != "" {
+		granter, err := sdk.AccAddressFromBech32(feeGranter)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, user.SetFeeGranter(granter))
+	}
+
+	return opts, nil
+}
+
+func readPartialTx(path string) (*user.PartialTx, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var ptx user.PartialTx
+	if err := json.Unmarshal(content, &ptx); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return &ptx, nil
+}
+
+func printPartialTx(clientCtx client.Context, ptx *user.PartialTx) error {
+	bz, err := json.Marshal(ptx)
+	if err != nil {
+		return err
+	}
+	return clientCtx.PrintBytes(bz)
+}
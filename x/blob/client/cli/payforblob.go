@@ -78,73 +78,18 @@ The namespaceID must be a hex encoded string of 10 bytes.
 The blob must be a hex encoded string of non-zero length.
 		`,
 		Aliases: []string{"pay-for-blobs", "PayForBlobs", "PayForBlob"},
-		Args: func(cmd *cobra.Command, args []string) error {
-			path, err := cmd.Flags().GetString(FlagFileInput)
-			if err != nil {
-				return err
-			}
-
-			if path != "" {
-				if filepath.Ext(path) != FileInputExtension {
-					return fmt.Errorf("invalid file extension %v. The only supported extension is %s", filepath.Ext(path), FileInputExtension)
-				}
-
-				return nil
-			}
-
-			if len(args) < 2 {
-				return fmt.Errorf("pay-for-blob requires two arguments if %s isn't provided: namespaceID and blob", FlagFileInput)
-			}
-
-			return nil
-		},
+		Args:    payForBlobArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			namespaceVersion, err := cmd.Flags().GetUint8(FlagNamespaceVersion)
-			if err != nil {
-				return err
-			}
-
-			shareVersion, err := cmd.Flags().GetUint8(FlagShareVersion)
-			if err != nil {
-				return err
-			}
-
-			path, err := cmd.Flags().GetString(FlagFileInput)
-			if err != nil {
-				return err
-			}
-
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			signer := clientCtx.FromAddress
-
-			// In case of no file input, get the namespaceID and blob from the arguments
-			if path == "" {
-				blob, err := getBlobFromArguments(args[0], args[1], namespaceVersion, shareVersion, signer)
-				if err != nil {
-					return err
-				}
-
-				return broadcastPFB(cmd, blob)
-			}
-
-			parsedBlobs, err := parseSubmitBlobs(path)
+			blobs, err := getBlobs(cmd, args, clientCtx.FromAddress)
 			if err != nil {
 				return err
 			}
 
-			var blobs []*share.Blob
-			for _, parsedBlob := range parsedBlobs {
-				blob, err := getBlobFromArguments(parsedBlob.NamespaceID, parsedBlob.Blob, namespaceVersion, shareVersion, signer)
-				if err != nil {
-					return err
-				}
-				blobs = append(blobs, blob)
-			}
-
 			return broadcastPFB(cmd, blobs...)
 		},
 	}
@@ -157,6 +102,74 @@ The blob must be a hex encoded string of non-zero length.
 	return cmd
 }
 
+// payForBlobArgs validates the arguments of the commands that read blobs
+// either from the arguments or from FlagFileInput.
+func payForBlobArgs(cmd *cobra.Command, args []string) error {
+	path, err := cmd.Flags().GetString(FlagFileInput)
+	if err != nil {
+		return err
+	}
+
+	if path != "" {
+		if filepath.Ext(path) != FileInputExtension {
+			return fmt.Errorf("invalid file extension %v. The only supported extension is %s", filepath.Ext(path), FileInputExtension)
+		}
+
+		return nil
+	}
+
+	if len(args) < 2 {
+		return fmt.Errorf("%s requires two arguments if %s isn't provided: namespaceID and blob", cmd.Name(), FlagFileInput)
+	}
+
+	return nil
+}
+
+// getBlobs returns the blobs specified either by the arguments or by the file
+// provided with FlagFileInput.
+func getBlobs(cmd *cobra.Command, args []string, signer sdk.AccAddress) ([]*share.Blob, error) {
+	namespaceVersion, err := cmd.Flags().GetUint8(FlagNamespaceVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	shareVersion, err := cmd.Flags().GetUint8(FlagShareVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := cmd.Flags().GetString(FlagFileInput)
+	if err != nil {
+		return nil, err
+	}
+
+	// In case of no file input, get the namespaceID and blob from the arguments
+	if path == "" {
+		blob, err := getBlobFromArguments(args[0], args[1], namespaceVersion, shareVersion, signer)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*share.Blob{blob}, nil
+	}
+
+	parsedBlobs, err := parseSubmitBlobs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var blobs []*share.Blob
+	for _, parsedBlob := range parsedBlobs {
+		blob, err := getBlobFromArguments(parsedBlob.NamespaceID, parsedBlob.Blob, namespaceVersion, shareVersion, signer)
+		if err != nil {
+			return nil, err
+		}
+		blobs = append(blobs, blob)
+	}
+
+	return blobs, nil
+}
+
 func getBlobFromArguments(namespaceIDArg, blobArg string, namespaceVersion, shareVersion uint8, signer sdk.AccAddress) (*share.Blob, error) {
 	namespaceID, err := hex.DecodeString(strings.TrimPrefix(namespaceIDArg, "0x"))
 	if err != nil {
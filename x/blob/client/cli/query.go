@@ -19,7 +19,12 @@ func GetQueryCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(
+		CmdQueryParams(),
+		CmdQueryNamespaceParams(),
+		CmdQueryReservationParams(),
+		CmdQueryNamespaceReservation(),
+	)
 
 	return cmd
 }
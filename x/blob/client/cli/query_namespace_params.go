@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/celestiaorg/celestia-app/v7/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryNamespaceParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "namespace-params [hex encoded full namespace]",
+		Short: "shows the per namespace blob parameters, or the parameters that apply to a namespace",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 0 {
+				res, err := queryClient.NamespaceParams(context.Background(), &types.QueryNamespaceParamsRequest{})
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+
+			namespace, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.BlobNamespaceParams(context.Background(), &types.QueryBlobNamespaceParamsRequest{Namespace: namespace})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/celestiaorg/celestia-app/v7/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryNamespaceReservation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "namespace-reservation [hex encoded full namespace]",
+		Short: "shows the reservation of a namespace, or all namespace reservations",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 0 {
+				pageReq, err := client.ReadPageRequest(cmd.Flags())
+				if err != nil {
+					return err
+				}
+				res, err := queryClient.NamespaceReservations(context.Background(), &types.QueryNamespaceReservationsRequest{Pagination: pageReq})
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+
+			namespace, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.NamespaceReservation(context.Background(), &types.QueryNamespaceReservationRequest{Namespace: namespace})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "namespace-reservation")
+
+	return cmd
+}
+
+func CmdQueryReservationParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reservation-params",
+		Short: "shows the deposit and duration of namespace reservations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ReservationParams(context.Background(), &types.QueryReservationParamsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
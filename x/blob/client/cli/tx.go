@@ -18,7 +18,17 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(CmdPayForBlob())
+	cmd.AddCommand(
+		CmdPayForBlob(),
+		CmdGeneratePayForBlob(),
+		CmdSignPayForBlob(),
+		CmdBroadcastPayForBlob(),
+		CmdReserveNamespace(),
+		CmdSetNamespaceSigners(),
+		CmdTransferNamespace(),
+		CmdRenewNamespace(),
+		CmdReleaseNamespace(),
+	)
 
 	return cmd
 }
@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"encoding/hex"
+
+	"github.com/celestiaorg/celestia-app/v7/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+// CmdReserveNamespace returns a command that reserves a namespace for the
+// signer.
+func CmdReserveNamespace() *cobra.Command {
+	return newNamespaceReservationTxCmd(
+		"reserve-namespace [hex encoded full namespace] [allowed signer]...",
+		"reserve a namespace so that only the signer and the allowed signers can publish blobs to it. The reservation deposit is taken from the signer.",
+		cobra.MinimumNArgs(1),
+		func(owner string, namespace []byte, args []string) sdk.Msg {
+			return types.NewMsgReserveNamespace(owner, namespace, args)
+		},
+	)
+}
+
+// CmdSetNamespaceSigners returns a command that replaces the allowed signers
+// of a reserved namespace.
+func CmdSetNamespaceSigners() *cobra.Command {
+	return newNamespaceReservationTxCmd(
+		"set-namespace-signers [hex encoded full namespace] [allowed signer]...",
+		"replace the allowed signers of a namespace reserved by the signer",
+		cobra.MinimumNArgs(1),
+		func(owner string, namespace []byte, args []string) sdk.Msg {
+			return types.NewMsgSetNamespaceSigners(owner, namespace, args)
+		},
+	)
+}
+
+// CmdTransferNamespace returns a command that transfers the ownership of a
+// reserved namespace.
+func CmdTransferNamespace() *cobra.Command {
+	return newNamespaceReservationTxCmd(
+		"transfer-namespace [hex encoded full namespace] [new owner]",
+		"transfer the ownership of a namespace reserved by the signer, and with it the claim on the deposit",
+		cobra.ExactArgs(2),
+		func(owner string, namespace []byte, args []string) sdk.Msg {
+			return types.NewMsgTransferNamespace(owner, namespace, args[0])
+		},
+	)
+}
+
+// CmdRenewNamespace returns a command that renews a namespace reservation.
+func CmdRenewNamespace() *cobra.Command {
+	return newNamespaceReservationTxCmd(
+		"renew-namespace [hex encoded full namespace]",
+		"extend the reservation of a namespace reserved by the signer",
+		cobra.ExactArgs(1),
+		func(owner string, namespace []byte, _ []string) sdk.Msg {
+			return types.NewMsgRenewNamespace(owner, namespace)
+		},
+	)
+}
+
+// CmdReleaseNamespace returns a command that releases a namespace reservation.
+func CmdReleaseNamespace() *cobra.Command {
+	return newNamespaceReservationTxCmd(
+		"release-namespace [hex encoded full namespace]",
+		"release a namespace reserved by the signer and refund the deposit",
+		cobra.ExactArgs(1),
+		func(owner string, namespace []byte, _ []string) sdk.Msg {
+			return types.NewMsgReleaseNamespace(owner, namespace)
+		},
+	)
+}
+
+// newNamespaceReservationTxCmd returns a command whose first argument is the
+// namespace. newMsg is passed the remaining arguments.
+func newNamespaceReservationTxCmd(use, short string, args cobra.PositionalArgs, newMsg func(owner string, namespace []byte, args []string) sdk.Msg) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  args,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			namespace, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), newMsg(clientCtx.GetFromAddress().String(), namespace, args[1:]))
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
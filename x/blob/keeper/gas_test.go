@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"bytes"
 	"testing"
 
 	"cosmossdk.io/log"
@@ -51,6 +52,7 @@ func TestPayForBlobGas(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			k, stateStore, _ := CreateKeeper(t, appconsts.Version)
 			ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+			tc.msg.Signer = sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
 			_, err := k.PayForBlobs(ctx, &tc.msg)
 			require.NoError(t, err)
 			if tc.wantGasConsumed != ctx.GasMeter().GasConsumed() {
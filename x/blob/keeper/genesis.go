@@ -14,7 +14,27 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 	if err := genState.Params.Validate(); err != nil {
 		return fmt.Errorf("invalid blob genesis state parameters: %w", err)
 	}
+	if err := types.ValidateNamespaceParams(genState.NamespaceParams); err != nil {
+		return fmt.Errorf("invalid blob genesis state namespace parameters: %w", err)
+	}
+	if err := types.ValidateNamespaceReservations(genState.NamespaceReservations); err != nil {
+		return fmt.Errorf("invalid blob genesis state namespace reservations: %w", err)
+	}
+	reservationParams := genState.ReservationParams
+	if reservationParams.IsEmpty() {
+		reservationParams = types.DefaultReservationParams()
+	}
+	if err := reservationParams.Validate(); err != nil {
+		return fmt.Errorf("invalid blob genesis state reservation parameters: %w", err)
+	}
 	k.SetParams(sdkCtx, genState.Params)
+	k.SetNamespaceParams(sdkCtx, genState.NamespaceParams)
+	k.SetReservationParams(sdkCtx, reservationParams)
+	// the deposits of the reservations are expected to be held by the blob
+	// module account, as exported with the bank genesis state.
+	for _, reservation := range genState.NamespaceReservations {
+		k.SetNamespaceReservation(sdkCtx, reservation)
+	}
 	return nil
 }
 
@@ -23,5 +43,8 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(sdkCtx)
+	genesis.NamespaceParams = k.GetNamespaceParams(sdkCtx)
+	genesis.ReservationParams = k.GetReservationParams(sdkCtx)
+	genesis.NamespaceReservations = k.GetNamespaceReservations(sdkCtx)
 	return genesis
 }
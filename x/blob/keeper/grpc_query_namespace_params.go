@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/v7/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) NamespaceParams(c context.Context, req *types.QueryNamespaceParamsRequest) (*types.QueryNamespaceParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryNamespaceParamsResponse{NamespaceParams: k.GetNamespaceParams(ctx)}, nil
+}
+
+func (k Keeper) BlobNamespaceParams(c context.Context, req *types.QueryBlobNamespaceParamsRequest) (*types.QueryBlobNamespaceParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(req.Namespace) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "namespace cannot be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	namespaceParams, found := types.LookupNamespaceParams(k.GetNamespaceParams(ctx), req.Namespace)
+	return &types.QueryBlobNamespaceParamsResponse{Found: found, NamespaceParams: namespaceParams}, nil
+}
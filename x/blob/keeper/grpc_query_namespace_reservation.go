@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/celestiaorg/celestia-app/v7/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ReservationParams(c context.Context, req *types.QueryReservationParamsRequest) (*types.QueryReservationParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryReservationParamsResponse{ReservationParams: k.GetReservationParams(ctx)}, nil
+}
+
+func (k Keeper) NamespaceReservation(c context.Context, req *types.QueryNamespaceReservationRequest) (*types.QueryNamespaceReservationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(req.Namespace) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "namespace cannot be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	reservation, found := k.GetNamespaceReservation(ctx, req.Namespace)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "namespace %X is not reserved", req.Namespace)
+	}
+	return &types.QueryNamespaceReservationResponse{
+		Reservation: reservation,
+		Expired:     reservation.IsExpired(ctx.BlockTime()),
+	}, nil
+}
+
+func (k Keeper) NamespaceReservations(c context.Context, req *types.QueryNamespaceReservationsRequest) (*types.QueryNamespaceReservationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NamespaceReservationKeyPrefix))
+	var reservations []types.NamespaceReservation
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var reservation types.NamespaceReservation
+		if err := k.cdc.Unmarshal(value, &reservation); err != nil {
+			return err
+		}
+		reservations = append(reservations, reservation)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryNamespaceReservationsResponse{Reservations: reservations, Pagination: pageRes}, nil
+}
@@ -22,6 +22,7 @@ type Keeper struct {
 	cdc            codec.Codec
 	storeKey       storetypes.StoreKey
 	legacySubspace paramtypes.Subspace
+	bankKeeper     types.BankKeeper
 	authority      string
 }
 
@@ -29,6 +30,7 @@ func NewKeeper(
 	cdc codec.Codec,
 	storeKey storetypes.StoreKey,
 	legacySubspace paramtypes.Subspace,
+	bankKeeper types.BankKeeper,
 	authority string,
 ) *Keeper {
 	if !legacySubspace.HasKeyTable() {
@@ -39,6 +41,7 @@ func NewKeeper(
 		cdc:            cdc,
 		storeKey:       storeKey,
 		legacySubspace: legacySubspace,
+		bankKeeper:     bankKeeper,
 		authority:      authority,
 	}
 }
@@ -51,10 +54,25 @@ func (k Keeper) GetAuthority() string {
 // PayForBlobs consumes gas based on the blob sizes in the MsgPayForBlobs.
 func (k Keeper) PayForBlobs(goCtx context.Context, msg *types.MsgPayForBlobs) (*types.MsgPayForBlobsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	gasToConsume := types.GasToConsume(msg, appconsts.GasPerBlobByte)
+	// the namespace params and reservations are read without charging gas so
+	// that the gas consumed matches the gas checked by the MinGasPFBDecorator.
+	gasFreeCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	if err := k.ValidateBlobSigner(gasFreeCtx, msg); err != nil {
+		return nil, err
+	}
+	namespaceParams := k.GetNamespaceParams(gasFreeCtx)
+	if err := types.ValidateBlobSizesForNamespaces(msg, namespaceParams); err != nil {
+		return nil, err
+	}
+	gasToConsume := types.GasToConsumeWithNamespaceParams(msg, appconsts.GasPerBlobByte, namespaceParams)
 
 	ctx.GasMeter().ConsumeGas(gasToConsume, payForBlobGasDescriptor)
 
+	// for the same reason the namespace usage is recorded without charging gas.
+	if err := k.recordNamespaceUsage(gasFreeCtx, msg); err != nil {
+		return nil, err
+	}
+
 	if err := ctx.EventManager().EmitTypedEvent(
 		types.NewPayForBlobsEvent(msg.Signer, msg.BlobSizes, msg.Namespaces),
 	); err != nil {
@@ -88,3 +106,27 @@ func (k Keeper) UpdateBlobParams(goCtx context.Context, msg *types.MsgUpdateBlob
 
 	return &types.MsgUpdateBlobParamsResponse{}, nil
 }
+
+// UpdateNamespaceParams replaces the per namespace blob parameters.
+func (k Keeper) UpdateNamespaceParams(goCtx context.Context, msg *types.MsgUpdateNamespaceParams) (*types.MsgUpdateNamespaceParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// ensure that the sender has the authority to update the parameters.
+	if msg.Authority != k.GetAuthority() {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority: expected: %s, got: %s", k.authority, msg.Authority)
+	}
+
+	if err := types.ValidateNamespaceParams(msg.NamespaceParams); err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid namespace parameters: %s", err)
+	}
+
+	k.SetNamespaceParams(ctx, msg.NamespaceParams)
+
+	if err := ctx.EventManager().EmitTypedEvent(
+		types.NewUpdateNamespaceParamsEvent(msg.Authority, msg.NamespaceParams),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateNamespaceParamsResponse{}, nil
+}
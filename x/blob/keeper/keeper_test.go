@@ -76,6 +76,10 @@ func createMsgPayForBlob(t *testing.T, signer string, namespace share.Namespace,
 }
 
 func CreateKeeper(t *testing.T, version uint64) (*keeper.Keeper, store.CommitMultiStore, sdk.Context) {
+	return createKeeperWithBankKeeper(t, version, nil)
+}
+
+func createKeeperWithBankKeeper(t *testing.T, version uint64, bankKeeper types.BankKeeper) (*keeper.Keeper, store.CommitMultiStore, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(paramtypes.StoreKey)
 	blobStoreKey := storetypes.NewKVStoreKey(types.StoreKey)
 	tStoreKey := storetypes.NewTransientStoreKey(paramtypes.TStoreKey)
@@ -106,6 +110,7 @@ func CreateKeeper(t *testing.T, version uint64) (*keeper.Keeper, store.CommitMul
 		cdc,
 		blobStoreKey,
 		paramsSubspace,
+		bankKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
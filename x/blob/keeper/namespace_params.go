@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	"github.com/celestiaorg/celestia-app/v7/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetNamespaceParams returns the per namespace blob parameters ordered by
+// namespace prefix.
+func (k Keeper) GetNamespaceParams(ctx sdk.Context) []types.NamespaceParams {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NamespaceParamsKeyPrefix))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var namespaceParams []types.NamespaceParams
+	for ; iterator.Valid(); iterator.Next() {
+		var p types.NamespaceParams
+		k.cdc.MustUnmarshal(iterator.Value(), &p)
+		namespaceParams = append(namespaceParams, p)
+	}
+	return namespaceParams
+}
+
+// SetNamespaceParams replaces the per namespace blob parameters.
+func (k Keeper) SetNamespaceParams(ctx sdk.Context, namespaceParams []types.NamespaceParams) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NamespaceParamsKeyPrefix))
+
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for _, p := range namespaceParams {
+		store.Set(p.NamespacePrefix, k.cdc.MustMarshal(&p))
+	}
+}
@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/celestiaorg/celestia-app/v7/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v7/x/blob/types"
+	"github.com/celestiaorg/go-square/v3/share"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamespaceParams(t *testing.T) {
+	k, _, ctx := CreateKeeper(t, appconsts.Version)
+	signer := "celestia15drmhzw5kwgenvemy30rqqqgq52axf5wwrruf7"
+	subsidized := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	throttled := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+	namespaceParams := []types.NamespaceParams{
+		types.NewNamespaceParams(subsidized.Bytes()[:20], math.LegacyNewDecWithPrec(5, 1), 0),
+		types.NewNamespaceParams(throttled.Bytes()[:20], math.LegacyNewDec(2), 0),
+		types.NewNamespaceParams(throttled.Bytes(), math.LegacyNewDec(4), 100),
+	}
+
+	_, err := k.UpdateNamespaceParams(ctx, types.NewMsgUpdateNamespaceParams(signer, namespaceParams))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	invalid := append([]types.NamespaceParams{}, namespaceParams[0], namespaceParams[0])
+	_, err = k.UpdateNamespaceParams(ctx, types.NewMsgUpdateNamespaceParams(k.GetAuthority(), invalid))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = k.UpdateNamespaceParams(ctx, types.NewMsgUpdateNamespaceParams(k.GetAuthority(), namespaceParams))
+	require.NoError(t, err)
+	require.Equal(t, namespaceParams, k.GetNamespaceParams(ctx))
+
+	t.Run("longest prefix applies", func(t *testing.T) {
+		resp, err := k.BlobNamespaceParams(ctx, &types.QueryBlobNamespaceParamsRequest{Namespace: throttled.Bytes()})
+		require.NoError(t, err)
+		require.True(t, resp.Found)
+		require.Equal(t, namespaceParams[2], resp.NamespaceParams)
+
+		other := share.MustNewV0Namespace(append(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize-1), 3))
+		resp, err = k.BlobNamespaceParams(ctx, &types.QueryBlobNamespaceParamsRequest{Namespace: other.Bytes()})
+		require.NoError(t, err)
+		require.True(t, resp.Found)
+		require.Equal(t, namespaceParams[1], resp.NamespaceParams)
+
+		resp, err = k.BlobNamespaceParams(ctx, &types.QueryBlobNamespaceParamsRequest{Namespace: share.RandomBlobNamespace().Bytes()})
+		require.NoError(t, err)
+		require.False(t, resp.Found)
+	})
+
+	t.Run("pay for blobs", func(t *testing.T) {
+		for _, tc := range []struct {
+			namespace  share.Namespace
+			multiplier math.LegacyDec
+		}{
+			{subsidized, math.LegacyNewDecWithPrec(5, 1)},
+			{throttled, math.LegacyNewDec(4)},
+		} {
+			msg := createMsgPayForBlob(t, signer, tc.namespace, []byte("blob"))
+			gasCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			_, err := k.PayForBlobs(gasCtx, msg)
+			require.NoError(t, err)
+			want := tc.multiplier.MulInt64(int64(types.GasToConsume(msg, appconsts.GasPerBlobByte))).Ceil().TruncateInt().Uint64()
+			require.Equal(t, want, gasCtx.GasMeter().GasConsumed())
+		}
+
+		msg := createMsgPayForBlob(t, signer, throttled, bytes.Repeat([]byte{1}, 101))
+		_, err := k.PayForBlobs(ctx, msg)
+		require.ErrorIs(t, err, types.ErrBlobTooLargeForNamespace)
+	})
+
+	t.Run("genesis", func(t *testing.T) {
+		genesis := k.ExportGenesis(ctx)
+		require.Equal(t, namespaceParams, genesis.NamespaceParams)
+
+		k2, _, ctx2 := CreateKeeper(t, appconsts.Version)
+		require.NoError(t, k2.InitGenesis(ctx2, *genesis))
+		resp, err := k2.NamespaceParams(ctx2, &types.QueryNamespaceParamsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, namespaceParams, resp.NamespaceParams)
+	})
+
+	t.Run("replace", func(t *testing.T) {
+		_, err := k.UpdateNamespaceParams(ctx, types.NewMsgUpdateNamespaceParams(k.GetAuthority(), namespaceParams[:1]))
+		require.NoError(t, err)
+		require.Equal(t, namespaceParams[:1], k.GetNamespaceParams(ctx))
+	})
+}
@@ -0,0 +1,353 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/celestiaorg/celestia-app/v7/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+)
+
+// GetReservationParams returns the namespace reservation parameters. It
+// returns the default parameters if none were set.
+func (k Keeper) GetReservationParams(ctx sdk.Context) types.ReservationParams {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyPrefix(types.ReservationParamsKey))
+	if bz == nil {
+		return types.DefaultReservationParams()
+	}
+	var params types.ReservationParams
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetReservationParams sets the namespace reservation parameters.
+func (k Keeper) SetReservationParams(ctx sdk.Context, params types.ReservationParams) {
+	ctx.KVStore(k.storeKey).Set(types.KeyPrefix(types.ReservationParamsKey), k.cdc.MustMarshal(&params))
+}
+
+// GetNamespaceReservation returns the reservation of namespace, expired or
+// not. It returns false if the namespace is not reserved.
+func (k Keeper) GetNamespaceReservation(ctx sdk.Context, namespace []byte) (types.NamespaceReservation, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NamespaceReservationKeyPrefix))
+	bz := store.Get(namespace)
+	if bz == nil {
+		return types.NamespaceReservation{}, false
+	}
+	var reservation types.NamespaceReservation
+	k.cdc.MustUnmarshal(bz, &reservation)
+	return reservation, true
+}
+
+// GetActiveNamespaceReservation returns the reservation of namespace if it has
+// not expired at the current block time.
+func (k Keeper) GetActiveNamespaceReservation(ctx sdk.Context, namespace []byte) (types.NamespaceReservation, bool) {
+	reservation, found := k.GetNamespaceReservation(ctx, namespace)
+	if !found || reservation.IsExpired(ctx.BlockTime()) {
+		return types.NamespaceReservation{}, false
+	}
+	return reservation, true
+}
+
+// GetNamespaceReservations returns all the namespace reservations ordered by
+// namespace.
+func (k Keeper) GetNamespaceReservations(ctx sdk.Context) []types.NamespaceReservation {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NamespaceReservationKeyPrefix))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var reservations []types.NamespaceReservation
+	for ; iterator.Valid(); iterator.Next() {
+		var reservation types.NamespaceReservation
+		k.cdc.MustUnmarshal(iterator.Value(), &reservation)
+		reservations = append(reservations, reservation)
+	}
+	return reservations
+}
+
+// SetNamespaceReservation stores a namespace reservation.
+func (k Keeper) SetNamespaceReservation(ctx sdk.Context, reservation types.NamespaceReservation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NamespaceReservationKeyPrefix))
+	store.Set(reservation.Namespace, k.cdc.MustMarshal(&reservation))
+}
+
+func (k Keeper) deleteNamespaceReservation(ctx sdk.Context, namespace []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NamespaceReservationKeyPrefix))
+	store.Delete(namespace)
+}
+
+func (k Keeper) namespaceUsageStore(ctx sdk.Context, namespace []byte) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefix(types.NamespaceUsageKeyPrefix), namespace...))
+}
+
+// recordNamespaceUsage stores the current block time as the last time the
+// signer of msg published blobs to each of its namespaces.
+func (k Keeper) recordNamespaceUsage(ctx sdk.Context, msg *types.MsgPayForBlobs) error {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address: %s", err)
+	}
+	usedAt, err := gogotypes.TimestampProto(ctx.BlockTime())
+	if err != nil {
+		return err
+	}
+	bz := k.cdc.MustMarshal(usedAt)
+	for _, namespace := range msg.Namespaces {
+		k.namespaceUsageStore(ctx, namespace).Set(signer, bz)
+	}
+	return nil
+}
+
+// validateNamespaceUsage returns an error if an account that may not publish
+// blobs to the namespace under reservation did so within the grace period.
+// Usage older than the grace period is deleted.
+func (k Keeper) validateNamespaceUsage(ctx sdk.Context, reservation types.NamespaceReservation, gracePeriod time.Duration) error {
+	store := k.namespaceUsageStore(ctx, reservation.Namespace)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var expired [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var ts gogotypes.Timestamp
+		k.cdc.MustUnmarshal(iterator.Value(), &ts)
+		usedAt, err := gogotypes.TimestampFromProto(&ts)
+		if err != nil {
+			return err
+		}
+		if !ctx.BlockTime().Before(usedAt.Add(gracePeriod)) {
+			expired = append(expired, append([]byte(nil), iterator.Key()...))
+			continue
+		}
+		signer := sdk.AccAddress(iterator.Key()).String()
+		if !reservation.IsAllowedSigner(signer) {
+			return types.ErrNamespaceRecentlyUsed.Wrapf("%s published blobs to namespace %X at %s", signer, reservation.Namespace, usedAt)
+		}
+	}
+
+	for _, key := range expired {
+		store.Delete(key)
+	}
+	return nil
+}
+
+// ValidateBlobSigner returns an error if the signer of msg may not publish
+// blobs to one of its namespaces because another account reserved it.
+func (k Keeper) ValidateBlobSigner(ctx sdk.Context, msg *types.MsgPayForBlobs) error {
+	return types.ValidateBlobSigner(msg, func(namespace []byte) (types.NamespaceReservation, bool) {
+		return k.GetActiveNamespaceReservation(ctx, namespace)
+	})
+}
+
+// UpdateReservationParams updates the namespace reservation parameters. Only
+// reservations made or renewed afterwards are affected.
+func (k Keeper) UpdateReservationParams(goCtx context.Context, msg *types.MsgUpdateReservationParams) (*types.MsgUpdateReservationParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// ensure that the sender has the authority to update the parameters.
+	if msg.Authority != k.GetAuthority() {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority: expected: %s, got: %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.ReservationParams.Validate(); err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid reservation parameters: %s", err)
+	}
+
+	k.SetReservationParams(ctx, msg.ReservationParams)
+
+	if err := ctx.EventManager().EmitTypedEvent(
+		types.NewUpdateReservationParamsEvent(msg.Authority, msg.ReservationParams),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateReservationParamsResponse{}, nil
+}
+
+// ReserveNamespace reserves a namespace for the owner in exchange for the
+// reservation deposit. A namespace whose reservation expired can be reserved by
+// any account, in which case the deposit of the previous owner is refunded.
+// A namespace can't be reserved if an account other than the owner and the
+// allowed signers published blobs to it within the usage grace period, so that
+// a namespace in use can't be taken from the rollup using it.
+func (k Keeper) ReserveNamespace(goCtx context.Context, msg *types.MsgReserveNamespace) (*types.MsgReserveNamespaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+
+	existing, found := k.GetNamespaceReservation(ctx, msg.Namespace)
+	if found && !existing.IsExpired(ctx.BlockTime()) {
+		return nil, types.ErrNamespaceAlreadyReserved.Wrapf("namespace %X is reserved by %s until %s", msg.Namespace, existing.Owner, existing.Expiry)
+	}
+
+	params := k.GetReservationParams(ctx)
+	reservation := types.NewNamespaceReservation(msg.Namespace, msg.Owner, msg.AllowedSigners, params.Deposit, ctx.BlockTime().Add(params.Duration))
+	if err := k.validateNamespaceUsage(ctx, reservation, params.UsageGracePeriod); err != nil {
+		return nil, err
+	}
+
+	if found {
+		if err := k.releaseNamespace(ctx, existing); err != nil {
+			return nil, err
+		}
+	}
+
+	if !params.Deposit.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.NewCoins(params.Deposit)); err != nil {
+			return nil, err
+		}
+	}
+
+	k.SetNamespaceReservation(ctx, reservation)
+
+	if err := ctx.EventManager().EmitTypedEvent(types.NewReserveNamespaceEvent(reservation)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReserveNamespaceResponse{Reservation: reservation}, nil
+}
+
+// SetNamespaceSigners replaces the allowed signers of a reserved namespace.
+func (k Keeper) SetNamespaceSigners(goCtx context.Context, msg *types.MsgSetNamespaceSigners) (*types.MsgSetNamespaceSignersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	reservation, err := k.getOwnedNamespaceReservation(ctx, msg.Owner, msg.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	reservation.AllowedSigners = msg.AllowedSigners
+	k.SetNamespaceReservation(ctx, reservation)
+
+	if err := ctx.EventManager().EmitTypedEvent(
+		types.NewSetNamespaceSignersEvent(msg.Namespace, msg.Owner, msg.AllowedSigners),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetNamespaceSignersResponse{}, nil
+}
+
+// TransferNamespace transfers the ownership of a reserved namespace, and with
+// it the claim on the deposit. The allowed signers are kept.
+func (k Keeper) TransferNamespace(goCtx context.Context, msg *types.MsgTransferNamespace) (*types.MsgTransferNamespaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	reservation, err := k.getOwnedNamespaceReservation(ctx, msg.Owner, msg.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	reservation.Owner = msg.NewOwner
+	k.SetNamespaceReservation(ctx, reservation)
+
+	if err := ctx.EventManager().EmitTypedEvent(
+		types.NewTransferNamespaceEvent(msg.Namespace, msg.Owner, msg.NewOwner),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferNamespaceResponse{}, nil
+}
+
+// RenewNamespace extends a namespace reservation by the reservation duration,
+// counted from its expiry or, if it already expired, from the current block
+// time. The owner pays the renewal fee to the fee collector, such that holding
+// a namespace has an ongoing cost. An expired reservation can only be renewed
+// if it could be reserved again, see ReserveNamespace.
+func (k Keeper) RenewNamespace(goCtx context.Context, msg *types.MsgRenewNamespace) (*types.MsgRenewNamespaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	reservation, err := k.getOwnedNamespaceReservation(ctx, msg.Owner, msg.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	params := k.GetReservationParams(ctx)
+	if reservation.IsExpired(ctx.BlockTime()) {
+		if err := k.validateNamespaceUsage(ctx, reservation, params.UsageGracePeriod); err != nil {
+			return nil, err
+		}
+	}
+
+	if !params.RenewalFee.IsZero() {
+		owner, err := sdk.AccAddressFromBech32(msg.Owner)
+		if err != nil {
+			return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+		}
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, authtypes.FeeCollectorName, sdk.NewCoins(params.RenewalFee)); err != nil {
+			return nil, err
+		}
+	}
+
+	from := reservation.Expiry
+	if reservation.IsExpired(ctx.BlockTime()) {
+		from = ctx.BlockTime()
+	}
+	reservation.Expiry = from.Add(params.Duration)
+	k.SetNamespaceReservation(ctx, reservation)
+
+	if err := ctx.EventManager().EmitTypedEvent(
+		types.NewRenewNamespaceEvent(msg.Namespace, msg.Owner, reservation.Expiry, params.RenewalFee),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRenewNamespaceResponse{Reservation: reservation}, nil
+}
+
+// ReleaseNamespace deletes a namespace reservation and refunds its deposit to
+// the owner.
+func (k Keeper) ReleaseNamespace(goCtx context.Context, msg *types.MsgReleaseNamespace) (*types.MsgReleaseNamespaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	reservation, err := k.getOwnedNamespaceReservation(ctx, msg.Owner, msg.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.releaseNamespace(ctx, reservation); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReleaseNamespaceResponse{}, nil
+}
+
+// getOwnedNamespaceReservation returns the reservation of namespace if it is
+// owned by owner. An expired reservation remains owned until another account
+// reserves the namespace.
+func (k Keeper) getOwnedNamespaceReservation(ctx sdk.Context, owner string, namespace []byte) (types.NamespaceReservation, error) {
+	reservation, found := k.GetNamespaceReservation(ctx, namespace)
+	if !found {
+		return types.NamespaceReservation{}, types.ErrNamespaceNotReserved.Wrapf("namespace %X", namespace)
+	}
+	if reservation.Owner != owner {
+		return types.NamespaceReservation{}, types.ErrNotNamespaceOwner.Wrapf("namespace %X is owned by %s", namespace, reservation.Owner)
+	}
+	return reservation, nil
+}
+
+// releaseNamespace deletes a reservation and refunds its deposit to the owner.
+func (k Keeper) releaseNamespace(ctx sdk.Context, reservation types.NamespaceReservation) error {
+	if !reservation.Deposit.IsZero() {
+		owner, err := sdk.AccAddressFromBech32(reservation.Owner)
+		if err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(reservation.Deposit)); err != nil {
+			return err
+		}
+	}
+
+	k.deleteNamespaceReservation(ctx, reservation.Namespace)
+
+	return ctx.EventManager().EmitTypedEvent(
+		types.NewReleaseNamespaceEvent(reservation.Namespace, reservation.Owner, reservation.Deposit),
+	)
+}
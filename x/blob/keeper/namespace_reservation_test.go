@@ -0,0 +1,265 @@
+package keeper_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v7/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v7/x/blob/types"
+	"github.com/celestiaorg/go-square/v3/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamespaceReservation(t *testing.T) {
+	bankKeeper := newMockBankKeeper()
+	k, _, ctx := createKeeperWithBankKeeper(t, appconsts.Version, bankKeeper)
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+
+	owner := sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+	allowed := sdk.AccAddress(bytes.Repeat([]byte{2}, 20)).String()
+	other := sdk.AccAddress(bytes.Repeat([]byte{3}, 20)).String()
+	namespace := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	params := types.NewReservationParams(sdk.NewCoin(appconsts.BondDenom, math.NewInt(1000)), time.Hour, sdk.NewCoin(appconsts.BondDenom, math.NewInt(10)), time.Hour)
+
+	_, err := k.UpdateReservationParams(ctx, types.NewMsgUpdateReservationParams(owner, params))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = k.UpdateReservationParams(ctx, types.NewMsgUpdateReservationParams(k.GetAuthority(), types.ReservationParams{Deposit: params.Deposit}))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = k.UpdateReservationParams(ctx, types.NewMsgUpdateReservationParams(k.GetAuthority(), params))
+	require.NoError(t, err)
+	require.Equal(t, params, k.GetReservationParams(ctx))
+
+	pfb := func(signer string) *types.MsgPayForBlobs {
+		return createMsgPayForBlob(t, signer, namespace, []byte("blob"))
+	}
+	require.NoError(t, k.ValidateBlobSigner(ctx, pfb(other)))
+
+	resp, err := k.ReserveNamespace(ctx, types.NewMsgReserveNamespace(owner, namespace.Bytes(), []string{allowed}))
+	require.NoError(t, err)
+	require.Equal(t, now.Add(time.Hour), resp.Reservation.Expiry)
+	require.Equal(t, params.Deposit, resp.Reservation.Deposit)
+	require.Equal(t, params.Deposit.Amount, bankKeeper.paid[owner])
+
+	_, err = k.ReserveNamespace(ctx, types.NewMsgReserveNamespace(other, namespace.Bytes(), nil))
+	require.ErrorIs(t, err, types.ErrNamespaceAlreadyReserved)
+
+	t.Run("only allowed signers may pay for blobs", func(t *testing.T) {
+		require.NoError(t, k.ValidateBlobSigner(ctx, pfb(owner)))
+		require.NoError(t, k.ValidateBlobSigner(ctx, pfb(allowed)))
+		require.ErrorIs(t, k.ValidateBlobSigner(ctx, pfb(other)), types.ErrNamespaceSignerNotAllowed)
+		_, err := k.PayForBlobs(ctx, pfb(other))
+		require.ErrorIs(t, err, types.ErrNamespaceSignerNotAllowed)
+	})
+
+	t.Run("only the owner may manage the reservation", func(t *testing.T) {
+		_, err := k.SetNamespaceSigners(ctx, types.NewMsgSetNamespaceSigners(other, namespace.Bytes(), nil))
+		require.ErrorIs(t, err, types.ErrNotNamespaceOwner)
+		_, err = k.RenewNamespace(ctx, types.NewMsgRenewNamespace(other, namespace.Bytes()))
+		require.ErrorIs(t, err, types.ErrNotNamespaceOwner)
+		_, err = k.ReleaseNamespace(ctx, types.NewMsgReleaseNamespace(other, share.RandomBlobNamespace().Bytes()))
+		require.ErrorIs(t, err, types.ErrNamespaceNotReserved)
+	})
+
+	t.Run("set signers, renew and transfer", func(t *testing.T) {
+		_, err := k.SetNamespaceSigners(ctx, types.NewMsgSetNamespaceSigners(owner, namespace.Bytes(), []string{other}))
+		require.NoError(t, err)
+		require.ErrorIs(t, k.ValidateBlobSigner(ctx, pfb(allowed)), types.ErrNamespaceSignerNotAllowed)
+		require.NoError(t, k.ValidateBlobSigner(ctx, pfb(other)))
+
+		renewed, err := k.RenewNamespace(ctx, types.NewMsgRenewNamespace(owner, namespace.Bytes()))
+		require.NoError(t, err)
+		require.Equal(t, now.Add(2*time.Hour), renewed.Reservation.Expiry)
+		require.Equal(t, params.RenewalFee.Amount, bankKeeper.fees[owner])
+
+		_, err = k.TransferNamespace(ctx, types.NewMsgTransferNamespace(owner, namespace.Bytes(), allowed))
+		require.NoError(t, err)
+		reservation, found := k.GetNamespaceReservation(ctx, namespace.Bytes())
+		require.True(t, found)
+		require.Equal(t, allowed, reservation.Owner)
+		require.NoError(t, k.ValidateBlobSigner(ctx, pfb(allowed)))
+		require.ErrorIs(t, k.ValidateBlobSigner(ctx, pfb(owner)), types.ErrNamespaceSignerNotAllowed)
+	})
+
+	t.Run("expired reservations can be claimed by another account", func(t *testing.T) {
+		expiredCtx := ctx.WithBlockTime(now.Add(2 * time.Hour))
+		require.NoError(t, k.ValidateBlobSigner(expiredCtx, pfb(owner)))
+		query, err := k.NamespaceReservation(expiredCtx, &types.QueryNamespaceReservationRequest{Namespace: namespace.Bytes()})
+		require.NoError(t, err)
+		require.True(t, query.Expired)
+
+		_, err = k.ReserveNamespace(expiredCtx, types.NewMsgReserveNamespace(owner, namespace.Bytes(), nil))
+		require.NoError(t, err)
+		// the deposit was refunded to the previous owner and the new owner
+		// paid a new deposit.
+		require.Equal(t, params.Deposit.Amount, bankKeeper.refunded[allowed])
+		require.Equal(t, params.Deposit.Amount.MulRaw(2), bankKeeper.paid[owner])
+		require.Equal(t, params.Deposit.Amount, bankKeeper.module)
+	})
+
+	t.Run("release refunds the deposit", func(t *testing.T) {
+		_, err := k.ReleaseNamespace(ctx, types.NewMsgReleaseNamespace(owner, namespace.Bytes()))
+		require.NoError(t, err)
+		_, found := k.GetNamespaceReservation(ctx, namespace.Bytes())
+		require.False(t, found)
+		require.Equal(t, params.Deposit.Amount, bankKeeper.refunded[owner])
+		require.True(t, bankKeeper.module.IsZero())
+		require.NoError(t, k.ValidateBlobSigner(ctx, pfb(other)))
+	})
+}
+
+func TestNamespaceReservationExpiry(t *testing.T) {
+	bankKeeper := newMockBankKeeper()
+	k, _, ctx := createKeeperWithBankKeeper(t, appconsts.Version, bankKeeper)
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+
+	owner := sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+	other := sdk.AccAddress(bytes.Repeat([]byte{3}, 20)).String()
+	namespace := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	params := types.NewReservationParams(sdk.NewCoin(appconsts.BondDenom, math.NewInt(1000)), time.Hour, sdk.NewCoin(appconsts.BondDenom, math.NewInt(10)), time.Hour)
+	k.SetReservationParams(ctx, params)
+	pfb := createMsgPayForBlob(t, other, namespace, []byte("blob"))
+
+	_, err := k.ReserveNamespace(ctx, types.NewMsgReserveNamespace(owner, namespace.Bytes(), nil))
+	require.NoError(t, err)
+	expiry := now.Add(params.Duration)
+
+	// the reservation is enforced until, and not including, its expiry.
+	require.ErrorIs(t, k.ValidateBlobSigner(ctx.WithBlockTime(expiry.Add(-time.Nanosecond)), pfb), types.ErrNamespaceSignerNotAllowed)
+	require.NoError(t, k.ValidateBlobSigner(ctx.WithBlockTime(expiry), pfb))
+
+	t.Run("renewing an expired reservation extends it from the block time", func(t *testing.T) {
+		renewCtx := ctx.WithBlockTime(expiry.Add(time.Minute))
+		resp, err := k.RenewNamespace(renewCtx, types.NewMsgRenewNamespace(owner, namespace.Bytes()))
+		require.NoError(t, err)
+		require.Equal(t, expiry.Add(time.Minute).Add(params.Duration), resp.Reservation.Expiry)
+		require.Equal(t, params.RenewalFee.Amount, bankKeeper.fees[owner])
+		expiry = resp.Reservation.Expiry
+	})
+
+	t.Run("a namespace used while the reservation expired can't be renewed", func(t *testing.T) {
+		usedCtx := ctx.WithBlockTime(expiry)
+		_, err := k.PayForBlobs(usedCtx, pfb)
+		require.NoError(t, err)
+
+		renewCtx := usedCtx.WithBlockTime(expiry.Add(params.UsageGracePeriod - time.Nanosecond))
+		_, err = k.RenewNamespace(renewCtx, types.NewMsgRenewNamespace(owner, namespace.Bytes()))
+		require.ErrorIs(t, err, types.ErrNamespaceRecentlyUsed)
+		require.Equal(t, params.RenewalFee.Amount, bankKeeper.fees[owner])
+
+		// the account using the namespace may reserve it, which refunds the
+		// deposit of the previous owner.
+		resp, err := k.ReserveNamespace(renewCtx, types.NewMsgReserveNamespace(other, namespace.Bytes(), nil))
+		require.NoError(t, err)
+		require.Equal(t, other, resp.Reservation.Owner)
+		require.Equal(t, params.Deposit.Amount, bankKeeper.refunded[owner])
+		_, err = k.RenewNamespace(renewCtx, types.NewMsgRenewNamespace(owner, namespace.Bytes()))
+		require.ErrorIs(t, err, types.ErrNotNamespaceOwner)
+	})
+}
+
+func TestReserveRecentlyUsedNamespace(t *testing.T) {
+	k, _, ctx := createKeeperWithBankKeeper(t, appconsts.Version, newMockBankKeeper())
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+
+	owner := sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+	rollup := sdk.AccAddress(bytes.Repeat([]byte{2}, 20)).String()
+	namespace := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	params := types.DefaultReservationParams()
+	k.SetReservationParams(ctx, params)
+
+	_, err := k.PayForBlobs(ctx, createMsgPayForBlob(t, rollup, namespace, []byte("blob")))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(now.Add(params.UsageGracePeriod - time.Nanosecond))
+	_, err = k.ReserveNamespace(ctx, types.NewMsgReserveNamespace(owner, namespace.Bytes(), nil))
+	require.ErrorIs(t, err, types.ErrNamespaceRecentlyUsed)
+
+	// the namespace can be reserved by the account using it or for it.
+	_, err = k.ReserveNamespace(ctx, types.NewMsgReserveNamespace(owner, namespace.Bytes(), []string{rollup}))
+	require.NoError(t, err)
+	_, err = k.ReleaseNamespace(ctx, types.NewMsgReleaseNamespace(owner, namespace.Bytes()))
+	require.NoError(t, err)
+	_, err = k.ReserveNamespace(ctx, types.NewMsgReserveNamespace(rollup, namespace.Bytes(), nil))
+	require.NoError(t, err)
+	_, err = k.ReleaseNamespace(ctx, types.NewMsgReleaseNamespace(rollup, namespace.Bytes()))
+	require.NoError(t, err)
+
+	// anyone may reserve the namespace once the grace period passed.
+	ctx = ctx.WithBlockTime(now.Add(params.UsageGracePeriod))
+	_, err = k.ReserveNamespace(ctx, types.NewMsgReserveNamespace(owner, namespace.Bytes(), nil))
+	require.NoError(t, err)
+}
+
+func TestNamespaceReservationGenesis(t *testing.T) {
+	k, _, ctx := createKeeperWithBankKeeper(t, appconsts.Version, newMockBankKeeper())
+	owner := sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+	reservation := types.NewNamespaceReservation(share.RandomBlobNamespace().Bytes(), owner, nil, types.DefaultReservationDeposit, time.Unix(100, 0).UTC())
+
+	genesis := types.DefaultGenesis()
+	// genesis files that predate namespace reservations use the default
+	// reservation params.
+	genesis.ReservationParams = types.ReservationParams{}
+	genesis.NamespaceReservations = []types.NamespaceReservation{reservation}
+	require.NoError(t, genesis.Validate())
+	require.NoError(t, k.InitGenesis(ctx, *genesis))
+
+	exported := k.ExportGenesis(ctx)
+	require.Equal(t, types.DefaultReservationParams(), exported.ReservationParams)
+	require.Equal(t, []types.NamespaceReservation{reservation}, exported.NamespaceReservations)
+
+	resp, err := k.NamespaceReservations(ctx, &types.QueryNamespaceReservationsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.NamespaceReservation{reservation}, resp.Reservations)
+}
+
+// mockBankKeeper tracks the deposits paid to and refunded by the blob module
+// and the renewal fees paid to the fee collector.
+type mockBankKeeper struct {
+	paid     map[string]math.Int
+	refunded map[string]math.Int
+	fees     map[string]math.Int
+	module   math.Int
+}
+
+func newMockBankKeeper() *mockBankKeeper {
+	return &mockBankKeeper{
+		paid:     make(map[string]math.Int),
+		refunded: make(map[string]math.Int),
+		fees:     make(map[string]math.Int),
+		module:   math.ZeroInt(),
+	}
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	amount := amt.AmountOf(appconsts.BondDenom)
+	if recipientModule == authtypes.FeeCollectorName {
+		m.fees[senderAddr.String()] = add(m.fees[senderAddr.String()], amount)
+		return nil
+	}
+	m.paid[senderAddr.String()] = add(m.paid[senderAddr.String()], amount)
+	m.module = m.module.Add(amount)
+	return nil
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, _ string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	amount := amt.AmountOf(appconsts.BondDenom)
+	m.refunded[recipientAddr.String()] = add(m.refunded[recipientAddr.String()], amount)
+	m.module = m.module.Sub(amount)
+	return nil
+}
+
+func add(a, b math.Int) math.Int {
+	if a.IsNil() {
+		return b
+	}
+	return a.Add(b)
+}
@@ -0,0 +1,1815 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/blob/v1/blob_query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	proof "github.com/celestiaorg/celestia-app/v7/pkg/proof"
+	da "github.com/celestiaorg/celestia-app/v7/proto/celestia/core/v1/da"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryNamespaceBlobsRequest is the request type for the
+// BlobQuery/NamespaceBlobs RPC method.
+type QueryNamespaceBlobsRequest struct {
+	// height is the height of the block. Zero means the latest block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// namespace is the full namespace, version included, of the blobs.
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *QueryNamespaceBlobsRequest) Reset()         { *m = QueryNamespaceBlobsRequest{} }
+func (m *QueryNamespaceBlobsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNamespaceBlobsRequest) ProtoMessage()    {}
+func (*QueryNamespaceBlobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7fe1b947d124a17, []int{0}
+}
+func (m *QueryNamespaceBlobsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamespaceBlobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamespaceBlobsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNamespaceBlobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamespaceBlobsRequest.Merge(m, src)
+}
+func (m *QueryNamespaceBlobsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNamespaceBlobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamespaceBlobsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNamespaceBlobsRequest proto.InternalMessageInfo
+
+func (m *QueryNamespaceBlobsRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryNamespaceBlobsRequest) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+// QueryNamespaceBlobsResponse is the response type for the
+// BlobQuery/NamespaceBlobs RPC method.
+type QueryNamespaceBlobsResponse struct {
+	// height is the height of the block the blobs were read from.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// data_root is the data root of the block that the proofs commit to.
+	DataRoot []byte `protobuf:"bytes,2,opt,name=data_root,json=dataRoot,proto3" json:"data_root,omitempty"`
+	// blobs are the blobs of the namespace, in the order of the square.
+	Blobs []*NamespaceBlob `protobuf:"bytes,3,rep,name=blobs,proto3" json:"blobs,omitempty"`
+}
+
+func (m *QueryNamespaceBlobsResponse) Reset()         { *m = QueryNamespaceBlobsResponse{} }
+func (m *QueryNamespaceBlobsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNamespaceBlobsResponse) ProtoMessage()    {}
+func (*QueryNamespaceBlobsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7fe1b947d124a17, []int{1}
+}
+func (m *QueryNamespaceBlobsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamespaceBlobsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamespaceBlobsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNamespaceBlobsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamespaceBlobsResponse.Merge(m, src)
+}
+func (m *QueryNamespaceBlobsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNamespaceBlobsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamespaceBlobsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNamespaceBlobsResponse proto.InternalMessageInfo
+
+func (m *QueryNamespaceBlobsResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryNamespaceBlobsResponse) GetDataRoot() []byte {
+	if m != nil {
+		return m.DataRoot
+	}
+	return nil
+}
+
+func (m *QueryNamespaceBlobsResponse) GetBlobs() []*NamespaceBlob {
+	if m != nil {
+		return m.Blobs
+	}
+	return nil
+}
+
+// NamespaceBlob is a blob included in a block along with its inclusion proof.
+type NamespaceBlob struct {
+	Data         []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ShareVersion uint32 `protobuf:"varint,2,opt,name=share_version,json=shareVersion,proto3" json:"share_version,omitempty"`
+	// signer is the address of the account that paid for the blob.
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+	// commitment is the share commitment of the blob.
+	Commitment []byte `protobuf:"bytes,4,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// start_share and end_share are the range, end exclusive, of the shares
+	// holding the blob in the original data square.
+	StartShare uint32 `protobuf:"varint,5,opt,name=start_share,json=startShare,proto3" json:"start_share,omitempty"`
+	EndShare   uint32 `protobuf:"varint,6,opt,name=end_share,json=endShare,proto3" json:"end_share,omitempty"`
+	// proof is the proof of the blob's shares to the block's data root.
+	Proof proof.ShareProof `protobuf:"bytes,7,opt,name=proof,proto3" json:"proof"`
+}
+
+func (m *NamespaceBlob) Reset()         { *m = NamespaceBlob{} }
+func (m *NamespaceBlob) String() string { return proto.CompactTextString(m) }
+func (*NamespaceBlob) ProtoMessage()    {}
+func (*NamespaceBlob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7fe1b947d124a17, []int{2}
+}
+func (m *NamespaceBlob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceBlob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceBlob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceBlob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceBlob.Merge(m, src)
+}
+func (m *NamespaceBlob) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceBlob) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceBlob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceBlob proto.InternalMessageInfo
+
+func (m *NamespaceBlob) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *NamespaceBlob) GetShareVersion() uint32 {
+	if m != nil {
+		return m.ShareVersion
+	}
+	return 0
+}
+
+func (m *NamespaceBlob) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *NamespaceBlob) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+func (m *NamespaceBlob) GetStartShare() uint32 {
+	if m != nil {
+		return m.StartShare
+	}
+	return 0
+}
+
+func (m *NamespaceBlob) GetEndShare() uint32 {
+	if m != nil {
+		return m.EndShare
+	}
+	return 0
+}
+
+func (m *NamespaceBlob) GetProof() proof.ShareProof {
+	if m != nil {
+		return m.Proof
+	}
+	return proof.ShareProof{}
+}
+
+// QueryCommitmentProofRequest is the request type for the
+// BlobQuery/CommitmentProof RPC method.
+type QueryCommitmentProofRequest struct {
+	// height is the height of the block. Zero means the latest block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// namespace is the full namespace, version included, of the blob.
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// commitment is the share commitment of the blob.
+	Commitment []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (m *QueryCommitmentProofRequest) Reset()         { *m = QueryCommitmentProofRequest{} }
+func (m *QueryCommitmentProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCommitmentProofRequest) ProtoMessage()    {}
+func (*QueryCommitmentProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7fe1b947d124a17, []int{3}
+}
+func (m *QueryCommitmentProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommitmentProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommitmentProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCommitmentProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommitmentProofRequest.Merge(m, src)
+}
+func (m *QueryCommitmentProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommitmentProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommitmentProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommitmentProofRequest proto.InternalMessageInfo
+
+func (m *QueryCommitmentProofRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryCommitmentProofRequest) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *QueryCommitmentProofRequest) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+// QueryCommitmentProofResponse is the response type for the
+// BlobQuery/CommitmentProof RPC method.
+type QueryCommitmentProofResponse struct {
+	// height is the height of the block the proof was built from.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// data_root is the data root of the block, the hash of dah.
+	DataRoot []byte `protobuf:"bytes,2,opt,name=data_root,json=dataRoot,proto3" json:"data_root,omitempty"`
+	// dah is the data availability header that the proof is against.
+	Dah   da.DataAvailabilityHeader `protobuf:"bytes,3,opt,name=dah,proto3" json:"dah"`
+	Proof proof.CommitmentProof     `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof"`
+}
+
+func (m *QueryCommitmentProofResponse) Reset()         { *m = QueryCommitmentProofResponse{} }
+func (m *QueryCommitmentProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCommitmentProofResponse) ProtoMessage()    {}
+func (*QueryCommitmentProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7fe1b947d124a17, []int{4}
+}
+func (m *QueryCommitmentProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommitmentProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommitmentProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCommitmentProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommitmentProofResponse.Merge(m, src)
+}
+func (m *QueryCommitmentProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommitmentProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommitmentProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommitmentProofResponse proto.InternalMessageInfo
+
+func (m *QueryCommitmentProofResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryCommitmentProofResponse) GetDataRoot() []byte {
+	if m != nil {
+		return m.DataRoot
+	}
+	return nil
+}
+
+func (m *QueryCommitmentProofResponse) GetDah() da.DataAvailabilityHeader {
+	if m != nil {
+		return m.Dah
+	}
+	return da.DataAvailabilityHeader{}
+}
+
+func (m *QueryCommitmentProofResponse) GetProof() proof.CommitmentProof {
+	if m != nil {
+		return m.Proof
+	}
+	return proof.CommitmentProof{}
+}
+
+func init() {
+	proto.RegisterType((*QueryNamespaceBlobsRequest)(nil), "celestia.blob.v1.QueryNamespaceBlobsRequest")
+	proto.RegisterType((*QueryNamespaceBlobsResponse)(nil), "celestia.blob.v1.QueryNamespaceBlobsResponse")
+	proto.RegisterType((*NamespaceBlob)(nil), "celestia.blob.v1.NamespaceBlob")
+	proto.RegisterType((*QueryCommitmentProofRequest)(nil), "celestia.blob.v1.QueryCommitmentProofRequest")
+	proto.RegisterType((*QueryCommitmentProofResponse)(nil), "celestia.blob.v1.QueryCommitmentProofResponse")
+}
+
+func init() { proto.RegisterFile("celestia/blob/v1/blob_query.proto", fileDescriptor_d7fe1b947d124a17) }
+
+var fileDescriptor_d7fe1b947d124a17 = []byte{
+	// 624 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x8b, 0xd3, 0x40,
+	0x14, 0xee, 0x6c, 0xba, 0x75, 0x3b, 0x6d, 0x55, 0x06, 0xd1, 0xd8, 0x2d, 0x69, 0x8d, 0x82, 0x45,
+	0xdd, 0xc4, 0xad, 0x78, 0x15, 0x6c, 0x3d, 0x88, 0x07, 0xd1, 0x08, 0x1e, 0xbc, 0x84, 0x49, 0x33,
+	0x26, 0x81, 0x34, 0x93, 0xcd, 0x4c, 0x8b, 0x45, 0xbc, 0x78, 0xf3, 0x22, 0x82, 0x57, 0xff, 0x13,
+	0xff, 0x81, 0x3d, 0x2e, 0x78, 0xf1, 0xa2, 0x48, 0xeb, 0xdf, 0x21, 0x32, 0x33, 0x69, 0xbb, 0xfd,
+	0x05, 0x0b, 0x7b, 0x69, 0x67, 0xde, 0xfb, 0xe6, 0x7d, 0xdf, 0xfb, 0xe6, 0x65, 0xe0, 0x8d, 0x3e,
+	0x89, 0x09, 0xe3, 0x11, 0xb6, 0xbd, 0x98, 0x7a, 0xf6, 0xe8, 0x50, 0xfe, 0xbb, 0x47, 0x43, 0x92,
+	0x8d, 0xad, 0x34, 0xa3, 0x9c, 0xa2, 0xcb, 0x33, 0x88, 0x25, 0x52, 0xd6, 0xe8, 0xb0, 0x7e, 0x25,
+	0xa0, 0x01, 0x95, 0x49, 0x5b, 0xac, 0x14, 0xae, 0xde, 0x08, 0x28, 0x0d, 0x62, 0x62, 0xe3, 0x34,
+	0xb2, 0x71, 0x92, 0x50, 0x8e, 0x79, 0x44, 0x13, 0x96, 0x67, 0xcd, 0x39, 0x51, 0x9f, 0x66, 0x44,
+	0x10, 0xa5, 0x19, 0xa5, 0x6f, 0xd5, 0x6f, 0x8e, 0xe9, 0xac, 0x61, 0x7c, 0x6c, 0xfb, 0x98, 0x63,
+	0x17, 0x8f, 0x70, 0x14, 0x63, 0x2f, 0x8a, 0x23, 0x3e, 0x76, 0x43, 0x82, 0x7d, 0x92, 0xa9, 0x33,
+	0xa6, 0x03, 0xeb, 0x2f, 0x85, 0xd8, 0xe7, 0x78, 0x40, 0x58, 0x8a, 0xfb, 0xa4, 0x1b, 0x53, 0x8f,
+	0x39, 0xe4, 0x68, 0x48, 0x18, 0x47, 0x57, 0x61, 0x29, 0x24, 0x51, 0x10, 0x72, 0x1d, 0xb4, 0x40,
+	0x5b, 0x73, 0xf2, 0x1d, 0x6a, 0xc0, 0x72, 0x32, 0x3b, 0xa0, 0xef, 0xb4, 0x40, 0xbb, 0xea, 0x2c,
+	0x02, 0xe6, 0x27, 0x00, 0xf7, 0x37, 0x16, 0x65, 0x29, 0x4d, 0x18, 0xd9, 0x5a, 0x75, 0x1f, 0x96,
+	0xa5, 0xda, 0x8c, 0x52, 0x9e, 0x57, 0xdd, 0x13, 0x01, 0x87, 0x52, 0x8e, 0x1e, 0xc2, 0x5d, 0xe1,
+	0x1f, 0xd3, 0xb5, 0x96, 0xd6, 0xae, 0x74, 0x9a, 0xd6, 0xaa, 0xad, 0xd6, 0x12, 0x9b, 0xa3, 0xd0,
+	0xe6, 0x3f, 0x00, 0x6b, 0x4b, 0x09, 0x84, 0x60, 0x51, 0x14, 0x95, 0xdc, 0x55, 0x47, 0xae, 0xd1,
+	0x4d, 0x58, 0x63, 0x21, 0xce, 0x88, 0x3b, 0x22, 0x19, 0x8b, 0x68, 0x22, 0xd9, 0x6b, 0x4e, 0x55,
+	0x06, 0x5f, 0xab, 0x98, 0x90, 0xcd, 0xa2, 0x20, 0x21, 0x99, 0xae, 0xb5, 0x40, 0xbb, 0xec, 0xe4,
+	0x3b, 0x64, 0x40, 0xd8, 0xa7, 0x83, 0x41, 0xc4, 0x07, 0x24, 0xe1, 0x7a, 0x51, 0x96, 0x3d, 0x15,
+	0x41, 0x4d, 0x58, 0x61, 0x1c, 0x67, 0xdc, 0x95, 0xd5, 0xf4, 0x5d, 0x59, 0x1a, 0xca, 0xd0, 0x2b,
+	0x11, 0x11, 0x7d, 0x93, 0xc4, 0xcf, 0xd3, 0x25, 0x99, 0xde, 0x23, 0x89, 0xaf, 0x92, 0x8f, 0xe0,
+	0xae, 0xbc, 0x63, 0xfd, 0x42, 0x0b, 0xb4, 0x2b, 0x1d, 0x73, 0xd1, 0xb7, 0xb8, 0x64, 0xd1, 0xb7,
+	0x4c, 0x5b, 0x12, 0xfd, 0x42, 0x2c, 0xbb, 0xc5, 0xe3, 0xdf, 0xcd, 0x82, 0xa3, 0x8e, 0x99, 0x2c,
+	0xbf, 0x8b, 0xde, 0x5c, 0x90, 0x04, 0x9d, 0xeb, 0x86, 0x57, 0x5a, 0xd6, 0x56, 0x5b, 0x36, 0x7f,
+	0x01, 0xd8, 0xd8, 0xcc, 0x7a, 0x9e, 0x11, 0xe8, 0x41, 0xcd, 0xc7, 0xa1, 0xa4, 0xab, 0x74, 0xee,
+	0xae, 0x1b, 0xe1, 0x63, 0xeb, 0x09, 0xe6, 0xf8, 0xf1, 0xa9, 0x61, 0x7f, 0x2a, 0x67, 0x3d, 0x77,
+	0x44, 0x9c, 0x46, 0xbd, 0x99, 0x9f, 0x45, 0x59, 0xe6, 0xf6, 0x36, 0x3f, 0x57, 0x94, 0x2f, 0x99,
+	0xda, 0xf9, 0xbe, 0x03, 0xcb, 0x62, 0x98, 0x64, 0x8f, 0xe8, 0x33, 0x80, 0x17, 0x97, 0x47, 0x1d,
+	0xdd, 0x5b, 0x1f, 0xcf, 0xed, 0x9f, 0x59, 0xfd, 0xe0, 0x8c, 0x68, 0x65, 0x9e, 0xd9, 0xfc, 0xf8,
+	0xe3, 0xef, 0xd7, 0x9d, 0xeb, 0xe8, 0xda, 0xd2, 0xa3, 0xc3, 0xec, 0xf7, 0xca, 0xc4, 0x0f, 0xe8,
+	0x1b, 0x80, 0x97, 0x56, 0xf4, 0xa3, 0x6d, 0x1c, 0x9b, 0xe7, 0xa2, 0x6e, 0x9d, 0x15, 0x9e, 0x6b,
+	0xba, 0x23, 0x35, 0xdd, 0x42, 0xe6, 0x5c, 0xd3, 0x62, 0x1c, 0x5c, 0xf5, 0x54, 0xcd, 0xe4, 0x75,
+	0x9f, 0x1d, 0x4f, 0x0c, 0x70, 0x32, 0x31, 0xc0, 0x9f, 0x89, 0x01, 0xbe, 0x4c, 0x8d, 0xc2, 0xc9,
+	0xd4, 0x28, 0xfc, 0x9c, 0x1a, 0x85, 0x37, 0xf7, 0x83, 0x88, 0x87, 0x43, 0xcf, 0xea, 0xd3, 0x81,
+	0x3d, 0xe3, 0xa7, 0x59, 0x30, 0x5f, 0x1f, 0xe0, 0x34, 0xb5, 0xdf, 0x29, 0x0a, 0x3e, 0x4e, 0x09,
+	0xf3, 0x4a, 0xf2, 0x19, 0x7b, 0xf0, 0x7f, 0x00, 0xa0, 0xf9, 0x9a, 0x61, 0x89, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BlobQueryClient is the client API for BlobQuery service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BlobQueryClient interface {
+	// NamespaceBlobs returns the blobs published under a namespace at a height
+	// along with the proofs of their inclusion in the block's data root.
+	NamespaceBlobs(ctx context.Context, in *QueryNamespaceBlobsRequest, opts ...grpc.CallOption) (*QueryNamespaceBlobsResponse, error)
+	// CommitmentProof returns the proof that the blob with a share commitment
+	// was included at a height, against the block's data availability header.
+	CommitmentProof(ctx context.Context, in *QueryCommitmentProofRequest, opts ...grpc.CallOption) (*QueryCommitmentProofResponse, error)
+}
+
+type blobQueryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewBlobQueryClient(cc grpc1.ClientConn) BlobQueryClient {
+	return &blobQueryClient{cc}
+}
+
+func (c *blobQueryClient) NamespaceBlobs(ctx context.Context, in *QueryNamespaceBlobsRequest, opts ...grpc.CallOption) (*QueryNamespaceBlobsResponse, error) {
+	out := new(QueryNamespaceBlobsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.BlobQuery/NamespaceBlobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobQueryClient) CommitmentProof(ctx context.Context, in *QueryCommitmentProofRequest, opts ...grpc.CallOption) (*QueryCommitmentProofResponse, error) {
+	out := new(QueryCommitmentProofResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.BlobQuery/CommitmentProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BlobQueryServer is the server API for BlobQuery service.
+type BlobQueryServer interface {
+	// NamespaceBlobs returns the blobs published under a namespace at a height
+	// along with the proofs of their inclusion in the block's data root.
+	NamespaceBlobs(context.Context, *QueryNamespaceBlobsRequest) (*QueryNamespaceBlobsResponse, error)
+	// CommitmentProof returns the proof that the blob with a share commitment
+	// was included at a height, against the block's data availability header.
+	CommitmentProof(context.Context, *QueryCommitmentProofRequest) (*QueryCommitmentProofResponse, error)
+}
+
+// UnimplementedBlobQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedBlobQueryServer struct {
+}
+
+func (*UnimplementedBlobQueryServer) NamespaceBlobs(ctx context.Context, req *QueryNamespaceBlobsRequest) (*QueryNamespaceBlobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamespaceBlobs not implemented")
+}
+func (*UnimplementedBlobQueryServer) CommitmentProof(ctx context.Context, req *QueryCommitmentProofRequest) (*QueryCommitmentProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitmentProof not implemented")
+}
+
+func RegisterBlobQueryServer(s grpc1.Server, srv BlobQueryServer) {
+	s.RegisterService(&_BlobQuery_serviceDesc, srv)
+}
+
+func _BlobQuery_NamespaceBlobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNamespaceBlobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobQueryServer).NamespaceBlobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.BlobQuery/NamespaceBlobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobQueryServer).NamespaceBlobs(ctx, req.(*QueryNamespaceBlobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlobQuery_CommitmentProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommitmentProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobQueryServer).CommitmentProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.BlobQuery/CommitmentProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobQueryServer).CommitmentProof(ctx, req.(*QueryCommitmentProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var BlobQuery_serviceDesc = _BlobQuery_serviceDesc
+var _BlobQuery_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.blob.v1.BlobQuery",
+	HandlerType: (*BlobQueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "NamespaceBlobs",
+			Handler:    _BlobQuery_NamespaceBlobs_Handler,
+		},
+		{
+			MethodName: "CommitmentProof",
+			Handler:    _BlobQuery_CommitmentProof_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/blob/v1/blob_query.proto",
+}
+
+func (m *QueryNamespaceBlobsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamespaceBlobsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamespaceBlobsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintBlobQuery(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintBlobQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNamespaceBlobsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamespaceBlobsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamespaceBlobsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Blobs) > 0 {
+		for iNdEx := len(m.Blobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBlobQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DataRoot) > 0 {
+		i -= len(m.DataRoot)
+		copy(dAtA[i:], m.DataRoot)
+		i = encodeVarintBlobQuery(dAtA, i, uint64(len(m.DataRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintBlobQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NamespaceBlob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceBlob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceBlob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBlobQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.EndShare != 0 {
+		i = encodeVarintBlobQuery(dAtA, i, uint64(m.EndShare))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartShare != 0 {
+		i = encodeVarintBlobQuery(dAtA, i, uint64(m.StartShare))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintBlobQuery(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintBlobQuery(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ShareVersion != 0 {
+		i = encodeVarintBlobQuery(dAtA, i, uint64(m.ShareVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintBlobQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommitmentProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommitmentProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommitmentProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintBlobQuery(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintBlobQuery(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintBlobQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommitmentProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommitmentProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommitmentProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBlobQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Dah.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBlobQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.DataRoot) > 0 {
+		i -= len(m.DataRoot)
+		copy(dAtA[i:], m.DataRoot)
+		i = encodeVarintBlobQuery(dAtA, i, uint64(len(m.DataRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintBlobQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBlobQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBlobQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryNamespaceBlobsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBlobQuery(uint64(m.Height))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovBlobQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNamespaceBlobsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBlobQuery(uint64(m.Height))
+	}
+	l = len(m.DataRoot)
+	if l > 0 {
+		n += 1 + l + sovBlobQuery(uint64(l))
+	}
+	if len(m.Blobs) > 0 {
+		for _, e := range m.Blobs {
+			l = e.Size()
+			n += 1 + l + sovBlobQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NamespaceBlob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovBlobQuery(uint64(l))
+	}
+	if m.ShareVersion != 0 {
+		n += 1 + sovBlobQuery(uint64(m.ShareVersion))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovBlobQuery(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovBlobQuery(uint64(l))
+	}
+	if m.StartShare != 0 {
+		n += 1 + sovBlobQuery(uint64(m.StartShare))
+	}
+	if m.EndShare != 0 {
+		n += 1 + sovBlobQuery(uint64(m.EndShare))
+	}
+	l = m.Proof.Size()
+	n += 1 + l + sovBlobQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCommitmentProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBlobQuery(uint64(m.Height))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovBlobQuery(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovBlobQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCommitmentProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBlobQuery(uint64(m.Height))
+	}
+	l = len(m.DataRoot)
+	if l > 0 {
+		n += 1 + l + sovBlobQuery(uint64(l))
+	}
+	l = m.Dah.Size()
+	n += 1 + l + sovBlobQuery(uint64(l))
+	l = m.Proof.Size()
+	n += 1 + l + sovBlobQuery(uint64(l))
+	return n
+}
+
+func sovBlobQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBlobQuery(x uint64) (n int) {
+	return sovBlobQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryNamespaceBlobsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlobQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNamespaceBlobsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNamespaceBlobsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlobQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlobQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlobQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNamespaceBlobsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlobQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNamespaceBlobsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNamespaceBlobsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlobQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRoot = append(m.DataRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRoot == nil {
+				m.DataRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlobQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blobs = append(m.Blobs, &NamespaceBlob{})
+			if err := m.Blobs[len(m.Blobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlobQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlobQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceBlob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlobQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceBlob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceBlob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlobQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareVersion", wireType)
+			}
+			m.ShareVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlobQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlobQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartShare", wireType)
+			}
+			m.StartShare = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartShare |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndShare", wireType)
+			}
+			m.EndShare = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndShare |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlobQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlobQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlobQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommitmentProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlobQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommitmentProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommitmentProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlobQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlobQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlobQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlobQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommitmentProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlobQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommitmentProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommitmentProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlobQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRoot = append(m.DataRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRoot == nil {
+				m.DataRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dah", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlobQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Dah.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlobQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlobQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlobQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBlobQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBlobQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlobQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlobQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBlobQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBlobQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBlobQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBlobQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBlobQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBlobQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -18,6 +18,13 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgPayForBlobs{},
 		&MsgUpdateBlobParams{},
+		&MsgUpdateNamespaceParams{},
+		&MsgUpdateReservationParams{},
+		&MsgReserveNamespace{},
+		&MsgSetNamespaceSigners{},
+		&MsgTransferNamespace{},
+		&MsgRenewNamespace{},
+		&MsgReleaseNamespace{},
 	)
 
 	registry.RegisterInterface(
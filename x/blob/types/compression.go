@@ -0,0 +1,178 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/celestiaorg/go-square/v3/share"
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// CompressionAlgorithmZstd identifies blob data compressed with zstd.
+	CompressionAlgorithmZstd uint8 = 1
+
+	// CompressedBlobHeaderSize is the size in bytes of the header prefixed to
+	// the data of a compressed blob.
+	CompressedBlobHeaderSize = len(compressionMagic) + 1 + 1 + 8
+
+	// MaxDecompressedBlobSize is the max size in bytes of the decompressed
+	// data of a compressed blob. It bounds the memory needed to decompress a
+	// blob.
+	MaxDecompressedBlobSize = 128 * 1024 * 1024
+
+	// compressionMagic marks the start of the header of a compressed blob.
+	compressionMagic = "CELZ"
+	// compressionHeaderVersion is the version of the header encoding.
+	compressionHeaderVersion = 1
+)
+
+// CompressedBlobHeader is the header of a compressed blob. Compression is
+// explicit: only blobs passed to CompressBlob are compressed, and readers
+// treat data as compressed only if it starts with a valid header, so ordinary
+// data that happens to start with the compression marker is left as is. The
+// blob is posted, and gas is charged, on the compressed bytes.
+type CompressedBlobHeader struct {
+	// Algorithm is the compression algorithm of the data that follows the
+	// header.
+	Algorithm uint8
+	// DecompressedSize is the size in bytes of the decompressed data.
+	DecompressedSize uint64
+}
+
+// Marshal encodes the header as CompressedBlobHeaderSize bytes.
+func (h CompressedBlobHeader) Marshal() []byte {
+	buf := make([]byte, 0, CompressedBlobHeaderSize)
+	buf = append(buf, compressionMagic...)
+	buf = append(buf, compressionHeaderVersion, h.Algorithm)
+	return binary.BigEndian.AppendUint64(buf, h.DecompressedSize)
+}
+
+// Validate returns an error if the header is not supported.
+func (h CompressedBlobHeader) Validate() error {
+	if h.Algorithm != CompressionAlgorithmZstd {
+		return ErrInvalidCompressedBlob.Wrapf("unsupported compression algorithm %d", h.Algorithm)
+	}
+	if h.DecompressedSize > MaxDecompressedBlobSize {
+		return ErrInvalidCompressedBlob.Wrapf("decompressed size %d exceeds the max of %d", h.DecompressedSize, MaxDecompressedBlobSize)
+	}
+	return nil
+}
+
+// IsCompressedBlobData returns true if data starts with the compression
+// marker.
+func IsCompressedBlobData(data []byte) bool {
+	return bytes.HasPrefix(data, []byte(compressionMagic))
+}
+
+// ParseCompressedBlobData splits the data of a compressed blob into its header
+// and the compressed bytes.
+func ParseCompressedBlobData(data []byte) (CompressedBlobHeader, []byte, error) {
+	if !IsCompressedBlobData(data) {
+		return CompressedBlobHeader{}, nil, ErrInvalidCompressedBlob.Wrap("missing compression marker")
+	}
+	if len(data) < CompressedBlobHeaderSize {
+		return CompressedBlobHeader{}, nil, ErrInvalidCompressedBlob.Wrapf("data of %d bytes is shorter than the header", len(data))
+	}
+	data = data[len(compressionMagic):]
+	if version := data[0]; version != compressionHeaderVersion {
+		return CompressedBlobHeader{}, nil, ErrInvalidCompressedBlob.Wrapf("unsupported header version %d", version)
+	}
+	header := CompressedBlobHeader{
+		Algorithm:        data[1],
+		DecompressedSize: binary.BigEndian.Uint64(data[2:10]),
+	}
+	if err := header.Validate(); err != nil {
+		return CompressedBlobHeader{}, nil, err
+	}
+	return header, data[10:], nil
+}
+
+// CompressBlobData compresses data with zstd and prefixes it with the
+// compression header.
+func CompressBlobData(data []byte) ([]byte, error) {
+	header := CompressedBlobHeader{
+		Algorithm:        CompressionAlgorithmZstd,
+		DecompressedSize: uint64(len(data)),
+	}
+	if err := header.Validate(); err != nil {
+		return nil, err
+	}
+
+	encoder, err := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+	defer encoder.Close()
+	return encoder.EncodeAll(data, header.Marshal()), nil
+}
+
+// DecompressBlobData returns the decompressed data of a compressed blob. It
+// returns an error if the data is not compressed or does not decompress to
+// the size in its header.
+func DecompressBlobData(data []byte) ([]byte, error) {
+	header, compressed, err := ParseCompressedBlobData(data)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder, err := zstd.NewReader(bytes.NewReader(compressed),
+		zstd.WithDecoderConcurrency(1),
+		zstd.WithDecoderMaxMemory(MaxDecompressedBlobSize),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer decoder.Close()
+
+	// The declared size is untrusted, so the output isn't pre-allocated.
+	// Reading one byte past it detects data that decompresses to more than
+	// declared without decompressing all of it.
+	decompressed, err := io.ReadAll(io.LimitReader(decoder, int64(header.DecompressedSize)+1))
+	if err != nil {
+		return nil, ErrInvalidCompressedBlob.Wrap(err.Error())
+	}
+	if uint64(len(decompressed)) != header.DecompressedSize {
+		return nil, ErrInvalidCompressedBlob.Wrapf("decompressed %d bytes, header declares %d", len(decompressed), header.DecompressedSize)
+	}
+	return decompressed, nil
+}
+
+// CompressBlob returns a copy of blob whose data is compressed. It returns an
+// error if the data already starts with a valid compression header.
+func CompressBlob(blob *share.Blob) (*share.Blob, error) {
+	if _, _, err := ParseCompressedBlobData(blob.Data()); err == nil {
+		return nil, ErrInvalidCompressedBlob.Wrap("blob is already compressed")
+	}
+	data, err := CompressBlobData(blob.Data())
+	if err != nil {
+		return nil, err
+	}
+	return share.NewBlob(blob.Namespace(), data, blob.ShareVersion(), blob.Signer())
+}
+
+// DecompressBlob returns a copy of blob whose data is decompressed. Blobs that
+// don't start with a valid compression header are not compressed and are
+// returned as is. It returns an error if the data following a valid header
+// does not decompress.
+func DecompressBlob(blob *share.Blob) (*share.Blob, error) {
+	if _, _, err := ParseCompressedBlobData(blob.Data()); err != nil {
+		return blob, nil
+	}
+	data, err := DecompressBlobData(blob.Data())
+	if err != nil {
+		return nil, err
+	}
+	return share.NewBlob(blob.Namespace(), data, blob.ShareVersion(), blob.Signer())
+}
+
+// DecompressedBlobSize returns the size of the data of blob once decompressed
+// and whether the blob is compressed. It only reads the compression header.
+func DecompressedBlobSize(blob *share.Blob) (uint64, bool) {
+	header, _, err := ParseCompressedBlobData(blob.Data())
+	if err != nil {
+		return uint64(len(blob.Data())), false
+	}
+	return header.DecompressedSize, true
+}
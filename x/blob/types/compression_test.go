@@ -0,0 +1,135 @@
+package types_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v7/x/blob/types"
+	"github.com/celestiaorg/go-square/v3/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressBlob(t *testing.T) {
+	signer := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+	data := bytes.Repeat([]byte("rollup batch "), 1000)
+	blob, err := share.NewV1Blob(share.RandomBlobNamespace(), data, signer)
+	require.NoError(t, err)
+
+	compressed, err := types.CompressBlob(blob)
+	require.NoError(t, err)
+	require.True(t, types.IsCompressedBlobData(compressed.Data()))
+	require.Less(t, len(compressed.Data()), len(data))
+	require.Equal(t, blob.Namespace(), compressed.Namespace())
+	require.Equal(t, blob.Signer(), compressed.Signer())
+
+	size, ok := types.DecompressedBlobSize(compressed)
+	require.True(t, ok)
+	require.EqualValues(t, len(data), size)
+	size, ok = types.DecompressedBlobSize(blob)
+	require.False(t, ok)
+	require.EqualValues(t, len(data), size)
+
+	decompressed, err := types.DecompressBlob(compressed)
+	require.NoError(t, err)
+	require.Equal(t, blob, decompressed)
+
+	// blobs that are not compressed are returned as is.
+	same, err := types.DecompressBlob(blob)
+	require.NoError(t, err)
+	require.Equal(t, blob, same)
+
+	_, err = types.CompressBlob(compressed)
+	require.ErrorIs(t, err, types.ErrInvalidCompressedBlob)
+
+	// gas is charged on the compressed bytes that are posted.
+	msg, err := types.NewMsgPayForBlobs(signer.String(), 0, compressed)
+	require.NoError(t, err)
+	require.Equal(t, []uint32{uint32(len(compressed.Data()))}, msg.BlobSizes)
+}
+
+func TestDecompressBlobData(t *testing.T) {
+	data := bytes.Repeat([]byte{1, 2, 3}, 100)
+	compressed, err := types.CompressBlobData(data)
+	require.NoError(t, err)
+
+	withSize := func(size uint64) []byte {
+		modified := bytes.Clone(compressed)
+		binary.BigEndian.PutUint64(modified[6:14], size)
+		return modified
+	}
+	withAlgorithm := func(algorithm byte) []byte {
+		modified := bytes.Clone(compressed)
+		modified[5] = algorithm
+		return modified
+	}
+
+	testCases := []struct {
+		name    string
+		data    []byte
+		wantErr bool
+	}{
+		{name: "valid", data: compressed},
+		{name: "empty payload", data: mustCompress(t, nil)},
+		{name: "not compressed", data: data, wantErr: true},
+		{name: "truncated header", data: compressed[:types.CompressedBlobHeaderSize-1], wantErr: true},
+		{name: "unsupported algorithm", data: withAlgorithm(2), wantErr: true},
+		{name: "smaller declared size", data: withSize(uint64(len(data) - 1)), wantErr: true},
+		{name: "larger declared size", data: withSize(uint64(len(data) + 1)), wantErr: true},
+		{name: "declared size too large", data: withSize(types.MaxDecompressedBlobSize + 1), wantErr: true},
+		{name: "corrupted payload", data: append(bytes.Clone(compressed[:types.CompressedBlobHeaderSize]), 1, 2, 3), wantErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := types.DecompressBlobData(tc.data)
+			if tc.wantErr {
+				require.ErrorIs(t, err, types.ErrInvalidCompressedBlob)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestBlobsStartingWithCompressionMarker(t *testing.T) {
+	signer := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+	compressed, err := types.CompressBlobData([]byte("data"))
+	require.NoError(t, err)
+	malformed := bytes.Clone(compressed)
+	malformed[5] = 0
+
+	for _, data := range [][]byte{[]byte("CELZ is how this rollup starts its batches"), malformed} {
+		blob, err := share.NewV1Blob(share.RandomBlobNamespace(), data, signer)
+		require.NoError(t, err)
+
+		// ordinary data that happens to carry the marker can be posted.
+		_, err = types.NewMsgPayForBlobs(signer.String(), 0, blob)
+		require.NoError(t, err)
+
+		// it is read back as is.
+		same, err := types.DecompressBlob(blob)
+		require.NoError(t, err)
+		require.Equal(t, blob, same)
+
+		// and it can be compressed explicitly.
+		compressedBlob, err := types.CompressBlob(blob)
+		require.NoError(t, err)
+		decompressed, err := types.DecompressBlob(compressedBlob)
+		require.NoError(t, err)
+		require.Equal(t, blob, decompressed)
+	}
+
+	// data following a valid header must decompress.
+	corrupted, err := share.NewV1Blob(share.RandomBlobNamespace(), append(bytes.Clone(compressed[:types.CompressedBlobHeaderSize]), 1, 2, 3), signer)
+	require.NoError(t, err)
+	_, err = types.DecompressBlob(corrupted)
+	require.ErrorIs(t, err, types.ErrInvalidCompressedBlob)
+}
+
+func mustCompress(t *testing.T, data []byte) []byte {
+	t.Helper()
+	compressed, err := types.CompressBlobData(data)
+	require.NoError(t, err)
+	return compressed
+}
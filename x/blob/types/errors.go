@@ -34,7 +34,17 @@ var (
 	ErrInvalidNamespace               = errors.Register(ModuleName, 11136, "invalid namespace")
 	ErrInvalidNamespaceVersion        = errors.Register(ModuleName, 11137, "invalid namespace version")
 	// ErrTotalBlobSizeTooLarge is deprecated; use ErrBlobsTooLarge instead.
-	ErrTotalBlobSizeTooLarge = errors.Register(ModuleName, 11138, "total blob size too large")
-	ErrBlobsTooLarge         = errors.Register(ModuleName, 11139, "blob(s) too large")
-	ErrInvalidBlobSigner     = errors.Register(ModuleName, 11140, "invalid blob signer")
+	ErrTotalBlobSizeTooLarge     = errors.Register(ModuleName, 11138, "total blob size too large")
+	ErrBlobsTooLarge             = errors.Register(ModuleName, 11139, "blob(s) too large")
+	ErrInvalidBlobSigner         = errors.Register(ModuleName, 11140, "invalid blob signer")
+	ErrInvalidNamespaceParams    = errors.Register(ModuleName, 11141, "invalid namespace params")
+	ErrBlobTooLargeForNamespace  = errors.Register(ModuleName, 11142, "blob exceeds the max blob size of its namespace")
+	ErrInvalidReservationParams  = errors.Register(ModuleName, 11143, "invalid reservation params")
+	ErrNamespaceAlreadyReserved  = errors.Register(ModuleName, 11144, "namespace is already reserved")
+	ErrNamespaceNotReserved      = errors.Register(ModuleName, 11145, "namespace is not reserved")
+	ErrNotNamespaceOwner         = errors.Register(ModuleName, 11146, "signer is not the owner of the namespace reservation")
+	ErrNamespaceSignerNotAllowed = errors.Register(ModuleName, 11147, "signer is not allowed to publish blobs to the reserved namespace")
+	ErrInvalidAllowedSigners     = errors.Register(ModuleName, 11148, "invalid allowed signers")
+	ErrInvalidCompressedBlob     = errors.Register(ModuleName, 11149, "invalid compressed blob")
+	ErrNamespaceRecentlyUsed     = errors.Register(ModuleName, 11150, "namespace was recently used by another account")
 )
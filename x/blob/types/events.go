@@ -1,12 +1,24 @@
 package types
 
 import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
 )
 
 var (
 	EventTypePayForBlob       = proto.MessageName(&EventPayForBlobs{})
 	EventTypeUpdateBlobParams = proto.MessageName(&EventUpdateBlobParams{})
+
+	EventTypeUpdateNamespaceParams = proto.MessageName(&EventUpdateNamespaceParams{})
+
+	EventTypeUpdateReservationParams = proto.MessageName(&EventUpdateReservationParams{})
+	EventTypeReserveNamespace        = proto.MessageName(&EventReserveNamespace{})
+	EventTypeSetNamespaceSigners     = proto.MessageName(&EventSetNamespaceSigners{})
+	EventTypeTransferNamespace       = proto.MessageName(&EventTransferNamespace{})
+	EventTypeRenewNamespace          = proto.MessageName(&EventRenewNamespace{})
+	EventTypeReleaseNamespace        = proto.MessageName(&EventReleaseNamespace{})
 )
 
 // NewPayForBlobsEvent returns a new EventPayForBlobs
@@ -25,3 +37,63 @@ func NewUpdateBlobParamsEvent(authority string, params Params) *EventUpdateBlobP
 		Params: params,
 	}
 }
+
+// NewUpdateNamespaceParamsEvent returns a new EventUpdateNamespaceParams
+func NewUpdateNamespaceParamsEvent(authority string, namespaceParams []NamespaceParams) *EventUpdateNamespaceParams {
+	return &EventUpdateNamespaceParams{
+		Signer:          authority,
+		NamespaceParams: namespaceParams,
+	}
+}
+
+// NewUpdateReservationParamsEvent returns a new EventUpdateReservationParams
+func NewUpdateReservationParamsEvent(authority string, reservationParams ReservationParams) *EventUpdateReservationParams {
+	return &EventUpdateReservationParams{
+		Signer:            authority,
+		ReservationParams: reservationParams,
+	}
+}
+
+// NewReserveNamespaceEvent returns a new EventReserveNamespace
+func NewReserveNamespaceEvent(reservation NamespaceReservation) *EventReserveNamespace {
+	return &EventReserveNamespace{
+		Reservation: reservation,
+	}
+}
+
+// NewSetNamespaceSignersEvent returns a new EventSetNamespaceSigners
+func NewSetNamespaceSignersEvent(namespace []byte, owner string, allowedSigners []string) *EventSetNamespaceSigners {
+	return &EventSetNamespaceSigners{
+		Namespace:      namespace,
+		Owner:          owner,
+		AllowedSigners: allowedSigners,
+	}
+}
+
+// NewTransferNamespaceEvent returns a new EventTransferNamespace
+func NewTransferNamespaceEvent(namespace []byte, owner, newOwner string) *EventTransferNamespace {
+	return &EventTransferNamespace{
+		Namespace: namespace,
+		Owner:     owner,
+		NewOwner:  newOwner,
+	}
+}
+
+// NewRenewNamespaceEvent returns a new EventRenewNamespace
+func NewRenewNamespaceEvent(namespace []byte, owner string, expiry time.Time, fee sdk.Coin) *EventRenewNamespace {
+	return &EventRenewNamespace{
+		Namespace: namespace,
+		Owner:     owner,
+		Expiry:    expiry,
+		Fee:       fee,
+	}
+}
+
+// NewReleaseNamespaceEvent returns a new EventReleaseNamespace
+func NewReleaseNamespaceEvent(namespace []byte, owner string, deposit sdk.Coin) *EventReleaseNamespace {
+	return &EventReleaseNamespace{
+		Namespace: namespace,
+		Owner:     owner,
+		Deposit:   deposit,
+	}
+}
@@ -6,12 +6,26 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:            DefaultParams(),
+		ReservationParams: DefaultReservationParams(),
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if err := ValidateNamespaceParams(gs.NamespaceParams); err != nil {
+		return err
+	}
+	// genesis files that predate namespace reservations use the default
+	// reservation params.
+	if !gs.ReservationParams.IsEmpty() {
+		if err := gs.ReservationParams.Validate(); err != nil {
+			return err
+		}
+	}
+	return ValidateNamespaceReservations(gs.NamespaceReservations)
 }
@@ -12,6 +12,23 @@ const (
 
 	// ParamsKey defines the key used for storing module parameters
 	ParamsKey = "params"
+
+	// NamespaceParamsKeyPrefix is the prefix of the keys used for storing the
+	// per namespace blob parameters, by namespace prefix.
+	NamespaceParamsKeyPrefix = "namespace_params/"
+
+	// ReservationParamsKey defines the key used for storing the namespace
+	// reservation parameters.
+	ReservationParamsKey = "reservation_params"
+
+	// NamespaceReservationKeyPrefix is the prefix of the keys used for storing
+	// the namespace reservations, by namespace.
+	NamespaceReservationKeyPrefix = "namespace_reservations/"
+
+	// NamespaceUsageKeyPrefix is the prefix of the keys used for storing the
+	// last time an account published blobs to a namespace, by namespace and
+	// account address.
+	NamespaceUsageKeyPrefix = "namespace_usage/"
 )
 
 func KeyPrefix(p string) []byte {
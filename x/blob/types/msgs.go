@@ -5,6 +5,7 @@ import sdk "github.com/cosmos/cosmos-sdk/types"
 var (
 	_ sdk.Msg = (*MsgPayForBlobs)(nil)
 	_ sdk.Msg = (*MsgUpdateBlobParams)(nil)
+	_ sdk.Msg = (*MsgUpdateNamespaceParams)(nil)
 )
 
 // NewMsgUpdateBlobParams creates a new MsgUpdateBlobParams instance.
@@ -14,3 +15,11 @@ func NewMsgUpdateBlobParams(authority string, params Params) *MsgUpdateBlobParam
 		Params:    params,
 	}
 }
+
+// NewMsgUpdateNamespaceParams creates a new MsgUpdateNamespaceParams instance.
+func NewMsgUpdateNamespaceParams(authority string, namespaceParams []NamespaceParams) *MsgUpdateNamespaceParams {
+	return &MsgUpdateNamespaceParams{
+		Authority:       authority,
+		NamespaceParams: namespaceParams,
+	}
+}
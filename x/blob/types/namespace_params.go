@@ -0,0 +1,147 @@
+package types
+
+import (
+	"bytes"
+	gomath "math"
+	"math/bits"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/go-square/v3/share"
+)
+
+// MaxGasMultiplier is the maximum gas multiplier of a namespace.
+const MaxGasMultiplier = 1000
+
+// NewNamespaceParams creates a new NamespaceParams instance.
+func NewNamespaceParams(namespacePrefix []byte, gasMultiplier math.LegacyDec, maxBlobSize uint32) NamespaceParams {
+	return NamespaceParams{
+		NamespacePrefix: namespacePrefix,
+		GasMultiplier:   gasMultiplier,
+		MaxBlobSize:     maxBlobSize,
+	}
+}
+
+// Validate validates the namespace params.
+func (p NamespaceParams) Validate() error {
+	if len(p.NamespacePrefix) == 0 || len(p.NamespacePrefix) > share.NamespaceSize {
+		return ErrInvalidNamespaceParams.Wrapf("namespace prefix must be between 1 and %d bytes, got %d", share.NamespaceSize, len(p.NamespacePrefix))
+	}
+	if p.GasMultiplier.IsNil() || !p.GasMultiplier.IsPositive() {
+		return ErrInvalidNamespaceParams.Wrapf("gas multiplier of namespace prefix %X must be positive", p.NamespacePrefix)
+	}
+	if p.GasMultiplier.GT(math.LegacyNewDec(MaxGasMultiplier)) {
+		return ErrInvalidNamespaceParams.Wrapf("gas multiplier of namespace prefix %X must be at most %d, got %s", p.NamespacePrefix, MaxGasMultiplier, p.GasMultiplier)
+	}
+	return nil
+}
+
+// ValidateNamespaceParams validates each of the namespace params and that no
+// namespace prefix is repeated.
+func ValidateNamespaceParams(namespaceParams []NamespaceParams) error {
+	seen := make(map[string]struct{}, len(namespaceParams))
+	for _, p := range namespaceParams {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seen[string(p.NamespacePrefix)]; ok {
+			return ErrInvalidNamespaceParams.Wrapf("duplicate namespace prefix %X", p.NamespacePrefix)
+		}
+		seen[string(p.NamespacePrefix)] = struct{}{}
+	}
+	return nil
+}
+
+// LookupNamespaceParams returns the namespace params with the longest
+// namespace prefix matching namespace. It returns false if none matches.
+func LookupNamespaceParams(namespaceParams []NamespaceParams, namespace []byte) (NamespaceParams, bool) {
+	var (
+		match NamespaceParams
+		found bool
+	)
+	for _, p := range namespaceParams {
+		if !bytes.HasPrefix(namespace, p.NamespacePrefix) {
+			continue
+		}
+		if !found || len(p.NamespacePrefix) > len(match.NamespacePrefix) {
+			match, found = p, true
+		}
+	}
+	return match, found
+}
+
+// GasToConsumeWithNamespaceParams is like GasToConsume but scales the gas
+// charged for each blob by the gas multiplier of its namespace. The result
+// saturates at math.MaxUint64 so that the gas meter runs out of gas instead of
+// the computation overflowing.
+func GasToConsumeWithNamespaceParams(msg *MsgPayForBlobs, gasPerByte uint32, namespaceParams []NamespaceParams) uint64 {
+	if len(namespaceParams) == 0 {
+		return GasToConsume(msg, gasPerByte)
+	}
+
+	var total uint64
+	for i, size := range msg.BlobSizes {
+		containsSigner := msg.ShareVersions[i] == uint32(share.ShareVersionOne)
+		gas := mulGas(uint64(share.SparseSharesNeeded(size, containsSigner))*share.ShareSize, uint64(gasPerByte))
+		if i < len(msg.Namespaces) {
+			if p, ok := LookupNamespaceParams(namespaceParams, msg.Namespaces[i]); ok {
+				gas = scaleGas(gas, p.GasMultiplier)
+			}
+		}
+		total = addGas(total, gas)
+	}
+	return total
+}
+
+// scaleGas returns gas * multiplier rounded up, saturating at math.MaxUint64.
+func scaleGas(gas uint64, multiplier math.LegacyDec) uint64 {
+	scaled := multiplier.MulInt(math.NewIntFromUint64(gas)).Ceil().TruncateInt()
+	if !scaled.IsUint64() {
+		return gomath.MaxUint64
+	}
+	return scaled.Uint64()
+}
+
+// mulGas returns a * b, saturating at math.MaxUint64.
+func mulGas(a, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	if hi != 0 {
+		return gomath.MaxUint64
+	}
+	return lo
+}
+
+// addGas returns a + b, saturating at math.MaxUint64.
+func addGas(a, b uint64) uint64 {
+	sum, carry := bits.Add64(a, b, 0)
+	if carry != 0 {
+		return gomath.MaxUint64
+	}
+	return sum
+}
+
+// ValidateBlobSizesForNamespaces returns an error if a blob of msg is larger
+// than the max blob size of its namespace.
+func ValidateBlobSizesForNamespaces(msg *MsgPayForBlobs, namespaceParams []NamespaceParams) error {
+	if len(namespaceParams) == 0 {
+		return nil
+	}
+	for i, size := range msg.BlobSizes {
+		if i >= len(msg.Namespaces) {
+			break
+		}
+		if err := ValidateBlobSizeForNamespace(namespaceParams, msg.Namespaces[i], size); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateBlobSizeForNamespace returns an error if size exceeds the max blob
+// size of namespace.
+func ValidateBlobSizeForNamespace(namespaceParams []NamespaceParams, namespace []byte, size uint32) error {
+	p, ok := LookupNamespaceParams(namespaceParams, namespace)
+	if !ok || p.MaxBlobSize == 0 || size <= p.MaxBlobSize {
+		return nil
+	}
+	return ErrBlobTooLargeForNamespace.Wrapf("blob of %d bytes in namespace %X exceeds the max blob size %d", size, namespace, p.MaxBlobSize)
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"bytes"
+	gomath "math"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/go-square/v3/share"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateNamespaceParams(t *testing.T) {
+	valid := NewNamespaceParams([]byte{0, 1}, math.LegacyOneDec(), 0)
+	tests := map[string]struct {
+		params    []NamespaceParams
+		expectErr bool
+	}{
+		"empty":              {params: nil},
+		"valid":              {params: []NamespaceParams{valid, NewNamespaceParams([]byte{0, 2}, math.LegacyNewDecWithPrec(1, 2), 10)}},
+		"empty prefix":       {params: []NamespaceParams{NewNamespaceParams(nil, math.LegacyOneDec(), 0)}, expectErr: true},
+		"prefix too long":    {params: []NamespaceParams{NewNamespaceParams(make([]byte, share.NamespaceSize+1), math.LegacyOneDec(), 0)}, expectErr: true},
+		"zero multiplier":    {params: []NamespaceParams{NewNamespaceParams([]byte{0}, math.LegacyZeroDec(), 0)}, expectErr: true},
+		"nil multiplier":     {params: []NamespaceParams{{NamespacePrefix: []byte{0}}}, expectErr: true},
+		"duplicate prefix":   {params: []NamespaceParams{valid, valid}, expectErr: true},
+		"negative multiple":  {params: []NamespaceParams{NewNamespaceParams([]byte{0}, math.LegacyNewDec(-1), 0)}, expectErr: true},
+		"max multiplier":     {params: []NamespaceParams{NewNamespaceParams([]byte{0}, math.LegacyNewDec(MaxGasMultiplier), 0)}},
+		"multiplier too big": {params: []NamespaceParams{NewNamespaceParams([]byte{0}, math.LegacyNewDec(MaxGasMultiplier).Add(math.LegacySmallestDec()), 0)}, expectErr: true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateNamespaceParams(tt.params)
+			if tt.expectErr {
+				require.ErrorIs(t, err, ErrInvalidNamespaceParams)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGasToConsumeWithNamespaceParams(t *testing.T) {
+	ns1 := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	ns2 := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+	msg := &MsgPayForBlobs{
+		Namespaces:    [][]byte{ns1.Bytes(), ns2.Bytes()},
+		BlobSizes:     []uint32{100, 100},
+		ShareVersions: []uint32{0, 0},
+	}
+	oneBlob := GasToConsume(&MsgPayForBlobs{BlobSizes: []uint32{100}, ShareVersions: []uint32{0}}, 8)
+
+	require.Equal(t, GasToConsume(msg, 8), GasToConsumeWithNamespaceParams(msg, 8, nil))
+
+	// the version prefix matches both namespaces but the full namespace of ns1
+	// is the longest match for ns1.
+	namespaceParams := []NamespaceParams{
+		NewNamespaceParams([]byte{share.NamespaceVersionZero}, math.LegacyNewDec(3), 0),
+		NewNamespaceParams(ns1.Bytes(), math.LegacyNewDecWithPrec(25, 2), 0),
+	}
+	require.Equal(t, oneBlob/4+3*oneBlob, GasToConsumeWithNamespaceParams(msg, 8, namespaceParams))
+
+	t.Run("saturates on overflow", func(t *testing.T) {
+		large := &MsgPayForBlobs{
+			Namespaces:    [][]byte{ns1.Bytes(), ns2.Bytes()},
+			BlobSizes:     []uint32{gomath.MaxUint32, gomath.MaxUint32},
+			ShareVersions: []uint32{0, 0},
+		}
+		multiplier := []NamespaceParams{NewNamespaceParams([]byte{share.NamespaceVersionZero}, math.LegacyNewDec(MaxGasMultiplier), 0)}
+		require.Equal(t, uint64(gomath.MaxUint64), GasToConsumeWithNamespaceParams(large, gomath.MaxUint32, multiplier))
+		require.Equal(t, uint64(gomath.MaxUint64), GasToConsumeWithNamespaceParams(large, 10_000_000, multiplier))
+		// each blob fits in a uint64 but their sum does not
+		require.Equal(t, uint64(gomath.MaxUint64), GasToConsumeWithNamespaceParams(large, 3_000_000, multiplier))
+	})
+}
+
+func TestValidateBlobSizesForNamespaces(t *testing.T) {
+	ns := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	msg := &MsgPayForBlobs{Namespaces: [][]byte{ns.Bytes()}, BlobSizes: []uint32{100}, ShareVersions: []uint32{0}}
+
+	require.NoError(t, ValidateBlobSizesForNamespaces(msg, nil))
+	require.NoError(t, ValidateBlobSizesForNamespaces(msg, []NamespaceParams{NewNamespaceParams(ns.Bytes(), math.LegacyOneDec(), 0)}))
+	require.NoError(t, ValidateBlobSizesForNamespaces(msg, []NamespaceParams{NewNamespaceParams(ns.Bytes(), math.LegacyOneDec(), 100)}))
+	require.ErrorIs(t, ValidateBlobSizesForNamespaces(msg, []NamespaceParams{NewNamespaceParams(ns.Bytes(), math.LegacyOneDec(), 99)}), ErrBlobTooLargeForNamespace)
+}
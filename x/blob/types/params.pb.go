@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -75,31 +77,102 @@ func (m *Params) GetGovMaxSquareSize() uint64 {
 	return 0
 }
 
+// NamespaceParams prices and bounds the blobs published under the namespaces
+// starting with a prefix. The parameters of the longest matching prefix apply
+// to a blob.
+type NamespaceParams struct {
+	// namespace_prefix is a prefix of the full namespace, version included.
+	NamespacePrefix []byte `protobuf:"bytes,1,opt,name=namespace_prefix,json=namespacePrefix,proto3" json:"namespace_prefix,omitempty"`
+	// gas_multiplier scales the gas charged per blob byte. A multiplier below
+	// one is a discount and a multiplier above one a premium.
+	GasMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=gas_multiplier,json=gasMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"gas_multiplier"`
+	// max_blob_size is the max size in bytes of a single blob. Zero means that
+	// blobs are only bounded by the square size.
+	MaxBlobSize uint32 `protobuf:"varint,3,opt,name=max_blob_size,json=maxBlobSize,proto3" json:"max_blob_size,omitempty"`
+}
+
+func (m *NamespaceParams) Reset()         { *m = NamespaceParams{} }
+func (m *NamespaceParams) String() string { return proto.CompactTextString(m) }
+func (*NamespaceParams) ProtoMessage()    {}
+func (*NamespaceParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2145b82d3e5371c6, []int{1}
+}
+func (m *NamespaceParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceParams.Merge(m, src)
+}
+func (m *NamespaceParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceParams proto.InternalMessageInfo
+
+func (m *NamespaceParams) GetNamespacePrefix() []byte {
+	if m != nil {
+		return m.NamespacePrefix
+	}
+	return nil
+}
+
+func (m *NamespaceParams) GetMaxBlobSize() uint32 {
+	if m != nil {
+		return m.MaxBlobSize
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "celestia.blob.v1.Params")
+	proto.RegisterType((*NamespaceParams)(nil), "celestia.blob.v1.NamespaceParams")
 }
 
 func init() { proto.RegisterFile("celestia/blob/v1/params.proto", fileDescriptor_2145b82d3e5371c6) }
 
 var fileDescriptor_2145b82d3e5371c6 = []byte{
-	// 270 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0x4f, 0xca, 0xc9, 0x4f, 0xd2, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c,
-	0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0x49, 0xeb, 0x81, 0xa4,
-	0xf5, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d,
-	0xd2, 0x32, 0x46, 0x2e, 0xb6, 0x00, 0xb0, 0x46, 0x21, 0x77, 0x2e, 0xc1, 0xf4, 0xc4, 0xe2, 0xf8,
-	0x82, 0xd4, 0xa2, 0x78, 0x90, 0x9e, 0xf8, 0xa4, 0xca, 0x92, 0x54, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0x5e, 0x27, 0x99, 0x4f, 0xf7, 0xe4, 0x25, 0x2a, 0x13, 0x73, 0x73, 0xac, 0x94, 0x30, 0x94, 0x28,
-	0x05, 0xf1, 0xa5, 0x27, 0x16, 0x07, 0xa4, 0x16, 0x39, 0xe5, 0xe4, 0x27, 0x39, 0x55, 0x96, 0xa4,
-	0x0a, 0xf9, 0x72, 0x09, 0xa7, 0xe7, 0x97, 0xc5, 0xe7, 0x26, 0x56, 0xc4, 0x17, 0x17, 0x96, 0x26,
-	0x16, 0xa5, 0xc6, 0x17, 0x67, 0x56, 0xa5, 0x4a, 0x30, 0x29, 0x30, 0x6a, 0xb0, 0x38, 0xc9, 0x7d,
-	0xba, 0x27, 0x2f, 0x05, 0x35, 0x0a, 0x53, 0x91, 0x52, 0x90, 0x40, 0x7a, 0x7e, 0x99, 0x6f, 0x62,
-	0x45, 0x30, 0x58, 0x2c, 0x38, 0xb3, 0x2a, 0xd5, 0x8a, 0x65, 0xc6, 0x02, 0x79, 0x06, 0x27, 0xaf,
-	0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39,
-	0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x48, 0xcf, 0x2c, 0xc9, 0x28,
-	0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0xf9, 0x3a, 0xbf, 0x28, 0x1d, 0xce, 0xd6, 0x4d, 0x2c,
-	0x28, 0xd0, 0xaf, 0x80, 0x04, 0x53, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0xef, 0xc6,
-	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x2d, 0x7d, 0x23, 0x44, 0x01, 0x00, 0x00,
+	// 402 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x51, 0xbf, 0x6e, 0xd3, 0x40,
+	0x1c, 0xb6, 0xa1, 0xaa, 0xc4, 0x41, 0x68, 0x30, 0x0c, 0x21, 0x80, 0x5d, 0x79, 0x2a, 0x43, 0x6d,
+	0x22, 0xb6, 0x8e, 0x56, 0x25, 0x24, 0x44, 0x50, 0xe4, 0x2e, 0x88, 0xe5, 0xf4, 0xb3, 0xf9, 0x71,
+	0x3d, 0xe1, 0xe3, 0x8e, 0xbb, 0x4b, 0x64, 0xf7, 0x29, 0x18, 0x19, 0x99, 0x78, 0x02, 0x26, 0x9e,
+	0xa0, 0x63, 0xc5, 0x84, 0x18, 0x22, 0x94, 0xbc, 0x41, 0x9f, 0x00, 0xdd, 0x39, 0xc9, 0xd2, 0xed,
+	0xee, 0xfb, 0x7e, 0xfa, 0xf4, 0xfd, 0x21, 0xcf, 0x6a, 0x6c, 0xd0, 0x58, 0x0e, 0x79, 0xd5, 0xc8,
+	0x2a, 0x5f, 0x4c, 0x72, 0x05, 0x1a, 0x84, 0xc9, 0x94, 0x96, 0x56, 0x46, 0xc3, 0x2d, 0x9d, 0x39,
+	0x3a, 0x5b, 0x4c, 0xc6, 0x8f, 0x98, 0x64, 0xd2, 0x93, 0xb9, 0x7b, 0xf5, 0x77, 0xe3, 0xc7, 0xb5,
+	0x34, 0x42, 0x1a, 0xda, 0x13, 0xfd, 0xa7, 0xa7, 0xd2, 0x1f, 0x21, 0xd9, 0x9f, 0x79, 0xcd, 0xe8,
+	0x15, 0x79, 0xc0, 0xc0, 0x50, 0x85, 0x9a, 0x3a, 0x39, 0x5a, 0x75, 0x16, 0x47, 0xe1, 0x61, 0x78,
+	0x34, 0x28, 0x9e, 0x5e, 0x2f, 0x93, 0x51, 0x07, 0xa2, 0x39, 0x49, 0x6f, 0x9c, 0xa4, 0xe5, 0x7d,
+	0x06, 0x66, 0x86, 0xba, 0x68, 0x64, 0x55, 0x74, 0x16, 0xa3, 0x29, 0x79, 0xc8, 0xe4, 0x82, 0x0a,
+	0x68, 0xa9, 0xf9, 0x32, 0x07, 0x8d, 0xd4, 0xf0, 0x0b, 0x1c, 0xdd, 0x3a, 0x0c, 0x8f, 0xf6, 0x8a,
+	0xf8, 0x7a, 0x99, 0x8c, 0x37, 0x52, 0x37, 0x8f, 0xd2, 0x72, 0xc8, 0xe4, 0x62, 0x0a, 0xed, 0x99,
+	0xc7, 0xce, 0xf8, 0x05, 0x9e, 0xec, 0x7d, 0xfb, 0x9e, 0x04, 0xe9, 0xaf, 0x90, 0x1c, 0xbc, 0x05,
+	0x81, 0x46, 0x41, 0x8d, 0x1b, 0xc7, 0xcf, 0xc9, 0xf0, 0xf3, 0x16, 0xa2, 0x4a, 0xe3, 0x47, 0xde,
+	0x7a, 0xc3, 0xf7, 0xca, 0x83, 0x1d, 0x3e, 0xf3, 0x70, 0xf4, 0x8e, 0x38, 0x97, 0x54, 0xcc, 0x1b,
+	0xcb, 0x55, 0xc3, 0x51, 0x7b, 0x3b, 0x77, 0x8a, 0xc9, 0xe5, 0x32, 0x09, 0xfe, 0x2e, 0x93, 0x27,
+	0x7d, 0x2b, 0xe6, 0xc3, 0xa7, 0x8c, 0xcb, 0x5c, 0x80, 0x3d, 0xcf, 0xde, 0x20, 0x83, 0xba, 0x3b,
+	0xc5, 0xfa, 0xf7, 0xcf, 0x63, 0xb2, 0x29, 0xed, 0x14, 0xeb, 0x72, 0xc0, 0xc0, 0x4c, 0x77, 0x3a,
+	0x51, 0x4a, 0x06, 0x2e, 0x84, 0xef, 0xc3, 0xe7, 0xbc, 0xed, 0x2a, 0x2b, 0xef, 0x0a, 0x68, 0x5d,
+	0x23, 0x2e, 0x42, 0xf1, 0xfa, 0x72, 0x15, 0x87, 0x57, 0xab, 0x38, 0xfc, 0xb7, 0x8a, 0xc3, 0xaf,
+	0xeb, 0x38, 0xb8, 0x5a, 0xc7, 0xc1, 0x9f, 0x75, 0x1c, 0xbc, 0x7f, 0xc1, 0xb8, 0x3d, 0x9f, 0x57,
+	0x59, 0x2d, 0x45, 0xbe, 0x5d, 0x53, 0x6a, 0xb6, 0x7b, 0x1f, 0x83, 0x52, 0x79, 0xdb, 0xcf, 0x6f,
+	0x3b, 0x85, 0xa6, 0xda, 0xf7, 0xc3, 0xbd, 0xfc, 0x3f, 0x00, 0x7b, 0x74, 0xf7, 0xe7, 0x1c, 0x02,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -135,6 +208,51 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NamespaceParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBlobSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBlobSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.GasMultiplier.Size()
+		i -= size
+		if _, err := m.GasMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.NamespacePrefix) > 0 {
+		i -= len(m.NamespacePrefix)
+		copy(dAtA[i:], m.NamespacePrefix)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.NamespacePrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -161,6 +279,24 @@ func (m *Params) Size() (n int) {
 	return n
 }
 
+func (m *NamespaceParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespacePrefix)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.GasMultiplier.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxBlobSize != 0 {
+		n += 1 + sovParams(uint64(m.MaxBlobSize))
+	}
+	return n
+}
+
 func sovParams(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -255,6 +391,143 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *NamespaceParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespacePrefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespacePrefix = append(m.NamespacePrefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.NamespacePrefix == nil {
+				m.NamespacePrefix = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlobSize", wireType)
+			}
+			m.MaxBlobSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlobSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipParams(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
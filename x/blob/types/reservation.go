@@ -0,0 +1,286 @@
+package types
+
+import (
+	"bytes"
+	"slices"
+	"time"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v7/pkg/appconsts"
+	"github.com/celestiaorg/go-square/v3/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// DefaultReservationDuration is the default time a namespace reservation
+	// lasts before it must be renewed.
+	DefaultReservationDuration = 90 * 24 * time.Hour
+
+	// DefaultUsageGracePeriod is the default time during which a namespace
+	// that another account published blobs to can't be reserved.
+	DefaultUsageGracePeriod = 30 * 24 * time.Hour
+
+	// MaxAllowedSigners is the max number of allowed signers of a namespace
+	// reservation.
+	MaxAllowedSigners = 32
+)
+
+var (
+	// DefaultReservationDeposit is the default deposit required to reserve a
+	// namespace: 100 TIA.
+	DefaultReservationDeposit = sdk.NewCoin(appconsts.BondDenom, math.NewInt(100_000_000))
+
+	// DefaultRenewalFee is the default fee charged to renew a namespace
+	// reservation: 10 TIA.
+	DefaultRenewalFee = sdk.NewCoin(appconsts.BondDenom, math.NewInt(10_000_000))
+)
+
+var (
+	_ sdk.Msg = (*MsgUpdateReservationParams)(nil)
+	_ sdk.Msg = (*MsgReserveNamespace)(nil)
+	_ sdk.Msg = (*MsgSetNamespaceSigners)(nil)
+	_ sdk.Msg = (*MsgTransferNamespace)(nil)
+	_ sdk.Msg = (*MsgRenewNamespace)(nil)
+	_ sdk.Msg = (*MsgReleaseNamespace)(nil)
+)
+
+// NewReservationParams creates a new ReservationParams instance.
+func NewReservationParams(deposit sdk.Coin, duration time.Duration, renewalFee sdk.Coin, usageGracePeriod time.Duration) ReservationParams {
+	return ReservationParams{
+		Deposit:          deposit,
+		Duration:         duration,
+		RenewalFee:       renewalFee,
+		UsageGracePeriod: usageGracePeriod,
+	}
+}
+
+// DefaultReservationParams returns the default namespace reservation
+// parameters.
+func DefaultReservationParams() ReservationParams {
+	return NewReservationParams(DefaultReservationDeposit, DefaultReservationDuration, DefaultRenewalFee, DefaultUsageGracePeriod)
+}
+
+// IsEmpty returns true if the reservation params are unset, as is the case in
+// genesis files that predate namespace reservations.
+func (p ReservationParams) IsEmpty() bool {
+	return p.Deposit.Denom == "" && p.Deposit.Amount.IsNil() && p.Duration == 0 &&
+		p.RenewalFee.Denom == "" && p.RenewalFee.Amount.IsNil() && p.UsageGracePeriod == 0
+}
+
+// Validate validates the reservation params.
+func (p ReservationParams) Validate() error {
+	if err := p.Deposit.Validate(); err != nil {
+		return ErrInvalidReservationParams.Wrapf("invalid deposit: %s", err)
+	}
+	if p.Duration <= 0 {
+		return ErrInvalidReservationParams.Wrapf("duration must be positive, got %s", p.Duration)
+	}
+	if err := p.RenewalFee.Validate(); err != nil {
+		return ErrInvalidReservationParams.Wrapf("invalid renewal fee: %s", err)
+	}
+	if p.UsageGracePeriod < 0 {
+		return ErrInvalidReservationParams.Wrapf("usage grace period must not be negative, got %s", p.UsageGracePeriod)
+	}
+	return nil
+}
+
+// NewNamespaceReservation creates a new NamespaceReservation instance.
+func NewNamespaceReservation(namespace []byte, owner string, allowedSigners []string, deposit sdk.Coin, expiry time.Time) NamespaceReservation {
+	return NamespaceReservation{
+		Namespace:      namespace,
+		Owner:          owner,
+		AllowedSigners: allowedSigners,
+		Deposit:        deposit,
+		Expiry:         expiry,
+	}
+}
+
+// Validate validates the namespace reservation.
+func (r NamespaceReservation) Validate() error {
+	if err := ValidateReservableNamespace(r.Namespace); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Owner); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+	if err := ValidateAllowedSigners(r.AllowedSigners); err != nil {
+		return err
+	}
+	return r.Deposit.Validate()
+}
+
+// IsExpired returns true if the reservation is no longer enforced at
+// blockTime.
+func (r NamespaceReservation) IsExpired(blockTime time.Time) bool {
+	return !blockTime.Before(r.Expiry)
+}
+
+// IsAllowedSigner returns true if signer may publish blobs to the reserved
+// namespace.
+func (r NamespaceReservation) IsAllowedSigner(signer string) bool {
+	return signer == r.Owner || slices.Contains(r.AllowedSigners, signer)
+}
+
+// ValidateNamespaceReservations validates each of the namespace reservations
+// and that no namespace is reserved twice.
+func ValidateNamespaceReservations(reservations []NamespaceReservation) error {
+	seen := make(map[string]struct{}, len(reservations))
+	for _, r := range reservations {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seen[string(r.Namespace)]; ok {
+			return ErrNamespaceAlreadyReserved.Wrapf("duplicate reservation of namespace %X", r.Namespace)
+		}
+		seen[string(r.Namespace)] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateReservableNamespace returns an error if namespace is not a full
+// namespace that blobs can be published to.
+func ValidateReservableNamespace(namespace []byte) error {
+	ns, err := share.NewNamespaceFromBytes(namespace)
+	if err != nil {
+		return errors.Wrap(ErrInvalidNamespace, err.Error())
+	}
+	return ValidateBlobNamespace(ns)
+}
+
+// ValidateAllowedSigners returns an error if an allowed signer is not a valid
+// address, is repeated or if there are more than MaxAllowedSigners.
+func ValidateAllowedSigners(allowedSigners []string) error {
+	if len(allowedSigners) > MaxAllowedSigners {
+		return ErrInvalidAllowedSigners.Wrapf("%d allowed signers exceeds the max of %d", len(allowedSigners), MaxAllowedSigners)
+	}
+	seen := make(map[string]struct{}, len(allowedSigners))
+	for _, signer := range allowedSigners {
+		if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+			return ErrInvalidAllowedSigners.Wrapf("invalid address %s: %s", signer, err)
+		}
+		if _, ok := seen[signer]; ok {
+			return ErrInvalidAllowedSigners.Wrapf("duplicate allowed signer %s", signer)
+		}
+		seen[signer] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateBlobSigner returns an error if the signer of a MsgPayForBlobs may not
+// publish blobs to one of its namespaces. lookup returns the unexpired
+// reservation of a namespace, if any.
+func ValidateBlobSigner(msg *MsgPayForBlobs, lookup func(namespace []byte) (NamespaceReservation, bool)) error {
+	for i, namespace := range msg.Namespaces {
+		// a namespace repeated in the same message is only checked once.
+		if slices.ContainsFunc(msg.Namespaces[:i], func(ns []byte) bool { return bytes.Equal(ns, namespace) }) {
+			continue
+		}
+		reservation, ok := lookup(namespace)
+		if !ok || reservation.IsAllowedSigner(msg.Signer) {
+			continue
+		}
+		return ErrNamespaceSignerNotAllowed.Wrapf("%s may not publish blobs to namespace %X", msg.Signer, namespace)
+	}
+	return nil
+}
+
+// NewMsgUpdateReservationParams creates a new MsgUpdateReservationParams
+// instance.
+func NewMsgUpdateReservationParams(authority string, reservationParams ReservationParams) *MsgUpdateReservationParams {
+	return &MsgUpdateReservationParams{
+		Authority:         authority,
+		ReservationParams: reservationParams,
+	}
+}
+
+// NewMsgReserveNamespace creates a new MsgReserveNamespace instance.
+func NewMsgReserveNamespace(owner string, namespace []byte, allowedSigners []string) *MsgReserveNamespace {
+	return &MsgReserveNamespace{
+		Owner:          owner,
+		Namespace:      namespace,
+		AllowedSigners: allowedSigners,
+	}
+}
+
+// ValidateBasic performs stateless validation of the MsgReserveNamespace.
+func (msg *MsgReserveNamespace) ValidateBasic() error {
+	if err := validateOwnerAndNamespace(msg.Owner, msg.Namespace); err != nil {
+		return err
+	}
+	return ValidateAllowedSigners(msg.AllowedSigners)
+}
+
+// NewMsgSetNamespaceSigners creates a new MsgSetNamespaceSigners instance.
+func NewMsgSetNamespaceSigners(owner string, namespace []byte, allowedSigners []string) *MsgSetNamespaceSigners {
+	return &MsgSetNamespaceSigners{
+		Owner:          owner,
+		Namespace:      namespace,
+		AllowedSigners: allowedSigners,
+	}
+}
+
+// ValidateBasic performs stateless validation of the MsgSetNamespaceSigners.
+func (msg *MsgSetNamespaceSigners) ValidateBasic() error {
+	if err := validateOwnerAndNamespace(msg.Owner, msg.Namespace); err != nil {
+		return err
+	}
+	return ValidateAllowedSigners(msg.AllowedSigners)
+}
+
+// NewMsgTransferNamespace creates a new MsgTransferNamespace instance.
+func NewMsgTransferNamespace(owner string, namespace []byte, newOwner string) *MsgTransferNamespace {
+	return &MsgTransferNamespace{
+		Owner:     owner,
+		Namespace: namespace,
+		NewOwner:  newOwner,
+	}
+}
+
+// ValidateBasic performs stateless validation of the MsgTransferNamespace.
+func (msg *MsgTransferNamespace) ValidateBasic() error {
+	if err := validateOwnerAndNamespace(msg.Owner, msg.Namespace); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.NewOwner); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new owner address: %s", err)
+	}
+	if msg.NewOwner == msg.Owner {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "new owner must differ from the owner")
+	}
+	return nil
+}
+
+// NewMsgRenewNamespace creates a new MsgRenewNamespace instance.
+func NewMsgRenewNamespace(owner string, namespace []byte) *MsgRenewNamespace {
+	return &MsgRenewNamespace{
+		Owner:     owner,
+		Namespace: namespace,
+	}
+}
+
+// ValidateBasic performs stateless validation of the MsgRenewNamespace.
+func (msg *MsgRenewNamespace) ValidateBasic() error {
+	return validateOwnerAndNamespace(msg.Owner, msg.Namespace)
+}
+
+// NewMsgReleaseNamespace creates a new MsgReleaseNamespace instance.
+func NewMsgReleaseNamespace(owner string, namespace []byte) *MsgReleaseNamespace {
+	return &MsgReleaseNamespace{
+		Owner:     owner,
+		Namespace: namespace,
+	}
+}
+
+// ValidateBasic performs stateless validation of the MsgReleaseNamespace.
+func (msg *MsgReleaseNamespace) ValidateBasic() error {
+	return validateOwnerAndNamespace(msg.Owner, msg.Namespace)
+}
+
+func validateOwnerAndNamespace(owner string, namespace []byte) error {
+	if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+	return ValidateReservableNamespace(namespace)
+}
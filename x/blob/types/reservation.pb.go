@@ -0,0 +1,901 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/blob/v1/reservation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// NamespaceReservation restricts the signers that may publish blobs to a
+// namespace until the reservation expires.
+type NamespaceReservation struct {
+	// namespace is the full reserved namespace, version included.
+	Namespace []byte `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// owner is the bech32 encoded address of the account that owns the
+	// reservation. The owner may always publish blobs to the namespace.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// allowed_signers are the bech32 encoded addresses, besides the owner, that
+	// may publish blobs to the namespace.
+	AllowedSigners []string `protobuf:"bytes,3,rep,name=allowed_signers,json=allowedSigners,proto3" json:"allowed_signers,omitempty"`
+	// deposit is held by the blob module until the reservation is released or
+	// claimed by another account after it expired.
+	Deposit types.Coin `protobuf:"bytes,4,opt,name=deposit,proto3" json:"deposit"`
+	// expiry is the block time after which the reservation is no longer
+	// enforced.
+	Expiry time.Time `protobuf:"bytes,5,opt,name=expiry,proto3,stdtime" json:"expiry"`
+}
+
+func (m *NamespaceReservation) Reset()         { *m = NamespaceReservation{} }
+func (m *NamespaceReservation) String() string { return proto.CompactTextString(m) }
+func (*NamespaceReservation) ProtoMessage()    {}
+func (*NamespaceReservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12dc8c7b87640fbc, []int{0}
+}
+func (m *NamespaceReservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceReservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceReservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceReservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceReservation.Merge(m, src)
+}
+func (m *NamespaceReservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceReservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceReservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceReservation proto.InternalMessageInfo
+
+func (m *NamespaceReservation) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *NamespaceReservation) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *NamespaceReservation) GetAllowedSigners() []string {
+	if m != nil {
+		return m.AllowedSigners
+	}
+	return nil
+}
+
+func (m *NamespaceReservation) GetDeposit() types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return types.Coin{}
+}
+
+func (m *NamespaceReservation) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
+// ReservationParams defines the cost and length of a namespace reservation.
+type ReservationParams struct {
+	// deposit is the deposit required to reserve a namespace.
+	Deposit types.Coin `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit"`
+	// duration is the time a reservation lasts before it must be renewed.
+	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration"`
+	// renewal_fee is paid to the fee collector every time a reservation is
+	// renewed. Unlike the deposit it is not refunded.
+	RenewalFee types.Coin `protobuf:"bytes,3,opt,name=renewal_fee,json=renewalFee,proto3" json:"renewal_fee"`
+	// usage_grace_period is the time during which a namespace that another
+	// account published blobs to can't be reserved. Zero disables the check.
+	UsageGracePeriod time.Duration `protobuf:"bytes,4,opt,name=usage_grace_period,json=usageGracePeriod,proto3,stdduration" json:"usage_grace_period"`
+}
+
+func (m *ReservationParams) Reset()         { *m = ReservationParams{} }
+func (m *ReservationParams) String() string { return proto.CompactTextString(m) }
+func (*ReservationParams) ProtoMessage()    {}
+func (*ReservationParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12dc8c7b87640fbc, []int{1}
+}
+func (m *ReservationParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReservationParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReservationParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReservationParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReservationParams.Merge(m, src)
+}
+func (m *ReservationParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReservationParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReservationParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReservationParams proto.InternalMessageInfo
+
+func (m *ReservationParams) GetDeposit() types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return types.Coin{}
+}
+
+func (m *ReservationParams) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *ReservationParams) GetRenewalFee() types.Coin {
+	if m != nil {
+		return m.RenewalFee
+	}
+	return types.Coin{}
+}
+
+func (m *ReservationParams) GetUsageGracePeriod() time.Duration {
+	if m != nil {
+		return m.UsageGracePeriod
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*NamespaceReservation)(nil), "celestia.blob.v1.NamespaceReservation")
+	proto.RegisterType((*ReservationParams)(nil), "celestia.blob.v1.ReservationParams")
+}
+
+func init() {
+	proto.RegisterFile("celestia/blob/v1/reservation.proto", fileDescriptor_12dc8c7b87640fbc)
+}
+
+var fileDescriptor_12dc8c7b87640fbc = []byte{
+	// 473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x31, 0x6f, 0xd3, 0x40,
+	0x18, 0x86, 0xe3, 0x24, 0x2d, 0xed, 0x15, 0x41, 0xb1, 0x32, 0xb8, 0x11, 0x72, 0xa2, 0x4c, 0x59,
+	0x7a, 0x47, 0x60, 0x42, 0x42, 0x82, 0x06, 0x04, 0x12, 0x03, 0x2a, 0x29, 0x13, 0x8b, 0x75, 0xb6,
+	0xbf, 0x1e, 0x27, 0xd9, 0xfe, 0xac, 0xbb, 0x4b, 0xd2, 0xfe, 0x8b, 0x8e, 0x8c, 0x0c, 0xfc, 0x04,
+	0x7e, 0x44, 0xc7, 0x8a, 0x89, 0x09, 0x50, 0xf2, 0x47, 0x90, 0x7d, 0xe7, 0xb6, 0x74, 0x40, 0x65,
+	0xbb, 0xef, 0xde, 0xf7, 0xd1, 0xbd, 0x7e, 0xad, 0x8f, 0x8c, 0x12, 0xc8, 0x40, 0x1b, 0xc9, 0x59,
+	0x9c, 0x61, 0xcc, 0x16, 0x13, 0xa6, 0x40, 0x83, 0x5a, 0x70, 0x23, 0xb1, 0xa0, 0xa5, 0x42, 0x83,
+	0xfe, 0x6e, 0xe3, 0xa1, 0x95, 0x87, 0x2e, 0x26, 0xfd, 0x9e, 0x40, 0x81, 0xb5, 0xc8, 0xaa, 0x93,
+	0xf5, 0xf5, 0xf7, 0x12, 0xd4, 0x39, 0xea, 0xc8, 0x0a, 0x76, 0x70, 0x52, 0x68, 0x27, 0x16, 0x73,
+	0x0d, 0x6c, 0x31, 0x89, 0xc1, 0xf0, 0x09, 0x4b, 0x50, 0x16, 0x8d, 0x2e, 0x10, 0x45, 0x06, 0xac,
+	0x9e, 0xe2, 0xf9, 0x31, 0x4b, 0xe7, 0xea, 0x5a, 0x84, 0xfe, 0xe0, 0xa6, 0x6e, 0x64, 0x0e, 0xda,
+	0xf0, 0xbc, 0xb4, 0x86, 0xd1, 0x97, 0x36, 0xe9, 0xbd, 0xe3, 0x39, 0xe8, 0x92, 0x27, 0x30, 0xbb,
+	0xfa, 0x04, 0xff, 0x21, 0xd9, 0x2e, 0x9a, 0xfb, 0xc0, 0x1b, 0x7a, 0xe3, 0xbb, 0xb3, 0xab, 0x0b,
+	0x9f, 0x92, 0x0d, 0x5c, 0x16, 0xa0, 0x82, 0xf6, 0xd0, 0x1b, 0x6f, 0x4f, 0x83, 0xef, 0xdf, 0xf6,
+	0x7b, 0x2e, 0xf8, 0x41, 0x9a, 0x2a, 0xd0, 0xfa, 0xc8, 0x28, 0x59, 0x88, 0x99, 0xb5, 0xf9, 0x07,
+	0xe4, 0x3e, 0xcf, 0x32, 0x5c, 0x42, 0x1a, 0x69, 0x29, 0x0a, 0x50, 0x3a, 0xe8, 0x0c, 0x3b, 0xff,
+	0x24, 0xef, 0x39, 0xe0, 0xc8, 0xfa, 0xfd, 0xa7, 0xe4, 0x4e, 0x0a, 0x25, 0x6a, 0x69, 0x82, 0xee,
+	0xd0, 0x1b, 0xef, 0x3c, 0xde, 0xa3, 0x8e, 0xab, 0xca, 0xa1, 0xae, 0x1c, 0xfa, 0x12, 0x65, 0x31,
+	0xed, 0x9e, 0xff, 0x1c, 0xb4, 0x66, 0x8d, 0xdf, 0x7f, 0x46, 0x36, 0xe1, 0xa4, 0x94, 0xea, 0x34,
+	0xd8, 0xa8, 0xc9, 0x3e, 0xb5, 0xb5, 0xd0, 0xa6, 0x16, 0xfa, 0xa1, 0xa9, 0x65, 0xba, 0x55, 0xa1,
+	0x67, 0xbf, 0x06, 0xde, 0xcc, 0x31, 0xa3, 0xaf, 0x6d, 0xf2, 0xe0, 0x5a, 0x33, 0x87, 0x5c, 0xf1,
+	0xfc, 0xaf, 0x38, 0xde, 0x7f, 0xc6, 0x79, 0x4e, 0xb6, 0x9a, 0xdf, 0x14, 0xb4, 0x1d, 0x7b, 0x33,
+	0xd0, 0x2b, 0x67, 0xb0, 0x79, 0x3e, 0x57, 0x79, 0x2e, 0x21, 0xff, 0x05, 0xd9, 0x51, 0x50, 0xc0,
+	0x92, 0x67, 0xd1, 0x31, 0x40, 0xd0, 0xb9, 0xdd, 0xfb, 0xc4, 0x31, 0xaf, 0x01, 0xfc, 0xf7, 0xc4,
+	0x9f, 0x6b, 0x2e, 0x20, 0x12, 0x8a, 0x27, 0x10, 0x95, 0xa0, 0x24, 0xa6, 0x41, 0xf7, 0xf6, 0x61,
+	0x76, 0x6b, 0xfc, 0x4d, 0x45, 0x1f, 0xd6, 0xf0, 0xf4, 0xed, 0xf9, 0x2a, 0xf4, 0x2e, 0x56, 0xa1,
+	0xf7, 0x7b, 0x15, 0x7a, 0x67, 0xeb, 0xb0, 0x75, 0xb1, 0x0e, 0x5b, 0x3f, 0xd6, 0x61, 0xeb, 0xe3,
+	0x23, 0x21, 0xcd, 0xa7, 0x79, 0x4c, 0x13, 0xcc, 0x59, 0xb3, 0x12, 0xa8, 0xc4, 0xe5, 0x79, 0x9f,
+	0x97, 0x25, 0x3b, 0xb1, 0x8b, 0x64, 0x4e, 0x4b, 0xd0, 0xf1, 0x66, 0xfd, 0xf4, 0x93, 0x3f, 0x03,
+	0x00, 0x67, 0x9e, 0xbd, 0xd8, 0x66, 0x03, 0x00, 0x00,
+}
+
+func (m *NamespaceReservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceReservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceReservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintReservation(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintReservation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.AllowedSigners) > 0 {
+		for iNdEx := len(m.AllowedSigners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSigners[iNdEx])
+			copy(dAtA[i:], m.AllowedSigners[iNdEx])
+			i = encodeVarintReservation(dAtA, i, uint64(len(m.AllowedSigners[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintReservation(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintReservation(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReservationParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReservationParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReservationParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UsageGracePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UsageGracePeriod):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintReservation(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.RenewalFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintReservation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintReservation(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintReservation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReservation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReservation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *NamespaceReservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovReservation(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovReservation(uint64(l))
+	}
+	if len(m.AllowedSigners) > 0 {
+		for _, s := range m.AllowedSigners {
+			l = len(s)
+			n += 1 + l + sovReservation(uint64(l))
+		}
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovReservation(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovReservation(uint64(l))
+	return n
+}
+
+func (m *ReservationParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Deposit.Size()
+	n += 1 + l + sovReservation(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovReservation(uint64(l))
+	l = m.RenewalFee.Size()
+	n += 1 + l + sovReservation(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UsageGracePeriod)
+	n += 1 + l + sovReservation(uint64(l))
+	return n
+}
+
+func sovReservation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReservation(x uint64) (n int) {
+	return sovReservation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *NamespaceReservation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReservation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceReservation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceReservation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthReservation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReservation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReservation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReservation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSigners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReservation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReservation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedSigners = append(m.AllowedSigners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReservation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReservation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReservation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReservation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReservation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReservation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReservationParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReservation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReservationParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReservationParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReservation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReservation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReservation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReservation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenewalFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReservation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReservation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RenewalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsageGracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReservation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReservation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UsageGracePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReservation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReservation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReservation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReservation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReservation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReservation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReservation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReservation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReservation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReservation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReservation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReservation = fmt.Errorf("proto: unexpected end of group")
+)
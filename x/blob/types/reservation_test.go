@@ -0,0 +1,64 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v7/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v7/x/blob/types"
+	"github.com/celestiaorg/go-square/v3/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgReserveNamespaceValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+	signer := sdk.AccAddress(bytes.Repeat([]byte{2}, 20)).String()
+	namespace := share.RandomBlobNamespace().Bytes()
+
+	tooManySigners := make([]string, types.MaxAllowedSigners+1)
+	for i := range tooManySigners {
+		tooManySigners[i] = sdk.AccAddress(bytes.Repeat([]byte{byte(i)}, 20)).String()
+	}
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgReserveNamespace
+		wantErr error
+	}{
+		{"valid", types.NewMsgReserveNamespace(owner, namespace, []string{signer}), nil},
+		{"invalid owner", types.NewMsgReserveNamespace("celestia1invalid", namespace, nil), sdkerrors.ErrInvalidAddress},
+		{"namespace too short", types.NewMsgReserveNamespace(owner, namespace[:20], nil), types.ErrInvalidNamespace},
+		{"protocol reserved namespace", types.NewMsgReserveNamespace(owner, share.TxNamespace.Bytes(), nil), types.ErrReservedNamespace},
+		{"invalid allowed signer", types.NewMsgReserveNamespace(owner, namespace, []string{"celestia1invalid"}), types.ErrInvalidAllowedSigners},
+		{"duplicate allowed signer", types.NewMsgReserveNamespace(owner, namespace, []string{signer, signer}), types.ErrInvalidAllowedSigners},
+		{"too many allowed signers", types.NewMsgReserveNamespace(owner, namespace, tooManySigners), types.ErrInvalidAllowedSigners},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+
+	require.ErrorIs(t, types.NewMsgTransferNamespace(owner, namespace, owner).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.NoError(t, types.NewMsgTransferNamespace(owner, namespace, signer).ValidateBasic())
+}
+
+func TestReservationParamsValidate(t *testing.T) {
+	coin := sdk.NewCoin(appconsts.BondDenom, math.NewInt(1))
+
+	require.NoError(t, types.DefaultReservationParams().Validate())
+	require.NoError(t, types.NewReservationParams(coin, time.Hour, sdk.NewCoin(appconsts.BondDenom, math.ZeroInt()), 0).Validate())
+	require.ErrorIs(t, types.NewReservationParams(coin, 0, coin, time.Hour).Validate(), types.ErrInvalidReservationParams)
+	require.ErrorIs(t, types.NewReservationParams(coin, time.Hour, sdk.Coin{}, time.Hour).Validate(), types.ErrInvalidReservationParams)
+	require.ErrorIs(t, types.NewReservationParams(coin, time.Hour, coin, -time.Hour).Validate(), types.ErrInvalidReservationParams)
+}
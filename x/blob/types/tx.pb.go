@@ -251,433 +251,3580 @@ func (m *MsgUpdateBlobParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateBlobParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgPayForBlobs)(nil), "celestia.blob.v1.MsgPayForBlobs")
-	proto.RegisterType((*MsgPayForBlobsResponse)(nil), "celestia.blob.v1.MsgPayForBlobsResponse")
-	proto.RegisterType((*MsgUpdateBlobParams)(nil), "celestia.blob.v1.MsgUpdateBlobParams")
-	proto.RegisterType((*MsgUpdateBlobParamsResponse)(nil), "celestia.blob.v1.MsgUpdateBlobParamsResponse")
+// MsgUpdateNamespaceParams defines the sdk.Msg type to update the per
+// namespace blob parameters.
+type MsgUpdateNamespaceParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// namespace_params replaces the current per namespace blob parameters.
+	//
+	// NOTE: All namespace parameters must be supplied.
+	NamespaceParams []NamespaceParams `protobuf:"bytes,2,rep,name=namespace_params,json=namespaceParams,proto3" json:"namespace_params"`
 }
 
-func init() { proto.RegisterFile("celestia/blob/v1/tx.proto", fileDescriptor_9157fbf3d3cd004d) }
-
-var fileDescriptor_9157fbf3d3cd004d = []byte{
-	// 521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xc1, 0x6a, 0xdb, 0x40,
-	0x10, 0xf5, 0xc6, 0xa9, 0xa9, 0xd7, 0x8d, 0x71, 0x55, 0xd3, 0xca, 0x6e, 0xac, 0x18, 0x41, 0x40,
-	0xb8, 0x58, 0x4a, 0x52, 0xe8, 0xc1, 0xb7, 0xba, 0xd0, 0x43, 0xc1, 0x10, 0x14, 0xda, 0x43, 0x2f,
-	0x66, 0x25, 0x6f, 0xd7, 0x02, 0x4b, 0x2b, 0x76, 0x36, 0x26, 0x4e, 0x2f, 0x25, 0x5f, 0x50, 0xe8,
-	0x8f, 0xe4, 0xd0, 0x8f, 0xc8, 0x31, 0xb4, 0x97, 0x9e, 0x4a, 0xb1, 0x0b, 0xb9, 0xf7, 0x0b, 0x8a,
-	0xb4, 0x52, 0xec, 0xc4, 0x81, 0xf6, 0x36, 0x7a, 0xef, 0xcd, 0x9b, 0x79, 0x9a, 0xc5, 0x0d, 0x9f,
-	0x4e, 0x28, 0xc8, 0x80, 0x38, 0xde, 0x84, 0x7b, 0xce, 0x74, 0xdf, 0x91, 0x27, 0x76, 0x2c, 0xb8,
-	0xe4, 0x5a, 0x2d, 0xa7, 0xec, 0x84, 0xb2, 0xa7, 0xfb, 0xcd, 0xd6, 0x9a, 0x38, 0x26, 0x82, 0x84,
-	0xa0, 0x1a, 0x9a, 0x75, 0xc6, 0x19, 0x4f, 0x4b, 0x27, 0xa9, 0x32, 0x74, 0x9b, 0x71, 0xce, 0x26,
-	0xd4, 0x21, 0x71, 0xe0, 0x90, 0x28, 0xe2, 0x92, 0xc8, 0x80, 0x47, 0x79, 0xcf, 0x13, 0x9f, 0x43,
-	0xc8, 0xc1, 0x09, 0x81, 0x25, 0x7e, 0x21, 0xb0, 0x8c, 0x68, 0x28, 0x62, 0xa8, 0xfc, 0xd4, 0x87,
-	0xa2, 0xcc, 0x39, 0xc2, 0xd5, 0x01, 0xb0, 0x43, 0x32, 0x7b, 0xcd, 0x45, 0x7f, 0xc2, 0x3d, 0xd0,
-	0xf6, 0x70, 0x09, 0x02, 0x16, 0x51, 0xa1, 0xa3, 0x36, 0xb2, 0xca, 0x7d, 0xfd, 0xdb, 0xd7, 0x6e,
-	0x3d, 0x6b, 0x7a, 0x39, 0x1a, 0x09, 0x0a, 0x70, 0x24, 0x45, 0x10, 0x31, 0x37, 0xd3, 0x69, 0x06,
-	0xc6, 0x11, 0x09, 0x29, 0xc4, 0xc4, 0xa7, 0xa0, 0x6f, 0xb4, 0x8b, 0xd6, 0x03, 0x77, 0x05, 0xd1,
-	0x5a, 0x18, 0x27, 0x21, 0x87, 0x10, 0x9c, 0x52, 0xd0, 0x8b, 0xed, 0xa2, 0xb5, 0xe5, 0x96, 0x13,
-	0xe4, 0x28, 0x01, 0xb4, 0x67, 0xf8, 0x21, 0x8c, 0x89, 0xa0, 0x43, 0x9f, 0x87, 0x61, 0x20, 0x43,
-	0x1a, 0x49, 0xd0, 0x37, 0x53, 0x97, 0x5a, 0x4a, 0xbc, 0x5a, 0xe2, 0xda, 0x2e, 0xae, 0x2a, 0xf1,
-	0x94, 0x0a, 0x48, 0xc2, 0xeb, 0xf7, 0x53, 0xbf, 0xad, 0x14, 0x7d, 0x97, 0x81, 0xbd, 0xca, 0xd9,
-	0xd5, 0x79, 0x27, 0xdb, 0xcf, 0xd4, 0xf1, 0xe3, 0x9b, 0x19, 0x5d, 0x0a, 0x31, 0x8f, 0x80, 0x9a,
-	0x1f, 0xf1, 0xa3, 0x01, 0xb0, 0xb7, 0xf1, 0x88, 0x48, 0x9a, 0x30, 0x87, 0xe9, 0x0d, 0xb4, 0x6d,
-	0x5c, 0x26, 0xc7, 0x72, 0xcc, 0x45, 0x20, 0x67, 0xea, 0x2f, 0xb8, 0x4b, 0x40, 0x7b, 0x81, 0x4b,
-	0xea, 0x56, 0xfa, 0x46, 0x1b, 0x59, 0x95, 0x03, 0xdd, 0xbe, 0x7d, 0x5d, 0x5b, 0xf9, 0xf4, 0x37,
-	0x2f, 0x7e, 0xee, 0x14, 0xdc, 0x4c, 0xdd, 0xab, 0x26, 0x3b, 0x2d, 0x7d, 0xcc, 0x16, 0x7e, 0x7a,
-	0xc7, 0xf0, 0x7c, 0xb7, 0x83, 0x3f, 0x08, 0x17, 0x07, 0xc0, 0xb4, 0x53, 0x5c, 0x59, 0x3d, 0x4f,
-	0x7b, 0x7d, 0xda, 0xcd, 0x70, 0x4d, 0xeb, 0x5f, 0x8a, 0xeb, 0xf8, 0x3b, 0x67, 0xdf, 0x7f, 0x7f,
-	0xd9, 0x68, 0xf4, 0x50, 0xc7, 0xac, 0xaf, 0xbc, 0xc3, 0xd9, 0x07, 0x2e, 0xbc, 0x74, 0xd8, 0x18,
-	0xd7, 0xd6, 0x7e, 0xce, 0xee, 0x9d, 0xf6, 0xb7, 0x65, 0xcd, 0xee, 0x7f, 0xc9, 0xf2, 0x55, 0x9a,
-	0xf7, 0x3e, 0x5d, 0x9d, 0x77, 0x50, 0xff, 0xcd, 0xc5, 0xdc, 0x40, 0x97, 0x73, 0x03, 0xfd, 0x9a,
-	0x1b, 0xe8, 0xf3, 0xc2, 0x28, 0x5c, 0x2e, 0x8c, 0xc2, 0x8f, 0x85, 0x51, 0x78, 0xbf, 0xc7, 0x02,
-	0x39, 0x3e, 0xf6, 0x6c, 0x9f, 0x87, 0x4e, 0xee, 0xcc, 0x05, 0xbb, 0xae, 0xbb, 0x24, 0x8e, 0x9d,
-	0x13, 0x95, 0x42, 0xce, 0x62, 0x0a, 0x5e, 0x29, 0x7d, 0xe2, 0xcf, 0xff, 0x06, 0x00, 0x00, 0xff,
-	0xff, 0xb3, 0xf0, 0x8e, 0x82, 0x98, 0x03, 0x00, 0x00,
+func (m *MsgUpdateNamespaceParams) Reset()         { *m = MsgUpdateNamespaceParams{} }
+func (m *MsgUpdateNamespaceParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateNamespaceParams) ProtoMessage()    {}
+func (*MsgUpdateNamespaceParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{4}
+}
+func (m *MsgUpdateNamespaceParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateNamespaceParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateNamespaceParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateNamespaceParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateNamespaceParams.Merge(m, src)
+}
+func (m *MsgUpdateNamespaceParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateNamespaceParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateNamespaceParams.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgUpdateNamespaceParams proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgUpdateNamespaceParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// PayForBlobs allows the user to pay for the inclusion of one or more blobs
-	PayForBlobs(ctx context.Context, in *MsgPayForBlobs, opts ...grpc.CallOption) (*MsgPayForBlobsResponse, error)
-	// UpdateBlobParams defines a rpc handler method for MsgUpdateBlobParams.
-	UpdateBlobParams(ctx context.Context, in *MsgUpdateBlobParams, opts ...grpc.CallOption) (*MsgUpdateBlobParamsResponse, error)
+func (m *MsgUpdateNamespaceParams) GetNamespaceParams() []NamespaceParams {
+	if m != nil {
+		return m.NamespaceParams
+	}
+	return nil
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+// MsgUpdateNamespaceParamsResponse defines the MsgUpdateNamespaceParams
+// response type.
+type MsgUpdateNamespaceParamsResponse struct {
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgUpdateNamespaceParamsResponse) Reset()         { *m = MsgUpdateNamespaceParamsResponse{} }
+func (m *MsgUpdateNamespaceParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateNamespaceParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateNamespaceParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{5}
+}
+func (m *MsgUpdateNamespaceParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateNamespaceParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateNamespaceParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateNamespaceParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateNamespaceParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateNamespaceParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateNamespaceParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateNamespaceParamsResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) PayForBlobs(ctx context.Context, in *MsgPayForBlobs, opts ...grpc.CallOption) (*MsgPayForBlobsResponse, error) {
-	out := new(MsgPayForBlobsResponse)
-	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Msg/PayForBlobs", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateNamespaceParamsResponse proto.InternalMessageInfo
+
+// MsgUpdateReservationParams defines the sdk.Msg type to update the namespace
+// reservation parameters.
+type MsgUpdateReservationParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// reservation_params defines the reservation parameters to update.
+	ReservationParams ReservationParams `protobuf:"bytes,2,opt,name=reservation_params,json=reservationParams,proto3" json:"reservation_params"`
+}
+
+func (m *MsgUpdateReservationParams) Reset()         { *m = MsgUpdateReservationParams{} }
+func (m *MsgUpdateReservationParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateReservationParams) ProtoMessage()    {}
+func (*MsgUpdateReservationParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{6}
+}
+func (m *MsgUpdateReservationParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateReservationParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateReservationParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateReservationParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateReservationParams.Merge(m, src)
+}
+func (m *MsgUpdateReservationParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateReservationParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateReservationParams.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateBlobParams(ctx context.Context, in *MsgUpdateBlobParams, opts ...grpc.CallOption) (*MsgUpdateBlobParamsResponse, error) {
-	out := new(MsgUpdateBlobParamsResponse)
-	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Msg/UpdateBlobParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateReservationParams proto.InternalMessageInfo
+
+func (m *MsgUpdateReservationParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// PayForBlobs allows the user to pay for the inclusion of one or more blobs
-	PayForBlobs(context.Context, *MsgPayForBlobs) (*MsgPayForBlobsResponse, error)
-	// UpdateBlobParams defines a rpc handler method for MsgUpdateBlobParams.
-	UpdateBlobParams(context.Context, *MsgUpdateBlobParams) (*MsgUpdateBlobParamsResponse, error)
+func (m *MsgUpdateReservationParams) GetReservationParams() ReservationParams {
+	if m != nil {
+		return m.ReservationParams
+	}
+	return ReservationParams{}
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgUpdateReservationParamsResponse defines the MsgUpdateReservationParams
+// response type.
+type MsgUpdateReservationParamsResponse struct {
 }
 
-func (*UnimplementedMsgServer) PayForBlobs(ctx context.Context, req *MsgPayForBlobs) (*MsgPayForBlobsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PayForBlobs not implemented")
+func (m *MsgUpdateReservationParamsResponse) Reset()         { *m = MsgUpdateReservationParamsResponse{} }
+func (m *MsgUpdateReservationParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateReservationParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateReservationParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{7}
 }
-func (*UnimplementedMsgServer) UpdateBlobParams(ctx context.Context, req *MsgUpdateBlobParams) (*MsgUpdateBlobParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateBlobParams not implemented")
+func (m *MsgUpdateReservationParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateReservationParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateReservationParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateReservationParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateReservationParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateReservationParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateReservationParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateReservationParamsResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgUpdateReservationParamsResponse proto.InternalMessageInfo
+
+// MsgReserveNamespace reserves a namespace for the owner. The reservation
+// deposit is transferred from the owner to the blob module.
+type MsgReserveNamespace struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// namespace is the full namespace, version included.
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// allowed_signers are the addresses, besides the owner, that may publish
+	// blobs to the namespace.
+	AllowedSigners []string `protobuf:"bytes,3,rep,name=allowed_signers,json=allowedSigners,proto3" json:"allowed_signers,omitempty"`
 }
 
-func _Msg_PayForBlobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPayForBlobs)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).PayForBlobs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celestia.blob.v1.Msg/PayForBlobs",
+func (m *MsgReserveNamespace) Reset()         { *m = MsgReserveNamespace{} }
+func (m *MsgReserveNamespace) String() string { return proto.CompactTextString(m) }
+func (*MsgReserveNamespace) ProtoMessage()    {}
+func (*MsgReserveNamespace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{8}
+}
+func (m *MsgReserveNamespace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReserveNamespace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReserveNamespace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PayForBlobs(ctx, req.(*MsgPayForBlobs))
+}
+func (m *MsgReserveNamespace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReserveNamespace.Merge(m, src)
+}
+func (m *MsgReserveNamespace) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReserveNamespace) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReserveNamespace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReserveNamespace proto.InternalMessageInfo
+
+func (m *MsgReserveNamespace) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateBlobParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateBlobParams)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgReserveNamespace) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateBlobParams(ctx, in)
+	return nil
+}
+
+func (m *MsgReserveNamespace) GetAllowedSigners() []string {
+	if m != nil {
+		return m.AllowedSigners
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celestia.blob.v1.Msg/UpdateBlobParams",
+	return nil
+}
+
+// MsgReserveNamespaceResponse defines the MsgReserveNamespace response type.
+type MsgReserveNamespaceResponse struct {
+	Reservation NamespaceReservation `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation"`
+}
+
+func (m *MsgReserveNamespaceResponse) Reset()         { *m = MsgReserveNamespaceResponse{} }
+func (m *MsgReserveNamespaceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReserveNamespaceResponse) ProtoMessage()    {}
+func (*MsgReserveNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{9}
+}
+func (m *MsgReserveNamespaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReserveNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReserveNamespaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateBlobParams(ctx, req.(*MsgUpdateBlobParams))
+}
+func (m *MsgReserveNamespaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReserveNamespaceResponse.Merge(m, src)
+}
+func (m *MsgReserveNamespaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReserveNamespaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReserveNamespaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReserveNamespaceResponse proto.InternalMessageInfo
+
+func (m *MsgReserveNamespaceResponse) GetReservation() NamespaceReservation {
+	if m != nil {
+		return m.Reservation
 	}
-	return interceptor(ctx, in, info, handler)
+	return NamespaceReservation{}
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "celestia.blob.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "PayForBlobs",
-			Handler:    _Msg_PayForBlobs_Handler,
-		},
-		{
-			MethodName: "UpdateBlobParams",
-			Handler:    _Msg_UpdateBlobParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "celestia/blob/v1/tx.proto",
+// MsgSetNamespaceSigners replaces the allowed signers of a reserved namespace.
+type MsgSetNamespaceSigners struct {
+	Owner          string   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Namespace      []byte   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	AllowedSigners []string `protobuf:"bytes,3,rep,name=allowed_signers,json=allowedSigners,proto3" json:"allowed_signers,omitempty"`
 }
 
-func (m *MsgPayForBlobs) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetNamespaceSigners) Reset()         { *m = MsgSetNamespaceSigners{} }
+func (m *MsgSetNamespaceSigners) String() string { return proto.CompactTextString(m) }
+func (*MsgSetNamespaceSigners) ProtoMessage()    {}
+func (*MsgSetNamespaceSigners) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{10}
+}
+func (m *MsgSetNamespaceSigners) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetNamespaceSigners) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetNamespaceSigners.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgPayForBlobs) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetNamespaceSigners) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetNamespaceSigners.Merge(m, src)
+}
+func (m *MsgSetNamespaceSigners) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetNamespaceSigners) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetNamespaceSigners.DiscardUnknown(m)
 }
 
-func (m *MsgPayForBlobs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ShareVersions) > 0 {
-		dAtA2 := make([]byte, len(m.ShareVersions)*10)
-		var j1 int
-		for _, num := range m.ShareVersions {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
-		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintTx(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.ShareCommitments) > 0 {
-		for iNdEx := len(m.ShareCommitments) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ShareCommitments[iNdEx])
-			copy(dAtA[i:], m.ShareCommitments[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.ShareCommitments[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.BlobSizes) > 0 {
-		dAtA4 := make([]byte, len(m.BlobSizes)*10)
-		var j3 int
-		for _, num := range m.BlobSizes {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
-		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintTx(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Namespaces) > 0 {
-		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Namespaces[iNdEx])
-			copy(dAtA[i:], m.Namespaces[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Namespaces[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgSetNamespaceSigners proto.InternalMessageInfo
+
+func (m *MsgSetNamespaceSigners) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgPayForBlobsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetNamespaceSigners) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgPayForBlobsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetNamespaceSigners) GetAllowedSigners() []string {
+	if m != nil {
+		return m.AllowedSigners
+	}
+	return nil
 }
 
-func (m *MsgPayForBlobsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgSetNamespaceSignersResponse defines the MsgSetNamespaceSigners response
+// type.
+type MsgSetNamespaceSignersResponse struct {
 }
 
-func (m *MsgUpdateBlobParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetNamespaceSignersResponse) Reset()         { *m = MsgSetNamespaceSignersResponse{} }
+func (m *MsgSetNamespaceSignersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetNamespaceSignersResponse) ProtoMessage()    {}
+func (*MsgSetNamespaceSignersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{11}
+}
+func (m *MsgSetNamespaceSignersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetNamespaceSignersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetNamespaceSignersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetNamespaceSignersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetNamespaceSignersResponse.Merge(m, src)
+}
+func (m *MsgSetNamespaceSignersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetNamespaceSignersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetNamespaceSignersResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateBlobParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetNamespaceSignersResponse proto.InternalMessageInfo
+
+// MsgTransferNamespace transfers the ownership of a reserved namespace, and
+// with it the claim on the deposit, to new_owner.
+type MsgTransferNamespace struct {
+	Owner     string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	NewOwner  string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
 }
 
-func (m *MsgUpdateBlobParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgTransferNamespace) Reset()         { *m = MsgTransferNamespace{} }
+func (m *MsgTransferNamespace) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferNamespace) ProtoMessage()    {}
+func (*MsgTransferNamespace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{12}
+}
+func (m *MsgTransferNamespace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferNamespace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferNamespace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgTransferNamespace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferNamespace.Merge(m, src)
+}
+func (m *MsgTransferNamespace) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferNamespace) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferNamespace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferNamespace proto.InternalMessageInfo
+
+func (m *MsgTransferNamespace) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateBlobParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTransferNamespace) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUpdateBlobParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTransferNamespace) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
 }
 
-func (m *MsgUpdateBlobParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgTransferNamespaceResponse defines the MsgTransferNamespace response type.
+type MsgTransferNamespaceResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgTransferNamespaceResponse) Reset()         { *m = MsgTransferNamespaceResponse{} }
+func (m *MsgTransferNamespaceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferNamespaceResponse) ProtoMessage()    {}
+func (*MsgTransferNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{13}
 }
-func (m *MsgPayForBlobs) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Namespaces) > 0 {
-		for _, b := range m.Namespaces {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.BlobSizes) > 0 {
-		l = 0
-		for _, e := range m.BlobSizes {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
-	}
-	if len(m.ShareCommitments) > 0 {
-		for _, b := range m.ShareCommitments {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgTransferNamespaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferNamespaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ShareVersions) > 0 {
-		l = 0
-		for _, e := range m.ShareVersions {
-			l += sovTx(uint64(e))
+}
+func (m *MsgTransferNamespaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferNamespaceResponse.Merge(m, src)
+}
+func (m *MsgTransferNamespaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferNamespaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferNamespaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferNamespaceResponse proto.InternalMessageInfo
+
+// MsgRenewNamespace extends the expiry of a namespace reservation by the
+// reservation duration.
+type MsgRenewNamespace struct {
+	Owner     string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *MsgRenewNamespace) Reset()         { *m = MsgRenewNamespace{} }
+func (m *MsgRenewNamespace) String() string { return proto.CompactTextString(m) }
+func (*MsgRenewNamespace) ProtoMessage()    {}
+func (*MsgRenewNamespace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{14}
+}
+func (m *MsgRenewNamespace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenewNamespace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenewNamespace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		n += 1 + sovTx(uint64(l)) + l
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgRenewNamespace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenewNamespace.Merge(m, src)
+}
+func (m *MsgRenewNamespace) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenewNamespace) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenewNamespace.DiscardUnknown(m)
 }
 
-func (m *MsgPayForBlobsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgRenewNamespace proto.InternalMessageInfo
+
+func (m *MsgRenewNamespace) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgUpdateBlobParams) Size() (n int) {
+func (m *MsgRenewNamespace) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+// MsgRenewNamespaceResponse defines the MsgRenewNamespace response type.
+type MsgRenewNamespaceResponse struct {
+	Reservation NamespaceReservation `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation"`
+}
+
+func (m *MsgRenewNamespaceResponse) Reset()         { *m = MsgRenewNamespaceResponse{} }
+func (m *MsgRenewNamespaceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRenewNamespaceResponse) ProtoMessage()    {}
+func (*MsgRenewNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{15}
+}
+func (m *MsgRenewNamespaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenewNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenewNamespaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRenewNamespaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenewNamespaceResponse.Merge(m, src)
+}
+func (m *MsgRenewNamespaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenewNamespaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenewNamespaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRenewNamespaceResponse proto.InternalMessageInfo
+
+func (m *MsgRenewNamespaceResponse) GetReservation() NamespaceReservation {
+	if m != nil {
+		return m.Reservation
+	}
+	return NamespaceReservation{}
+}
+
+// MsgReleaseNamespace releases a namespace reservation and refunds its deposit
+// to the owner.
+type MsgReleaseNamespace struct {
+	Owner     string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *MsgReleaseNamespace) Reset()         { *m = MsgReleaseNamespace{} }
+func (m *MsgReleaseNamespace) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseNamespace) ProtoMessage()    {}
+func (*MsgReleaseNamespace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{16}
+}
+func (m *MsgReleaseNamespace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseNamespace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseNamespace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReleaseNamespace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseNamespace.Merge(m, src)
+}
+func (m *MsgReleaseNamespace) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseNamespace) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseNamespace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReleaseNamespace proto.InternalMessageInfo
+
+func (m *MsgReleaseNamespace) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgReleaseNamespace) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+// MsgReleaseNamespaceResponse defines the MsgReleaseNamespace response type.
+type MsgReleaseNamespaceResponse struct {
+}
+
+func (m *MsgReleaseNamespaceResponse) Reset()         { *m = MsgReleaseNamespaceResponse{} }
+func (m *MsgReleaseNamespaceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseNamespaceResponse) ProtoMessage()    {}
+func (*MsgReleaseNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{17}
+}
+func (m *MsgReleaseNamespaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseNamespaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReleaseNamespaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseNamespaceResponse.Merge(m, src)
+}
+func (m *MsgReleaseNamespaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseNamespaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseNamespaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReleaseNamespaceResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgPayForBlobs)(nil), "celestia.blob.v1.MsgPayForBlobs")
+	proto.RegisterType((*MsgPayForBlobsResponse)(nil), "celestia.blob.v1.MsgPayForBlobsResponse")
+	proto.RegisterType((*MsgUpdateBlobParams)(nil), "celestia.blob.v1.MsgUpdateBlobParams")
+	proto.RegisterType((*MsgUpdateBlobParamsResponse)(nil), "celestia.blob.v1.MsgUpdateBlobParamsResponse")
+	proto.RegisterType((*MsgUpdateNamespaceParams)(nil), "celestia.blob.v1.MsgUpdateNamespaceParams")
+	proto.RegisterType((*MsgUpdateNamespaceParamsResponse)(nil), "celestia.blob.v1.MsgUpdateNamespaceParamsResponse")
+	proto.RegisterType((*MsgUpdateReservationParams)(nil), "celestia.blob.v1.MsgUpdateReservationParams")
+	proto.RegisterType((*MsgUpdateReservationParamsResponse)(nil), "celestia.blob.v1.MsgUpdateReservationParamsResponse")
+	proto.RegisterType((*MsgReserveNamespace)(nil), "celestia.blob.v1.MsgReserveNamespace")
+	proto.RegisterType((*MsgReserveNamespaceResponse)(nil), "celestia.blob.v1.MsgReserveNamespaceResponse")
+	proto.RegisterType((*MsgSetNamespaceSigners)(nil), "celestia.blob.v1.MsgSetNamespaceSigners")
+	proto.RegisterType((*MsgSetNamespaceSignersResponse)(nil), "celestia.blob.v1.MsgSetNamespaceSignersResponse")
+	proto.RegisterType((*MsgTransferNamespace)(nil), "celestia.blob.v1.MsgTransferNamespace")
+	proto.RegisterType((*MsgTransferNamespaceResponse)(nil), "celestia.blob.v1.MsgTransferNamespaceResponse")
+	proto.RegisterType((*MsgRenewNamespace)(nil), "celestia.blob.v1.MsgRenewNamespace")
+	proto.RegisterType((*MsgRenewNamespaceResponse)(nil), "celestia.blob.v1.MsgRenewNamespaceResponse")
+	proto.RegisterType((*MsgReleaseNamespace)(nil), "celestia.blob.v1.MsgReleaseNamespace")
+	proto.RegisterType((*MsgReleaseNamespaceResponse)(nil), "celestia.blob.v1.MsgReleaseNamespaceResponse")
+}
+
+func init() { proto.RegisterFile("celestia/blob/v1/tx.proto", fileDescriptor_9157fbf3d3cd004d) }
+
+var fileDescriptor_9157fbf3d3cd004d = []byte{
+	// 917 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x8f, 0xdb, 0x44,
+	0x14, 0x5e, 0x6f, 0xda, 0xd5, 0xee, 0x4b, 0x9b, 0x66, 0xdd, 0x85, 0x7a, 0xbd, 0xbb, 0x6e, 0x70,
+	0x69, 0x15, 0x6d, 0x89, 0xbd, 0x0d, 0x3f, 0x0e, 0x7b, 0xeb, 0x22, 0x71, 0x40, 0xda, 0x52, 0x39,
+	0x80, 0x10, 0x97, 0x68, 0x92, 0x4c, 0x1d, 0xab, 0xb1, 0xc7, 0xcc, 0xb8, 0x49, 0x53, 0x84, 0x84,
+	0xf6, 0x2f, 0x40, 0xe2, 0xca, 0x11, 0x89, 0x6b, 0x85, 0xe0, 0x7f, 0xe8, 0xb1, 0x82, 0x0b, 0x27,
+	0x84, 0xb2, 0x48, 0xfd, 0x37, 0x90, 0x3d, 0xce, 0xc4, 0xbf, 0x92, 0x9a, 0xc3, 0x22, 0x6e, 0xce,
+	0x7b, 0xdf, 0x7c, 0xef, 0xfb, 0xde, 0xbc, 0x99, 0x09, 0xec, 0xf6, 0xf1, 0x08, 0xb3, 0xc0, 0x41,
+	0x66, 0x6f, 0x44, 0x7a, 0xe6, 0xf8, 0x9e, 0x19, 0x3c, 0x35, 0x7c, 0x4a, 0x02, 0x22, 0xd7, 0xe7,
+	0x29, 0x23, 0x4c, 0x19, 0xe3, 0x7b, 0xea, 0x41, 0x0e, 0xec, 0x23, 0x8a, 0x5c, 0xc6, 0x17, 0xa8,
+	0x7a, 0x2e, 0x4d, 0x31, 0xc3, 0x74, 0x8c, 0x02, 0x87, 0x78, 0x31, 0x66, 0xc7, 0x26, 0x36, 0x89,
+	0x3e, 0xcd, 0xf0, 0x2b, 0x8e, 0xee, 0xdb, 0x84, 0xd8, 0x23, 0x6c, 0x22, 0xdf, 0x31, 0x91, 0xe7,
+	0x91, 0x20, 0x5a, 0x32, 0xe7, 0xbd, 0xd1, 0x27, 0xcc, 0x25, 0xcc, 0x74, 0x99, 0x1d, 0x92, 0xba,
+	0xcc, 0x8e, 0x13, 0xbb, 0x3c, 0xd1, 0xe5, 0x7c, 0xfc, 0x07, 0x4f, 0xe9, 0x33, 0x09, 0x6a, 0xa7,
+	0xcc, 0x7e, 0x88, 0xa6, 0x1f, 0x11, 0x7a, 0x32, 0x22, 0x3d, 0x26, 0x1f, 0xc1, 0x06, 0x73, 0x6c,
+	0x0f, 0x53, 0x45, 0x6a, 0x48, 0xcd, 0xad, 0x13, 0xe5, 0xb7, 0x5f, 0x5a, 0x3b, 0xf1, 0xa2, 0xfb,
+	0x83, 0x01, 0xc5, 0x8c, 0x75, 0x02, 0xea, 0x78, 0xb6, 0x15, 0xe3, 0x64, 0x0d, 0xc0, 0x43, 0x2e,
+	0x66, 0x3e, 0xea, 0x63, 0xa6, 0xac, 0x37, 0x2a, 0xcd, 0x2b, 0x56, 0x22, 0x22, 0x1f, 0x00, 0x84,
+	0x4e, 0xbb, 0xcc, 0x79, 0x86, 0x99, 0x52, 0x69, 0x54, 0x9a, 0x57, 0xad, 0xad, 0x30, 0xd2, 0x09,
+	0x03, 0xf2, 0x5d, 0xd8, 0x66, 0x43, 0x44, 0x71, 0xb7, 0x4f, 0x5c, 0xd7, 0x09, 0x5c, 0xec, 0x05,
+	0x4c, 0xb9, 0x14, 0xb1, 0xd4, 0xa3, 0xc4, 0x87, 0x8b, 0xb8, 0x7c, 0x1b, 0x6a, 0x1c, 0x3c, 0xc6,
+	0x94, 0x85, 0xe6, 0x95, 0xcd, 0x88, 0xef, 0x6a, 0x14, 0xfd, 0x3c, 0x0e, 0x1e, 0x57, 0xcf, 0x5e,
+	0x3d, 0x3f, 0x8c, 0xf5, 0xe9, 0x0a, 0xbc, 0x99, 0xf6, 0x68, 0x61, 0xe6, 0x13, 0x8f, 0x61, 0xfd,
+	0x6b, 0xb8, 0x7e, 0xca, 0xec, 0xcf, 0xfc, 0x01, 0x0a, 0x70, 0x98, 0x79, 0x18, 0xed, 0x93, 0xbc,
+	0x0f, 0x5b, 0xe8, 0x49, 0x30, 0x24, 0xd4, 0x09, 0xa6, 0xbc, 0x0b, 0xd6, 0x22, 0x20, 0x7f, 0x00,
+	0x1b, 0x7c, 0x3f, 0x95, 0xf5, 0x86, 0xd4, 0xac, 0xb6, 0x15, 0x23, 0x3b, 0x01, 0x06, 0xe7, 0x39,
+	0xb9, 0xf4, 0xe2, 0xcf, 0x9b, 0x6b, 0x56, 0x8c, 0x3e, 0xae, 0x85, 0x9a, 0x16, 0x3c, 0xfa, 0x01,
+	0xec, 0x15, 0x14, 0x17, 0xda, 0x7e, 0x90, 0x40, 0x11, 0xf9, 0x07, 0xf3, 0x6e, 0x96, 0x52, 0x68,
+	0x41, 0x5d, 0xb4, 0xbf, 0x2b, 0xb4, 0x56, 0x9a, 0xd5, 0xf6, 0x5b, 0x79, 0xad, 0x19, 0xea, 0x58,
+	0xf4, 0x35, 0x2f, 0x1d, 0xce, 0xa9, 0xd7, 0xa1, 0xb1, 0x4c, 0x9d, 0xb0, 0xf0, 0xa3, 0x04, 0xaa,
+	0x00, 0x59, 0x8b, 0x21, 0x2f, 0x65, 0xe2, 0x0b, 0x90, 0x13, 0xe7, 0xa2, 0x9b, 0x6a, 0xf9, 0xad,
+	0xbc, 0x8d, 0x1c, 0x7d, 0x6c, 0x64, 0x9b, 0x66, 0x13, 0x39, 0x2b, 0x6f, 0x83, 0xbe, 0x5c, 0xa5,
+	0x30, 0xf3, 0xb3, 0x14, 0x0d, 0x0b, 0x07, 0x2c, 0x2c, 0xcb, 0x06, 0x5c, 0x26, 0x93, 0x32, 0xc7,
+	0x85, 0xc3, 0x42, 0xd7, 0xa2, 0xb7, 0x91, 0x9d, 0x2b, 0xd6, 0x22, 0x20, 0xdf, 0x87, 0x6b, 0x68,
+	0x34, 0x22, 0x13, 0x3c, 0xe8, 0xf2, 0xe9, 0xe5, 0x07, 0x66, 0x15, 0x6f, 0x2d, 0x5e, 0xd0, 0xe1,
+	0xf8, 0x63, 0x08, 0xed, 0xf1, 0x62, 0xba, 0x0b, 0x7b, 0x05, 0x9a, 0xe7, 0x9e, 0xe4, 0x07, 0x50,
+	0x4d, 0xb4, 0x27, 0x72, 0x50, 0x6d, 0xdf, 0x59, 0x31, 0x23, 0x89, 0xf6, 0xc4, 0xfd, 0x4d, 0x12,
+	0xe8, 0xbf, 0x4a, 0xd1, 0x51, 0xeb, 0xe0, 0x40, 0xac, 0x88, 0x55, 0xfd, 0xbf, 0xdb, 0xd4, 0x00,
+	0xad, 0x58, 0xb6, 0xd8, 0xfd, 0x9f, 0x24, 0xd8, 0x39, 0x65, 0xf6, 0xa7, 0x14, 0x79, 0xec, 0x11,
+	0xa6, 0x17, 0xb5, 0xfd, 0xef, 0xc3, 0x96, 0x87, 0x27, 0x5d, 0xce, 0x58, 0x79, 0x0d, 0xe3, 0xa6,
+	0x87, 0x27, 0x9f, 0x84, 0xc8, 0x94, 0x17, 0x0d, 0xf6, 0x8b, 0x84, 0x0a, 0x27, 0x2e, 0x6c, 0x47,
+	0x23, 0xe1, 0xe1, 0xc9, 0x05, 0xb9, 0x48, 0xc9, 0x79, 0x0c, 0xbb, 0xb9, 0x72, 0x17, 0x36, 0x7f,
+	0x24, 0x3e, 0xa2, 0x23, 0x8c, 0x18, 0xfe, 0x2f, 0xdc, 0x1d, 0xc0, 0x5e, 0x41, 0xc1, 0xb9, 0xbf,
+	0xf6, 0xd9, 0x26, 0x54, 0x4e, 0x99, 0x2d, 0x3f, 0x83, 0x6a, 0xf2, 0x89, 0x6d, 0xe4, 0x1d, 0xa6,
+	0x1f, 0x28, 0xb5, 0xf9, 0x3a, 0x84, 0xd8, 0xce, 0x9b, 0x67, 0xbf, 0xff, 0xfd, 0xfd, 0xfa, 0xae,
+	0xbe, 0x93, 0xf8, 0xb3, 0x31, 0x7d, 0x44, 0x68, 0xf8, 0x8b, 0x1d, 0x4b, 0x87, 0xf2, 0x10, 0xea,
+	0xb9, 0x07, 0xee, 0x76, 0x21, 0x7d, 0x16, 0xa6, 0xb6, 0x4a, 0xc1, 0xc4, 0x6e, 0x4e, 0xe0, 0x8d,
+	0xe2, 0xd7, 0xea, 0x70, 0x05, 0x4f, 0x06, 0xab, 0xb6, 0xcb, 0x63, 0x45, 0xe1, 0x6f, 0xe0, 0xc6,
+	0xb2, 0x37, 0xe6, 0x9d, 0x15, 0x74, 0x39, 0xb4, 0xfa, 0xde, 0xbf, 0x41, 0x8b, 0xf2, 0x43, 0xa8,
+	0xe7, 0x5e, 0x85, 0xe2, 0x0e, 0x67, 0x61, 0x6a, 0xab, 0x14, 0x4c, 0x54, 0xfa, 0x0a, 0xae, 0x17,
+	0xdd, 0xad, 0xc5, 0xd3, 0x52, 0x80, 0x54, 0x8f, 0xca, 0x22, 0x45, 0xc9, 0xc7, 0xb0, 0x9d, 0xbf,
+	0xf4, 0xee, 0x14, 0xd2, 0xe4, 0x70, 0xaa, 0x51, 0x0e, 0x27, 0x8a, 0xf5, 0xa0, 0x96, 0xb9, 0x98,
+	0x6e, 0x2d, 0x69, 0x50, 0x12, 0xa4, 0xde, 0x2d, 0x01, 0x4a, 0xef, 0x56, 0xe6, 0x82, 0x58, 0xb6,
+	0x5b, 0x69, 0x98, 0xda, 0x2a, 0x05, 0x9b, 0x57, 0x52, 0x2f, 0x7f, 0xfb, 0xea, 0xf9, 0xa1, 0x74,
+	0xf2, 0xf1, 0x8b, 0x99, 0x26, 0xbd, 0x9c, 0x69, 0xd2, 0x5f, 0x33, 0x4d, 0xfa, 0xee, 0x5c, 0x5b,
+	0x7b, 0x79, 0xae, 0xad, 0xfd, 0x71, 0xae, 0xad, 0x7d, 0x79, 0x64, 0x3b, 0xc1, 0xf0, 0x49, 0xcf,
+	0xe8, 0x13, 0xd7, 0x9c, 0x33, 0x13, 0x6a, 0x8b, 0xef, 0x16, 0xf2, 0x7d, 0xf3, 0x29, 0x3f, 0xd8,
+	0xc1, 0xd4, 0xc7, 0xac, 0xb7, 0x11, 0xfd, 0x6d, 0x7f, 0xf7, 0x9f, 0x01, 0x00, 0xf3, 0x12, 0xc8,
+	0x5d, 0x90, 0x0c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// PayForBlobs allows the user to pay for the inclusion of one or more blobs
+	PayForBlobs(ctx context.Context, in *MsgPayForBlobs, opts ...grpc.CallOption) (*MsgPayForBlobsResponse, error)
+	// UpdateBlobParams defines a rpc handler method for MsgUpdateBlobParams.
+	UpdateBlobParams(ctx context.Context, in *MsgUpdateBlobParams, opts ...grpc.CallOption) (*MsgUpdateBlobParamsResponse, error)
+	// UpdateNamespaceParams defines a rpc handler method for
+	// MsgUpdateNamespaceParams.
+	UpdateNamespaceParams(ctx context.Context, in *MsgUpdateNamespaceParams, opts ...grpc.CallOption) (*MsgUpdateNamespaceParamsResponse, error)
+	// UpdateReservationParams defines a rpc handler method for
+	// MsgUpdateReservationParams.
+	UpdateReservationParams(ctx context.Context, in *MsgUpdateReservationParams, opts ...grpc.CallOption) (*MsgUpdateReservationParamsResponse, error)
+	// ReserveNamespace reserves a namespace for the signer.
+	ReserveNamespace(ctx context.Context, in *MsgReserveNamespace, opts ...grpc.CallOption) (*MsgReserveNamespaceResponse, error)
+	// SetNamespaceSigners replaces the allowed signers of a reserved namespace.
+	SetNamespaceSigners(ctx context.Context, in *MsgSetNamespaceSigners, opts ...grpc.CallOption) (*MsgSetNamespaceSignersResponse, error)
+	// TransferNamespace transfers the ownership of a reserved namespace.
+	TransferNamespace(ctx context.Context, in *MsgTransferNamespace, opts ...grpc.CallOption) (*MsgTransferNamespaceResponse, error)
+	// RenewNamespace extends the expiry of a namespace reservation.
+	RenewNamespace(ctx context.Context, in *MsgRenewNamespace, opts ...grpc.CallOption) (*MsgRenewNamespaceResponse, error)
+	// ReleaseNamespace releases a namespace reservation and refunds its
+	// deposit.
+	ReleaseNamespace(ctx context.Context, in *MsgReleaseNamespace, opts ...grpc.CallOption) (*MsgReleaseNamespaceResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) PayForBlobs(ctx context.Context, in *MsgPayForBlobs, opts ...grpc.CallOption) (*MsgPayForBlobsResponse, error) {
+	out := new(MsgPayForBlobsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Msg/PayForBlobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateBlobParams(ctx context.Context, in *MsgUpdateBlobParams, opts ...grpc.CallOption) (*MsgUpdateBlobParamsResponse, error) {
+	out := new(MsgUpdateBlobParamsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Msg/UpdateBlobParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateNamespaceParams(ctx context.Context, in *MsgUpdateNamespaceParams, opts ...grpc.CallOption) (*MsgUpdateNamespaceParamsResponse, error) {
+	out := new(MsgUpdateNamespaceParamsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Msg/UpdateNamespaceParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateReservationParams(ctx context.Context, in *MsgUpdateReservationParams, opts ...grpc.CallOption) (*MsgUpdateReservationParamsResponse, error) {
+	out := new(MsgUpdateReservationParamsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Msg/UpdateReservationParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReserveNamespace(ctx context.Context, in *MsgReserveNamespace, opts ...grpc.CallOption) (*MsgReserveNamespaceResponse, error) {
+	out := new(MsgReserveNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Msg/ReserveNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetNamespaceSigners(ctx context.Context, in *MsgSetNamespaceSigners, opts ...grpc.CallOption) (*MsgSetNamespaceSignersResponse, error) {
+	out := new(MsgSetNamespaceSignersResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Msg/SetNamespaceSigners", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferNamespace(ctx context.Context, in *MsgTransferNamespace, opts ...grpc.CallOption) (*MsgTransferNamespaceResponse, error) {
+	out := new(MsgTransferNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Msg/TransferNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RenewNamespace(ctx context.Context, in *MsgRenewNamespace, opts ...grpc.CallOption) (*MsgRenewNamespaceResponse, error) {
+	out := new(MsgRenewNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Msg/RenewNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReleaseNamespace(ctx context.Context, in *MsgReleaseNamespace, opts ...grpc.CallOption) (*MsgReleaseNamespaceResponse, error) {
+	out := new(MsgReleaseNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Msg/ReleaseNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// PayForBlobs allows the user to pay for the inclusion of one or more blobs
+	PayForBlobs(context.Context, *MsgPayForBlobs) (*MsgPayForBlobsResponse, error)
+	// UpdateBlobParams defines a rpc handler method for MsgUpdateBlobParams.
+	UpdateBlobParams(context.Context, *MsgUpdateBlobParams) (*MsgUpdateBlobParamsResponse, error)
+	// UpdateNamespaceParams defines a rpc handler method for
+	// MsgUpdateNamespaceParams.
+	UpdateNamespaceParams(context.Context, *MsgUpdateNamespaceParams) (*MsgUpdateNamespaceParamsResponse, error)
+	// UpdateReservationParams defines a rpc handler method for
+	// MsgUpdateReservationParams.
+	UpdateReservationParams(context.Context, *MsgUpdateReservationParams) (*MsgUpdateReservationParamsResponse, error)
+	// ReserveNamespace reserves a namespace for the signer.
+	ReserveNamespace(context.Context, *MsgReserveNamespace) (*MsgReserveNamespaceResponse, error)
+	// SetNamespaceSigners replaces the allowed signers of a reserved namespace.
+	SetNamespaceSigners(context.Context, *MsgSetNamespaceSigners) (*MsgSetNamespaceSignersResponse, error)
+	// TransferNamespace transfers the ownership of a reserved namespace.
+	TransferNamespace(context.Context, *MsgTransferNamespace) (*MsgTransferNamespaceResponse, error)
+	// RenewNamespace extends the expiry of a namespace reservation.
+	RenewNamespace(context.Context, *MsgRenewNamespace) (*MsgRenewNamespaceResponse, error)
+	// ReleaseNamespace releases a namespace reservation and refunds its
+	// deposit.
+	ReleaseNamespace(context.Context, *MsgReleaseNamespace) (*MsgReleaseNamespaceResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) PayForBlobs(ctx context.Context, req *MsgPayForBlobs) (*MsgPayForBlobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayForBlobs not implemented")
+}
+func (*UnimplementedMsgServer) UpdateBlobParams(ctx context.Context, req *MsgUpdateBlobParams) (*MsgUpdateBlobParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBlobParams not implemented")
+}
+func (*UnimplementedMsgServer) UpdateNamespaceParams(ctx context.Context, req *MsgUpdateNamespaceParams) (*MsgUpdateNamespaceParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNamespaceParams not implemented")
+}
+func (*UnimplementedMsgServer) UpdateReservationParams(ctx context.Context, req *MsgUpdateReservationParams) (*MsgUpdateReservationParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReservationParams not implemented")
+}
+func (*UnimplementedMsgServer) ReserveNamespace(ctx context.Context, req *MsgReserveNamespace) (*MsgReserveNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveNamespace not implemented")
+}
+func (*UnimplementedMsgServer) SetNamespaceSigners(ctx context.Context, req *MsgSetNamespaceSigners) (*MsgSetNamespaceSignersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNamespaceSigners not implemented")
+}
+func (*UnimplementedMsgServer) TransferNamespace(ctx context.Context, req *MsgTransferNamespace) (*MsgTransferNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferNamespace not implemented")
+}
+func (*UnimplementedMsgServer) RenewNamespace(ctx context.Context, req *MsgRenewNamespace) (*MsgRenewNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewNamespace not implemented")
+}
+func (*UnimplementedMsgServer) ReleaseNamespace(ctx context.Context, req *MsgReleaseNamespace) (*MsgReleaseNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseNamespace not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_PayForBlobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPayForBlobs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PayForBlobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Msg/PayForBlobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PayForBlobs(ctx, req.(*MsgPayForBlobs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateBlobParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBlobParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBlobParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Msg/UpdateBlobParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBlobParams(ctx, req.(*MsgUpdateBlobParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateNamespaceParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateNamespaceParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateNamespaceParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Msg/UpdateNamespaceParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateNamespaceParams(ctx, req.(*MsgUpdateNamespaceParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateReservationParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateReservationParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateReservationParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Msg/UpdateReservationParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateReservationParams(ctx, req.(*MsgUpdateReservationParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReserveNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReserveNamespace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReserveNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Msg/ReserveNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReserveNamespace(ctx, req.(*MsgReserveNamespace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetNamespaceSigners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetNamespaceSigners)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetNamespaceSigners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Msg/SetNamespaceSigners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetNamespaceSigners(ctx, req.(*MsgSetNamespaceSigners))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferNamespace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Msg/TransferNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferNamespace(ctx, req.(*MsgTransferNamespace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RenewNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenewNamespace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RenewNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Msg/RenewNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenewNamespace(ctx, req.(*MsgRenewNamespace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReleaseNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReleaseNamespace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReleaseNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Msg/ReleaseNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReleaseNamespace(ctx, req.(*MsgReleaseNamespace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.blob.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PayForBlobs",
+			Handler:    _Msg_PayForBlobs_Handler,
+		},
+		{
+			MethodName: "UpdateBlobParams",
+			Handler:    _Msg_UpdateBlobParams_Handler,
+		},
+		{
+			MethodName: "UpdateNamespaceParams",
+			Handler:    _Msg_UpdateNamespaceParams_Handler,
+		},
+		{
+			MethodName: "UpdateReservationParams",
+			Handler:    _Msg_UpdateReservationParams_Handler,
+		},
+		{
+			MethodName: "ReserveNamespace",
+			Handler:    _Msg_ReserveNamespace_Handler,
+		},
+		{
+			MethodName: "SetNamespaceSigners",
+			Handler:    _Msg_SetNamespaceSigners_Handler,
+		},
+		{
+			MethodName: "TransferNamespace",
+			Handler:    _Msg_TransferNamespace_Handler,
+		},
+		{
+			MethodName: "RenewNamespace",
+			Handler:    _Msg_RenewNamespace_Handler,
+		},
+		{
+			MethodName: "ReleaseNamespace",
+			Handler:    _Msg_ReleaseNamespace_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/blob/v1/tx.proto",
+}
+
+func (m *MsgPayForBlobs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPayForBlobs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPayForBlobs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ShareVersions) > 0 {
+		dAtA2 := make([]byte, len(m.ShareVersions)*10)
+		var j1 int
+		for _, num := range m.ShareVersions {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTx(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ShareCommitments) > 0 {
+		for iNdEx := len(m.ShareCommitments) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ShareCommitments[iNdEx])
+			copy(dAtA[i:], m.ShareCommitments[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ShareCommitments[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.BlobSizes) > 0 {
+		dAtA4 := make([]byte, len(m.BlobSizes)*10)
+		var j3 int
+		for _, num := range m.BlobSizes {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Namespaces[iNdEx])
+			copy(dAtA[i:], m.Namespaces[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Namespaces[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPayForBlobsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPayForBlobsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPayForBlobsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBlobParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBlobParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBlobParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBlobParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBlobParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBlobParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateNamespaceParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateNamespaceParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateNamespaceParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NamespaceParams) > 0 {
+		for iNdEx := len(m.NamespaceParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NamespaceParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateNamespaceParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateNamespaceParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateNamespaceParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateReservationParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateReservationParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateReservationParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ReservationParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateReservationParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateReservationParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateReservationParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReserveNamespace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReserveNamespace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReserveNamespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedSigners) > 0 {
+		for iNdEx := len(m.AllowedSigners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSigners[iNdEx])
+			copy(dAtA[i:], m.AllowedSigners[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AllowedSigners[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReserveNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReserveNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReserveNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Reservation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetNamespaceSigners) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetNamespaceSigners) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetNamespaceSigners) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedSigners) > 0 {
+		for iNdEx := len(m.AllowedSigners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSigners[iNdEx])
+			copy(dAtA[i:], m.AllowedSigners[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AllowedSigners[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetNamespaceSignersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetNamespaceSignersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetNamespaceSignersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferNamespace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferNamespace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferNamespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenewNamespace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewNamespace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewNamespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenewNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Reservation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseNamespace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseNamespace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseNamespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgPayForBlobs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Namespaces) > 0 {
+		for _, b := range m.Namespaces {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.BlobSizes) > 0 {
+		l = 0
+		for _, e := range m.BlobSizes {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.ShareCommitments) > 0 {
+		for _, b := range m.ShareCommitments {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ShareVersions) > 0 {
+		l = 0
+		for _, e := range m.ShareVersions {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgPayForBlobsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateBlobParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateBlobParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateNamespaceParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.NamespaceParams) > 0 {
+		for _, e := range m.NamespaceParams {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateNamespaceParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateReservationParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ReservationParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateReservationParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReserveNamespace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AllowedSigners) > 0 {
+		for _, s := range m.AllowedSigners {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgReserveNamespaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Reservation.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetNamespaceSigners) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AllowedSigners) > 0 {
+		for _, s := range m.AllowedSigners {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetNamespaceSignersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferNamespace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferNamespaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRenewNamespace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRenewNamespaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Reservation.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgReleaseNamespace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReleaseNamespaceResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgPayForBlobs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayForBlobs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayForBlobs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, make([]byte, postIndex-iNdEx))
+			copy(m.Namespaces[len(m.Namespaces)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BlobSizes = append(m.BlobSizes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BlobSizes) == 0 {
+					m.BlobSizes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BlobSizes = append(m.BlobSizes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobSizes", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareCommitments", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareCommitments = append(m.ShareCommitments, make([]byte, postIndex-iNdEx))
+			copy(m.ShareCommitments[len(m.ShareCommitments)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ShareVersions = append(m.ShareVersions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ShareVersions) == 0 {
+					m.ShareVersions = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShareVersions = append(m.ShareVersions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareVersions", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPayForBlobsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayForBlobsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayForBlobsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateBlobParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateBlobParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateBlobParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateBlobParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateBlobParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateBlobParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateNamespaceParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateNamespaceParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateNamespaceParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceParams = append(m.NamespaceParams, NamespaceParams{})
+			if err := m.NamespaceParams[len(m.NamespaceParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateNamespaceParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateNamespaceParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateNamespaceParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateReservationParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateReservationParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateReservationParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservationParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReservationParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateReservationParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateReservationParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateReservationParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReserveNamespace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReserveNamespace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReserveNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSigners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedSigners = append(m.AllowedSigners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReserveNamespaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReserveNamespaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReserveNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reservation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reservation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetNamespaceSigners) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetNamespaceSigners: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetNamespaceSigners: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSigners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedSigners = append(m.AllowedSigners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return nil
 }
+func (m *MsgSetNamespaceSignersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetNamespaceSignersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetNamespaceSignersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUpdateBlobParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
+}
+func (m *MsgTransferNamespace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferNamespace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
+func (m *MsgTransferNamespaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferNamespaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgPayForBlobs) Unmarshal(dAtA []byte) error {
+func (m *MsgRenewNamespace) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -700,15 +3847,15 @@ func (m *MsgPayForBlobs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayForBlobs: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRenewNamespace: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayForBlobs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRenewNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -736,11 +3883,11 @@ func (m *MsgPayForBlobs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -767,193 +3914,11 @@ func (m *MsgPayForBlobs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespaces = append(m.Namespaces, make([]byte, postIndex-iNdEx))
-			copy(m.Namespaces[len(m.Namespaces)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.BlobSizes = append(m.BlobSizes, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.BlobSizes) == 0 {
-					m.BlobSizes = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.BlobSizes = append(m.BlobSizes, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlobSizes", wireType)
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareCommitments", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
 			}
-			m.ShareCommitments = append(m.ShareCommitments, make([]byte, postIndex-iNdEx))
-			copy(m.ShareCommitments[len(m.ShareCommitments)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ShareVersions = append(m.ShareVersions, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ShareVersions) == 0 {
-					m.ShareVersions = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ShareVersions = append(m.ShareVersions, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareVersions", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -975,7 +3940,7 @@ func (m *MsgPayForBlobs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayForBlobsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRenewNamespaceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -998,12 +3963,45 @@ func (m *MsgPayForBlobsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayForBlobsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRenewNamespaceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayForBlobsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRenewNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reservation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reservation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1025,7 +4023,7 @@ func (m *MsgPayForBlobsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateBlobParams) Unmarshal(dAtA []byte) error {
+func (m *MsgReleaseNamespace) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1048,15 +4046,15 @@ func (m *MsgUpdateBlobParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateBlobParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReleaseNamespace: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateBlobParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReleaseNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1084,13 +4082,13 @@ func (m *MsgUpdateBlobParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1100,23 +4098,24 @@ func (m *MsgUpdateBlobParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1140,7 +4139,7 @@ func (m *MsgUpdateBlobParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateBlobParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReleaseNamespaceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1163,10 +4162,10 @@ func (m *MsgUpdateBlobParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateBlobParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReleaseNamespaceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateBlobParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReleaseNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
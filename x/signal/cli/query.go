@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/celestiaorg/celestia-app/v7/x/signal/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -10,6 +11,13 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	// FlagValidator is the flag that filters the signal history by validator.
+	FlagValidator = "validator"
+	// FlagVersion is the flag that filters the signal history by version.
+	FlagVersion = "version"
+)
+
 // GetQueryCmd returns the CLI query commands for this module.
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -23,6 +31,9 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdQueryTally())
 	cmd.AddCommand(CmdGetUpgrade())
 	cmd.AddCommand(CmdGetMissingValidators())
+	cmd.AddCommand(CmdUpgradeCountdown())
+	cmd.AddCommand(CmdSignalHistory())
+	cmd.AddCommand(CmdUpgradeHistory())
 	return cmd
 }
 
@@ -116,3 +127,111 @@ func CmdGetMissingValidators() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdUpgradeCountdown() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "upgrade-countdown",
+		Short:   "Query for the blocks and the estimated time remaining until a pending upgrade",
+		Args:    cobra.NoArgs,
+		Example: "upgrade-countdown",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.UpgradeCountdown(cmd.Context(), &types.QueryUpgradeCountdownRequest{})
+			if err != nil {
+				return err
+			}
+
+			if resp.Upgrade != nil {
+				return clientCtx.PrintString(fmt.Sprintf("An upgrade is pending to app version %d at height %d, in %d blocks (about %s, around %s).\n",
+					resp.Upgrade.AppVersion, resp.Upgrade.UpgradeHeight, resp.BlocksRemaining, resp.EstimatedTimeRemaining, resp.EstimatedUpgradeTime.Format(time.RFC3339)))
+			}
+			return clientCtx.PrintString("No upgrade is pending.\n")
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSignalHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "signal-history",
+		Short:   "Query for the history of version signals, optionally filtered by validator and version",
+		Args:    cobra.NoArgs,
+		Example: "signal-history --validator celestiavaloper1... --version 3",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			validator, err := cmd.Flags().GetString(FlagValidator)
+			if err != nil {
+				return err
+			}
+			version, err := cmd.Flags().GetUint64(FlagVersion)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.SignalHistory(cmd.Context(), &types.QuerySignalHistoryRequest{
+				ValidatorAddress: validator,
+				Version:          version,
+				Pagination:       pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().String(FlagValidator, "", "Only return the signals of this validator operator address")
+	cmd.Flags().Uint64(FlagVersion, 0, "Only return the signals for this version")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "signal-history")
+	return cmd
+}
+
+func CmdUpgradeHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "upgrade-history",
+		Short:   "Query for the history of upgrades",
+		Args:    cobra.NoArgs,
+		Example: "upgrade-history",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.UpgradeHistory(cmd.Context(), &types.QueryUpgradeHistoryRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "upgrade-history")
+	return cmd
+}
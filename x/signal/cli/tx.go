@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/celestiaorg/celestia-app/v7/x/signal/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -12,6 +13,10 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// FlagEarliestUpgradeTime is the flag of the earliest time at which a
+// validator prefers the upgrade to take place.
+const FlagEarliestUpgradeTime = "earliest-upgrade-time"
+
 // GetTxCmd returns the transaction commands for this module.
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -46,10 +51,23 @@ func CmdSignalVersion() *cobra.Command {
 			addr := clientCtx.GetFromAddress().Bytes()
 			valAddr := sdk.ValAddress(addr)
 			msg := types.NewMsgSignalVersion(valAddr.String(), version)
+
+			earliestUpgradeTime, err := cmd.Flags().GetString(FlagEarliestUpgradeTime)
+			if err != nil {
+				return err
+			}
+			if earliestUpgradeTime != "" {
+				t, err := time.Parse(time.RFC3339, earliestUpgradeTime)
+				if err != nil {
+					return fmt.Errorf("invalid %s: %w", FlagEarliestUpgradeTime, err)
+				}
+				msg = types.NewMsgSignalVersionWithEarliestUpgradeTime(valAddr.String(), version, t)
+			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagEarliestUpgradeTime, "", "The earliest time, in RFC3339 format, at which the validator prefers the upgrade to take place")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
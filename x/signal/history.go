@@ -0,0 +1,320 @@
+package signal
+
+import (
+	"context"
+	"encoding/binary"
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/celestiaorg/celestia-app/v7/x/signal/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// historyStore returns the signal and upgrade history, which is kept under
+// types.HistoryPrefix in the signal store.
+func (k Keeper) historyStore(ctx sdk.Context) storetypes.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.HistoryPrefix)
+}
+
+// recordSignal appends a version signal to the signal history.
+func (k Keeper) recordSignal(ctx sdk.Context, valAddress sdk.ValAddress, version uint64, votingPower int64, earliestUpgradeTime *time.Time) {
+	id := k.nextSequence(ctx, types.SignalSequenceKey)
+	record := types.NewSignalRecord(id, valAddress.String(), version, ctx.BlockHeight(), ctx.BlockTime(), votingPower, earliestUpgradeTime)
+	k.setSignalRecord(ctx, valAddress, record)
+}
+
+// setSignalRecord stores a signal record and indexes it by validator and
+// version.
+func (k Keeper) setSignalRecord(ctx sdk.Context, valAddress sdk.ValAddress, record types.SignalRecord) {
+	store := k.historyStore(ctx)
+	id := sdk.Uint64ToBigEndian(record.Id)
+	prefix.NewStore(store, types.SignalRecordPrefix).Set(id, k.binaryCodec.MustMarshal(&record))
+	prefix.NewStore(store, types.SignalValidatorIndexKey(valAddress)).Set(id, []byte{})
+	prefix.NewStore(store, types.SignalVersionIndexKey(record.Version)).Set(id, []byte{})
+}
+
+// getSignalRecord returns the signal record with the given id.
+func (k Keeper) getSignalRecord(ctx sdk.Context, id []byte) (types.SignalRecord, bool) {
+	bz := prefix.NewStore(k.historyStore(ctx), types.SignalRecordPrefix).Get(id)
+	if bz == nil {
+		return types.SignalRecord{}, false
+	}
+	var record types.SignalRecord
+	k.binaryCodec.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// GetSignalHistory returns all the signal records ordered by id.
+func (k Keeper) GetSignalHistory(ctx sdk.Context) []types.SignalRecord {
+	iterator := prefix.NewStore(k.historyStore(ctx), types.SignalRecordPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var records []types.SignalRecord
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.SignalRecord
+		k.binaryCodec.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// recordUpgrade appends a pending upgrade to the upgrade history.
+func (k Keeper) recordUpgrade(ctx sdk.Context, upgrade types.Upgrade) {
+	id := k.nextSequence(ctx, types.UpgradeSequenceKey)
+	k.setUpgradeRecord(ctx, types.NewUpgradeRecord(id, upgrade, ctx.BlockHeight(), ctx.BlockTime()))
+}
+
+// resolveUpgrade sets the status of the most recent upgrade record to status
+// if it is still pending.
+func (k Keeper) resolveUpgrade(ctx sdk.Context, status types.UpgradeStatus) {
+	iterator := prefix.NewStore(k.historyStore(ctx), types.UpgradeRecordPrefix).ReverseIterator(nil, nil)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return
+	}
+
+	var record types.UpgradeRecord
+	k.binaryCodec.MustUnmarshal(iterator.Value(), &record)
+	if record.Status != types.UpgradeStatus_UPGRADE_STATUS_PENDING {
+		return
+	}
+	record.Status = status
+	record.ResolvedHeight = ctx.BlockHeight()
+	k.setUpgradeRecord(ctx, record)
+}
+
+// setUpgradeRecord stores an upgrade record.
+func (k Keeper) setUpgradeRecord(ctx sdk.Context, record types.UpgradeRecord) {
+	store := prefix.NewStore(k.historyStore(ctx), types.UpgradeRecordPrefix)
+	store.Set(sdk.Uint64ToBigEndian(record.Id), k.binaryCodec.MustMarshal(&record))
+}
+
+// GetUpgradeHistory returns all the upgrade records ordered by id.
+func (k Keeper) GetUpgradeHistory(ctx sdk.Context) []types.UpgradeRecord {
+	iterator := prefix.NewStore(k.historyStore(ctx), types.UpgradeRecordPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var records []types.UpgradeRecord
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.UpgradeRecord
+		k.binaryCodec.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// nextSequence returns the sequence number stored under key and increments
+// it.
+func (k Keeper) nextSequence(ctx sdk.Context, key []byte) uint64 {
+	store := k.historyStore(ctx)
+	var seq uint64
+	if bz := store.Get(key); bz != nil {
+		seq = binary.BigEndian.Uint64(bz)
+	}
+	store.Set(key, sdk.Uint64ToBigEndian(seq+1))
+	return seq
+}
+
+// setSequence sets the sequence number stored under key.
+func (k Keeper) setSequence(ctx sdk.Context, key []byte, seq uint64) {
+	k.historyStore(ctx).Set(key, sdk.Uint64ToBigEndian(seq))
+}
+
+// getSequence returns the sequence number stored under key.
+func (k Keeper) getSequence(ctx sdk.Context, key []byte) uint64 {
+	bz := k.historyStore(ctx).Get(key)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// EndBlocker prunes the signal and upgrade records that fall out of the
+// retention window of the history.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := k.pruneSignalHistory(sdkCtx); err != nil {
+		return err
+	}
+	k.pruneUpgradeHistory(sdkCtx)
+	return nil
+}
+
+// pruneSignalHistory removes up to types.MaxPrunedRecordsPerBlock signal
+// records, along with their index entries, whose id is more than
+// types.MaxSignalRecords behind the next signal record id.
+func (k Keeper) pruneSignalHistory(ctx sdk.Context) error {
+	nextID := k.getSequence(ctx, types.SignalSequenceKey)
+	if nextID <= types.MaxSignalRecords {
+		return nil
+	}
+	end := sdk.Uint64ToBigEndian(nextID - types.MaxSignalRecords)
+
+	store := k.historyStore(ctx)
+	recordStore := prefix.NewStore(store, types.SignalRecordPrefix)
+	iterator := recordStore.Iterator(nil, end)
+	defer iterator.Close()
+
+	var expired []types.SignalRecord
+	for ; iterator.Valid() && len(expired) < types.MaxPrunedRecordsPerBlock; iterator.Next() {
+		var record types.SignalRecord
+		k.binaryCodec.MustUnmarshal(iterator.Value(), &record)
+		expired = append(expired, record)
+	}
+
+	for _, record := range expired {
+		valAddress, err := sdk.ValAddressFromBech32(record.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		id := sdk.Uint64ToBigEndian(record.Id)
+		recordStore.Delete(id)
+		prefix.NewStore(store, types.SignalValidatorIndexKey(valAddress)).Delete(id)
+		prefix.NewStore(store, types.SignalVersionIndexKey(record.Version)).Delete(id)
+	}
+	return nil
+}
+
+// pruneUpgradeHistory removes up to types.MaxPrunedRecordsPerBlock upgrade
+// records whose id is more than types.MaxUpgradeRecords behind the next
+// upgrade record id. The most recent record, which may still be pending, is
+// never pruned.
+func (k Keeper) pruneUpgradeHistory(ctx sdk.Context) {
+	nextID := k.getSequence(ctx, types.UpgradeSequenceKey)
+	if nextID <= types.MaxUpgradeRecords {
+		return
+	}
+	end := sdk.Uint64ToBigEndian(nextID - types.MaxUpgradeRecords)
+
+	recordStore := prefix.NewStore(k.historyStore(ctx), types.UpgradeRecordPrefix)
+	iterator := recordStore.Iterator(nil, end)
+	defer iterator.Close()
+
+	var expired [][]byte
+	for ; iterator.Valid() && len(expired) < types.MaxPrunedRecordsPerBlock; iterator.Next() {
+		expired = append(expired, iterator.Key())
+	}
+	for _, id := range expired {
+		recordStore.Delete(id)
+	}
+}
+
+// InitGenesis initializes the signal history from the genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) error {
+	var nextSignalID uint64
+	for _, record := range gs.SignalHistory {
+		valAddress, err := sdk.ValAddressFromBech32(record.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		k.setSignalRecord(ctx, valAddress, record)
+		nextSignalID = max(nextSignalID, record.Id+1)
+	}
+	k.setSequence(ctx, types.SignalSequenceKey, nextSignalID)
+
+	var nextUpgradeID uint64
+	for _, record := range gs.UpgradeHistory {
+		k.setUpgradeRecord(ctx, record)
+		nextUpgradeID = max(nextUpgradeID, record.Id+1)
+	}
+	k.setSequence(ctx, types.UpgradeSequenceKey, nextUpgradeID)
+	return nil
+}
+
+// ExportGenesis returns the signal history as a genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		SignalHistory:  k.GetSignalHistory(ctx),
+		UpgradeHistory: k.GetUpgradeHistory(ctx),
+	}
+}
+
+// SignalHistory enables a client to query for the history of version signals,
+// optionally filtered by validator and version.
+func (k Keeper) SignalHistory(ctx context.Context, req *types.QuerySignalHistoryRequest) (*types.QuerySignalHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := k.historyStore(sdkCtx)
+
+	var (
+		signals []types.SignalRecord
+		pageRes *query.PageResponse
+		err     error
+	)
+	switch {
+	case req.ValidatorAddress != "":
+		valAddress, addrErr := sdk.ValAddressFromBech32(req.ValidatorAddress)
+		if addrErr != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %s", addrErr)
+		}
+		indexStore := prefix.NewStore(store, types.SignalValidatorIndexKey(valAddress))
+		pageRes, err = query.FilteredPaginate(indexStore, req.Pagination, func(id, _ []byte, accumulate bool) (bool, error) {
+			record, ok := k.getSignalRecord(sdkCtx, id)
+			if !ok {
+				return false, status.Errorf(codes.Internal, "signal record %d not found", binary.BigEndian.Uint64(id))
+			}
+			if req.Version != 0 && record.Version != req.Version {
+				return false, nil
+			}
+			if accumulate {
+				signals = append(signals, record)
+			}
+			return true, nil
+		})
+	case req.Version != 0:
+		indexStore := prefix.NewStore(store, types.SignalVersionIndexKey(req.Version))
+		pageRes, err = query.Paginate(indexStore, req.Pagination, func(id, _ []byte) error {
+			record, ok := k.getSignalRecord(sdkCtx, id)
+			if !ok {
+				return status.Errorf(codes.Internal, "signal record %d not found", binary.BigEndian.Uint64(id))
+			}
+			signals = append(signals, record)
+			return nil
+		})
+	default:
+		recordStore := prefix.NewStore(store, types.SignalRecordPrefix)
+		pageRes, err = query.Paginate(recordStore, req.Pagination, func(_, value []byte) error {
+			var record types.SignalRecord
+			if err := k.binaryCodec.Unmarshal(value, &record); err != nil {
+				return err
+			}
+			signals = append(signals, record)
+			return nil
+		})
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySignalHistoryResponse{Signals: signals, Pagination: pageRes}, nil
+}
+
+// UpgradeHistory enables a client to query for the history of upgrades.
+func (k Keeper) UpgradeHistory(ctx context.Context, req *types.QueryUpgradeHistoryRequest) (*types.QueryUpgradeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	store := prefix.NewStore(k.historyStore(sdkCtx), types.UpgradeRecordPrefix)
+	var upgrades []types.UpgradeRecord
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var record types.UpgradeRecord
+		if err := k.binaryCodec.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		upgrades = append(upgrades, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUpgradeHistoryResponse{Upgrades: upgrades, Pagination: pageRes}, nil
+}
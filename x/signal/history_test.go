@@ -0,0 +1,214 @@
+package signal_test
+
+import (
+	"testing"
+	"time"
+
+	testutil "github.com/celestiaorg/celestia-app/v7/test/util"
+	"github.com/celestiaorg/celestia-app/v7/x/signal/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignalHistory(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(now)
+
+	earliest := now.Add(time.Hour)
+	msgs := []*types.MsgSignalVersion{
+		types.NewMsgSignalVersion(testutil.ValAddrs[0].String(), 2),
+		types.NewMsgSignalVersionWithEarliestUpgradeTime(testutil.ValAddrs[1].String(), 2, earliest),
+		types.NewMsgSignalVersion(testutil.ValAddrs[0].String(), 3),
+	}
+	for _, msg := range msgs {
+		_, err := upgradeKeeper.SignalVersion(ctx, msg)
+		require.NoError(t, err)
+	}
+
+	// the history is kept when the tally is reset.
+	upgradeKeeper.ResetTally(ctx)
+
+	all, err := upgradeKeeper.SignalHistory(ctx, &types.QuerySignalHistoryRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.SignalRecord{
+		types.NewSignalRecord(0, testutil.ValAddrs[0].String(), 2, 1, now, 40, nil),
+		types.NewSignalRecord(1, testutil.ValAddrs[1].String(), 2, 1, now, 1, &earliest),
+		types.NewSignalRecord(2, testutil.ValAddrs[0].String(), 3, 1, now, 40, nil),
+	}, all.Signals)
+
+	testCases := []struct {
+		name    string
+		req     *types.QuerySignalHistoryRequest
+		wantIDs []uint64
+	}{
+		{
+			name:    "by validator",
+			req:     &types.QuerySignalHistoryRequest{ValidatorAddress: testutil.ValAddrs[0].String()},
+			wantIDs: []uint64{0, 2},
+		},
+		{
+			name:    "by version",
+			req:     &types.QuerySignalHistoryRequest{Version: 2},
+			wantIDs: []uint64{0, 1},
+		},
+		{
+			name:    "by validator and version",
+			req:     &types.QuerySignalHistoryRequest{ValidatorAddress: testutil.ValAddrs[0].String(), Version: 3},
+			wantIDs: []uint64{2},
+		},
+		{
+			name:    "by validator without signals",
+			req:     &types.QuerySignalHistoryRequest{ValidatorAddress: testutil.ValAddrs[2].String()},
+			wantIDs: nil,
+		},
+		{
+			name:    "paginated in reverse",
+			req:     &types.QuerySignalHistoryRequest{Pagination: &query.PageRequest{Limit: 2, Reverse: true}},
+			wantIDs: []uint64{2, 1},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := upgradeKeeper.SignalHistory(ctx, tc.req)
+			require.NoError(t, err)
+			var ids []uint64
+			for _, signal := range resp.Signals {
+				ids = append(ids, signal.Id)
+			}
+			require.Equal(t, tc.wantIDs, ids)
+		})
+	}
+
+	_, err = upgradeKeeper.SignalHistory(ctx, &types.QuerySignalHistoryRequest{ValidatorAddress: "invalid"})
+	require.Error(t, err)
+}
+
+func TestUpgradeHistory(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(now)
+
+	signalAndTryUpgrade := func() {
+		for _, valAddr := range testutil.ValAddrs[:4] {
+			_, err := upgradeKeeper.SignalVersion(ctx, types.NewMsgSignalVersion(valAddr.String(), 2))
+			require.NoError(t, err)
+		}
+		_, err := upgradeKeeper.TryUpgrade(ctx, &types.MsgTryUpgrade{})
+		require.NoError(t, err)
+	}
+
+	signalAndTryUpgrade()
+	resp, err := upgradeKeeper.UpgradeHistory(ctx, &types.QueryUpgradeHistoryRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Upgrades, 1)
+	require.Equal(t, types.UpgradeStatus_UPGRADE_STATUS_PENDING, resp.Upgrades[0].Status)
+	require.Equal(t, int64(1), resp.Upgrades[0].ScheduledHeight)
+	require.Equal(t, now, resp.Upgrades[0].ScheduledTime)
+
+	_, err = upgradeKeeper.CancelUpgrade(ctx.WithBlockHeight(2), types.NewMsgCancelUpgrade(authority))
+	require.NoError(t, err)
+
+	signalAndTryUpgrade()
+	// the tally is reset once the upgrade was applied.
+	upgradeKeeper.ResetTally(ctx.WithBlockHeight(5))
+
+	resp, err = upgradeKeeper.UpgradeHistory(ctx, &types.QueryUpgradeHistoryRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Upgrades, 2)
+	require.Equal(t, types.UpgradeStatus_UPGRADE_STATUS_CANCELLED, resp.Upgrades[0].Status)
+	require.Equal(t, int64(2), resp.Upgrades[0].ResolvedHeight)
+	require.Equal(t, uint64(1), resp.Upgrades[1].Id)
+	require.Equal(t, types.UpgradeStatus_UPGRADE_STATUS_APPLIED, resp.Upgrades[1].Status)
+	require.Equal(t, int64(5), resp.Upgrades[1].ResolvedHeight)
+
+	signals, err := upgradeKeeper.SignalHistory(ctx, &types.QuerySignalHistoryRequest{Version: 2})
+	require.NoError(t, err)
+	require.Len(t, signals.Signals, 8)
+}
+
+func TestHistoryGenesis(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+	now := time.Unix(1_700_000_000, 0).UTC()
+
+	genesis := types.GenesisState{
+		SignalHistory: []types.SignalRecord{
+			types.NewSignalRecord(0, testutil.ValAddrs[0].String(), 2, 1, now, 40, nil),
+			types.NewSignalRecord(4, testutil.ValAddrs[1].String(), 3, 2, now, 1, nil),
+		},
+		UpgradeHistory: []types.UpgradeRecord{
+			types.NewUpgradeRecord(0, types.Upgrade{AppVersion: 2, UpgradeHeight: 10}, 1, now),
+		},
+	}
+	require.NoError(t, genesis.Validate())
+	require.NoError(t, upgradeKeeper.InitGenesis(ctx, genesis))
+	require.Equal(t, &genesis, upgradeKeeper.ExportGenesis(ctx))
+
+	// the indexes are rebuilt from the genesis state.
+	resp, err := upgradeKeeper.SignalHistory(ctx, &types.QuerySignalHistoryRequest{ValidatorAddress: testutil.ValAddrs[1].String()})
+	require.NoError(t, err)
+	require.Equal(t, genesis.SignalHistory[1:], resp.Signals)
+
+	// new signals are numbered after the ones in the genesis state.
+	_, err = upgradeKeeper.SignalVersion(ctx, types.NewMsgSignalVersion(testutil.ValAddrs[2].String(), 2))
+	require.NoError(t, err)
+	history := upgradeKeeper.ExportGenesis(ctx).SignalHistory
+	require.Equal(t, uint64(5), history[len(history)-1].Id)
+
+	t.Run("invalid genesis", func(t *testing.T) {
+		duplicate := types.GenesisState{SignalHistory: []types.SignalRecord{genesis.SignalHistory[0], genesis.SignalHistory[0]}}
+		require.Error(t, duplicate.Validate())
+		unspecified := types.GenesisState{UpgradeHistory: []types.UpgradeRecord{{Id: 0}}}
+		require.Error(t, unspecified.Validate())
+		require.NoError(t, types.DefaultGenesis().Validate())
+	})
+}
+
+func TestHistoryPruning(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+	now := time.Unix(1_700_000_000, 0).UTC()
+	upgrade := types.Upgrade{AppVersion: 2, UpgradeHeight: 10}
+
+	genesis := types.GenesisState{
+		SignalHistory: []types.SignalRecord{
+			types.NewSignalRecord(0, testutil.ValAddrs[0].String(), 2, 1, now, 40, nil),
+			types.NewSignalRecord(1, testutil.ValAddrs[1].String(), 2, 1, now, 1, nil),
+			types.NewSignalRecord(types.MaxSignalRecords+1, testutil.ValAddrs[0].String(), 3, 2, now, 40, nil),
+		},
+		UpgradeHistory: []types.UpgradeRecord{
+			types.NewUpgradeRecord(0, upgrade, 1, now),
+			types.NewUpgradeRecord(types.MaxUpgradeRecords, upgrade, 1, now),
+		},
+	}
+	require.NoError(t, upgradeKeeper.InitGenesis(ctx, genesis))
+	require.NoError(t, upgradeKeeper.EndBlocker(ctx))
+
+	// only the records within the retention window are kept.
+	exported := upgradeKeeper.ExportGenesis(ctx)
+	require.Equal(t, genesis.SignalHistory[2:], exported.SignalHistory)
+	require.Equal(t, genesis.UpgradeHistory[1:], exported.UpgradeHistory)
+
+	// the index entries of pruned signal records are removed as well.
+	resp, err := upgradeKeeper.SignalHistory(ctx, &types.QuerySignalHistoryRequest{Version: 2})
+	require.NoError(t, err)
+	require.Empty(t, resp.Signals)
+	resp, err = upgradeKeeper.SignalHistory(ctx, &types.QuerySignalHistoryRequest{ValidatorAddress: testutil.ValAddrs[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, genesis.SignalHistory[2:], resp.Signals)
+
+	t.Run("bounded per block", func(t *testing.T) {
+		upgradeKeeper, ctx, _ := setup(t)
+		total := types.MaxSignalRecords + types.MaxPrunedRecordsPerBlock + 10
+		records := make([]types.SignalRecord, 0, types.MaxPrunedRecordsPerBlock+11)
+		for id := 0; id < types.MaxPrunedRecordsPerBlock+10; id++ {
+			records = append(records, types.NewSignalRecord(uint64(id), testutil.ValAddrs[0].String(), 2, 1, now, 40, nil))
+		}
+		records = append(records, types.NewSignalRecord(uint64(total), testutil.ValAddrs[0].String(), 2, 1, now, 40, nil))
+		require.NoError(t, upgradeKeeper.InitGenesis(ctx, types.GenesisState{SignalHistory: records}))
+
+		require.NoError(t, upgradeKeeper.EndBlocker(ctx))
+		require.Len(t, upgradeKeeper.GetSignalHistory(ctx), 11)
+		require.NoError(t, upgradeKeeper.EndBlocker(ctx))
+		require.Len(t, upgradeKeeper.GetSignalHistory(ctx), 1)
+	})
+}
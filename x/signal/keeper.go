@@ -1,36 +1,35 @@
 package signal
 
 import (
-	"bytes"
 	"context"
 	"encoding/binary"
 	"errors"
+	"sort"
+	"strconv"
+	"time"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/celestiaorg/celestia-app/v7/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v7/x/signal/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
 )
 
 // Keeper implements the MsgServer and QueryServer interfaces
 var (
 	_ types.MsgServer   = &Keeper{}
 	_ types.QueryServer = Keeper{}
-
-	// defaultSignalThreshold is 5/6 or approximately 83.33%
-	defaultSignalThreshold = math.LegacyNewDec(5).Quo(math.LegacyNewDec(6))
 )
 
-// Threshold is the fraction of voting power that is required
-// to signal for a version change. It is set to 5/6 as the middle point
-// between 2/3 and 3/3 providing 1/6 fault tolerance to halting the
-// network during an upgrade period. It can be modified through a
-// hard fork change that modified the app version
-func Threshold(_ uint64) math.LegacyDec {
-	return defaultSignalThreshold
+// Threshold is the fraction of voting power that is required to signal for a
+// version change at appVersion. See appconsts.GetSignalThreshold.
+func Threshold(appVersion uint64) math.LegacyDec {
+	return appconsts.GetSignalThreshold(appVersion)
 }
 
 type Keeper struct {
@@ -44,6 +43,10 @@ type Keeper struct {
 	// stakingKeeper is used to fetch validators to calculate the total power
 	// signalled to a version.
 	stakingKeeper StakingKeeper
+
+	// authority is the address allowed to cancel a pending upgrade. It is
+	// usually the governance module account.
+	authority string
 }
 
 // NewKeeper returns a signal keeper.
@@ -51,14 +54,21 @@ func NewKeeper(
 	binaryCodec codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
 	stakingKeeper StakingKeeper,
+	authority string,
 ) Keeper {
 	return Keeper{
 		binaryCodec:   binaryCodec,
 		storeKey:      storeKey,
 		stakingKeeper: stakingKeeper,
+		authority:     authority,
 	}
 }
 
+// GetAuthority returns the signal module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // SignalVersion is a method required by the MsgServer interface.
 func (k Keeper) SignalVersion(ctx context.Context, req *types.MsgSignalVersion) (*types.MsgSignalVersionResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -77,12 +87,27 @@ func (k Keeper) SignalVersion(ctx context.Context, req *types.MsgSignalVersion)
 		return nil, types.ErrInvalidSignalVersion.Wrapf("signalled version %d, current version %d", req.Version, currentVersion)
 	}
 
+	if req.EarliestUpgradeTime != nil {
+		latest := sdkCtx.BlockTime().Add(types.MaxEarliestUpgradeDelay)
+		if req.EarliestUpgradeTime.After(latest) {
+			return nil, types.ErrInvalidEarliestUpgradeTime.Wrapf("%s is more than %s after the block time", req.EarliestUpgradeTime, types.MaxEarliestUpgradeDelay)
+		}
+	}
+
 	_, err = k.stakingKeeper.GetValidator(sdkCtx, valAddr)
 	if err != nil {
 		return nil, err
 	}
 
-	k.SetValidatorVersion(sdkCtx, valAddr, req.Version)
+	power, err := k.stakingKeeper.GetLastValidatorPower(sdkCtx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.setValidatorSignal(sdkCtx, valAddr, req.Version, req.EarliestUpgradeTime); err != nil {
+		return nil, err
+	}
+	k.recordSignal(sdkCtx, valAddr, req.Version, power, req.EarliestUpgradeTime)
 
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -121,12 +146,16 @@ func (k *Keeper) TryUpgrade(ctx context.Context, req *types.MsgTryUpgrade) (*typ
 		if version <= appVersion {
 			return &types.MsgTryUpgradeResponse{}, types.ErrInvalidUpgradeVersion.Wrapf("can not upgrade to version %v because it is less than or equal to current version %v", version, appVersion)
 		}
-		header := sdkCtx.HeaderInfo()
+		upgradeHeight, err := k.upgradeHeight(sdkCtx, version)
+		if err != nil {
+			return nil, err
+		}
 		upgrade := types.Upgrade{
 			AppVersion:    version,
-			UpgradeHeight: header.Height + appconsts.GetUpgradeHeightDelay(header.ChainID),
+			UpgradeHeight: upgradeHeight,
 		}
 		k.setUpgrade(sdkCtx, upgrade)
+		k.recordUpgrade(sdkCtx, upgrade)
 	}
 
 	sdkCtx.EventManager().EmitEvent(
@@ -140,6 +169,37 @@ func (k *Keeper) TryUpgrade(ctx context.Context, req *types.MsgTryUpgrade) (*typ
 	return &types.MsgTryUpgradeResponse{}, nil
 }
 
+// CancelUpgrade is a method required by the MsgServer interface. It clears a
+// pending upgrade and resets the tally so that validators have to signal
+// again.
+func (k *Keeper) CancelUpgrade(ctx context.Context, req *types.MsgCancelUpgrade) (*types.MsgCancelUpgradeResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.Authority != k.authority {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority: expected: %s, got: %s", k.authority, req.Authority)
+	}
+
+	upgrade, ok := k.getUpgrade(sdkCtx)
+	if !ok {
+		return nil, types.ErrNoUpgradePending
+	}
+
+	k.resolveUpgrade(sdkCtx, types.UpgradeStatus_UPGRADE_STATUS_CANCELLED)
+	k.ResetTally(sdkCtx)
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelUpgrade,
+			sdk.NewAttribute(types.AttributeKeyAuthority, req.Authority),
+			sdk.NewAttribute(types.AttributeKeyAppVersion, strconv.FormatUint(upgrade.AppVersion, 10)),
+			sdk.NewAttribute(types.AttributeKeyUpgradeHeight, strconv.FormatInt(upgrade.UpgradeHeight, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.URLMsgCancelUpgrade),
+		),
+	)
+
+	return &types.MsgCancelUpgradeResponse{}, nil
+}
+
 // VersionTally enables a client to query for the tally of voting power has
 // signalled for a particular version.
 func (k Keeper) VersionTally(ctx context.Context, req *types.QueryVersionTallyRequest) (*types.QueryVersionTallyResponse, error) {
@@ -149,22 +209,18 @@ func (k Keeper) VersionTally(ctx context.Context, req *types.QueryVersionTallyRe
 		return nil, err
 	}
 	currentVotingPower := math.NewInt(0)
-	store := sdkCtx.KVStore(k.storeKey)
-	iterator := store.Iterator(types.FirstSignalKey, nil)
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		if bytes.Equal(iterator.Key(), types.UpgradeKey) {
-			continue
-		}
-		valAddress := sdk.ValAddress(iterator.Key())
+	err = k.iterateSignals(sdkCtx, func(valAddress sdk.ValAddress, version uint64) (bool, error) {
 		power, err := k.stakingKeeper.GetLastValidatorPower(sdkCtx, valAddress)
 		if err != nil {
-			return nil, err
+			return false, err
 		}
-		version := VersionFromBytes(iterator.Value())
 		if version == req.Version {
 			currentVotingPower = currentVotingPower.AddRaw(power)
 		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	threshold, err := k.GetVotingPowerThreshold(sdkCtx)
@@ -227,16 +283,57 @@ func (k Keeper) GetMissingValidators(ctx context.Context, req *types.QueryGetMis
 	return &types.QueryGetMissingValidatorsResponse{MissingValidators: missingValidators}, nil
 }
 
-// SetValidatorVersion saves a signalled version for a validator.
+// SetValidatorVersion saves a signalled version for a validator and clears the
+// earliest upgrade time of its previous signal, if any.
 func (k Keeper) SetValidatorVersion(ctx sdk.Context, valAddress sdk.ValAddress, version uint64) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(valAddress, VersionToBytes(version))
+	store.Delete(types.EarliestUpgradeTimeKey(valAddress))
+}
+
+// setValidatorSignal saves a signalled version for a validator along with the
+// earliest time at which the validator prefers the upgrade to take place, if
+// any.
+func (k Keeper) setValidatorSignal(ctx sdk.Context, valAddress sdk.ValAddress, version uint64, earliestUpgradeTime *time.Time) error {
+	k.SetValidatorVersion(ctx, valAddress, version)
+	if earliestUpgradeTime == nil {
+		return nil
+	}
+	timestamp, err := gogotypes.TimestampProto(*earliestUpgradeTime)
+	if err != nil {
+		return err
+	}
+	bz, err := k.binaryCodec.Marshal(timestamp)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.EarliestUpgradeTimeKey(valAddress), bz)
+	return nil
+}
+
+// getEarliestUpgradeTime returns the earliest upgrade time signalled by a
+// validator, if any.
+func (k Keeper) getEarliestUpgradeTime(ctx sdk.Context, valAddress sdk.ValAddress) (time.Time, bool, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.EarliestUpgradeTimeKey(valAddress))
+	if bz == nil {
+		return time.Time{}, false, nil
+	}
+	var timestamp gogotypes.Timestamp
+	if err := k.binaryCodec.Unmarshal(bz, &timestamp); err != nil {
+		return time.Time{}, false, err
+	}
+	earliestUpgradeTime, err := gogotypes.TimestampFromProto(&timestamp)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return earliestUpgradeTime, true, nil
 }
 
 // DeleteValidatorVersion deletes a signalled version for a validator.
 func (k Keeper) DeleteValidatorVersion(ctx sdk.Context, valAddress sdk.ValAddress) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(valAddress)
+	store.Delete(types.EarliestUpgradeTimeKey(valAddress))
 }
 
 // TallyVotingPower tallies the voting power for each version and returns true
@@ -244,14 +341,11 @@ func (k Keeper) DeleteValidatorVersion(ctx sdk.Context, valAddress sdk.ValAddres
 // Returns false and 0 otherwise.
 func (k Keeper) TallyVotingPower(ctx sdk.Context, threshold int64) (bool, uint64, error) {
 	versionToPower := make(map[uint64]int64)
-	store := ctx.KVStore(k.storeKey)
-	iterator := store.Iterator(types.FirstSignalKey, nil)
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		if bytes.Equal(iterator.Key(), types.UpgradeKey) {
-			continue
-		}
-		valAddress := sdk.ValAddress(iterator.Key())
+	var (
+		hasQuorum     bool
+		quorumVersion uint64
+	)
+	err := k.iterateSignals(ctx, func(valAddress sdk.ValAddress, version uint64) (bool, error) {
 		// check that the validator is still part of the bonded set
 		found := true
 		val, err := k.stakingKeeper.GetValidator(ctx, valAddress)
@@ -260,28 +354,110 @@ func (k Keeper) TallyVotingPower(ctx sdk.Context, threshold int64) (bool, uint64
 				k.DeleteValidatorVersion(ctx, valAddress)
 				found = false
 			} else {
-				return false, 0, err
+				return false, err
 			}
 		}
 		// if the validator is not bonded, skip it's voting power
 		if !found || !val.IsBonded() {
-			continue
+			return false, nil
 		}
 		power, err := k.stakingKeeper.GetLastValidatorPower(ctx, valAddress)
 		if err != nil {
-			return false, 0, err
+			return false, err
 		}
-		version := VersionFromBytes(iterator.Value())
 		if _, ok := versionToPower[version]; !ok {
 			versionToPower[version] = power
 		} else {
 			versionToPower[version] += power
 		}
 		if versionToPower[version] >= threshold {
-			return true, version, nil
+			hasQuorum, quorumVersion = true, version
+			return true, nil
+		}
+		return false, nil
+	})
+	if err != nil {
+		return false, 0, err
+	}
+	return hasQuorum, quorumVersion, nil
+}
+
+// upgradeHeight returns the voting power weighted median of the upgrade
+// heights preferred by the bonded validators that signalled for version. A
+// validator prefers the first height after the upgrade height delay that is
+// estimated to be reached at or after its earliest upgrade time, if any.
+func (k Keeper) upgradeHeight(ctx sdk.Context, version uint64) (int64, error) {
+	header := ctx.HeaderInfo()
+	minUpgradeHeight := header.Height + appconsts.GetUpgradeHeightDelay(header.ChainID)
+
+	type preference struct {
+		height int64
+		power  int64
+	}
+	var (
+		preferences []preference
+		totalPower  int64
+	)
+	err := k.iterateSignals(ctx, func(valAddress sdk.ValAddress, signalledVersion uint64) (bool, error) {
+		if signalledVersion != version {
+			return false, nil
+		}
+		val, err := k.stakingKeeper.GetValidator(ctx, valAddress)
+		if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if !val.IsBonded() {
+			return false, nil
+		}
+		power, err := k.stakingKeeper.GetLastValidatorPower(ctx, valAddress)
+		if err != nil {
+			return false, err
+		}
+
+		height := minUpgradeHeight
+		earliestUpgradeTime, ok, err := k.getEarliestUpgradeTime(ctx, valAddress)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			height = max(height, header.Height+blocksUntil(ctx.BlockTime(), earliestUpgradeTime))
+		}
+		preferences = append(preferences, preference{height: height, power: power})
+		totalPower += power
+		return false, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	sort.SliceStable(preferences, func(i, j int) bool {
+		return preferences[i].height < preferences[j].height
+	})
+	var cumulativePower int64
+	for _, p := range preferences {
+		cumulativePower += p.power
+		if 2*cumulativePower >= totalPower {
+			return p.height, nil
 		}
 	}
-	return false, 0, nil
+	return minUpgradeHeight, nil
+}
+
+// blocksUntil returns the estimated number of blocks from the block time from
+// until the block time to.
+func blocksUntil(from, to time.Time) int64 {
+	if !to.After(from) {
+		return 0
+	}
+	remaining := to.Sub(from)
+	blocks := int64(remaining / appconsts.EstimatedBlockInterval)
+	if remaining%appconsts.EstimatedBlockInterval != 0 {
+		blocks++
+	}
+	return blocks
 }
 
 // GetVotingPowerThreshold returns the voting power threshold required to
@@ -314,15 +490,47 @@ func (k *Keeper) ShouldUpgrade(ctx sdk.Context) (isQuorumVersion bool, upgrade t
 
 // ResetTally resets the tally after a version change. It iterates over the
 // store and deletes all versions. It also resets the quorumVersion and
-// upgradeHeight to 0.
+// upgradeHeight to 0. A pending upgrade is recorded as applied in the upgrade
+// history. The signal history is kept.
 func (k *Keeper) ResetTally(ctx sdk.Context) {
+	k.resolveUpgrade(ctx, types.UpgradeStatus_UPGRADE_STATUS_APPLIED)
 	store := ctx.KVStore(k.storeKey)
-	iterator := store.Iterator(nil, nil)
+	// delete the value in the upgrade key and all signals but not the history.
+	for _, r := range types.SignalRanges() {
+		iterator := store.Iterator(r[0], r[1])
+		for ; iterator.Valid(); iterator.Next() {
+			store.Delete(iterator.Key())
+		}
+		iterator.Close()
+	}
+}
+
+// iterateSignals calls cb with the address and version of every validator
+// that has signalled until cb returns true or an error. The signal history is
+// skipped.
+func (k Keeper) iterateSignals(ctx sdk.Context, cb func(valAddress sdk.ValAddress, version uint64) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	for _, r := range types.SignalRanges() {
+		stop, err := iterateSignalRange(store.Iterator(r[0], r[1]), cb)
+		if stop || err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func iterateSignalRange(iterator storetypes.Iterator, cb func(valAddress sdk.ValAddress, version uint64) (bool, error)) (bool, error) {
 	defer iterator.Close()
-	// delete the value in the upgrade key and all signals.
 	for ; iterator.Valid(); iterator.Next() {
-		store.Delete(iterator.Key())
+		if !types.IsSignalKey(iterator.Key()) {
+			continue
+		}
+		stop, err := cb(sdk.ValAddress(iterator.Key()), VersionFromBytes(iterator.Value()))
+		if stop || err != nil {
+			return stop, err
+		}
 	}
+	return false, nil
 }
 
 func VersionToBytes(version uint64) []byte {
@@ -342,6 +550,25 @@ func (k Keeper) GetUpgrade(ctx context.Context, _ *types.QueryGetUpgradeRequest)
 	return &types.QueryGetUpgradeResponse{Upgrade: &upgrade}, nil
 }
 
+// UpgradeCountdown returns the blocks and the estimated time remaining until
+// the pending upgrade.
+func (k Keeper) UpgradeCountdown(ctx context.Context, _ *types.QueryUpgradeCountdownRequest) (*types.QueryUpgradeCountdownResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	upgrade, ok := k.getUpgrade(sdkCtx)
+	if !ok {
+		return &types.QueryUpgradeCountdownResponse{}, nil
+	}
+
+	blocksRemaining := max(upgrade.UpgradeHeight-sdkCtx.BlockHeight(), 0)
+	timeRemaining := time.Duration(blocksRemaining) * appconsts.EstimatedBlockInterval
+	return &types.QueryUpgradeCountdownResponse{
+		Upgrade:                &upgrade,
+		BlocksRemaining:        blocksRemaining,
+		EstimatedTimeRemaining: timeRemaining,
+		EstimatedUpgradeTime:   sdkCtx.BlockTime().Add(timeRemaining),
+	}, nil
+}
+
 // IsUpgradePending returns true if an app version has reached quorum and the
 // chain should upgrade to the app version at the upgrade height. While the
 // keeper has an upgrade pending the SignalVersion and TryUpgrade messages will
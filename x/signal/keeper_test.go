@@ -6,6 +6,7 @@ import (
 	"math"
 	"math/big"
 	"testing"
+	"time"
 
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/log"
@@ -16,6 +17,7 @@ import (
 	"github.com/celestiaorg/celestia-app/v7/app"
 	"github.com/celestiaorg/celestia-app/v7/app/encoding"
 	"github.com/celestiaorg/celestia-app/v7/pkg/appconsts"
+	v5 "github.com/celestiaorg/celestia-app/v7/pkg/appconsts/v5"
 	testutil "github.com/celestiaorg/celestia-app/v7/test/util"
 	"github.com/celestiaorg/celestia-app/v7/x/signal"
 	"github.com/celestiaorg/celestia-app/v7/x/signal/types"
@@ -23,11 +25,16 @@ import (
 	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
 	dbm "github.com/cosmos/cosmos-db"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+var authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
 func TestGetVotingPowerThreshold(t *testing.T) {
 	bigInt := big.NewInt(0)
 	bigInt.SetString("23058430092136939509", 10)
@@ -44,7 +51,7 @@ func TestGetVotingPowerThreshold(t *testing.T) {
 			want:       sdkmath.NewInt(0),
 		},
 		{
-			name:       "one validator with 6 power returns 5 because the defaultSignalThreshold is 5/6",
+			name:       "one validator with 6 power returns 5 because the signal threshold is 5/6",
 			validators: map[string]int64{testutil.ValAddrs[0].String(): 6},
 			want:       sdkmath.NewInt(5),
 		},
@@ -72,7 +79,7 @@ func TestGetVotingPowerThreshold(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			config := encoding.MakeConfig(app.ModuleEncodingRegisters...)
 			stakingKeeper := newMockStakingKeeper(tc.validators)
-			k := signal.NewKeeper(config.Codec, nil, stakingKeeper)
+			k := signal.NewKeeper(config.Codec, nil, stakingKeeper, authority)
 			got, err := k.GetVotingPowerThreshold(sdk.Context{})
 			assert.NoError(t, err)
 			assert.Equal(t, tc.want, got, fmt.Sprintf("want %v, got %v", tc.want.String(), got.String()))
@@ -369,6 +376,61 @@ func TestThresholdVotingPower(t *testing.T) {
 	}
 }
 
+// TestThresholdAcrossUpgrade verifies that the voting power threshold is the
+// one of the current app version, both before and after an upgrade.
+func TestThresholdAcrossUpgrade(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+
+	testCases := []struct {
+		appVersion uint64
+		// wantThreshold is the threshold fraction of the total voting power
+		// of 120, rounded up.
+		wantThreshold int64
+		// wantQuorum is whether validators 0 and 2, which hold 99 of the 120
+		// voting power, reach the threshold.
+		wantQuorum bool
+	}{
+		{appVersion: v5.Version, wantThreshold: 100, wantQuorum: false},
+		{appVersion: v5.Version + 1, wantThreshold: 100, wantQuorum: false},
+		{appVersion: appconsts.Version, wantThreshold: 100, wantQuorum: false},
+	}
+	for _, tc := range testCases {
+
+		ctx = ctx.WithBlockHeader(tmproto.Header{
+			Version: cmtversion.Consensus{Block: 1, App: tc.appVersion},
+		})
+
+		threshold, err := upgradeKeeper.GetVotingPowerThreshold(ctx)
+		require.NoError(t, err)
+		require.Equal(t, sdkmath.NewInt(tc.wantThreshold), threshold)
+
+		res, err := upgradeKeeper.VersionTally(ctx, &types.QueryVersionTallyRequest{Version: tc.appVersion + 1})
+		require.NoError(t, err)
+		require.Equal(t, uint64(tc.wantThreshold), res.ThresholdPower)
+
+		for _, valAddr := range []sdk.ValAddress{testutil.ValAddrs[0], testutil.ValAddrs[2]} {
+			_, err := upgradeKeeper.SignalVersion(ctx, types.NewMsgSignalVersion(valAddr.String(), tc.appVersion+1))
+			require.NoError(t, err)
+		}
+		hasQuorum, _, err := upgradeKeeper.TallyVotingPower(ctx, threshold.Int64())
+		require.NoError(t, err)
+		require.Equal(t, tc.wantQuorum, hasQuorum)
+
+		// validators 0, 2 and 3 hold 119 of the 120 voting power, which
+		// reaches every threshold.
+		_, err = upgradeKeeper.SignalVersion(ctx, types.NewMsgSignalVersion(testutil.ValAddrs[3].String(), tc.appVersion+1))
+		require.NoError(t, err)
+		_, err = upgradeKeeper.TryUpgrade(ctx, &types.MsgTryUpgrade{})
+		require.NoError(t, err)
+		shouldUpgrade, upgrade := upgradeKeeper.ShouldUpgrade(ctx.WithBlockHeight(appconsts.TestUpgradeHeightDelay))
+		require.True(t, shouldUpgrade)
+		require.Equal(t, tc.appVersion+1, upgrade.AppVersion)
+
+		// the application resets the tally once it upgraded.
+		upgradeKeeper.ResetTally(ctx)
+	}
+}
+
 // TestResetTally verifies that ResetTally resets the VotingPower for all
 // versions to 0 and any pending upgrade is cleared.
 func TestResetTally(t *testing.T) {
@@ -483,6 +545,161 @@ func TestTryUpgrade(t *testing.T) {
 	})
 }
 
+func TestTryUpgradeWithEarliestUpgradeTime(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	// The validators have 40, 1, 59 and 20 voting power. The first validator
+	// prefers an upgrade 10 blocks away and the third one 100 blocks away. The
+	// others prefer the upgrade height delay, so the voting power weighted
+	// median is 10.
+	msgs := []*types.MsgSignalVersion{
+		types.NewMsgSignalVersionWithEarliestUpgradeTime(testutil.ValAddrs[0].String(), 2, now.Add(10*appconsts.EstimatedBlockInterval)),
+		types.NewMsgSignalVersion(testutil.ValAddrs[1].String(), 2),
+		types.NewMsgSignalVersionWithEarliestUpgradeTime(testutil.ValAddrs[2].String(), 2, now.Add(100*appconsts.EstimatedBlockInterval)),
+		types.NewMsgSignalVersionWithEarliestUpgradeTime(testutil.ValAddrs[3].String(), 2, now.Add(-time.Hour)),
+	}
+	for _, msg := range msgs {
+		require.NoError(t, msg.ValidateBasic())
+		_, err := upgradeKeeper.SignalVersion(ctx, msg)
+		require.NoError(t, err)
+	}
+
+	// signals with an earliest upgrade time are tallied like any other.
+	res, err := upgradeKeeper.VersionTally(ctx, &types.QueryVersionTallyRequest{Version: 2})
+	require.NoError(t, err)
+	require.EqualValues(t, 120, res.VotingPower)
+
+	_, err = upgradeKeeper.TryUpgrade(ctx, &types.MsgTryUpgrade{})
+	require.NoError(t, err)
+	got, err := upgradeKeeper.GetUpgrade(ctx, &types.QueryGetUpgradeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(10), got.Upgrade.UpgradeHeight)
+
+	countdown, err := upgradeKeeper.UpgradeCountdown(ctx.WithBlockHeight(4), &types.QueryUpgradeCountdownRequest{})
+	require.NoError(t, err)
+	require.Equal(t, got.Upgrade, countdown.Upgrade)
+	require.Equal(t, int64(6), countdown.BlocksRemaining)
+	require.Equal(t, 6*appconsts.EstimatedBlockInterval, countdown.EstimatedTimeRemaining)
+	require.Equal(t, now.Add(6*appconsts.EstimatedBlockInterval), countdown.EstimatedUpgradeTime)
+}
+
+func TestSignalVersionEarliestUpgradeTimeTooLate(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	_, err := upgradeKeeper.SignalVersion(ctx, types.NewMsgSignalVersionWithEarliestUpgradeTime(testutil.ValAddrs[0].String(), 2, now.Add(types.MaxEarliestUpgradeDelay+time.Second)))
+	require.ErrorIs(t, err, types.ErrInvalidEarliestUpgradeTime)
+
+	_, err = upgradeKeeper.SignalVersion(ctx, types.NewMsgSignalVersionWithEarliestUpgradeTime(testutil.ValAddrs[0].String(), 2, now.Add(types.MaxEarliestUpgradeDelay)))
+	require.NoError(t, err)
+}
+
+func TestSignalVersionClearsEarliestUpgradeTime(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	for _, valAddr := range testutil.ValAddrs[:4] {
+		_, err := upgradeKeeper.SignalVersion(ctx, types.NewMsgSignalVersionWithEarliestUpgradeTime(valAddr.String(), 2, now.Add(100*appconsts.EstimatedBlockInterval)))
+		require.NoError(t, err)
+	}
+	// signalling again without an earliest upgrade time drops the earlier one.
+	for _, valAddr := range testutil.ValAddrs[:4] {
+		_, err := upgradeKeeper.SignalVersion(ctx, types.NewMsgSignalVersion(valAddr.String(), 2))
+		require.NoError(t, err)
+	}
+
+	_, err := upgradeKeeper.TryUpgrade(ctx, &types.MsgTryUpgrade{})
+	require.NoError(t, err)
+	got, err := upgradeKeeper.GetUpgrade(ctx, &types.QueryGetUpgradeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, appconsts.GetUpgradeHeightDelay(appconsts.TestChainID), got.Upgrade.UpgradeHeight)
+}
+
+func TestSignalOfValidatorWithReservedPrefix(t *testing.T) {
+	upgradeKeeper, ctx, stakingKeeper := setup(t)
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	// the address starts with the prefix of the earliest upgrade times but is
+	// a signal since it is as long as a validator address.
+	valAddr := sdk.ValAddress(append([]byte{}, types.EarliestUpgradeTimeKey(make([]byte, 17))...))
+	require.Len(t, valAddr, 20)
+	stakingKeeper.validators[valAddr.String()] = 30
+
+	_, err := upgradeKeeper.SignalVersion(ctx, types.NewMsgSignalVersionWithEarliestUpgradeTime(valAddr.String(), 2, now.Add(time.Hour)))
+	require.NoError(t, err)
+	_, err = upgradeKeeper.SignalVersion(ctx, types.NewMsgSignalVersion(testutil.ValAddrs[0].String(), 2))
+	require.NoError(t, err)
+
+	res, err := upgradeKeeper.VersionTally(ctx, &types.QueryVersionTallyRequest{Version: 2})
+	require.NoError(t, err)
+	require.EqualValues(t, 70, res.VotingPower)
+}
+
+func TestSignalOfValidatorWithHistoryPrefix(t *testing.T) {
+	upgradeKeeper, ctx, stakingKeeper := setup(t)
+
+	// the address is the prefix of the history but is a signal since it is as
+	// long as a validator address.
+	valAddr := sdk.ValAddress(append([]byte{}, types.HistoryPrefix...))
+	require.Len(t, valAddr, 20)
+	stakingKeeper.validators[valAddr.String()] = 30
+
+	_, err := upgradeKeeper.SignalVersion(ctx, types.NewMsgSignalVersion(valAddr.String(), 2))
+	require.NoError(t, err)
+	_, err = upgradeKeeper.SignalVersion(ctx, types.NewMsgSignalVersion(testutil.ValAddrs[0].String(), 2))
+	require.NoError(t, err)
+
+	res, err := upgradeKeeper.VersionTally(ctx, &types.QueryVersionTallyRequest{Version: 2})
+	require.NoError(t, err)
+	require.EqualValues(t, 70, res.VotingPower)
+
+	upgradeKeeper.ResetTally(ctx)
+
+	res, err = upgradeKeeper.VersionTally(ctx, &types.QueryVersionTallyRequest{Version: 2})
+	require.NoError(t, err)
+	require.EqualValues(t, 0, res.VotingPower)
+	history, err := upgradeKeeper.SignalHistory(ctx, &types.QuerySignalHistoryRequest{})
+	require.NoError(t, err)
+	require.Len(t, history.Signals, 2)
+}
+
+func TestCancelUpgrade(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+
+	_, err := upgradeKeeper.CancelUpgrade(ctx, types.NewMsgCancelUpgrade(authority))
+	require.ErrorIs(t, err, types.ErrNoUpgradePending)
+
+	for _, valAddr := range testutil.ValAddrs[:4] {
+		_, err := upgradeKeeper.SignalVersion(ctx, types.NewMsgSignalVersion(valAddr.String(), 2))
+		require.NoError(t, err)
+	}
+	_, err = upgradeKeeper.TryUpgrade(ctx, &types.MsgTryUpgrade{})
+	require.NoError(t, err)
+	require.True(t, upgradeKeeper.IsUpgradePending(ctx))
+
+	_, err = upgradeKeeper.CancelUpgrade(ctx, types.NewMsgCancelUpgrade(testutil.ValAddrs[0].String()))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = upgradeKeeper.CancelUpgrade(ctx, types.NewMsgCancelUpgrade(authority))
+	require.NoError(t, err)
+	require.False(t, upgradeKeeper.IsUpgradePending(ctx))
+	require.Equal(t, types.EventTypeCancelUpgrade, ctx.EventManager().Events()[0].Type)
+
+	// the tally was reset so validators have to signal again.
+	res, err := upgradeKeeper.VersionTally(ctx, &types.QueryVersionTallyRequest{Version: 2})
+	require.NoError(t, err)
+	require.EqualValues(t, 0, res.VotingPower)
+	countdown, err := upgradeKeeper.UpgradeCountdown(ctx, &types.QueryUpgradeCountdownRequest{})
+	require.NoError(t, err)
+	require.Nil(t, countdown.Upgrade)
+}
+
 func TestGetUpgrade(t *testing.T) {
 	upgradeKeeper, ctx, _ := setup(t)
 
@@ -571,7 +788,7 @@ func setup(t *testing.T) (signal.Keeper, sdk.Context, *mockStakingKeeper) {
 		},
 	)
 	config := encoding.MakeConfig(app.ModuleEncodingRegisters...)
-	upgradeKeeper := signal.NewKeeper(config.Codec, signalStore, mockStakingKeeper)
+	upgradeKeeper := signal.NewKeeper(config.Codec, signalStore, mockStakingKeeper, authority)
 	return upgradeKeeper, mockCtx, mockStakingKeeper
 }
 
@@ -12,6 +12,7 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgTryUpgrade{}, URLMsgTryUpgrade, nil)
 	cdc.RegisterConcrete(&MsgSignalVersion{}, URLMsgSignalVersion, nil)
+	cdc.RegisterConcrete(&MsgCancelUpgrade{}, URLMsgCancelUpgrade, nil)
 }
 
 // RegisterInterfaces registers the signal module types on the provided
@@ -19,5 +20,6 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgTryUpgrade{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSignalVersion{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgCancelUpgrade{})
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -5,7 +5,9 @@ import (
 )
 
 var (
-	ErrInvalidSignalVersion  = errors.Register(ModuleName, 1, "invalid signal version because signal version can not be less than the current version")
-	ErrInvalidUpgradeVersion = errors.Register(ModuleName, 3, "invalid upgrade version")
-	ErrUpgradePending        = errors.Register(ModuleName, 2, "upgrade is already pending")
+	ErrInvalidSignalVersion       = errors.Register(ModuleName, 1, "invalid signal version because signal version can not be less than the current version")
+	ErrInvalidUpgradeVersion      = errors.Register(ModuleName, 3, "invalid upgrade version")
+	ErrUpgradePending             = errors.Register(ModuleName, 2, "upgrade is already pending")
+	ErrNoUpgradePending           = errors.Register(ModuleName, 4, "no upgrade is pending")
+	ErrInvalidEarliestUpgradeTime = errors.Register(ModuleName, 5, "invalid earliest upgrade time")
 )
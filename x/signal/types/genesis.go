@@ -0,0 +1,36 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultGenesis returns the default signal genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic validation of the signal genesis state.
+func (gs GenesisState) Validate() error {
+	ids := make(map[uint64]struct{}, len(gs.SignalHistory))
+	for _, record := range gs.SignalHistory {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		if _, ok := ids[record.Id]; ok {
+			return fmt.Errorf("duplicate signal record id %d", record.Id)
+		}
+		ids[record.Id] = struct{}{}
+	}
+
+	ids = make(map[uint64]struct{}, len(gs.UpgradeHistory))
+	for _, record := range gs.UpgradeHistory {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		if _, ok := ids[record.Id]; ok {
+			return fmt.Errorf("duplicate upgrade record id %d", record.Id)
+		}
+		ids[record.Id] = struct{}{}
+	}
+	return nil
+}
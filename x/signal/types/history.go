@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxSignalRecords is the number of most recent signal records retained
+	// in the signal history. Older records are pruned at the end of a block.
+	MaxSignalRecords = 10_000
+
+	// MaxUpgradeRecords is the number of most recent upgrade records retained
+	// in the upgrade history. Older records are pruned at the end of a block.
+	MaxUpgradeRecords = 100
+
+	// MaxPrunedRecordsPerBlock is the maximum number of signal and of upgrade
+	// records pruned in a single block.
+	MaxPrunedRecordsPerBlock = 100
+)
+
+// NewSignalRecord creates a new SignalRecord instance.
+func NewSignalRecord(id uint64, valAddress string, version uint64, height int64, blockTime time.Time, votingPower int64, earliestUpgradeTime *time.Time) SignalRecord {
+	return SignalRecord{
+		Id:                  id,
+		ValidatorAddress:    valAddress,
+		Version:             version,
+		Height:              height,
+		Time:                blockTime,
+		VotingPower:         votingPower,
+		EarliestUpgradeTime: earliestUpgradeTime,
+	}
+}
+
+// Validate performs basic validation of the signal record.
+func (r SignalRecord) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(r.ValidatorAddress); err != nil {
+		return fmt.Errorf("signal record %d: invalid validator address: %w", r.Id, err)
+	}
+	if r.Height < 0 {
+		return fmt.Errorf("signal record %d: negative height %d", r.Id, r.Height)
+	}
+	if r.VotingPower < 0 {
+		return fmt.Errorf("signal record %d: negative voting power %d", r.Id, r.VotingPower)
+	}
+	return nil
+}
+
+// NewUpgradeRecord creates a new pending UpgradeRecord instance.
+func NewUpgradeRecord(id uint64, upgrade Upgrade, scheduledHeight int64, scheduledTime time.Time) UpgradeRecord {
+	return UpgradeRecord{
+		Id:              id,
+		AppVersion:      upgrade.AppVersion,
+		UpgradeHeight:   upgrade.UpgradeHeight,
+		ScheduledHeight: scheduledHeight,
+		ScheduledTime:   scheduledTime,
+		Status:          UpgradeStatus_UPGRADE_STATUS_PENDING,
+	}
+}
+
+// Validate performs basic validation of the upgrade record.
+func (r UpgradeRecord) Validate() error {
+	if _, ok := UpgradeStatus_name[int32(r.Status)]; !ok || r.Status == UpgradeStatus_UPGRADE_STATUS_UNSPECIFIED {
+		return fmt.Errorf("upgrade record %d: invalid status %d", r.Id, r.Status)
+	}
+	if r.UpgradeHeight < r.ScheduledHeight {
+		return fmt.Errorf("upgrade record %d: upgrade height %d is before scheduled height %d", r.Id, r.UpgradeHeight, r.ScheduledHeight)
+	}
+	if r.Status == UpgradeStatus_UPGRADE_STATUS_PENDING && r.ResolvedHeight != 0 {
+		return fmt.Errorf("upgrade record %d: pending upgrade has resolved height %d", r.Id, r.ResolvedHeight)
+	}
+	return nil
+}
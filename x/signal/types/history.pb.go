@@ -0,0 +1,1028 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/signal/v1/history.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// UpgradeStatus is the status of an upgrade in the upgrade history.
+type UpgradeStatus int32
+
+const (
+	// UPGRADE_STATUS_UNSPECIFIED is an invalid status.
+	UpgradeStatus_UPGRADE_STATUS_UNSPECIFIED UpgradeStatus = 0
+	// UPGRADE_STATUS_PENDING is the status of an upgrade that reached quorum
+	// but was not applied yet.
+	UpgradeStatus_UPGRADE_STATUS_PENDING UpgradeStatus = 1
+	// UPGRADE_STATUS_APPLIED is the status of an upgrade that was applied.
+	UpgradeStatus_UPGRADE_STATUS_APPLIED UpgradeStatus = 2
+	// UPGRADE_STATUS_CANCELLED is the status of an upgrade that was cancelled
+	// before it was applied.
+	UpgradeStatus_UPGRADE_STATUS_CANCELLED UpgradeStatus = 3
+)
+
+var UpgradeStatus_name = map[int32]string{
+	0: "UPGRADE_STATUS_UNSPECIFIED",
+	1: "UPGRADE_STATUS_PENDING",
+	2: "UPGRADE_STATUS_APPLIED",
+	3: "UPGRADE_STATUS_CANCELLED",
+}
+
+var UpgradeStatus_value = map[string]int32{
+	"UPGRADE_STATUS_UNSPECIFIED": 0,
+	"UPGRADE_STATUS_PENDING":     1,
+	"UPGRADE_STATUS_APPLIED":     2,
+	"UPGRADE_STATUS_CANCELLED":   3,
+}
+
+func (x UpgradeStatus) String() string {
+	return proto.EnumName(UpgradeStatus_name, int32(x))
+}
+
+func (UpgradeStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e00287d263198a01, []int{0}
+}
+
+// SignalRecord is a version signal of a validator kept in the signal history.
+type SignalRecord struct {
+	// id is the sequence number of the signal. Signals are numbered in the
+	// order in which they were submitted.
+	Id               uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Version          uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// height is the block height at which the validator signalled.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the validator signalled.
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	// voting_power is the voting power of the validator when it signalled.
+	VotingPower int64 `protobuf:"varint,6,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	// earliest_upgrade_time is the earliest upgrade time signalled by the
+	// validator, if any.
+	EarliestUpgradeTime *time.Time `protobuf:"bytes,7,opt,name=earliest_upgrade_time,json=earliestUpgradeTime,proto3,stdtime" json:"earliest_upgrade_time,omitempty"`
+}
+
+func (m *SignalRecord) Reset()         { *m = SignalRecord{} }
+func (m *SignalRecord) String() string { return proto.CompactTextString(m) }
+func (*SignalRecord) ProtoMessage()    {}
+func (*SignalRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e00287d263198a01, []int{0}
+}
+func (m *SignalRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignalRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignalRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignalRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalRecord.Merge(m, src)
+}
+func (m *SignalRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignalRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignalRecord proto.InternalMessageInfo
+
+func (m *SignalRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SignalRecord) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *SignalRecord) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SignalRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SignalRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *SignalRecord) GetVotingPower() int64 {
+	if m != nil {
+		return m.VotingPower
+	}
+	return 0
+}
+
+func (m *SignalRecord) GetEarliestUpgradeTime() *time.Time {
+	if m != nil {
+		return m.EarliestUpgradeTime
+	}
+	return nil
+}
+
+// UpgradeRecord is an upgrade kept in the upgrade history.
+type UpgradeRecord struct {
+	// id is the sequence number of the upgrade.
+	Id         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AppVersion uint64 `protobuf:"varint,2,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	// upgrade_height is the height at which the network was scheduled to
+	// upgrade.
+	UpgradeHeight int64 `protobuf:"varint,3,opt,name=upgrade_height,json=upgradeHeight,proto3" json:"upgrade_height,omitempty"`
+	// scheduled_height is the block height at which the upgrade reached quorum.
+	ScheduledHeight int64 `protobuf:"varint,4,opt,name=scheduled_height,json=scheduledHeight,proto3" json:"scheduled_height,omitempty"`
+	// scheduled_time is the block time at which the upgrade reached quorum.
+	ScheduledTime time.Time     `protobuf:"bytes,5,opt,name=scheduled_time,json=scheduledTime,proto3,stdtime" json:"scheduled_time"`
+	Status        UpgradeStatus `protobuf:"varint,6,opt,name=status,proto3,enum=celestia.signal.v1.UpgradeStatus" json:"status,omitempty"`
+	// resolved_height is the block height at which the upgrade was applied or
+	// cancelled. It is zero while the upgrade is pending.
+	ResolvedHeight int64 `protobuf:"varint,7,opt,name=resolved_height,json=resolvedHeight,proto3" json:"resolved_height,omitempty"`
+}
+
+func (m *UpgradeRecord) Reset()         { *m = UpgradeRecord{} }
+func (m *UpgradeRecord) String() string { return proto.CompactTextString(m) }
+func (*UpgradeRecord) ProtoMessage()    {}
+func (*UpgradeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e00287d263198a01, []int{1}
+}
+func (m *UpgradeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpgradeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeRecord.Merge(m, src)
+}
+func (m *UpgradeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeRecord proto.InternalMessageInfo
+
+func (m *UpgradeRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *UpgradeRecord) GetAppVersion() uint64 {
+	if m != nil {
+		return m.AppVersion
+	}
+	return 0
+}
+
+func (m *UpgradeRecord) GetUpgradeHeight() int64 {
+	if m != nil {
+		return m.UpgradeHeight
+	}
+	return 0
+}
+
+func (m *UpgradeRecord) GetScheduledHeight() int64 {
+	if m != nil {
+		return m.ScheduledHeight
+	}
+	return 0
+}
+
+func (m *UpgradeRecord) GetScheduledTime() time.Time {
+	if m != nil {
+		return m.ScheduledTime
+	}
+	return time.Time{}
+}
+
+func (m *UpgradeRecord) GetStatus() UpgradeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return UpgradeStatus_UPGRADE_STATUS_UNSPECIFIED
+}
+
+func (m *UpgradeRecord) GetResolvedHeight() int64 {
+	if m != nil {
+		return m.ResolvedHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("celestia.signal.v1.UpgradeStatus", UpgradeStatus_name, UpgradeStatus_value)
+	proto.RegisterType((*SignalRecord)(nil), "celestia.signal.v1.SignalRecord")
+	proto.RegisterType((*UpgradeRecord)(nil), "celestia.signal.v1.UpgradeRecord")
+}
+
+func init() { proto.RegisterFile("celestia/signal/v1/history.proto", fileDescriptor_e00287d263198a01) }
+
+var fileDescriptor_e00287d263198a01 = []byte{
+	// 546 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcf, 0x8e, 0xd2, 0x40,
+	0x18, 0xa7, 0x05, 0x59, 0x9d, 0x5d, 0x58, 0x1c, 0x75, 0xd3, 0x10, 0x53, 0xd8, 0x4d, 0x8c, 0xa8,
+	0xb1, 0xcd, 0xe2, 0x45, 0x8f, 0x2c, 0xd4, 0x95, 0x48, 0x48, 0x53, 0xc0, 0x83, 0x97, 0x66, 0xa0,
+	0x63, 0x99, 0xa4, 0x30, 0x93, 0x99, 0xa1, 0xba, 0x0f, 0xe0, 0x7d, 0x1f, 0xc5, 0x77, 0xf0, 0xb2,
+	0xc7, 0x3d, 0x7a, 0x52, 0x03, 0x2f, 0x62, 0x3a, 0x6d, 0xd9, 0xec, 0xaa, 0x31, 0xde, 0xe6, 0xfb,
+	0xfd, 0xf9, 0xbe, 0x1f, 0xbf, 0x50, 0xd0, 0x9c, 0xe1, 0x08, 0x0b, 0x49, 0x90, 0x2d, 0x48, 0xb8,
+	0x44, 0x91, 0x1d, 0x1f, 0xdb, 0x73, 0x22, 0x24, 0xe5, 0x67, 0x16, 0xe3, 0x54, 0x52, 0x08, 0x73,
+	0x85, 0x95, 0x2a, 0xac, 0xf8, 0xb8, 0x7e, 0x3f, 0xa4, 0x21, 0x55, 0xb4, 0x9d, 0xbc, 0x52, 0x65,
+	0xbd, 0x11, 0x52, 0x1a, 0x46, 0xd8, 0x56, 0xd3, 0x74, 0xf5, 0xc1, 0x96, 0x64, 0x81, 0x85, 0x44,
+	0x0b, 0x96, 0x0a, 0x8e, 0xbe, 0xe8, 0x60, 0x6f, 0xa4, 0x96, 0x78, 0x78, 0x46, 0x79, 0x00, 0xab,
+	0x40, 0x27, 0x81, 0xa1, 0x35, 0xb5, 0x56, 0xc9, 0xd3, 0x49, 0x00, 0x9f, 0x81, 0xbb, 0x31, 0x8a,
+	0x48, 0x80, 0x24, 0xe5, 0x3e, 0x0a, 0x02, 0x8e, 0x85, 0x30, 0xf4, 0xa6, 0xd6, 0xba, 0xe3, 0xd5,
+	0xb6, 0x44, 0x27, 0xc5, 0xa1, 0x01, 0x76, 0x62, 0xcc, 0x05, 0xa1, 0x4b, 0xa3, 0xa8, 0x36, 0xe4,
+	0x23, 0x3c, 0x00, 0xe5, 0x39, 0x26, 0xe1, 0x5c, 0x1a, 0xa5, 0xa6, 0xd6, 0x2a, 0x7a, 0xd9, 0x04,
+	0x5f, 0x82, 0x52, 0x12, 0xc9, 0xb8, 0xd5, 0xd4, 0x5a, 0xbb, 0xed, 0xba, 0x95, 0xe6, 0xb5, 0xf2,
+	0xbc, 0xd6, 0x38, 0xcf, 0x7b, 0x72, 0xfb, 0xe2, 0x7b, 0xa3, 0x70, 0xfe, 0xa3, 0xa1, 0x79, 0xca,
+	0x01, 0x0f, 0xc1, 0x5e, 0x4c, 0x25, 0x59, 0x86, 0x3e, 0xa3, 0x1f, 0x31, 0x37, 0xca, 0x6a, 0xef,
+	0x6e, 0x8a, 0xb9, 0x09, 0x04, 0xc7, 0xe0, 0x01, 0x46, 0x3c, 0x22, 0x58, 0x48, 0x7f, 0xc5, 0x42,
+	0x8e, 0x02, 0xec, 0xab, 0x6b, 0x3b, 0xff, 0xbc, 0x56, 0x52, 0x97, 0xee, 0xe5, 0xf6, 0x49, 0xea,
+	0x4e, 0xf8, 0xa3, 0xaf, 0x3a, 0xa8, 0x64, 0xf3, 0x5f, 0x3a, 0x6b, 0x80, 0x5d, 0xc4, 0x98, 0x9f,
+	0x57, 0xa1, 0x2b, 0x02, 0x20, 0xc6, 0xde, 0x65, 0x6d, 0x3c, 0x02, 0xd5, 0x3c, 0x4f, 0xd6, 0x4a,
+	0x51, 0xa5, 0xaf, 0x64, 0xe8, 0x9b, 0xb4, 0x9c, 0x27, 0xa0, 0x26, 0x66, 0x73, 0x1c, 0xac, 0x22,
+	0x1c, 0xf8, 0xd7, 0xea, 0xdb, 0xdf, 0xe2, 0x99, 0xf4, 0x2d, 0xa8, 0x5e, 0x49, 0xff, 0xbb, 0xd1,
+	0xca, 0xd6, 0x9b, 0xb0, 0xf0, 0x15, 0x28, 0x0b, 0x89, 0xe4, 0x4a, 0xa8, 0x52, 0xab, 0xed, 0x43,
+	0xeb, 0xf7, 0x3f, 0x9c, 0x95, 0x55, 0x30, 0x52, 0x42, 0x2f, 0x33, 0xc0, 0xc7, 0x60, 0x9f, 0x63,
+	0x41, 0xa3, 0xf8, 0x2a, 0xf1, 0x8e, 0x4a, 0x5c, 0xcd, 0xe1, 0x34, 0xf0, 0xd3, 0xcf, 0x1a, 0xa8,
+	0x5c, 0x5b, 0x01, 0x4d, 0x50, 0x9f, 0xb8, 0xa7, 0x5e, 0xa7, 0xe7, 0xf8, 0xa3, 0x71, 0x67, 0x3c,
+	0x19, 0xf9, 0x93, 0xe1, 0xc8, 0x75, 0xba, 0xfd, 0xd7, 0x7d, 0xa7, 0x57, 0x2b, 0xc0, 0x3a, 0x38,
+	0xb8, 0xc1, 0xbb, 0xce, 0xb0, 0xd7, 0x1f, 0x9e, 0xd6, 0xb4, 0x3f, 0x70, 0x1d, 0xd7, 0x1d, 0x24,
+	0x3e, 0x1d, 0x3e, 0x04, 0xc6, 0x0d, 0xae, 0xdb, 0x19, 0x76, 0x9d, 0xc1, 0xc0, 0xe9, 0xd5, 0x8a,
+	0x27, 0x83, 0x8b, 0xb5, 0xa9, 0x5d, 0xae, 0x4d, 0xed, 0xe7, 0xda, 0xd4, 0xce, 0x37, 0x66, 0xe1,
+	0x72, 0x63, 0x16, 0xbe, 0x6d, 0xcc, 0xc2, 0xfb, 0x76, 0x48, 0xe4, 0x7c, 0x35, 0xb5, 0x66, 0x74,
+	0x61, 0xe7, 0xbf, 0x9f, 0xf2, 0x70, 0xfb, 0x7e, 0x8e, 0x18, 0xb3, 0x3f, 0xe5, 0x1f, 0xa9, 0x3c,
+	0x63, 0x58, 0x4c, 0xcb, 0xaa, 0xe6, 0x17, 0xbf, 0x06, 0x00, 0x1f, 0xfa, 0xa9, 0x9c, 0xc4, 0x03,
+	0x00, 0x00,
+}
+
+func (m *SignalRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignalRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignalRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EarliestUpgradeTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EarliestUpgradeTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EarliestUpgradeTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintHistory(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.VotingPower != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x30
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintHistory(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Version != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResolvedHeight != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.ResolvedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Status != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ScheduledTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ScheduledTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintHistory(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	if m.ScheduledHeight != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.ScheduledHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UpgradeHeight != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.UpgradeHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppVersion != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.AppVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SignalRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovHistory(uint64(m.Id))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovHistory(uint64(m.Version))
+	}
+	if m.Height != 0 {
+		n += 1 + sovHistory(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovHistory(uint64(l))
+	if m.VotingPower != 0 {
+		n += 1 + sovHistory(uint64(m.VotingPower))
+	}
+	if m.EarliestUpgradeTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EarliestUpgradeTime)
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+
+func (m *UpgradeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovHistory(uint64(m.Id))
+	}
+	if m.AppVersion != 0 {
+		n += 1 + sovHistory(uint64(m.AppVersion))
+	}
+	if m.UpgradeHeight != 0 {
+		n += 1 + sovHistory(uint64(m.UpgradeHeight))
+	}
+	if m.ScheduledHeight != 0 {
+		n += 1 + sovHistory(uint64(m.ScheduledHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ScheduledTime)
+	n += 1 + l + sovHistory(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovHistory(uint64(m.Status))
+	}
+	if m.ResolvedHeight != 0 {
+		n += 1 + sovHistory(uint64(m.ResolvedHeight))
+	}
+	return n
+}
+
+func sovHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHistory(x uint64) (n int) {
+	return sovHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SignalRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarliestUpgradeTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EarliestUpgradeTime == nil {
+				m.EarliestUpgradeTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EarliestUpgradeTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradeRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppVersion", wireType)
+			}
+			m.AppVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeHeight", wireType)
+			}
+			m.UpgradeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledHeight", wireType)
+			}
+			m.ScheduledHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= UpgradeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedHeight", wireType)
+			}
+			m.ResolvedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolvedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHistory = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,6 +1,22 @@
 package types
 
+import (
+	"bytes"
+	"encoding/binary"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cometbft/cometbft/crypto"
+)
+
+// The signals of validators are keyed by the raw validator address. Every other
+// entry of the signal store is keyed under ReservedPrefix and its key is never
+// crypto.AddressSize bytes long, the length of a validator address, so that it
+// can't be mistaken for a signal.
 var (
+	// ReservedPrefix is the prefix of all keys of the signal store that are not
+	// signals.
+	ReservedPrefix = []byte{0x00}
+
 	// UpgradeKey is the key in the signal store used to persist an upgrade if one is
 	// pending.
 	UpgradeKey = []byte{0x00}
@@ -9,4 +25,76 @@ var (
 	// the keys associated with signals from validators. In practice, this key
 	// isn't expected to be set or retrieved.
 	FirstSignalKey = []byte{0x000}
+
+	// EarliestUpgradeTimePrefix is the prefix of the earliest upgrade times
+	// signalled by validators along with their version.
+	EarliestUpgradeTimePrefix = []byte{0x00, 0x01}
 )
+
+// IsSignalKey returns whether key is the key of a validator's signal.
+func IsSignalKey(key []byte) bool {
+	return !bytes.HasPrefix(key, ReservedPrefix) || len(key) == crypto.AddressSize
+}
+
+// EarliestUpgradeTimeKey returns the key of the earliest upgrade time
+// signalled by a validator. The address is length prefixed so that the key
+// is never crypto.AddressSize bytes long.
+func EarliestUpgradeTimeKey(valAddress []byte) []byte {
+	key := append([]byte{}, EarliestUpgradeTimePrefix...)
+	key = append(key, byte(len(valAddress)))
+	return append(key, valAddress...)
+}
+
+// HistoryPrefix is the prefix of the signal and upgrade history in the signal
+// store. It is crypto.AddressSize bytes long, so the only validator address it
+// can be mistaken for is the prefix itself, while every history key is longer.
+// This lets the signals be iterated without visiting the history, see
+// SignalRanges, and lets the history outlive a reset of the tally.
+var HistoryPrefix = append([]byte{0x00, 0x02}, make([]byte, crypto.AddressSize-2)...)
+
+// SignalRanges returns the key ranges of the signal store holding everything
+// but the history, i.e. the signals, the upgrade and the earliest upgrade
+// times. The end of a range is exclusive and nil means the end of the store.
+func SignalRanges() [][2][]byte {
+	historyStart := append(bytes.Clone(HistoryPrefix), 0x00)
+	historyEnd := storetypes.PrefixEndBytes(HistoryPrefix)
+	return [][2][]byte{{nil, historyStart}, {historyEnd, nil}}
+}
+
+// Keys of the signal history, relative to HistoryPrefix.
+var (
+	// SignalSequenceKey is the key of the id of the next signal record.
+	SignalSequenceKey = []byte{0x00}
+
+	// SignalRecordPrefix is the prefix of the signal records keyed by id.
+	SignalRecordPrefix = []byte{0x01}
+
+	// SignalValidatorIndexPrefix is the prefix of the index of signal records
+	// by validator address.
+	SignalValidatorIndexPrefix = []byte{0x02}
+
+	// SignalVersionIndexPrefix is the prefix of the index of signal records
+	// by version.
+	SignalVersionIndexPrefix = []byte{0x03}
+
+	// UpgradeSequenceKey is the key of the id of the next upgrade record.
+	UpgradeSequenceKey = []byte{0x04}
+
+	// UpgradeRecordPrefix is the prefix of the upgrade records keyed by id.
+	UpgradeRecordPrefix = []byte{0x05}
+)
+
+// SignalValidatorIndexKey returns the prefix of the signal records of a
+// validator in the validator index. The address is length prefixed so that
+// the prefix of one validator is never the prefix of another.
+func SignalValidatorIndexKey(valAddress []byte) []byte {
+	key := append([]byte{}, SignalValidatorIndexPrefix...)
+	key = append(key, byte(len(valAddress)))
+	return append(key, valAddress...)
+}
+
+// SignalVersionIndexKey returns the prefix of the signal records of a version
+// in the version index.
+func SignalVersionIndexKey(version uint64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{}, SignalVersionIndexPrefix...), version)
+}
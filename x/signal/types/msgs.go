@@ -1,6 +1,9 @@
 package types
 
 import (
+	"time"
+
+	"cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,17 +15,23 @@ const (
 
 	URLMsgSignalVersion = "/celestia.signal.v1.Msg/SignalVersion"
 	URLMsgTryUpgrade    = "/celestia.signal.v1.Msg/TryUpgrade"
+	URLMsgCancelUpgrade = "/celestia.signal.v1.Msg/CancelUpgrade"
 
 	EventTypeTryUpgrade    = "signal_try_upgrade"
 	EventTypeSignalVersion = "signal_version"
+	EventTypeCancelUpgrade = "signal_cancel_upgrade"
 
 	AttributeKeyValidatorAddress = "validator_address"
 	AttributeKeySigner           = "signer"
+	AttributeKeyAuthority        = "authority"
+	AttributeKeyAppVersion       = "app_version"
+	AttributeKeyUpgradeHeight    = "upgrade_height"
 )
 
 var (
 	_ sdk.Msg = &MsgSignalVersion{}
 	_ sdk.Msg = &MsgTryUpgrade{}
+	_ sdk.Msg = &MsgCancelUpgrade{}
 )
 
 var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
@@ -34,9 +43,27 @@ func NewMsgSignalVersion(valAddress string, version uint64) *MsgSignalVersion {
 	}
 }
 
+// MaxEarliestUpgradeDelay is the maximum time between the block time of a
+// signal and the earliest upgrade time it prefers.
+const MaxEarliestUpgradeDelay = 30 * 24 * time.Hour
+
+// NewMsgSignalVersionWithEarliestUpgradeTime returns a MsgSignalVersion that
+// also signals the earliest time at which the validator prefers the upgrade to
+// take place.
+func NewMsgSignalVersionWithEarliestUpgradeTime(valAddress string, version uint64, earliestUpgradeTime time.Time) *MsgSignalVersion {
+	msg := NewMsgSignalVersion(valAddress, version)
+	msg.EarliestUpgradeTime = &earliestUpgradeTime
+	return msg
+}
+
 func (msg *MsgSignalVersion) ValidateBasic() error {
-	_, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
-	return err
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return err
+	}
+	if msg.EarliestUpgradeTime != nil && msg.EarliestUpgradeTime.Unix() <= 0 {
+		return errors.Wrapf(ErrInvalidEarliestUpgradeTime, "%s is not after the unix epoch", msg.EarliestUpgradeTime)
+	}
+	return nil
 }
 
 func NewMsgTryUpgrade(signer sdk.AccAddress) *MsgTryUpgrade {
@@ -49,3 +76,14 @@ func (msg *MsgTryUpgrade) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(msg.Signer)
 	return err
 }
+
+func NewMsgCancelUpgrade(authority string) *MsgCancelUpgrade {
+	return &MsgCancelUpgrade{
+		Authority: authority,
+	}
+}
+
+func (msg *MsgCancelUpgrade) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
@@ -6,21 +6,28 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -307,6 +314,334 @@ func (m *QueryGetMissingValidatorsResponse) GetMissingValidators() []string {
 	return nil
 }
 
+// QueryUpgradeCountdownRequest is the request type for the UpgradeCountdown
+// query.
+type QueryUpgradeCountdownRequest struct {
+}
+
+func (m *QueryUpgradeCountdownRequest) Reset()         { *m = QueryUpgradeCountdownRequest{} }
+func (m *QueryUpgradeCountdownRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeCountdownRequest) ProtoMessage()    {}
+func (*QueryUpgradeCountdownRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{6}
+}
+func (m *QueryUpgradeCountdownRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeCountdownRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeCountdownRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeCountdownRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeCountdownRequest.Merge(m, src)
+}
+func (m *QueryUpgradeCountdownRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeCountdownRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeCountdownRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeCountdownRequest proto.InternalMessageInfo
+
+// QueryUpgradeCountdownResponse is the response type for the UpgradeCountdown
+// query.
+type QueryUpgradeCountdownResponse struct {
+	Upgrade *Upgrade `protobuf:"bytes,1,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
+	// blocks_remaining is the number of blocks until the upgrade height.
+	BlocksRemaining int64 `protobuf:"varint,2,opt,name=blocks_remaining,json=blocksRemaining,proto3" json:"blocks_remaining,omitempty"`
+	// estimated_time_remaining is blocks_remaining times the estimated block
+	// interval.
+	EstimatedTimeRemaining time.Duration `protobuf:"bytes,3,opt,name=estimated_time_remaining,json=estimatedTimeRemaining,proto3,stdduration" json:"estimated_time_remaining"`
+	// estimated_upgrade_time is the current block time plus
+	// estimated_time_remaining.
+	EstimatedUpgradeTime time.Time `protobuf:"bytes,4,opt,name=estimated_upgrade_time,json=estimatedUpgradeTime,proto3,stdtime" json:"estimated_upgrade_time"`
+}
+
+func (m *QueryUpgradeCountdownResponse) Reset()         { *m = QueryUpgradeCountdownResponse{} }
+func (m *QueryUpgradeCountdownResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeCountdownResponse) ProtoMessage()    {}
+func (*QueryUpgradeCountdownResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{7}
+}
+func (m *QueryUpgradeCountdownResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeCountdownResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeCountdownResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeCountdownResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeCountdownResponse.Merge(m, src)
+}
+func (m *QueryUpgradeCountdownResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeCountdownResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeCountdownResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeCountdownResponse proto.InternalMessageInfo
+
+func (m *QueryUpgradeCountdownResponse) GetUpgrade() *Upgrade {
+	if m != nil {
+		return m.Upgrade
+	}
+	return nil
+}
+
+func (m *QueryUpgradeCountdownResponse) GetBlocksRemaining() int64 {
+	if m != nil {
+		return m.BlocksRemaining
+	}
+	return 0
+}
+
+func (m *QueryUpgradeCountdownResponse) GetEstimatedTimeRemaining() time.Duration {
+	if m != nil {
+		return m.EstimatedTimeRemaining
+	}
+	return 0
+}
+
+func (m *QueryUpgradeCountdownResponse) GetEstimatedUpgradeTime() time.Time {
+	if m != nil {
+		return m.EstimatedUpgradeTime
+	}
+	return time.Time{}
+}
+
+// QuerySignalHistoryRequest is the request type for the SignalHistory query.
+type QuerySignalHistoryRequest struct {
+	// validator_address filters the signals by validator if set.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// version filters the signals by version if non-zero.
+	Version    uint64             `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySignalHistoryRequest) Reset()         { *m = QuerySignalHistoryRequest{} }
+func (m *QuerySignalHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySignalHistoryRequest) ProtoMessage()    {}
+func (*QuerySignalHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{8}
+}
+func (m *QuerySignalHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignalHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignalHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySignalHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignalHistoryRequest.Merge(m, src)
+}
+func (m *QuerySignalHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignalHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignalHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySignalHistoryRequest proto.InternalMessageInfo
+
+func (m *QuerySignalHistoryRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *QuerySignalHistoryRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *QuerySignalHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySignalHistoryResponse is the response type for the SignalHistory query.
+type QuerySignalHistoryResponse struct {
+	Signals    []SignalRecord      `protobuf:"bytes,1,rep,name=signals,proto3" json:"signals"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySignalHistoryResponse) Reset()         { *m = QuerySignalHistoryResponse{} }
+func (m *QuerySignalHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySignalHistoryResponse) ProtoMessage()    {}
+func (*QuerySignalHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{9}
+}
+func (m *QuerySignalHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignalHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignalHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySignalHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignalHistoryResponse.Merge(m, src)
+}
+func (m *QuerySignalHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignalHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignalHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySignalHistoryResponse proto.InternalMessageInfo
+
+func (m *QuerySignalHistoryResponse) GetSignals() []SignalRecord {
+	if m != nil {
+		return m.Signals
+	}
+	return nil
+}
+
+func (m *QuerySignalHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryUpgradeHistoryRequest is the request type for the UpgradeHistory query.
+type QueryUpgradeHistoryRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUpgradeHistoryRequest) Reset()         { *m = QueryUpgradeHistoryRequest{} }
+func (m *QueryUpgradeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeHistoryRequest) ProtoMessage()    {}
+func (*QueryUpgradeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{10}
+}
+func (m *QueryUpgradeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeHistoryRequest.Merge(m, src)
+}
+func (m *QueryUpgradeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryUpgradeHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryUpgradeHistoryResponse is the response type for the UpgradeHistory
+// query.
+type QueryUpgradeHistoryResponse struct {
+	Upgrades   []UpgradeRecord     `protobuf:"bytes,1,rep,name=upgrades,proto3" json:"upgrades"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUpgradeHistoryResponse) Reset()         { *m = QueryUpgradeHistoryResponse{} }
+func (m *QueryUpgradeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeHistoryResponse) ProtoMessage()    {}
+func (*QueryUpgradeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{11}
+}
+func (m *QueryUpgradeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeHistoryResponse.Merge(m, src)
+}
+func (m *QueryUpgradeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryUpgradeHistoryResponse) GetUpgrades() []UpgradeRecord {
+	if m != nil {
+		return m.Upgrades
+	}
+	return nil
+}
+
+func (m *QueryUpgradeHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryVersionTallyRequest)(nil), "celestia.signal.v1.QueryVersionTallyRequest")
 	proto.RegisterType((*QueryVersionTallyResponse)(nil), "celestia.signal.v1.QueryVersionTallyResponse")
@@ -314,43 +649,76 @@ func init() {
 	proto.RegisterType((*QueryGetUpgradeResponse)(nil), "celestia.signal.v1.QueryGetUpgradeResponse")
 	proto.RegisterType((*QueryGetMissingValidatorsRequest)(nil), "celestia.signal.v1.QueryGetMissingValidatorsRequest")
 	proto.RegisterType((*QueryGetMissingValidatorsResponse)(nil), "celestia.signal.v1.QueryGetMissingValidatorsResponse")
+	proto.RegisterType((*QueryUpgradeCountdownRequest)(nil), "celestia.signal.v1.QueryUpgradeCountdownRequest")
+	proto.RegisterType((*QueryUpgradeCountdownResponse)(nil), "celestia.signal.v1.QueryUpgradeCountdownResponse")
+	proto.RegisterType((*QuerySignalHistoryRequest)(nil), "celestia.signal.v1.QuerySignalHistoryRequest")
+	proto.RegisterType((*QuerySignalHistoryResponse)(nil), "celestia.signal.v1.QuerySignalHistoryResponse")
+	proto.RegisterType((*QueryUpgradeHistoryRequest)(nil), "celestia.signal.v1.QueryUpgradeHistoryRequest")
+	proto.RegisterType((*QueryUpgradeHistoryResponse)(nil), "celestia.signal.v1.QueryUpgradeHistoryResponse")
 }
 
 func init() { proto.RegisterFile("celestia/signal/v1/query.proto", fileDescriptor_7af24246367e432c) }
 
 var fileDescriptor_7af24246367e432c = []byte{
-	// 490 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xcd, 0x36, 0x40, 0xc5, 0xb4, 0xe2, 0x63, 0x54, 0x81, 0x31, 0x91, 0x95, 0x5a, 0x48, 0x54,
-	0xd0, 0xd8, 0x6a, 0x68, 0x6f, 0x9c, 0xb8, 0x70, 0x01, 0xa9, 0x44, 0x90, 0x03, 0x97, 0x68, 0xdb,
-	0xac, 0x9c, 0x95, 0x1c, 0xaf, 0xbb, 0xbb, 0x31, 0x44, 0x88, 0x03, 0xfc, 0x01, 0x90, 0x10, 0x3f,
-	0x82, 0x7f, 0x02, 0xb7, 0x4a, 0x5c, 0x38, 0xa2, 0x84, 0x1f, 0x82, 0xb2, 0xbb, 0x4e, 0x02, 0x49,
-	0x4a, 0xb9, 0x25, 0x33, 0xef, 0xbd, 0x79, 0xf3, 0x66, 0x0d, 0xc1, 0x31, 0x4b, 0x99, 0xd2, 0x9c,
-	0xc6, 0x8a, 0x27, 0x19, 0x4d, 0xe3, 0x62, 0x2f, 0x3e, 0x19, 0x30, 0x39, 0x8c, 0x72, 0x29, 0xb4,
-	0x40, 0x2c, 0xfb, 0x91, 0xed, 0x47, 0xc5, 0x9e, 0x5f, 0x4b, 0x84, 0x48, 0x52, 0x16, 0xd3, 0x9c,
-	0xc7, 0x34, 0xcb, 0x84, 0xa6, 0x9a, 0x8b, 0x4c, 0x59, 0x86, 0x5f, 0x5f, 0xa2, 0x38, 0xc8, 0x13,
-	0x49, 0xbb, 0xcc, 0x22, 0xc2, 0x7d, 0xf0, 0x9e, 0x4d, 0x46, 0xb4, 0x99, 0x54, 0x5c, 0x64, 0xcf,
-	0x69, 0x9a, 0x0e, 0x5b, 0xec, 0x64, 0xc0, 0x94, 0x46, 0x0f, 0xd6, 0x0b, 0x5b, 0xf6, 0x48, 0x9d,
-	0xec, 0x5c, 0x68, 0x95, 0x7f, 0xc3, 0xcf, 0x04, 0x6e, 0x2d, 0xa1, 0xa9, 0x5c, 0x64, 0x8a, 0xe1,
-	0x36, 0x6c, 0x16, 0x42, 0xf3, 0x2c, 0xe9, 0xe4, 0xe2, 0x15, 0x93, 0x8e, 0xbc, 0x61, 0x6b, 0x87,
-	0x93, 0x12, 0xde, 0x85, 0xab, 0xba, 0x27, 0x99, 0xea, 0x89, 0xb4, 0xeb, 0x50, 0x6b, 0x06, 0x75,
-	0x65, 0x5a, 0xb6, 0xc0, 0x5d, 0x40, 0x2d, 0x34, 0x4d, 0x3b, 0x7f, 0x28, 0x56, 0x0d, 0xf6, 0x9a,
-	0xe9, 0xb4, 0x67, 0xb2, 0xa1, 0x07, 0x37, 0x8c, 0xad, 0xc7, 0x4c, 0xbf, 0xb0, 0x6b, 0xba, 0x5d,
-	0xc2, 0x43, 0xb8, 0xb9, 0xd0, 0x71, 0x76, 0x0f, 0x60, 0xdd, 0x65, 0x62, 0x9c, 0x6e, 0x34, 0x6f,
-	0x47, 0x8b, 0x41, 0x47, 0x25, 0xab, 0xc4, 0x86, 0x0f, 0xa1, 0x5e, 0x2a, 0x3e, 0xe5, 0x4a, 0xf1,
-	0x2c, 0x69, 0xd3, 0x94, 0x77, 0xa9, 0x16, 0x52, 0xfd, 0x3b, 0xc1, 0x16, 0x6c, 0x9f, 0xc1, 0x76,
-	0xce, 0x1a, 0x80, 0x7d, 0xdb, 0xec, 0x14, 0xd3, 0xae, 0x47, 0xea, 0xd5, 0x9d, 0xcb, 0xad, 0xeb,
-	0xfd, 0xbf, 0x69, 0xcd, 0x6f, 0x55, 0xb8, 0x68, 0x44, 0xf1, 0x03, 0x81, 0xcd, 0xf9, 0xd3, 0xe0,
-	0xee, 0xb2, 0x95, 0x56, 0x1d, 0xde, 0x6f, 0x9c, 0x13, 0x6d, 0x6d, 0x86, 0xe1, 0xfb, 0xef, 0xbf,
-	0x3e, 0xad, 0xd5, 0xd0, 0x9f, 0x7b, 0x65, 0x7a, 0x82, 0x88, 0xdf, 0xb8, 0x75, 0xdf, 0xe2, 0x3b,
-	0x02, 0x30, 0xcb, 0x1e, 0xef, 0xad, 0x9c, 0xb0, 0x70, 0x3a, 0xff, 0xfe, 0xb9, 0xb0, 0xce, 0x8b,
-	0x6f, 0xbc, 0x6c, 0x21, 0x2e, 0xbe, 0x78, 0xfc, 0x42, 0x60, 0x6b, 0x59, 0xde, 0xb8, 0x7f, 0xd6,
-	0x84, 0x55, 0xc7, 0xf5, 0x0f, 0xfe, 0x93, 0xe5, 0x1c, 0xde, 0x31, 0x0e, 0x03, 0xac, 0xcd, 0x39,
-	0x74, 0xb7, 0x9c, 0xe5, 0xf5, 0xe8, 0xc9, 0xd7, 0x51, 0x40, 0x4e, 0x47, 0x01, 0xf9, 0x39, 0x0a,
-	0xc8, 0xc7, 0x71, 0x50, 0x39, 0x1d, 0x07, 0x95, 0x1f, 0xe3, 0xa0, 0xf2, 0xb2, 0x99, 0x70, 0xdd,
-	0x1b, 0x1c, 0x45, 0xc7, 0xa2, 0x1f, 0x97, 0x06, 0x84, 0x4c, 0xa6, 0xbf, 0x1b, 0x34, 0xcf, 0xe3,
-	0xd7, 0xa5, 0xb8, 0x1e, 0xe6, 0x4c, 0x1d, 0x5d, 0x32, 0x1f, 0xfb, 0x83, 0xdf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x79, 0xc3, 0x7d, 0xbc, 0x62, 0x04, 0x00, 0x00,
+	// 923 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0xcf, 0x6c, 0x02, 0x69, 0x5f, 0x4a, 0x9b, 0x8e, 0xa2, 0xb2, 0x75, 0x52, 0x67, 0x63, 0x10,
+	0x2d, 0x6d, 0x63, 0xb3, 0xa1, 0xbd, 0x71, 0x80, 0x14, 0x51, 0x0e, 0x20, 0x05, 0x13, 0x72, 0xa8,
+	0x84, 0x56, 0xb3, 0xeb, 0xc1, 0xb1, 0xb0, 0x3d, 0xae, 0x67, 0x76, 0x4b, 0x84, 0x38, 0xc0, 0x17,
+	0xa0, 0x02, 0xf1, 0xe7, 0xc2, 0x19, 0x01, 0x5f, 0x81, 0x0f, 0xd0, 0x63, 0x25, 0x2e, 0x9c, 0x00,
+	0x25, 0x7c, 0x10, 0xe4, 0xf9, 0xe3, 0xf5, 0xee, 0x7a, 0x37, 0x1b, 0xe0, 0xb6, 0x7e, 0xef, 0xf7,
+	0xde, 0xfb, 0xcd, 0x6f, 0xde, 0x7b, 0xb3, 0x60, 0xf7, 0x68, 0x4c, 0xb9, 0x88, 0x88, 0xc7, 0xa3,
+	0x30, 0x25, 0xb1, 0x37, 0x68, 0x7b, 0x0f, 0xfb, 0x34, 0x3f, 0x72, 0xb3, 0x9c, 0x09, 0x86, 0xb1,
+	0xf1, 0xbb, 0xca, 0xef, 0x0e, 0xda, 0xd6, 0x5a, 0xc8, 0x42, 0x26, 0xdd, 0x5e, 0xf1, 0x4b, 0x21,
+	0xad, 0x8d, 0x90, 0xb1, 0x30, 0xa6, 0x1e, 0xc9, 0x22, 0x8f, 0xa4, 0x29, 0x13, 0x44, 0x44, 0x2c,
+	0xe5, 0xda, 0x6b, 0x6b, 0xaf, 0xfc, 0xea, 0xf6, 0x3f, 0xf2, 0x82, 0x7e, 0x2e, 0x01, 0xda, 0xbf,
+	0x39, 0xee, 0x17, 0x51, 0x42, 0xb9, 0x20, 0x49, 0xa6, 0x01, 0xad, 0x1a, 0xa2, 0xfd, 0x2c, 0xcc,
+	0x49, 0x40, 0x67, 0x20, 0x0e, 0x23, 0x2e, 0x98, 0x39, 0x8c, 0x75, 0xb3, 0xc7, 0x78, 0xc2, 0xb8,
+	0xd7, 0x25, 0x9c, 0xaa, 0x53, 0x7a, 0x83, 0x76, 0x97, 0x0a, 0xd2, 0xf6, 0x32, 0x12, 0x46, 0x69,
+	0x85, 0x90, 0x73, 0x07, 0x9a, 0xef, 0x15, 0x88, 0x03, 0x9a, 0xf3, 0x88, 0xa5, 0xfb, 0x24, 0x8e,
+	0x8f, 0x7c, 0xfa, 0xb0, 0x4f, 0xb9, 0xc0, 0x4d, 0x58, 0x1e, 0x28, 0x73, 0x13, 0xb5, 0xd0, 0x8d,
+	0x25, 0xdf, 0x7c, 0x3a, 0xdf, 0x20, 0xb8, 0x5a, 0x13, 0xc6, 0x33, 0x96, 0x72, 0x8a, 0xb7, 0xe0,
+	0xc2, 0x80, 0x89, 0x28, 0x0d, 0x3b, 0x19, 0x7b, 0x44, 0x73, 0x1d, 0xbc, 0xa2, 0x6c, 0x7b, 0x85,
+	0x09, 0x5f, 0x87, 0x4b, 0xe2, 0x30, 0xa7, 0xfc, 0x90, 0xc5, 0x81, 0x46, 0x35, 0x24, 0xea, 0x62,
+	0x69, 0x56, 0xc0, 0xdb, 0x80, 0x05, 0x13, 0x24, 0xee, 0x8c, 0x64, 0x5c, 0x94, 0xd8, 0x55, 0xe9,
+	0x39, 0x18, 0xa6, 0x75, 0x9a, 0x70, 0x45, 0xd2, 0xba, 0x4f, 0xc5, 0x07, 0x4a, 0x34, 0x7d, 0x16,
+	0x67, 0x0f, 0x9e, 0x9f, 0xf0, 0x68, 0xba, 0x77, 0x61, 0x59, 0x2b, 0x2c, 0x99, 0xae, 0xec, 0xac,
+	0xbb, 0x93, 0xdd, 0xe0, 0x9a, 0x28, 0x83, 0x75, 0x5e, 0x83, 0x96, 0xc9, 0xf8, 0x6e, 0xc4, 0x79,
+	0x94, 0x86, 0x07, 0x24, 0x8e, 0x02, 0x22, 0x58, 0xce, 0x4f, 0x57, 0xd0, 0x87, 0xad, 0x19, 0xd1,
+	0x9a, 0xd9, 0x36, 0xe0, 0x44, 0x39, 0x3b, 0x83, 0xd2, 0xdb, 0x44, 0xad, 0xc5, 0x1b, 0xe7, 0xfd,
+	0xcb, 0xc9, 0x78, 0x98, 0x63, 0xc3, 0x86, 0xcc, 0xa9, 0xa9, 0xde, 0x63, 0xfd, 0x54, 0x04, 0xec,
+	0x51, 0x6a, 0x34, 0xf8, 0xb5, 0x01, 0xd7, 0xa6, 0x00, 0xfe, 0x93, 0x14, 0xf8, 0x65, 0x58, 0xed,
+	0xc6, 0xac, 0xf7, 0x31, 0xef, 0xe4, 0x34, 0x21, 0x51, 0x1a, 0xa5, 0xa1, 0xbc, 0xce, 0x45, 0xff,
+	0x92, 0xb2, 0xfb, 0xc6, 0x8c, 0x3f, 0x84, 0x66, 0x91, 0x2e, 0x21, 0x82, 0x06, 0x9d, 0xa2, 0xf9,
+	0x2b, 0x21, 0x8b, 0xb2, 0xe4, 0x55, 0x57, 0xcd, 0x88, 0x6b, 0x66, 0xc4, 0x7d, 0x53, 0xcf, 0xd0,
+	0xee, 0xb9, 0x27, 0x7f, 0x6c, 0x2e, 0x7c, 0xff, 0xe7, 0x26, 0xf2, 0xaf, 0x94, 0x49, 0xf6, 0xa3,
+	0x84, 0x0e, 0xd3, 0x3f, 0x80, 0xa1, 0xa7, 0xa3, 0xe9, 0xc9, 0x32, 0xcd, 0x25, 0x99, 0xdc, 0x9a,
+	0x48, 0xbe, 0x6f, 0x06, 0x50, 0x65, 0x7f, 0x5c, 0x64, 0x5f, 0x2b, 0x73, 0xe8, 0xa3, 0x16, 0x20,
+	0xe7, 0x67, 0xd3, 0xf4, 0xef, 0x4b, 0x25, 0xde, 0x56, 0x33, 0x67, 0xae, 0xfa, 0x16, 0x5c, 0x2e,
+	0xef, 0xa8, 0x43, 0x82, 0x20, 0xa7, 0x9c, 0x4b, 0x11, 0xcf, 0xfb, 0xab, 0xa5, 0xe3, 0x0d, 0x65,
+	0xaf, 0xf6, 0x45, 0x63, 0xa4, 0x2f, 0xf0, 0x5b, 0x00, 0xc3, 0x19, 0xd5, 0x8a, 0xbc, 0xe4, 0xaa,
+	0x81, 0x76, 0x8b, 0x81, 0x76, 0xd5, 0xda, 0xd2, 0x03, 0xed, 0xee, 0x91, 0xd0, 0xf4, 0xb8, 0x5f,
+	0x89, 0x74, 0x7e, 0x44, 0x60, 0xd5, 0x91, 0xd5, 0x17, 0xfd, 0x3a, 0x2c, 0xab, 0xfb, 0x54, 0xed,
+	0xb4, 0xb2, 0xd3, 0xaa, 0xbb, 0x68, 0x15, 0xeb, 0xd3, 0x1e, 0xcb, 0x83, 0xdd, 0xa5, 0x42, 0x1e,
+	0xdf, 0x84, 0xe1, 0xfb, 0x23, 0x44, 0x1b, 0x92, 0xe8, 0xf5, 0x53, 0x89, 0xaa, 0xf2, 0x23, 0x4c,
+	0x03, 0x4d, 0x54, 0x4b, 0x3d, 0x26, 0xeb, 0xa8, 0x1e, 0xe8, 0x5f, 0xeb, 0xf1, 0x0b, 0x82, 0xf5,
+	0xda, 0x32, 0x5a, 0x90, 0x7b, 0x70, 0x4e, 0xb7, 0x8b, 0x51, 0x64, 0x6b, 0x56, 0xeb, 0x57, 0x25,
+	0x29, 0x03, 0xff, 0x37, 0x4d, 0x76, 0xbe, 0x5b, 0x86, 0x67, 0x24, 0x5b, 0xfc, 0x25, 0x82, 0x0b,
+	0xd5, 0x25, 0x8b, 0x6f, 0xd7, 0xd1, 0x9a, 0xb6, 0xc2, 0xad, 0xed, 0x39, 0xd1, 0x8a, 0x83, 0xe3,
+	0x7c, 0xf1, 0xdb, 0xdf, 0x5f, 0x37, 0x36, 0xb0, 0x55, 0x79, 0x5b, 0x44, 0x81, 0xf0, 0x3e, 0xd5,
+	0x0d, 0xfa, 0x19, 0xfe, 0x1c, 0x01, 0x0c, 0xb7, 0x28, 0xbe, 0x39, 0xb5, 0xc2, 0xc4, 0x12, 0xb6,
+	0x6e, 0xcd, 0x85, 0xd5, 0x5c, 0x2c, 0xc9, 0x65, 0x0d, 0xe3, 0xc9, 0x97, 0x10, 0xff, 0x84, 0x60,
+	0xad, 0x6e, 0x73, 0xe2, 0x3b, 0xb3, 0x2a, 0x4c, 0x5b, 0xd3, 0xd6, 0xdd, 0x33, 0x46, 0x69, 0x86,
+	0x2f, 0x4a, 0x86, 0x36, 0xde, 0xa8, 0x30, 0xd4, 0x5b, 0xb9, 0xa2, 0xd7, 0x0f, 0x08, 0x56, 0xc7,
+	0x17, 0x2e, 0x7e, 0x65, 0x6a, 0xc5, 0x29, 0xcb, 0xdb, 0x6a, 0x9f, 0x21, 0x62, 0x06, 0x3f, 0xad,
+	0xa0, 0xd7, 0x2b, 0xa9, 0x7c, 0x85, 0xe0, 0xb9, 0x91, 0x25, 0x81, 0xa7, 0x37, 0x4d, 0xdd, 0xe6,
+	0xb3, 0xdc, 0x79, 0xe1, 0x33, 0x9a, 0x4c, 0xff, 0x81, 0xf1, 0xcc, 0x76, 0xf9, 0x16, 0xc1, 0xc5,
+	0xd1, 0x49, 0xc5, 0xee, 0x69, 0x02, 0x8c, 0xd1, 0xf2, 0xe6, 0xc6, 0x6b, 0x5e, 0x2f, 0x48, 0x5e,
+	0xd7, 0xf0, 0x7a, 0x0d, 0x2f, 0x33, 0xe2, 0xbb, 0xef, 0x3c, 0x39, 0xb6, 0xd1, 0xd3, 0x63, 0x1b,
+	0xfd, 0x75, 0x6c, 0xa3, 0xc7, 0x27, 0xf6, 0xc2, 0xd3, 0x13, 0x7b, 0xe1, 0xf7, 0x13, 0x7b, 0xe1,
+	0xc1, 0x4e, 0x18, 0x89, 0xc3, 0x7e, 0xd7, 0xed, 0xb1, 0xc4, 0x33, 0x95, 0x59, 0x1e, 0x96, 0xbf,
+	0xb7, 0x49, 0x96, 0x79, 0x9f, 0x98, 0xdc, 0xe2, 0x28, 0xa3, 0xbc, 0xfb, 0xac, 0x7c, 0x86, 0x5e,
+	0xfd, 0x67, 0x00, 0x9e, 0x93, 0x88, 0xc6, 0x9f, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -374,6 +742,15 @@ type QueryClient interface {
 	// GetMissingValidators enables a client to query for the validators that
 	// have not yet signalled for a particular version
 	GetMissingValidators(ctx context.Context, in *QueryGetMissingValidatorsRequest, opts ...grpc.CallOption) (*QueryGetMissingValidatorsResponse, error)
+	// UpgradeCountdown enables a client to query for the blocks and the
+	// estimated time remaining until a pending upgrade. The response will be
+	// empty if no upgrade is pending.
+	UpgradeCountdown(ctx context.Context, in *QueryUpgradeCountdownRequest, opts ...grpc.CallOption) (*QueryUpgradeCountdownResponse, error)
+	// SignalHistory enables a client to query for the history of version
+	// signals, optionally filtered by validator and version.
+	SignalHistory(ctx context.Context, in *QuerySignalHistoryRequest, opts ...grpc.CallOption) (*QuerySignalHistoryResponse, error)
+	// UpgradeHistory enables a client to query for the history of upgrades.
+	UpgradeHistory(ctx context.Context, in *QueryUpgradeHistoryRequest, opts ...grpc.CallOption) (*QueryUpgradeHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -411,6 +788,33 @@ func (c *queryClient) GetMissingValidators(ctx context.Context, in *QueryGetMiss
 	return out, nil
 }
 
+func (c *queryClient) UpgradeCountdown(ctx context.Context, in *QueryUpgradeCountdownRequest, opts ...grpc.CallOption) (*QueryUpgradeCountdownResponse, error) {
+	out := new(QueryUpgradeCountdownResponse)
+	err := c.cc.Invoke(ctx, "/celestia.signal.v1.Query/UpgradeCountdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SignalHistory(ctx context.Context, in *QuerySignalHistoryRequest, opts ...grpc.CallOption) (*QuerySignalHistoryResponse, error) {
+	out := new(QuerySignalHistoryResponse)
+	err := c.cc.Invoke(ctx, "/celestia.signal.v1.Query/SignalHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UpgradeHistory(ctx context.Context, in *QueryUpgradeHistoryRequest, opts ...grpc.CallOption) (*QueryUpgradeHistoryResponse, error) {
+	out := new(QueryUpgradeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/celestia.signal.v1.Query/UpgradeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// VersionTally enables a client to query for the tally of voting power that
@@ -422,6 +826,15 @@ type QueryServer interface {
 	// GetMissingValidators enables a client to query for the validators that
 	// have not yet signalled for a particular version
 	GetMissingValidators(context.Context, *QueryGetMissingValidatorsRequest) (*QueryGetMissingValidatorsResponse, error)
+	// UpgradeCountdown enables a client to query for the blocks and the
+	// estimated time remaining until a pending upgrade. The response will be
+	// empty if no upgrade is pending.
+	UpgradeCountdown(context.Context, *QueryUpgradeCountdownRequest) (*QueryUpgradeCountdownResponse, error)
+	// SignalHistory enables a client to query for the history of version
+	// signals, optionally filtered by validator and version.
+	SignalHistory(context.Context, *QuerySignalHistoryRequest) (*QuerySignalHistoryResponse, error)
+	// UpgradeHistory enables a client to query for the history of upgrades.
+	UpgradeHistory(context.Context, *QueryUpgradeHistoryRequest) (*QueryUpgradeHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -437,6 +850,15 @@ func (*UnimplementedQueryServer) GetUpgrade(ctx context.Context, req *QueryGetUp
 func (*UnimplementedQueryServer) GetMissingValidators(ctx context.Context, req *QueryGetMissingValidatorsRequest) (*QueryGetMissingValidatorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMissingValidators not implemented")
 }
+func (*UnimplementedQueryServer) UpgradeCountdown(ctx context.Context, req *QueryUpgradeCountdownRequest) (*QueryUpgradeCountdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeCountdown not implemented")
+}
+func (*UnimplementedQueryServer) SignalHistory(ctx context.Context, req *QuerySignalHistoryRequest) (*QuerySignalHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalHistory not implemented")
+}
+func (*UnimplementedQueryServer) UpgradeHistory(ctx context.Context, req *QueryUpgradeHistoryRequest) (*QueryUpgradeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -496,6 +918,60 @@ func _Query_GetMissingValidators_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UpgradeCountdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpgradeCountdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpgradeCountdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.signal.v1.Query/UpgradeCountdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpgradeCountdown(ctx, req.(*QueryUpgradeCountdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignalHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignalHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignalHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.signal.v1.Query/SignalHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignalHistory(ctx, req.(*QuerySignalHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UpgradeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpgradeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpgradeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.signal.v1.Query/UpgradeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpgradeHistory(ctx, req.(*QueryUpgradeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.signal.v1.Query",
@@ -513,6 +989,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMissingValidators",
 			Handler:    _Query_GetMissingValidators_Handler,
 		},
+		{
+			MethodName: "UpgradeCountdown",
+			Handler:    _Query_UpgradeCountdown_Handler,
+		},
+		{
+			MethodName: "SignalHistory",
+			Handler:    _Query_SignalHistory_Handler,
+		},
+		{
+			MethodName: "UpgradeHistory",
+			Handler:    _Query_UpgradeHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/signal/v1/query.proto",
@@ -702,12 +1190,271 @@ func (m *QueryGetMissingValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
+func (m *QueryUpgradeCountdownRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeCountdownRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeCountdownRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradeCountdownResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeCountdownResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeCountdownResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EstimatedUpgradeTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EstimatedUpgradeTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.EstimatedTimeRemaining, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EstimatedTimeRemaining):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	if m.BlocksRemaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksRemaining))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Upgrade != nil {
+		{
+			size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignalHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignalHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignalHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignalHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignalHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignalHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signals) > 0 {
+		for iNdEx := len(m.Signals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Upgrades) > 0 {
+		for iNdEx := len(m.Upgrades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Upgrades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
 		offset++
 	}
 	dAtA[offset] = uint8(v)
@@ -792,46 +1539,747 @@ func (m *QueryGetMissingValidatorsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryUpgradeCountdownRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryUpgradeCountdownResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Upgrade != nil {
+		l = m.Upgrade.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlocksRemaining != 0 {
+		n += 1 + sovQuery(uint64(m.BlocksRemaining))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EstimatedTimeRemaining)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EstimatedUpgradeTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
 }
-func (m *QueryVersionTallyRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+
+func (m *QuerySignalHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignalHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signals) > 0 {
+		for _, e := range m.Signals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUpgradeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUpgradeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Upgrades) > 0 {
+		for _, e := range m.Upgrades {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryVersionTallyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVersionTallyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVersionTallyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVersionTallyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVersionTallyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVersionTallyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdPower", wireType)
+			}
+			m.ThresholdPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThresholdPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetUpgradeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetUpgradeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetUpgradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetUpgradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetUpgradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Upgrade == nil {
+				m.Upgrade = &Upgrade{}
+			}
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMissingValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMissingValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMissingValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMissingValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMissingValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMissingValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissingValidators = append(m.MissingValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpgradeCountdownRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradeCountdownRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradeCountdownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpgradeCountdownResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradeCountdownResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradeCountdownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Upgrade == nil {
+				m.Upgrade = &Upgrade{}
+			}
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksRemaining", wireType)
+			}
+			m.BlocksRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksRemaining |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedTimeRemaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.EstimatedTimeRemaining, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVersionTallyRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVersionTallyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedUpgradeTime", wireType)
 			}
-			m.Version = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -841,11 +2289,25 @@ func (m *QueryVersionTallyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EstimatedUpgradeTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -867,7 +2329,7 @@ func (m *QueryVersionTallyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVersionTallyResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySignalHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -890,17 +2352,17 @@ func (m *QueryVersionTallyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVersionTallyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignalHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVersionTallyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignalHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.VotingPower = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -910,16 +2372,29 @@ func (m *QueryVersionTallyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotingPower |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.ThresholdPower = 0
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -929,16 +2404,16 @@ func (m *QueryVersionTallyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ThresholdPower |= uint64(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.TotalVotingPower = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -948,61 +2423,28 @@ func (m *QueryVersionTallyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalVotingPower |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetUpgradeRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetUpgradeRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetUpgradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1024,7 +2466,7 @@ func (m *QueryGetUpgradeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetUpgradeResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySignalHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1047,15 +2489,15 @@ func (m *QueryGetUpgradeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetUpgradeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignalHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignalHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1082,10 +2524,44 @@ func (m *QueryGetUpgradeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Upgrade == nil {
-				m.Upgrade = &Upgrade{}
+			m.Signals = append(m.Signals, SignalRecord{})
+			if err := m.Signals[len(m.Signals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1110,7 +2586,7 @@ func (m *QueryGetUpgradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMissingValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUpgradeHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1133,17 +2609,17 @@ func (m *QueryGetMissingValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMissingValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUpgradeHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMissingValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUpgradeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Version = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1153,11 +2629,28 @@ func (m *QueryGetMissingValidatorsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1179,7 +2672,7 @@ func (m *QueryGetMissingValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMissingValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUpgradeHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1202,17 +2695,17 @@ func (m *QueryGetMissingValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMissingValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUpgradeHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMissingValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUpgradeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissingValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrades", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1222,23 +2715,61 @@ func (m *QueryGetMissingValidatorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MissingValidators = append(m.MissingValidators, string(dAtA[iNdEx:postIndex]))
+			m.Upgrades = append(m.Upgrades, UpgradeRecord{})
+			if err := m.Upgrades[len(m.Upgrades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8,21 +8,26 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -34,6 +39,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type MsgSignalVersion struct {
 	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	Version          uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// earliest_upgrade_time is the optional earliest time at which the validator
+	// prefers the upgrade to take place. When a version reaches quorum, the
+	// upgrade height is the voting power weighted median of the heights
+	// preferred by the validators that signalled for it.
+	EarliestUpgradeTime *time.Time `protobuf:"bytes,3,opt,name=earliest_upgrade_time,json=earliestUpgradeTime,proto3,stdtime" json:"earliest_upgrade_time,omitempty"`
 }
 
 func (m *MsgSignalVersion) Reset()         { *m = MsgSignalVersion{} }
@@ -83,6 +93,13 @@ func (m *MsgSignalVersion) GetVersion() uint64 {
 	return 0
 }
 
+func (m *MsgSignalVersion) GetEarliestUpgradeTime() *time.Time {
+	if m != nil {
+		return m.EarliestUpgradeTime
+	}
+	return nil
+}
+
 // MsgSignalVersionResponse is the response type for the SignalVersion method.
 type MsgSignalVersionResponse struct {
 }
@@ -202,44 +219,137 @@ func (m *MsgTryUpgradeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgTryUpgradeResponse proto.InternalMessageInfo
 
+// MsgCancelUpgrade clears a pending upgrade and resets the tally so that
+// validators have to signal again.
+type MsgCancelUpgrade struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgCancelUpgrade) Reset()         { *m = MsgCancelUpgrade{} }
+func (m *MsgCancelUpgrade) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUpgrade) ProtoMessage()    {}
+func (*MsgCancelUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_815f2cc162e6e27e, []int{4}
+}
+func (m *MsgCancelUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUpgrade.Merge(m, src)
+}
+func (m *MsgCancelUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelUpgrade proto.InternalMessageInfo
+
+func (m *MsgCancelUpgrade) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgCancelUpgradeResponse is the response type for the CancelUpgrade method.
+type MsgCancelUpgradeResponse struct {
+}
+
+func (m *MsgCancelUpgradeResponse) Reset()         { *m = MsgCancelUpgradeResponse{} }
+func (m *MsgCancelUpgradeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUpgradeResponse) ProtoMessage()    {}
+func (*MsgCancelUpgradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_815f2cc162e6e27e, []int{5}
+}
+func (m *MsgCancelUpgradeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUpgradeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelUpgradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUpgradeResponse.Merge(m, src)
+}
+func (m *MsgCancelUpgradeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUpgradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUpgradeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelUpgradeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSignalVersion)(nil), "celestia.signal.v1.MsgSignalVersion")
 	proto.RegisterType((*MsgSignalVersionResponse)(nil), "celestia.signal.v1.MsgSignalVersionResponse")
 	proto.RegisterType((*MsgTryUpgrade)(nil), "celestia.signal.v1.MsgTryUpgrade")
 	proto.RegisterType((*MsgTryUpgradeResponse)(nil), "celestia.signal.v1.MsgTryUpgradeResponse")
+	proto.RegisterType((*MsgCancelUpgrade)(nil), "celestia.signal.v1.MsgCancelUpgrade")
+	proto.RegisterType((*MsgCancelUpgradeResponse)(nil), "celestia.signal.v1.MsgCancelUpgradeResponse")
 }
 
 func init() { proto.RegisterFile("celestia/signal/v1/tx.proto", fileDescriptor_815f2cc162e6e27e) }
 
 var fileDescriptor_815f2cc162e6e27e = []byte{
-	// 424 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4d, 0x6f, 0xd3, 0x30,
-	0x1c, 0xc6, 0xeb, 0x02, 0x43, 0x18, 0x4d, 0xda, 0xcc, 0x60, 0x59, 0x80, 0x68, 0x8b, 0x38, 0x8c,
-	0x89, 0xc6, 0x6c, 0xdc, 0x76, 0x63, 0x67, 0xba, 0x43, 0x07, 0x3b, 0x70, 0xa9, 0xdc, 0xc6, 0x32,
-	0x96, 0x92, 0x38, 0xf2, 0xdf, 0x8d, 0xda, 0x0b, 0x42, 0x15, 0x1f, 0x00, 0x09, 0x3e, 0x48, 0x0f,
-	0xfd, 0x10, 0x1c, 0xab, 0x72, 0xe1, 0x88, 0x5a, 0xa4, 0x7e, 0x0d, 0xd4, 0x38, 0x29, 0x7d, 0x11,
-	0x62, 0x37, 0xff, 0xf3, 0x3c, 0x79, 0x7e, 0x8f, 0x5f, 0xf0, 0xe3, 0x36, 0x8f, 0x38, 0x18, 0xc9,
-	0x28, 0x48, 0x91, 0xb0, 0x88, 0x66, 0xa7, 0xd4, 0x74, 0x83, 0x54, 0x2b, 0xa3, 0x08, 0x29, 0xc5,
-	0xc0, 0x8a, 0x41, 0x76, 0xea, 0x3e, 0x11, 0x4a, 0x89, 0x88, 0x53, 0x96, 0x4a, 0xca, 0x92, 0x44,
-	0x19, 0x66, 0xa4, 0x4a, 0xc0, 0xfe, 0xe1, 0xee, 0xb7, 0x15, 0xc4, 0x0a, 0x68, 0x0c, 0x62, 0x9e,
-	0x14, 0x83, 0x28, 0x84, 0x03, 0x2b, 0x34, 0xf3, 0x89, 0xda, 0xc1, 0x4a, 0xfe, 0x37, 0x84, 0x77,
-	0xea, 0x20, 0xae, 0x72, 0xc4, 0x35, 0xd7, 0x20, 0x55, 0x42, 0x2e, 0xf1, 0x6e, 0xc6, 0x22, 0x19,
-	0x32, 0xa3, 0x74, 0x93, 0x85, 0xa1, 0xe6, 0x00, 0x0e, 0x3a, 0x44, 0xc7, 0xf7, 0x2e, 0x8e, 0xc6,
-	0xc3, 0xda, 0xd3, 0x22, 0xe1, 0xba, 0xf4, 0xbc, 0xb6, 0x96, 0x2b, 0xa3, 0x65, 0x22, 0x1a, 0x3b,
-	0xd9, 0xda, 0x77, 0xe2, 0xe0, 0xbb, 0x99, 0x8d, 0x76, 0xaa, 0x87, 0xe8, 0xf8, 0x76, 0xa3, 0x1c,
-	0xcf, 0x1f, 0xf5, 0x67, 0x83, 0x93, 0x4d, 0x98, 0xef, 0x62, 0x67, 0xbd, 0x55, 0x83, 0x43, 0xaa,
-	0x12, 0xe0, 0xfe, 0x25, 0xde, 0xae, 0x83, 0x78, 0xab, 0x7b, 0xef, 0x52, 0xa1, 0x59, 0xc8, 0xc9,
-	0x4b, 0xbc, 0x35, 0x3f, 0x22, 0xae, 0x8b, 0x8e, 0xce, 0x78, 0x58, 0xdb, 0x2b, 0x3a, 0xae, 0x56,
-	0x2b, 0x7c, 0xe7, 0xf7, 0xe7, 0xd8, 0x62, 0xf0, 0xf7, 0xf1, 0xc3, 0x95, 0xbc, 0x12, 0x74, 0xf6,
-	0xb9, 0x8a, 0x6f, 0xd5, 0x41, 0x90, 0x8f, 0x78, 0x7b, 0xf5, 0x7c, 0x9e, 0x05, 0x9b, 0x77, 0x13,
-	0xac, 0xf7, 0x75, 0x5f, 0xdc, 0xc4, 0xb5, 0xd8, 0xd5, 0x41, 0xff, 0xc7, 0xef, 0xaf, 0xd5, 0x07,
-	0xfe, 0xee, 0xd2, 0x5b, 0xb0, 0x2b, 0x92, 0x61, 0xbc, 0xb4, 0xdb, 0xa3, 0x7f, 0xc4, 0xfe, 0xb5,
-	0xb8, 0xcf, 0xff, 0x6b, 0x59, 0x60, 0xdd, 0x1c, 0xbb, 0xe7, 0x93, 0x25, 0x6c, 0xa7, 0x88, 0xb9,
-	0xf3, 0x69, 0x36, 0x38, 0x41, 0x17, 0x6f, 0xbe, 0x4f, 0x3c, 0x34, 0x9a, 0x78, 0xe8, 0xd7, 0xc4,
-	0x43, 0x5f, 0xa6, 0x5e, 0x65, 0x34, 0xf5, 0x2a, 0x3f, 0xa7, 0x5e, 0xe5, 0xfd, 0x99, 0x90, 0xe6,
-	0x43, 0xa7, 0x15, 0xb4, 0x55, 0x4c, 0x4b, 0xa2, 0xd2, 0x62, 0xb1, 0xae, 0xb1, 0x34, 0xa5, 0xdd,
-	0x32, 0xd9, 0xf4, 0x52, 0x0e, 0xad, 0xad, 0xfc, 0xdd, 0xbd, 0xfa, 0x13, 0x00, 0x00, 0xff, 0xff,
-	0xac, 0xd7, 0x83, 0x5d, 0xfc, 0x02, 0x00, 0x00,
+	// 543 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x41, 0x4f, 0xdb, 0x3c,
+	0x18, 0x26, 0x85, 0x8f, 0x4f, 0x18, 0x75, 0x82, 0x50, 0x46, 0xc8, 0xb6, 0x50, 0xa2, 0x1d, 0x3a,
+	0x44, 0x93, 0xd1, 0x49, 0x3b, 0xf4, 0xb6, 0xee, 0xba, 0x72, 0x28, 0x1d, 0x07, 0x2e, 0x95, 0xdb,
+	0x7a, 0xc6, 0x52, 0x12, 0x47, 0xb6, 0x1b, 0xd1, 0xcb, 0x34, 0xf1, 0x0b, 0x90, 0xf6, 0x47, 0x38,
+	0xf0, 0x23, 0x76, 0x44, 0xec, 0xb2, 0xdb, 0xa6, 0x76, 0x12, 0xda, 0x7e, 0xc5, 0x94, 0xd8, 0x6e,
+	0x9b, 0x22, 0x04, 0x37, 0xbf, 0x79, 0x1e, 0x3f, 0xcf, 0xfb, 0xbc, 0x7e, 0x03, 0x9e, 0xf5, 0x50,
+	0x80, 0xb8, 0x20, 0xd0, 0xe7, 0x04, 0x47, 0x30, 0xf0, 0x93, 0x03, 0x5f, 0x9c, 0x79, 0x31, 0xa3,
+	0x82, 0x9a, 0xa6, 0x06, 0x3d, 0x09, 0x7a, 0xc9, 0x81, 0x5d, 0xc2, 0x14, 0xd3, 0x0c, 0xf6, 0xd3,
+	0x93, 0x64, 0xda, 0xcf, 0x31, 0xa5, 0x38, 0x40, 0x3e, 0x8c, 0x89, 0x0f, 0xa3, 0x88, 0x0a, 0x28,
+	0x08, 0x8d, 0xb8, 0x42, 0x77, 0x14, 0x9a, 0x55, 0xdd, 0xc1, 0x27, 0x5f, 0x90, 0x10, 0x71, 0x01,
+	0xc3, 0x58, 0x11, 0xb6, 0x7a, 0x94, 0x87, 0x94, 0xfb, 0x21, 0xc7, 0x69, 0x03, 0x21, 0xc7, 0x0a,
+	0xd8, 0x96, 0x40, 0x47, 0x1a, 0xca, 0x42, 0x42, 0xee, 0x5f, 0x03, 0xac, 0x35, 0x39, 0x3e, 0xca,
+	0x3a, 0x3b, 0x46, 0x8c, 0x13, 0x1a, 0x99, 0x87, 0x60, 0x3d, 0x81, 0x01, 0xe9, 0x43, 0x41, 0x59,
+	0x07, 0xf6, 0xfb, 0x0c, 0x71, 0x6e, 0x19, 0x65, 0xa3, 0xb2, 0xd2, 0xd8, 0xbd, 0xb9, 0xaa, 0xbe,
+	0x50, 0x0a, 0xc7, 0x9a, 0xf3, 0x4e, 0x52, 0x8e, 0x04, 0x23, 0x11, 0x6e, 0xad, 0x25, 0x73, 0xdf,
+	0x4d, 0x0b, 0xfc, 0x9f, 0x48, 0x69, 0xab, 0x50, 0x36, 0x2a, 0x4b, 0x2d, 0x5d, 0x9a, 0x6d, 0xb0,
+	0x89, 0x20, 0x0b, 0x08, 0xe2, 0xa2, 0x33, 0x88, 0x31, 0x83, 0x7d, 0xd4, 0x49, 0x63, 0x59, 0x8b,
+	0x65, 0xa3, 0xb2, 0x5a, 0xb3, 0x3d, 0x99, 0xd9, 0xd3, 0x99, 0xbd, 0xb6, 0xce, 0xdc, 0x58, 0xba,
+	0xf8, 0xb9, 0x63, 0xb4, 0x36, 0xf4, 0xf5, 0x8f, 0xf2, 0x76, 0x8a, 0xd7, 0x9f, 0x9e, 0xdf, 0x5e,
+	0xee, 0xdd, 0x8d, 0xe0, 0xda, 0xc0, 0x9a, 0xcf, 0xda, 0x42, 0x3c, 0xa6, 0x11, 0x47, 0xee, 0x21,
+	0x28, 0x36, 0x39, 0x6e, 0xb3, 0xa1, 0x12, 0x32, 0x5f, 0x83, 0xe5, 0xf4, 0xbd, 0x10, 0x53, 0xc9,
+	0xad, 0x9b, 0xab, 0x6a, 0x49, 0x25, 0xcf, 0x07, 0x56, 0xbc, 0xfa, 0x6a, 0x6a, 0xab, 0x0a, 0x77,
+	0x0b, 0x6c, 0xe6, 0xf4, 0x26, 0x46, 0x27, 0xd9, 0xc0, 0xdf, 0xc3, 0xa8, 0x87, 0x02, 0xed, 0xf5,
+	0x16, 0xac, 0xc0, 0x81, 0x38, 0xa5, 0x8c, 0x88, 0xe1, 0x83, 0x76, 0x53, 0x6a, 0xfd, 0x49, 0xea,
+	0x38, 0xad, 0x55, 0xc0, 0x9c, 0xb6, 0xf6, 0xad, 0xfd, 0x29, 0x80, 0xc5, 0x26, 0xc7, 0xe6, 0x67,
+	0x50, 0xcc, 0xbf, 0xf6, 0x4b, 0xef, 0xee, 0x82, 0x7a, 0xf3, 0x73, 0xb2, 0xf7, 0x1f, 0xc3, 0x9a,
+	0x84, 0xdc, 0x3e, 0xff, 0xfe, 0xfb, 0x6b, 0x61, 0xc3, 0x5d, 0x9f, 0xf9, 0x21, 0xe4, 0xc9, 0x4c,
+	0x00, 0x98, 0x99, 0xf2, 0xee, 0x3d, 0xb2, 0x53, 0x8a, 0xfd, 0xea, 0x41, 0xca, 0xc4, 0xd6, 0xce,
+	0x6c, 0x4b, 0xae, 0x39, 0x63, 0xab, 0xd6, 0xca, 0xec, 0x81, 0x62, 0x7e, 0xe8, 0xf7, 0xe5, 0xce,
+	0xb1, 0xec, 0xfd, 0xc7, 0xb0, 0x74, 0x03, 0xf6, 0x7f, 0x5f, 0x6e, 0x2f, 0xf7, 0x8c, 0xc6, 0x87,
+	0x6f, 0x23, 0xc7, 0xb8, 0x1e, 0x39, 0xc6, 0xaf, 0x91, 0x63, 0x5c, 0x8c, 0x9d, 0x85, 0xeb, 0xb1,
+	0xb3, 0xf0, 0x63, 0xec, 0x2c, 0x9c, 0xd4, 0x30, 0x11, 0xa7, 0x83, 0xae, 0xd7, 0xa3, 0xa1, 0xaf,
+	0x85, 0x29, 0xc3, 0x93, 0x73, 0x15, 0xc6, 0xb1, 0x7f, 0xa6, 0xdb, 0x17, 0xc3, 0x18, 0xf1, 0xee,
+	0x72, 0xb6, 0xfd, 0x6f, 0xfe, 0x0d, 0x00, 0xb2, 0xd9, 0x24, 0x8d, 0x66, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -259,6 +369,8 @@ type MsgClient interface {
 	// TryUpgrade tallies all the votes for all the versions to determine if a
 	// quorum has been reached for a version.
 	TryUpgrade(ctx context.Context, in *MsgTryUpgrade, opts ...grpc.CallOption) (*MsgTryUpgradeResponse, error)
+	// CancelUpgrade clears a pending upgrade and resets the tally.
+	CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opts ...grpc.CallOption) (*MsgCancelUpgradeResponse, error)
 }
 
 type msgClient struct {
@@ -287,6 +399,15 @@ func (c *msgClient) TryUpgrade(ctx context.Context, in *MsgTryUpgrade, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opts ...grpc.CallOption) (*MsgCancelUpgradeResponse, error) {
+	out := new(MsgCancelUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/celestia.signal.v1.Msg/CancelUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SignalVersion allows a validator to signal for a version.
@@ -294,6 +415,8 @@ type MsgServer interface {
 	// TryUpgrade tallies all the votes for all the versions to determine if a
 	// quorum has been reached for a version.
 	TryUpgrade(context.Context, *MsgTryUpgrade) (*MsgTryUpgradeResponse, error)
+	// CancelUpgrade clears a pending upgrade and resets the tally.
+	CancelUpgrade(context.Context, *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -306,6 +429,9 @@ func (*UnimplementedMsgServer) SignalVersion(ctx context.Context, req *MsgSignal
 func (*UnimplementedMsgServer) TryUpgrade(ctx context.Context, req *MsgTryUpgrade) (*MsgTryUpgradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TryUpgrade not implemented")
 }
+func (*UnimplementedMsgServer) CancelUpgrade(ctx context.Context, req *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelUpgrade not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -347,6 +473,24 @@ func _Msg_TryUpgrade_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.signal.v1.Msg/CancelUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelUpgrade(ctx, req.(*MsgCancelUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.signal.v1.Msg",
@@ -360,6 +504,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TryUpgrade",
 			Handler:    _Msg_TryUpgrade_Handler,
 		},
+		{
+			MethodName: "CancelUpgrade",
+			Handler:    _Msg_CancelUpgrade_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/signal/v1/tx.proto",
@@ -385,6 +533,16 @@ func (m *MsgSignalVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EarliestUpgradeTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EarliestUpgradeTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EarliestUpgradeTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Version != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.Version))
 		i--
@@ -476,6 +634,59 @@ func (m *MsgTryUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelUpgradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -500,6 +711,10 @@ func (m *MsgSignalVersion) Size() (n int) {
 	if m.Version != 0 {
 		n += 1 + sovTx(uint64(m.Version))
 	}
+	if m.EarliestUpgradeTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EarliestUpgradeTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -534,6 +749,28 @@ func (m *MsgTryUpgradeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -620,6 +857,42 @@ func (m *MsgSignalVersion) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarliestUpgradeTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EarliestUpgradeTime == nil {
+				m.EarliestUpgradeTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EarliestUpgradeTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -823,6 +1096,138 @@ func (m *MsgTryUpgradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCancelUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelUpgradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelUpgradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
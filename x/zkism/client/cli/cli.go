@@ -21,6 +21,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(NewQueryIsmCmd())
 	cmd.AddCommand(NewQueryIsmsCmd())
 	cmd.AddCommand(NewQueryMessagesCmd())
+	cmd.AddCommand(NewQueryParamsCmd())
 
 	return cmd
 }
@@ -38,6 +39,10 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(NewCreateInterchainSecurityModuleCmd())
 	cmd.AddCommand(NewUpdateInterchainSecurityModuleCmd())
 	cmd.AddCommand(NewSubmitMessagesCmd())
+	cmd.AddCommand(NewSubmitProofsCmd())
+	cmd.AddCommand(NewUpdateIsmVerifierCmd())
+	cmd.AddCommand(NewTransferIsmOwnershipCmd())
+	cmd.AddCommand(NewUpdateIsmMessageTtlCmd())
 
 	return cmd
 }
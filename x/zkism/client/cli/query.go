@@ -127,3 +127,32 @@ func NewQueryMessagesCmd() *cobra.Command {
 	flags.AddPaginationFlagsToCmd(cmd, "messages")
 	return cmd
 }
+
+// NewQueryParamsCmd creates and returns the query command for the module params.
+func NewQueryParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the zkism module params",
+		Long:    "Query the governance controlled params of the zkism module.",
+		Example: fmt.Sprintf("%s query %s params", version.AppName, types.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
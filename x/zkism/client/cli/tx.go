@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/bcp-innovations/hyperlane-cosmos/util"
@@ -15,6 +16,14 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	// FlagDelay is the flag for the delay after which new verifier keys are applied.
+	FlagDelay = "delay"
+
+	// FlagProofSystem is the flag for the proof system used to verify proofs submitted to an ism.
+	FlagProofSystem = "proof-system"
+)
+
 // NewCreateInterchainSecurityModuleCmd creates and returns the zk ism creation cmd.
 func NewCreateInterchainSecurityModuleCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -26,7 +35,7 @@ The command registers the initial trusted state, Merkle tree address, and verifi
 Arguments:
   [state-hex]                 Hex-encoded initial trusted state bytes.
   [merkle-tree-address-hex]   Hex-encoded 32-byte Merkle tree address.
-  [groth16-vkey-file]         Path to the Groth16 verifier key file (binary bytes).
+  [groth16-vkey-file]         Path to the verifier key file (binary bytes) of the proof system selected with --proof-system.
   [state-transition-key-hex]  Hex-encoded 32-byte commitment to the state transition verifier key.
   [state-membership-key-hex]  Hex-encoded 32-byte commitment to the state membership verifier key.`),
 		Example: fmt.Sprintf("%s tx %s create 0xdead...beef 0xdead...beef ./groth16.vkey 3f8a8f3be3cd62e2f9b742de9e4b2c1f5a62a7e0e52a29b4bb4d7a6a2fcaf9c2 2c9fafc2a6a7d4bb4b92a2e5e0a7625a1f2c4b9ede42b7f9e262cde3b8f8a3f", version.AppName, types.ModuleName),
@@ -62,10 +71,16 @@ Arguments:
 				return err
 			}
 
+			proofSystem, err := readProofSystemFlag(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := types.MsgCreateInterchainSecurityModule{
 				Creator:             clientCtx.GetFromAddress().String(),
 				State:               state,
 				MerkleTreeAddress:   merkleTreeAddress,
+				ProofSystem:         proofSystem,
 				Groth16Vkey:         groth16Vkey,
 				StateTransitionVkey: stateTransitionVerKey,
 				StateMembershipVkey: stateMembershipVerKey,
@@ -75,6 +90,7 @@ Arguments:
 		},
 	}
 
+	addProofSystemFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -85,11 +101,11 @@ func NewUpdateInterchainSecurityModuleCmd() *cobra.Command {
 		Use:   "update [ism-id] [proof-hex] [public-values-hex]",
 		Short: "Update a Hyperlane zk ism",
 		Long: strings.TrimSpace(`Update a Hyperlane zk interchain security module (ISM) with a new trusted state.
-The command submits a Groth16 state transition proof plus its public values to advance the ISM's state.
+The command submits a state transition proof plus its public values to advance the ISM's state.
 
 Arguments:
   [ism-id]             Hex-encoded 32-byte ISM identifier (0x-prefixed is accepted).
-  [proof-hex]          Hex-encoded SP1 Groth16 proof bytes (260 bytes: 4-byte prefix + 256-byte proof).
+  [proof-hex]          Hex-encoded proof bytes in the encoding of the ISM's proof system.
   [public-values-hex]  Hex-encoded bincode-serialized StateTransitionValues (state || new_state with length prefixes).`),
 		Example: fmt.Sprintf("%s tx %s update 0x726f757465725f69736d000000000000000000000000002a0000000000000000 0xdead...beef 0xdead...beef", version.AppName, types.ModuleName),
 		Args:    cobra.ExactArgs(3),
@@ -135,11 +151,11 @@ func NewSubmitMessagesCmd() *cobra.Command {
 		Use:   "submit-messages [ism-id] [proof-hex] [public-values-hex]",
 		Short: "Submit a batched Hyperlane message proof for an ISM",
 		Long: strings.TrimSpace(`Submit a Hyperlane zk membership proof to authorize message IDs for processing.
-The command submits a Groth16 state membership proof and public values against an existing ISM.
+The command submits a state membership proof and public values against an existing ISM.
 
 Arguments:
   [ism-id]             Hex-encoded 32-byte ISM identifier (0x-prefixed is accepted).
-  [proof-hex]          Hex-encoded SP1 Groth16 proof bytes (260 bytes: 4-byte prefix + 256-byte proof).
+  [proof-hex]          Hex-encoded proof bytes in the encoding of the ISM's proof system.
   [public-values-hex]  Hex-encoded bincode-serialized StateMembershipValues (state_root || merkle_tree_address || message_ids).`),
 		Example: fmt.Sprintf("%s tx %s submit-messages 0x726f757465725f69736d000000000000000000000000002a0000000000000000 0xdead...beef 0xdead...beef", version.AppName, types.ModuleName),
 		Args:    cobra.ExactArgs(3),
@@ -179,7 +195,264 @@ Arguments:
 	return cmd
 }
 
+// NewSubmitProofsCmd creates and returns the submit proofs cmd.
+func NewSubmitProofsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-proofs [ism-id] [proof]...",
+		Short: "Submit an ordered batch of state transition and membership proofs for an ISM",
+		Long: strings.TrimSpace(fmt.Sprintf(`Submit an ordered batch of Hyperlane zk state transition and state membership proofs for an existing ISM.
+Each proof is checked against the ISM state resulting from the proofs before it and all proofs are verified together.
+Either all proofs are applied or none.
+
+Arguments:
+  [ism-id]  Hex-encoded 32-byte ISM identifier (0x-prefixed is accepted).
+  [proof]   Up to %d proofs, each formatted as <transition|membership>:<proof-hex>:<public-values-hex>.`, types.MaxBatchProofs)),
+		Example: fmt.Sprintf("%s tx %s submit-proofs 0x726f757465725f69736d000000000000000000000000002a0000000000000000 transition:0xdead...beef:0xdead...beef membership:0xdead...beef:0xdead...beef", version.AppName, types.ModuleName),
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ismID, err := util.DecodeHexAddress(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid ism identifier: %w", err)
+			}
+
+			proofs := make([]types.Proof, 0, len(args)-1)
+			for i, arg := range args[1:] {
+				proof, err := parseProof(arg)
+				if err != nil {
+					return fmt.Errorf("invalid proof %d: %w", i, err)
+				}
+
+				proofs = append(proofs, proof)
+			}
+
+			msg := types.MsgSubmitProofs{
+				Id:     ismID,
+				Proofs: proofs,
+				Signer: clientCtx.GetFromAddress().String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewUpdateIsmVerifierCmd creates and returns the update ism verifier cmd.
+func NewUpdateIsmVerifierCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-verifier [ism-id] [groth16-vkey-file] [state-transition-key-hex] [state-membership-key-hex]",
+		Short: "Update the verifier keys of a Hyperlane zk ism",
+		Long: strings.TrimSpace(`Update the verifier keys of a Hyperlane zk interchain security module (ISM). Only the owner of the ISM can update its verifier keys.
+The new keys are applied immediately unless a delay is provided, in which case they are applied once the delay has elapsed.
+A pending update is replaced by any subsequent update.
+
+Arguments:
+  [ism-id]                    Hex-encoded 32-byte ISM identifier (0x-prefixed is accepted).
+  [groth16-vkey-file]         Path to the new verifier key file (binary bytes) of the proof system selected with --proof-system.
+  [state-transition-key-hex]  Hex-encoded 32-byte commitment to the new state transition verifier key.
+  [state-membership-key-hex]  Hex-encoded 32-byte commitment to the new state membership verifier key.`),
+		Example: fmt.Sprintf("%s tx %s update-verifier 0x726f757465725f69736d000000000000000000000000002a0000000000000000 ./groth16.vkey 3f8a8f3be3cd62e2f9b742de9e4b2c1f5a62a7e0e52a29b4bb4d7a6a2fcaf9c2 2c9fafc2a6a7d4bb4b92a2e5e0a7625a1f2c4b9ede42b7f9e262cde3b8f8a3f --%s 48h", version.AppName, types.ModuleName, FlagDelay),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ismID, err := util.DecodeHexAddress(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid ism identifier: %w", err)
+			}
+
+			groth16Vkey, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			stateTransitionVerKey, err := decodeHexString(args[2])
+			if err != nil {
+				return err
+			}
+
+			stateMembershipVerKey, err := decodeHexString(args[3])
+			if err != nil {
+				return err
+			}
+
+			delay, err := cmd.Flags().GetDuration(FlagDelay)
+			if err != nil {
+				return err
+			}
+
+			proofSystem, err := readProofSystemFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUpdateIsmVerifier{
+				Id:                  ismID,
+				Owner:               clientCtx.GetFromAddress().String(),
+				ProofSystem:         proofSystem,
+				Groth16Vkey:         groth16Vkey,
+				StateTransitionVkey: stateTransitionVerKey,
+				StateMembershipVkey: stateMembershipVerKey,
+				Delay:               delay,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().Duration(FlagDelay, 0, "delay after which the new verifier keys are applied")
+	addProofSystemFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewTransferIsmOwnershipCmd creates and returns the transfer ism ownership cmd.
+func NewTransferIsmOwnershipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-ownership [ism-id] [new-owner]",
+		Short: "Transfer the ownership of a Hyperlane zk ism",
+		Long: strings.TrimSpace(`Transfer the ownership of a Hyperlane zk interchain security module (ISM) to a new owner. Only the owner of the ISM can transfer its ownership.
+
+Arguments:
+  [ism-id]     Hex-encoded 32-byte ISM identifier (0x-prefixed is accepted).
+  [new-owner]  Bech32 address of the new owner.`),
+		Example: fmt.Sprintf("%s tx %s transfer-ownership 0x726f757465725f69736d000000000000000000000000002a0000000000000000 celestia1...", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ismID, err := util.DecodeHexAddress(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid ism identifier: %w", err)
+			}
+
+			msg := types.MsgTransferIsmOwnership{
+				Id:       ismID,
+				Owner:    clientCtx.GetFromAddress().String(),
+				NewOwner: args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewUpdateIsmMessageTtlCmd creates and returns the update ism message ttl cmd.
+func NewUpdateIsmMessageTtlCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-message-ttl [ism-id] [ttl]",
+		Short: "Update the message ttl of a Hyperlane zk ism",
+		Long: strings.TrimSpace(`Update the number of blocks for which message IDs authorized by a Hyperlane zk interchain security module (ISM) remain valid. Only the owner of the ISM can update its message ttl.
+
+Arguments:
+  [ism-id]  Hex-encoded 32-byte ISM identifier (0x-prefixed is accepted).
+  [ttl]     Message ttl in blocks. Zero falls back to the module param.`),
+		Example: fmt.Sprintf("%s tx %s update-message-ttl 0x726f757465725f69736d000000000000000000000000002a0000000000000000 14400", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ismID, err := util.DecodeHexAddress(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid ism identifier: %w", err)
+			}
+
+			ttl, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid message ttl: %w", err)
+			}
+
+			msg := types.MsgUpdateIsmMessageTtl{
+				Id:         ismID,
+				Owner:      clientCtx.GetFromAddress().String(),
+				MessageTtl: ttl,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func decodeHexString(input string) ([]byte, error) {
 	input = strings.TrimPrefix(input, "0x")
 	return hex.DecodeString(input)
 }
+
+func parseProof(input string) (types.Proof, error) {
+	parts := strings.Split(input, ":")
+	if len(parts) != 3 {
+		return types.Proof{}, fmt.Errorf("expected <transition|membership>:<proof-hex>:<public-values-hex>, got %q", input)
+	}
+
+	var proofType types.ProofType
+	switch parts[0] {
+	case "transition":
+		proofType = types.ProofType_PROOF_TYPE_STATE_TRANSITION
+	case "membership":
+		proofType = types.ProofType_PROOF_TYPE_STATE_MEMBERSHIP
+	default:
+		return types.Proof{}, fmt.Errorf("unknown proof type %q", parts[0])
+	}
+
+	proof, err := decodeHexString(parts[1])
+	if err != nil {
+		return types.Proof{}, fmt.Errorf("invalid proof: %w", err)
+	}
+
+	publicValues, err := decodeHexString(parts[2])
+	if err != nil {
+		return types.Proof{}, fmt.Errorf("invalid public values: %w", err)
+	}
+
+	return types.Proof{
+		Type:         proofType,
+		Proof:        proof,
+		PublicValues: publicValues,
+	}, nil
+}
+
+// proofSystems maps the values accepted by the proof system flag to the corresponding proof systems.
+var proofSystems = map[string]types.ProofSystem{
+	"sp1-groth16": types.ProofSystem_PROOF_SYSTEM_SP1_GROTH16,
+	"groth16":     types.ProofSystem_PROOF_SYSTEM_GROTH16,
+}
+
+func addProofSystemFlag(cmd *cobra.Command) {
+	cmd.Flags().String(FlagProofSystem, "sp1-groth16", "proof system used to verify proofs: sp1-groth16 or groth16")
+}
+
+func readProofSystemFlag(cmd *cobra.Command) (types.ProofSystem, error) {
+	value, err := cmd.Flags().GetString(FlagProofSystem)
+	if err != nil {
+		return 0, err
+	}
+
+	proofSystem, ok := proofSystems[value]
+	if !ok {
+		return 0, fmt.Errorf("unknown proof system %q", value)
+	}
+
+	return proofSystem, nil
+}
@@ -0,0 +1,157 @@
+package groth16
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	curve "github.com/consensys/gnark-crypto/ecc/bn254"
+	bn254fr "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark/backend/groth16"
+	bn254 "github.com/consensys/gnark/backend/groth16/bn254" //nolint:revive
+)
+
+// batchDomainSeparator is the domain separator used when deriving the batch verification challenges.
+const batchDomainSeparator = "celestia/zkism/groth16-batch/v1"
+
+// ErrBatchPairingCheckFailed is returned when the batched pairing check of a set of proofs fails.
+var ErrBatchPairingCheckFailed = errors.New("batched pairing check failed")
+
+// BatchVerifyProofs verifies a set of Groth16 proofs created for the same verifying key.
+//
+// Verifying a proof requires checking e(A, B) = e(α, β) · e(L, γ) · e(C, δ), where L is
+// the linear combination of the verifying key's public input points with the public inputs.
+// Rather than checking each equation separately, the equations are combined using random
+// challenges r_i into a single multi-pairing:
+//
+//	Π e(r_i·A_i, B_i) · e(-(Σ r_i)·α, β) · e(-Σ r_i·L_i, γ) · e(-Σ r_i·C_i, δ) = 1
+//
+// which costs n+3 Miller loops and a single final exponentiation instead of n of each.
+// The challenges are derived deterministically from the proofs and public inputs so that
+// every node obtains the same result. If the batch check fails, at least one proof is invalid.
+//
+// Verifying keys which make use of commitments are not supported by the batched check,
+// in which case the proofs are verified individually.
+func BatchVerifyProofs(vk groth16.VerifyingKey, proofs []*bn254.Proof, publicInputs [][]bn254fr.Element) error {
+	if len(proofs) != len(publicInputs) {
+		return fmt.Errorf("mismatched number of proofs and public inputs: %d != %d", len(proofs), len(publicInputs))
+	}
+
+	if len(proofs) == 0 {
+		return nil
+	}
+
+	key, ok := vk.(*bn254.VerifyingKey)
+	if !ok {
+		return fmt.Errorf("unsupported verifying key type %T", vk)
+	}
+
+	if len(proofs) == 1 || len(key.CommitmentKeys) > 0 || len(key.PublicAndCommitmentCommitted) > 0 {
+		for i := range proofs {
+			if err := bn254.Verify(proofs[i], key, publicInputs[i]); err != nil {
+				return fmt.Errorf("proof %d: %w", i, err)
+			}
+		}
+		return nil
+	}
+
+	for i, proof := range proofs {
+		if len(publicInputs[i]) != len(key.G1.K)-1 {
+			return fmt.Errorf("proof %d: invalid witness size, got %d, expected %d", i, len(publicInputs[i]), len(key.G1.K)-1)
+		}
+
+		if !proof.Ar.IsInSubGroup() || !proof.Bs.IsInSubGroup() || !proof.Krs.IsInSubGroup() {
+			return fmt.Errorf("proof %d: points in the proof are not in the correct subgroup", i)
+		}
+	}
+
+	challenges := batchChallenges(proofs, publicInputs)
+
+	g1 := make([]curve.G1Affine, 0, len(proofs)+3)
+	g2 := make([]curve.G2Affine, 0, len(proofs)+3)
+
+	var (
+		challengeSum bn254fr.Element
+		kSum, cSum   curve.G1Jac
+	)
+	for i, proof := range proofs {
+		var r big.Int
+		challenges[i].BigInt(&r)
+		challengeSum.Add(&challengeSum, &challenges[i])
+
+		var ar curve.G1Affine
+		ar.ScalarMultiplication(&proof.Ar, &r)
+		g1 = append(g1, ar)
+		g2 = append(g2, proof.Bs)
+
+		var l curve.G1Jac
+		if _, err := l.MultiExp(key.G1.K[1:], publicInputs[i], ecc.MultiExpConfig{}); err != nil {
+			return err
+		}
+		l.AddMixed(&key.G1.K[0])
+		l.ScalarMultiplication(&l, &r)
+		kSum.AddAssign(&l)
+
+		var c curve.G1Jac
+		c.FromAffine(&proof.Krs)
+		c.ScalarMultiplication(&c, &r)
+		cSum.AddAssign(&c)
+	}
+
+	var sum big.Int
+	challengeSum.BigInt(&sum)
+
+	var alpha, kSumAff, cSumAff curve.G1Affine
+	alpha.ScalarMultiplication(&key.G1.Alpha, &sum)
+	alpha.Neg(&alpha)
+	kSumAff.FromJacobian(&kSum)
+	kSumAff.Neg(&kSumAff)
+	cSumAff.FromJacobian(&cSum)
+	cSumAff.Neg(&cSumAff)
+
+	g1 = append(g1, alpha, kSumAff, cSumAff)
+	g2 = append(g2, key.G2.Beta, key.G2.Gamma, key.G2.Delta)
+
+	ok, err := curve.PairingCheck(g1, g2)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return ErrBatchPairingCheckFailed
+	}
+
+	return nil
+}
+
+// batchChallenges derives a non-zero challenge per proof from a transcript of all proofs and public inputs.
+func batchChallenges(proofs []*bn254.Proof, publicInputs [][]bn254fr.Element) []bn254fr.Element {
+	transcript := sha256.New()
+	transcript.Write([]byte(batchDomainSeparator))
+	for i, proof := range proofs {
+		transcript.Write(proof.Ar.Marshal())
+		transcript.Write(proof.Bs.Marshal())
+		transcript.Write(proof.Krs.Marshal())
+		for j := range publicInputs[i] {
+			transcript.Write(publicInputs[i][j].Marshal())
+		}
+	}
+	seed := transcript.Sum(nil)
+
+	challenges := make([]bn254fr.Element, len(proofs))
+	for i := range challenges {
+		for counter := uint32(0); challenges[i].IsZero(); counter++ {
+			h := sha256.New()
+			h.Write(seed)
+			_ = binary.Write(h, binary.BigEndian, uint64(i))
+			_ = binary.Write(h, binary.BigEndian, counter)
+			// 128-bit challenges are sufficient for soundness and halve the cost of the scalar multiplications
+			challenges[i].SetBytes(h.Sum(nil)[:16])
+		}
+	}
+
+	return challenges
+}
@@ -2,11 +2,14 @@ package groth16_test
 
 import (
 	"encoding/hex"
+	"fmt"
 	"math/big"
 	"os"
 	"testing"
 
 	"github.com/celestiaorg/celestia-app/v7/x/zkism/internal/groth16"
+	bn254fr "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	bn254 "github.com/consensys/gnark/backend/groth16/bn254"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 )
 
@@ -104,6 +107,72 @@ func BenchmarkGroth16VerifyProofStateMembership(b *testing.B) {
 	}
 }
 
+func BenchmarkGroth16BatchVerifyProofs(b *testing.B) {
+	vkBytes, transitionProofBz, transitionValues := readGroth16Testdata(b, "state_transition")
+	_, membershipProofBz, membershipValues := readGroth16Testdata(b, "state_membership")
+
+	vk, err := groth16.NewVerifyingKey(vkBytes)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	transitionProof, err := groth16.UnmarshalProof(transitionProofBz[proofPrefixLen:])
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	membershipProof, err := groth16.UnmarshalProof(membershipProofBz[proofPrefixLen:])
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	transitionInputs := benchPublicInputs(b, stateTransitionProgramVkHex, transitionValues)
+	membershipInputs := benchPublicInputs(b, stateMembershipProgramVkHex, membershipValues)
+
+	for _, size := range []int{2, 4, 8} {
+		proofs := make([]*bn254.Proof, 0, size)
+		inputs := make([][]bn254fr.Element, 0, size)
+		for i := range size {
+			if i%2 == 0 {
+				proofs = append(proofs, transitionProof)
+				inputs = append(inputs, transitionInputs)
+			} else {
+				proofs = append(proofs, membershipProof)
+				inputs = append(inputs, membershipInputs)
+			}
+		}
+
+		b.Run(fmt.Sprintf("proofs=%d", size), func(b *testing.B) {
+			if err := groth16.BatchVerifyProofs(vk, proofs, inputs); err != nil {
+				b.Fatal(err)
+			}
+
+			b.ReportAllocs()
+
+			for b.Loop() {
+				if err := groth16.BatchVerifyProofs(vk, proofs, inputs); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkHashBN254(b *testing.B) {
+	for _, size := range []int{1 << 10, 1 << 15, 1 << 20} {
+		data := make([]byte, size)
+
+		b.Run(fmt.Sprintf("bytes=%d", size), func(b *testing.B) {
+			b.SetBytes(int64(size))
+			b.ReportAllocs()
+
+			for b.Loop() {
+				groth16.HashBN254(data)
+			}
+		})
+	}
+}
+
 func BenchmarkSecp256k1VerifySignature(b *testing.B) {
 	privKey := secp256k1.GenPrivKey()
 	msg := []byte("celestia-secp256k1-benchmark")
@@ -147,3 +216,17 @@ func readGroth16Testdata(tb testing.TB, proofDir string) (vkBytes, proofBytes, v
 
 	return vkBytes, proofBytes, valuesBytes
 }
+
+func benchPublicInputs(b *testing.B, programVkHex string, publicValues []byte) []bn254fr.Element {
+	b.Helper()
+
+	programVk, err := hex.DecodeString(programVkHex)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	return []bn254fr.Element{
+		*groth16.NewBN254FrElement(new(big.Int).SetBytes(programVk)),
+		*groth16.NewBN254FrElement(groth16.HashBN254(publicValues)),
+	}
+}
@@ -65,6 +65,26 @@ func UnmarshalProof(proofBz []byte) (*bn254.Proof, error) {
 	return proof, nil
 }
 
+// ReadProof deserializes a Groth16 proof for the BN254 curve from a byte slice.
+//
+// Unlike UnmarshalProof, the input byte slice is expected to contain a proof serialized
+// using the gnark binary encoding, which includes any commitments of the proof. The function
+// ensures that the entire input is consumed during deserialization to catch trailing or
+// truncated data.
+func ReadProof(proofBz []byte) (*bn254.Proof, error) {
+	proof := &bn254.Proof{}
+	n, err := proof.ReadFrom(bytes.NewReader(proofBz))
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling proof: %v", err)
+	}
+
+	if int(n) != len(proofBz) {
+		return nil, fmt.Errorf("invalid proof length: expected %d, got %d", len(proofBz), n)
+	}
+
+	return proof, nil
+}
+
 // HashBN254 hashes the buffer using SHA-256, masks the top 3 bits, and returns a big.Int
 // compliant with BN254 field elements.
 func HashBN254(data []byte) *big.Int {
@@ -2,6 +2,7 @@ package groth16_test
 
 import (
 	"crypto/sha256"
+	"encoding/hex"
 	"math/big"
 	"os"
 	"testing"
@@ -9,6 +10,7 @@ import (
 	"github.com/celestiaorg/celestia-app/v7/x/zkism/internal/groth16"
 	"github.com/consensys/gnark-crypto/ecc"
 	bn254fr "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	bn254 "github.com/consensys/gnark/backend/groth16/bn254"
 	"github.com/stretchr/testify/require"
 )
 
@@ -38,6 +40,26 @@ func TestUnmarshalProof(t *testing.T) {
 	require.False(t, proof.Krs.IsInfinity(), "Krs should not be point at infinity")
 }
 
+func TestReadProof(t *testing.T) {
+	proofBz, err := os.ReadFile("../testdata/groth16/state_transition/proof.bin")
+	require.NoError(t, err, "failed to read proof file")
+
+	proof, err := groth16.ReadProof(proofBz)
+	require.NoError(t, err, "failed to read proof")
+	require.NotNil(t, proof)
+
+	// sanity checks that the proof components are non-zero
+	require.False(t, proof.Ar.IsInfinity(), "Ar should not be point at infinity")
+	require.False(t, proof.Bs.IsInfinity(), "Bs should not be point at infinity")
+	require.False(t, proof.Krs.IsInfinity(), "Krs should not be point at infinity")
+
+	_, err = groth16.ReadProof(append(proofBz, 0x00))
+	require.Error(t, err, "expected error for trailing bytes")
+
+	_, err = groth16.ReadProof(proofBz[:len(proofBz)-1])
+	require.Error(t, err, "expected error for truncated proof")
+}
+
 func TestHashBN254(t *testing.T) {
 	input := []byte("just trust me bro")
 
@@ -74,3 +96,84 @@ func TestNewPublicWitness(t *testing.T) {
 	require.Equal(t, inputs[0], &vec[0])
 	require.Equal(t, inputs[1], &vec[1])
 }
+
+func TestBatchVerifyProofs(t *testing.T) {
+	vkBytes, transitionProofBz, transitionValues := readGroth16Testdata(t, "state_transition")
+	_, membershipProofBz, membershipValues := readGroth16Testdata(t, "state_membership")
+
+	vk, err := groth16.NewVerifyingKey(vkBytes)
+	require.NoError(t, err)
+
+	transitionProof, err := groth16.UnmarshalProof(transitionProofBz[proofPrefixLen:])
+	require.NoError(t, err)
+
+	membershipProof, err := groth16.UnmarshalProof(membershipProofBz[proofPrefixLen:])
+	require.NoError(t, err)
+
+	transitionInputs := publicInputs(t, stateTransitionProgramVkHex, transitionValues)
+	membershipInputs := publicInputs(t, stateMembershipProgramVkHex, membershipValues)
+
+	tests := []struct {
+		name    string
+		proofs  []*bn254.Proof
+		inputs  [][]bn254fr.Element
+		wantErr bool
+	}{
+		{
+			name:   "empty batch",
+			proofs: nil,
+			inputs: nil,
+		},
+		{
+			name:   "single proof",
+			proofs: []*bn254.Proof{transitionProof},
+			inputs: [][]bn254fr.Element{transitionInputs},
+		},
+		{
+			name:   "multiple proofs",
+			proofs: []*bn254.Proof{transitionProof, membershipProof, transitionProof},
+			inputs: [][]bn254fr.Element{transitionInputs, membershipInputs, transitionInputs},
+		},
+		{
+			name:    "mismatched public inputs",
+			proofs:  []*bn254.Proof{transitionProof, membershipProof},
+			inputs:  [][]bn254fr.Element{transitionInputs},
+			wantErr: true,
+		},
+		{
+			name:    "swapped public inputs",
+			proofs:  []*bn254.Proof{transitionProof, membershipProof},
+			inputs:  [][]bn254fr.Element{membershipInputs, transitionInputs},
+			wantErr: true,
+		},
+		{
+			name:    "one invalid proof",
+			proofs:  []*bn254.Proof{transitionProof, transitionProof},
+			inputs:  [][]bn254fr.Element{transitionInputs, membershipInputs},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := groth16.BatchVerifyProofs(vk, tc.proofs, tc.inputs)
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func publicInputs(t *testing.T, programVkHex string, publicValues []byte) []bn254fr.Element {
+	t.Helper()
+
+	programVk, err := hex.DecodeString(programVkHex)
+	require.NoError(t, err)
+
+	return []bn254fr.Element{
+		*groth16.NewBN254FrElement(new(big.Int).SetBytes(programVk)),
+		*groth16.NewBN254FrElement(groth16.HashBN254(publicValues)),
+	}
+}
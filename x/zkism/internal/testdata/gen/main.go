@@ -0,0 +1,170 @@
+// Package main generates the gnark Groth16 test vectors used by the zkism module.
+//
+// The proofs are created over the public values of the SP1 Groth16 test vectors in the parent directory,
+// using the same program verifying key commitments, for a stand-in circuit which exposes the public inputs
+// of the GROTH16 proof system, i.e. the program verifying key commitment and the high and low 128 bits of
+// the SHA-256 digest of the public values, and proves knowledge of their sum.
+//
+// Usage (from the x/zkism/internal/testdata directory):
+//
+//	go run ./gen
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"github.com/celestiaorg/celestia-app/v7/x/zkism/internal/groth16"
+	"github.com/consensys/gnark-crypto/ecc"
+	bn254fr "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	gnarkgroth16 "github.com/consensys/gnark/backend/groth16"
+	"github.com/consensys/gnark/backend/witness"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+)
+
+var programVks = map[string]string{
+	"state_transition": "0017bc91d53b93c46eb842d7f9020a94ea13d8877a21608b34b71fcc4da64f29",
+	"state_membership": "004959d5fb2c3d5bc1f98e032188dd94fbb5c6b6152df356c7c20be23be824a2",
+}
+
+// groth16Circuit exposes the public inputs of plain Groth16 proofs and proves knowledge of their sum.
+type groth16Circuit struct {
+	ProgramVk frontend.Variable `gnark:",public"`
+	DigestHi  frontend.Variable `gnark:",public"`
+	DigestLo  frontend.Variable `gnark:",public"`
+	Sum       frontend.Variable
+}
+
+// Define implements frontend.Circuit.
+func (c *groth16Circuit) Define(api frontend.API) error {
+	api.AssertIsEqual(api.Add(c.ProgramVk, c.DigestHi, c.DigestLo), c.Sum)
+	return nil
+}
+
+func main() {
+	if err := generateGroth16(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generateGroth16 writes a gnark Groth16 verifying key and proofs using the gnark binary encoding.
+func generateGroth16() error {
+	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &groth16Circuit{})
+	if err != nil {
+		return err
+	}
+
+	pk, vk, err := gnarkgroth16.Setup(ccs)
+	if err != nil {
+		return err
+	}
+
+	if err := writeTo("groth16/vk.bin", vk); err != nil {
+		return err
+	}
+
+	for name := range programVks {
+		w, err := newGroth16Witness(name)
+		if err != nil {
+			return err
+		}
+
+		proof, err := gnarkgroth16.Prove(ccs, pk, w)
+		if err != nil {
+			return err
+		}
+
+		if err := writeTo(filepath.Join("groth16", name, "proof.bin"), proof); err != nil {
+			return err
+		}
+
+		if err := copyPublicValues(filepath.Join("groth16", name), name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// newGroth16Witness returns the witness of the Groth16 test vector name.
+func newGroth16Witness(name string) (witness.Witness, error) {
+	programVk, publicValues, err := readInputs(name)
+	if err != nil {
+		return nil, err
+	}
+
+	digest := sha256.Sum256(publicValues)
+	vkElement := groth16.NewBN254FrElement(new(big.Int).SetBytes(programVk))
+	hiElement := groth16.NewBN254FrElement(new(big.Int).SetBytes(digest[:16]))
+	loElement := groth16.NewBN254FrElement(new(big.Int).SetBytes(digest[16:]))
+
+	assignment := &groth16Circuit{
+		ProgramVk: vkElement.String(),
+		DigestHi:  hiElement.String(),
+		DigestLo:  loElement.String(),
+		Sum:       sum(vkElement, hiElement, loElement),
+	}
+
+	return frontend.NewWitness(assignment, ecc.BN254.ScalarField())
+}
+
+// readInputs returns the program verifying key commitment and the public values of the test vector name.
+func readInputs(name string) (programVk, publicValues []byte, err error) {
+	programVk, err = hex.DecodeString(programVks[name])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	publicValues, err = os.ReadFile(filepath.Join(name, "public_values.bin"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return programVk, publicValues, nil
+}
+
+// sum returns the sum of the elements as a decimal string.
+func sum(elements ...*bn254fr.Element) string {
+	var total bn254fr.Element
+	for _, e := range elements {
+		total.Add(&total, e)
+	}
+
+	return total.String()
+}
+
+func writeTo(path string, w io.WriterTo) error {
+	var buf bytes.Buffer
+	if _, err := w.WriteTo(&buf); err != nil {
+		return err
+	}
+
+	return writeFile(path, buf.Bytes())
+}
+
+func writeFile(path string, bz []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	fmt.Printf("writing %s (%d bytes)\n", path, len(bz))
+	return os.WriteFile(path, bz, 0o644)
+}
+
+// copyPublicValues copies the public values of the SP1 Groth16 test vector to dir.
+func copyPublicValues(dir, name string) error {
+	publicValues, err := os.ReadFile(filepath.Join(name, "public_values.bin"))
+	if err != nil {
+		return err
+	}
+
+	return writeFile(filepath.Join(dir, "public_values.bin"), publicValues)
+}
@@ -12,6 +12,7 @@ func EmitCreateISMEvent(ctx sdk.Context, ism types.InterchainSecurityModule) err
 		Owner:               ism.Owner,
 		State:               types.EncodeHex(ism.State),
 		MerkleTreeAddress:   types.EncodeHex(ism.MerkleTreeAddress),
+		ProofSystem:         ism.ProofSystem,
 		Groth16Vkey:         types.EncodeHex(ism.Groth16Vkey),
 		StateTransitionVkey: types.EncodeHex(ism.StateTransitionVkey),
 		StateMembershipVkey: types.EncodeHex(ism.StateMembershipVkey),
@@ -39,3 +40,36 @@ func EmitSubmitMessagesEvent(ctx sdk.Context, ism types.InterchainSecurityModule
 		Messages:  messages,
 	})
 }
+
+// EmitScheduleIsmVerifierUpdateEvent emits a typed event to signal that a verifier key update of an ism was scheduled.
+func EmitScheduleIsmVerifierUpdateEvent(ctx sdk.Context, ism types.InterchainSecurityModule) error {
+	update := ism.PendingVerifierUpdate
+	return ctx.EventManager().EmitTypedEvent(&types.EventScheduleIsmVerifierUpdate{
+		Id:                  ism.Id,
+		ProofSystem:         update.ProofSystem,
+		Groth16Vkey:         types.EncodeHex(update.Groth16Vkey),
+		StateTransitionVkey: types.EncodeHex(update.StateTransitionVkey),
+		StateMembershipVkey: types.EncodeHex(update.StateMembershipVkey),
+		ActivationTime:      update.ActivationTime,
+	})
+}
+
+// EmitUpdateIsmVerifierEvent emits a typed event to signal that the verifier keys of an ism were updated.
+func EmitUpdateIsmVerifierEvent(ctx sdk.Context, ism types.InterchainSecurityModule) error {
+	return ctx.EventManager().EmitTypedEvent(&types.EventUpdateIsmVerifier{
+		Id:                  ism.Id,
+		ProofSystem:         ism.ProofSystem,
+		Groth16Vkey:         types.EncodeHex(ism.Groth16Vkey),
+		StateTransitionVkey: types.EncodeHex(ism.StateTransitionVkey),
+		StateMembershipVkey: types.EncodeHex(ism.StateMembershipVkey),
+	})
+}
+
+// EmitTransferIsmOwnershipEvent emits a typed event to signal the transfer of ownership of an ism.
+func EmitTransferIsmOwnershipEvent(ctx sdk.Context, ism types.InterchainSecurityModule, previousOwner string) error {
+	return ctx.EventManager().EmitTypedEvent(&types.EventTransferIsmOwnership{
+		Id:            ism.Id,
+		PreviousOwner: previousOwner,
+		NewOwner:      ism.Owner,
+	})
+}
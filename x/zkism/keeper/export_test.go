@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"cosmossdk.io/collections"
 	"github.com/bcp-innovations/hyperlane-cosmos/util"
@@ -37,3 +38,24 @@ func (k *Keeper) GetIsm(ctx context.Context, ismId util.HexAddress) (types.Inter
 func (k *Keeper) SetIsm(ctx context.Context, ismId util.HexAddress, ism types.InterchainSecurityModule) error {
 	return k.isms.Set(ctx, ismId.GetInternalId(), ism)
 }
+
+// AuthorizeMessage is a test func used for authorizing a message id with the given ttl.
+func (k *Keeper) AuthorizeMessage(ctx context.Context, ismId util.HexAddress, messageId []byte, ttl uint64) error {
+	return k.authorizeMessage(ctx, ismId.GetInternalId(), messageId, ttl)
+}
+
+// GetMessageAuthorization is a test func used for getting the authorization of a message id.
+func (k *Keeper) GetMessageAuthorization(ctx context.Context, ismId util.HexAddress, messageId []byte) (types.MessageAuthorization, error) {
+	return k.authorizations.Get(ctx, collections.Join(ismId.GetInternalId(), messageId))
+}
+
+// HasVerifierUpdate is a test func used for checking whether a verifier update of an ism is queued for the
+// activation time.
+func (k *Keeper) HasVerifierUpdate(ctx context.Context, ismId util.HexAddress, activationTime time.Time) (bool, error) {
+	return k.verifierUpdates.Has(ctx, collections.Join(activationTime, ismId.GetInternalId()))
+}
+
+// QueueVerifierUpdate is a test func used for queueing a verifier update of an ism for the activation time.
+func (k *Keeper) QueueVerifierUpdate(ctx context.Context, ismId util.HexAddress, activationTime time.Time) error {
+	return k.verifierUpdates.Set(ctx, collections.Join(activationTime, ismId.GetInternalId()))
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"math"
+	"math/bits"
+
+	"github.com/celestiaorg/celestia-app/v7/x/zkism/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// consumeProofGas consumes the gas for verifying a single proof of the given proof system with the provided public
+// values, which authorizes the given number of message ids, according to the gas schedule of the module params.
+// It must be called prior to proof verification such that invalid proofs are charged the full cost.
+func consumeProofGas(ctx sdk.Context, params types.Params, proofSystem types.ProofSystem, publicValues []byte, messageIds int) {
+	ctx.GasMeter().ConsumeGas(params.ProofVerifyCost(proofSystem), "zk proof verify")
+	ctx.GasMeter().ConsumeGas(mulGas(params.PublicValuesCostPerByte, uint64(len(publicValues))), "zk proof public values")
+	ctx.GasMeter().ConsumeGas(mulGas(params.MessageIdCost, uint64(messageIds)), "zk proof message ids")
+}
+
+// mulGas returns a * b, saturating at math.MaxUint64 on overflow such that the gas meter runs out of gas.
+func mulGas(a, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	if hi != 0 {
+		return math.MaxUint64
+	}
+
+	return lo
+}
@@ -14,10 +14,21 @@ import (
 
 // InitGenesis initialises the module genesis state.
 func (k *Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
+	if err := k.params.Set(ctx, gs.Params); err != nil {
+		return err
+	}
+
 	for _, ism := range gs.Isms {
 		if err := k.isms.Set(ctx, ism.Id.GetInternalId(), ism); err != nil {
 			return err
 		}
+
+		if ism.PendingVerifierUpdate != nil {
+			key := collections.Join(ism.PendingVerifierUpdate.ActivationTime, ism.Id.GetInternalId())
+			if err := k.verifierUpdates.Set(ctx, key); err != nil {
+				return err
+			}
+		}
 	}
 
 	for _, messages := range gs.Messages {
@@ -41,6 +52,17 @@ func (k *Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error
 				return err
 			}
 		}
+
+		for _, entry := range messages.Authorizations {
+			messageId, err := types.DecodeHex(entry.MessageId)
+			if err != nil {
+				return fmt.Errorf("invalid message id %q: %w", entry.MessageId, err)
+			}
+
+			if err := k.setMessage(ctx, ismId.GetInternalId(), messageId, entry.Authorization); err != nil {
+				return err
+			}
+		}
 	}
 
 	for _, entry := range gs.Submissions {
@@ -93,9 +115,22 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		}
 
 		sort.Strings(msgs)
+
+		var authorizations []types.GenesisMessageAuthorization
+		if err := k.authorizations.Walk(ctx, collections.NewPrefixedPairRange[uint64, []byte](ism.Id.GetInternalId()), func(key collections.Pair[uint64, []byte], value types.MessageAuthorization) (bool, error) {
+			authorizations = append(authorizations, types.GenesisMessageAuthorization{
+				MessageId:     types.EncodeHex(key.K2()),
+				Authorization: value,
+			})
+			return false, nil
+		}); err != nil {
+			return nil, errorsmod.Wrapf(err, "collecting message authorizations for ism %s", ism.Id.String())
+		}
+
 		genesisMessages = append(genesisMessages, types.GenesisMessages{
-			Id:       ism.Id,
-			Messages: msgs,
+			Id:             ism.Id,
+			Messages:       msgs,
+			Authorizations: authorizations,
 		})
 	}
 
@@ -129,9 +164,15 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return submissions[i].Id.Compare(submissions[j].Id) < 0
 	})
 
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
 		Isms:        isms,
 		Messages:    genesisMessages,
 		Submissions: submissions,
+		Params:      params,
 	}, nil
 }
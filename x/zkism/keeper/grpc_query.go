@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"cosmossdk.io/collections"
 	"github.com/bcp-innovations/hyperlane-cosmos/util"
 	"github.com/celestiaorg/celestia-app/v7/x/zkism/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -75,8 +77,24 @@ func (q queryServer) Messages(ctx context.Context, req *types.QueryMessagesReque
 		return nil, status.Errorf(codes.InvalidArgument, "invalid hex address %s, %s", req.Id, err.Error())
 	}
 
-	transformFunc := func(key collections.Pair[uint64, []byte], _ collections.NoValue) (string, error) {
-		return types.EncodeHex(key.K2()), nil
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	transformFunc := func(key collections.Pair[uint64, []byte], _ collections.NoValue) (types.AuthorizedMessage, error) {
+		message := types.AuthorizedMessage{Id: types.EncodeHex(key.K2())}
+
+		authorization, err := q.k.authorizations.Get(ctx, key)
+		if errors.Is(err, collections.ErrNotFound) {
+			return message, nil
+		}
+		if err != nil {
+			return types.AuthorizedMessage{}, err
+		}
+
+		message.AuthorizedHeight = authorization.AuthorizedHeight
+		message.ExpiryHeight = authorization.ExpiryHeight
+		if height > authorization.AuthorizedHeight {
+			message.Age = height - authorization.AuthorizedHeight
+		}
+		return message, nil
 	}
 
 	pagination := req.Pagination
@@ -92,7 +110,7 @@ func (q queryServer) Messages(ctx context.Context, req *types.QueryMessagesReque
 		}
 	}
 
-	msgs, pageRes, err := query.CollectionPaginate(
+	authorized, pageRes, err := query.CollectionPaginate(
 		ctx,
 		q.k.messages,
 		pagination,
@@ -103,8 +121,30 @@ func (q queryServer) Messages(ctx context.Context, req *types.QueryMessagesReque
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	var msgs []string
+	for _, message := range authorized {
+		msgs = append(msgs, message.Id)
+	}
+
 	return &types.QueryMessagesResponse{
-		Messages:   msgs,
-		Pagination: pageRes,
+		Messages:           msgs,
+		Pagination:         pageRes,
+		AuthorizedMessages: authorized,
+	}, nil
+}
+
+// Params implements types.QueryServer.
+func (q queryServer) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be empty")
+	}
+
+	params, err := q.k.GetParams(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryParamsResponse{
+		Params: params,
 	}, nil
 }
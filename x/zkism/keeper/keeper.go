@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"cosmossdk.io/collections"
 	corestore "cosmossdk.io/core/store"
@@ -20,6 +21,13 @@ type Keeper struct {
 	isms        collections.Map[uint64, types.InterchainSecurityModule]
 	messages    collections.KeySet[collections.Pair[uint64, []byte]]
 	submissions collections.Map[uint64, bool]
+	// verifierUpdates queues the isms with a pending verifier update by activation time
+	verifierUpdates collections.KeySet[collections.Pair[time.Time, uint64]]
+	// authorizations records the authorization and expiry height of authorized messages
+	authorizations collections.Map[collections.Pair[uint64, []byte], types.MessageAuthorization]
+	// expiryQueue queues the authorized messages by expiry height
+	expiryQueue collections.KeySet[collections.Triple[uint64, uint64, []byte]]
+	params      collections.Item[types.Params]
 	schema      collections.Schema
 
 	coreKeeper types.HyperlaneKeeper
@@ -33,6 +41,10 @@ func NewKeeper(cdc codec.Codec, storeService corestore.KVStoreService, hyperlane
 	isms := collections.NewMap(sb, types.IsmsKeyPrefix, "isms", collections.Uint64Key, codec.CollValue[types.InterchainSecurityModule](cdc))
 	messages := collections.NewKeySet(sb, types.MessageKeyPrefix, "messages", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey))
 	submissions := collections.NewMap(sb, types.MessageProofSubmittedPrefix, "message_proof_submitted", collections.Uint64Key, collections.BoolValue)
+	verifierUpdates := collections.NewKeySet(sb, types.VerifierUpdateQueuePrefix, "verifier_update_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key))
+	authorizations := collections.NewMap(sb, types.MessageAuthorizationPrefix, "message_authorizations", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey), codec.CollValue[types.MessageAuthorization](cdc))
+	expiryQueue := collections.NewKeySet(sb, types.MessageExpiryQueuePrefix, "message_expiry_queue", collections.TripleKeyCodec(collections.Uint64Key, collections.Uint64Key, collections.BytesKey))
+	params := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc))
 
 	schema, err := sb.Build()
 	if err != nil {
@@ -40,12 +52,16 @@ func NewKeeper(cdc codec.Codec, storeService corestore.KVStoreService, hyperlane
 	}
 
 	keeper := &Keeper{
-		coreKeeper:  hyperlaneKeeper,
-		isms:        isms,
-		messages:    messages,
-		submissions: submissions,
-		schema:      schema,
-		authority:   authority,
+		coreKeeper:      hyperlaneKeeper,
+		isms:            isms,
+		messages:        messages,
+		submissions:     submissions,
+		verifierUpdates: verifierUpdates,
+		authorizations:  authorizations,
+		expiryQueue:     expiryQueue,
+		params:          params,
+		schema:          schema,
+		authority:       authority,
 	}
 
 	router := hyperlaneKeeper.IsmRouter()
@@ -73,13 +89,13 @@ func (k *Keeper) Verify(ctx context.Context, ismId util.HexAddress, _ []byte, me
 
 	k.Logger(ctx).Info("processing message", "id", message.Id().String(), "ism", ism.Id.String())
 
-	authorized, err := k.messages.Has(ctx, collections.Join(ism.Id.GetInternalId(), message.Id().Bytes()))
+	authorized, err := k.isAuthorized(ctx, ism.Id.GetInternalId(), message.Id().Bytes())
 	if err != nil {
 		return false, err
 	}
 
 	if authorized {
-		if err := k.messages.Remove(ctx, collections.Join(ism.Id.GetInternalId(), message.Id().Bytes())); err != nil {
+		if err := k.removeMessage(ctx, ism.Id.GetInternalId(), message.Id().Bytes()); err != nil {
 			return false, err
 		}
 	}
@@ -92,6 +92,15 @@ func readGroth16Vkey(t *testing.T) []byte {
 	return groth16Vkey
 }
 
+func readTestdata(t *testing.T, path string) []byte {
+	t.Helper()
+
+	bz, err := os.ReadFile("../internal/testdata/" + path)
+	require.NoError(t, err, "failed to read testdata file")
+
+	return bz
+}
+
 func readStateTransitionProofData(t *testing.T) ([]byte, []byte) {
 	t.Helper()
 
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/celestiaorg/celestia-app/v7/x/zkism/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetParams returns the module parameters. The default parameters are returned if none were set.
+func (k *Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	params, err := k.params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DefaultParams(), nil
+	}
+	return params, err
+}
+
+// authorizeMessage authorizes the message id for the ism at the current height. The message id expires once the
+// message ttl of the ism has elapsed. Authorizing a message id again renews its authorization.
+func (k *Keeper) authorizeMessage(ctx context.Context, ismId uint64, messageId []byte, ttl uint64) error {
+	if err := k.removeMessage(ctx, ismId, messageId); err != nil {
+		return err
+	}
+
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	authorization := types.MessageAuthorization{AuthorizedHeight: height}
+	if ttl != 0 {
+		authorization.ExpiryHeight = height + ttl
+	}
+
+	return k.setMessage(ctx, ismId, messageId, authorization)
+}
+
+// setMessage stores the authorized message id along with its authorization and queues it for pruning if it expires.
+func (k *Keeper) setMessage(ctx context.Context, ismId uint64, messageId []byte, authorization types.MessageAuthorization) error {
+	if err := k.messages.Set(ctx, collections.Join(ismId, messageId)); err != nil {
+		return err
+	}
+
+	if err := k.authorizations.Set(ctx, collections.Join(ismId, messageId), authorization); err != nil {
+		return err
+	}
+
+	if authorization.ExpiryHeight == 0 {
+		return nil
+	}
+
+	return k.expiryQueue.Set(ctx, collections.Join3(authorization.ExpiryHeight, ismId, messageId))
+}
+
+// removeMessage removes the authorized message id along with its authorization and expiry.
+func (k *Keeper) removeMessage(ctx context.Context, ismId uint64, messageId []byte) error {
+	key := collections.Join(ismId, messageId)
+	if err := k.messages.Remove(ctx, key); err != nil {
+		return err
+	}
+
+	authorization, err := k.authorizations.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		// message ids authorized before authorizations were recorded never expire
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := k.authorizations.Remove(ctx, key); err != nil {
+		return err
+	}
+
+	if authorization.ExpiryHeight == 0 {
+		return nil
+	}
+
+	return k.expiryQueue.Remove(ctx, collections.Join3(authorization.ExpiryHeight, ismId, messageId))
+}
+
+// isAuthorized returns true if the message id is authorized for the ism and its authorization has not expired.
+// Expired message ids are only pruned at the end of a block, and at most types.MaxPrunedMessagesPerBlock at a time,
+// so the expiry height is checked rather than relying on the message id having been pruned.
+func (k *Keeper) isAuthorized(ctx context.Context, ismId uint64, messageId []byte) (bool, error) {
+	key := collections.Join(ismId, messageId)
+	authorized, err := k.messages.Has(ctx, key)
+	if err != nil || !authorized {
+		return false, err
+	}
+
+	authorization, err := k.authorizations.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		// message ids authorized before authorizations were recorded never expire
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	return authorization.ExpiryHeight == 0 || authorization.ExpiryHeight > height, nil
+}
+
+// pruneExpiredMessages removes up to types.MaxPrunedMessagesPerBlock message ids whose expiry height has been
+// reached. Remaining expired message ids are pruned in subsequent blocks.
+func (k *Keeper) pruneExpiredMessages(ctx context.Context) error {
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+
+	var expired []collections.Triple[uint64, uint64, []byte]
+	if err := k.expiryQueue.Walk(ctx, nil, func(key collections.Triple[uint64, uint64, []byte]) (bool, error) {
+		if key.K1() > height || len(expired) == types.MaxPrunedMessagesPerBlock {
+			return true, nil
+		}
+
+		expired = append(expired, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		if err := k.removeMessage(ctx, key.K2(), key.K3()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,299 @@
+package keeper_test
+
+import (
+	"bytes"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/celestiaorg/celestia-app/v7/x/zkism/keeper"
+	"github.com/celestiaorg/celestia-app/v7/x/zkism/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func (suite *KeeperTestSuite) TestUpdateIsmMessageTtl() {
+	owner := sdk.AccAddress(bytes.Repeat([]byte{0x01}, 20)).String()
+
+	var msg *types.MsgUpdateIsmMessageTtl
+
+	testCases := []struct {
+		name      string
+		setupTest func()
+		expError  error
+	}{
+		{
+			name:      "success",
+			setupTest: func() {},
+			expError:  nil,
+		},
+		{
+			name: "success, zero ttl falls back to the param",
+			setupTest: func() {
+				msg.MessageTtl = 0
+			},
+			expError: nil,
+		},
+		{
+			name: "success, any ttl when the param is disabled",
+			setupTest: func() {
+				suite.Require().NoError(suite.setMessageTTLParam(0))
+				msg.MessageTtl = types.DefaultMessageTTL + 1
+			},
+			expError: nil,
+		},
+		{
+			name: "ttl exceeds the param",
+			setupTest: func() {
+				msg.MessageTtl = types.DefaultMessageTTL + 1
+			},
+			expError: types.ErrInvalidMessageTTL,
+		},
+		{
+			name: "ism not found",
+			setupTest: func() {
+				msg.Id = util.CreateMockHexAddress("ism", 2)
+			},
+			expError: types.ErrIsmNotFound,
+		},
+		{
+			name: "signer is not the owner",
+			setupTest: func() {
+				msg.Owner = sdk.AccAddress(bytes.Repeat([]byte{0x02}, 20)).String()
+			},
+			expError: sdkerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ism := suite.CreateTestIsm(randBytes(32))
+			ism.Owner = owner
+			suite.Require().NoError(suite.zkISMKeeper.SetIsm(suite.ctx, ism.Id, ism))
+
+			msg = &types.MsgUpdateIsmMessageTtl{
+				Id:         ism.Id,
+				Owner:      owner,
+				MessageTtl: 100,
+			}
+
+			tc.setupTest()
+
+			msgServer := keeper.NewMsgServerImpl(suite.zkISMKeeper)
+			res, err := msgServer.UpdateIsmMessageTtl(suite.ctx, msg)
+
+			if tc.expError != nil {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().NotNil(res)
+
+			updated, err := suite.zkISMKeeper.GetIsm(suite.ctx, ism.Id)
+			suite.Require().NoError(err)
+			suite.Require().Equal(msg.MessageTtl, updated.MessageTtl)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	params := types.DefaultParams()
+	params.MessageTtl = 50
+
+	msgServer := keeper.NewMsgServerImpl(suite.zkISMKeeper)
+
+	_, err := msgServer.UpdateParams(suite.ctx, &types.MsgUpdateParams{
+		Authority: sdk.AccAddress(bytes.Repeat([]byte{0x01}, 20)).String(),
+		Params:    params,
+	})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.UpdateParams(suite.ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	suite.Require().NoError(err)
+
+	res, err := keeper.NewQueryServerImpl(suite.zkISMKeeper).Params(suite.ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, res.Params)
+}
+
+func (suite *KeeperTestSuite) TestMessageExpiry() {
+	ism := suite.CreateTestIsm(randBytes(32))
+	message := util.HyperlaneMessage{Nonce: uint32(1)}
+	messageId := message.Id().Bytes()
+	legacyMessageId := bytes.Repeat([]byte{0x02}, 32)
+
+	suite.ctx = suite.ctx.WithBlockHeight(10)
+	suite.Require().NoError(suite.zkISMKeeper.AuthorizeMessage(suite.ctx, ism.Id, messageId, 5))
+	// message ids authorized without a recorded authorization never expire
+	suite.Require().NoError(suite.zkISMKeeper.SetMessageId(suite.ctx, ism.Id, legacyMessageId))
+
+	authorization, err := suite.zkISMKeeper.GetMessageAuthorization(suite.ctx, ism.Id, messageId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.MessageAuthorization{AuthorizedHeight: 10, ExpiryHeight: 15}, authorization)
+
+	// the message id is retained until its expiry height
+	suite.ctx = suite.ctx.WithBlockHeight(14)
+	suite.Require().NoError(suite.zkISMKeeper.EndBlocker(suite.ctx))
+	has, err := suite.zkISMKeeper.HasMessageId(suite.ctx, ism.Id, messageId)
+	suite.Require().NoError(err)
+	suite.Require().True(has)
+
+	res, err := keeper.NewQueryServerImpl(suite.zkISMKeeper).Messages(suite.ctx, &types.QueryMessagesRequest{Id: ism.Id.String()})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch([]types.AuthorizedMessage{
+		{Id: types.EncodeHex(messageId), AuthorizedHeight: 10, Age: 4, ExpiryHeight: 15},
+		{Id: types.EncodeHex(legacyMessageId)},
+	}, res.AuthorizedMessages)
+
+	suite.ctx = suite.ctx.WithBlockHeight(15)
+	suite.Require().NoError(suite.zkISMKeeper.EndBlocker(suite.ctx))
+	has, err = suite.zkISMKeeper.HasMessageId(suite.ctx, ism.Id, messageId)
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+
+	has, err = suite.zkISMKeeper.HasMessageId(suite.ctx, ism.Id, legacyMessageId)
+	suite.Require().NoError(err)
+	suite.Require().True(has)
+
+	// an expired message id is no longer authorized
+	verified, err := suite.zkISMKeeper.Verify(suite.ctx, ism.Id, nil, message)
+	suite.Require().NoError(err)
+	suite.Require().False(verified)
+}
+
+func (suite *KeeperTestSuite) TestVerifyExpiredMessageBeforePruning() {
+	ism := suite.CreateTestIsm(randBytes(32))
+	message := util.HyperlaneMessage{Nonce: uint32(1)}
+	expiredMessage := util.HyperlaneMessage{Nonce: uint32(2)}
+
+	suite.ctx = suite.ctx.WithBlockHeight(10)
+	suite.Require().NoError(suite.zkISMKeeper.AuthorizeMessage(suite.ctx, ism.Id, message.Id().Bytes(), 5))
+	suite.Require().NoError(suite.zkISMKeeper.AuthorizeMessage(suite.ctx, ism.Id, expiredMessage.Id().Bytes(), 5))
+
+	// the message id is authorized until its expiry height
+	suite.ctx = suite.ctx.WithBlockHeight(14)
+	verified, err := suite.zkISMKeeper.Verify(suite.ctx, ism.Id, nil, message)
+	suite.Require().NoError(err)
+	suite.Require().True(verified)
+
+	// the expired message id is not pruned yet but is no longer authorized
+	suite.ctx = suite.ctx.WithBlockHeight(15)
+	has, err := suite.zkISMKeeper.HasMessageId(suite.ctx, ism.Id, expiredMessage.Id().Bytes())
+	suite.Require().NoError(err)
+	suite.Require().True(has)
+
+	verified, err = suite.zkISMKeeper.Verify(suite.ctx, ism.Id, nil, expiredMessage)
+	suite.Require().NoError(err)
+	suite.Require().False(verified)
+}
+
+func (suite *KeeperTestSuite) TestMessageReauthorization() {
+	ism := suite.CreateTestIsm(randBytes(32))
+	messageId := bytes.Repeat([]byte{0x01}, 32)
+
+	suite.ctx = suite.ctx.WithBlockHeight(10)
+	suite.Require().NoError(suite.zkISMKeeper.AuthorizeMessage(suite.ctx, ism.Id, messageId, 5))
+
+	// authorizing a message id again renews its expiry
+	suite.ctx = suite.ctx.WithBlockHeight(12)
+	suite.Require().NoError(suite.zkISMKeeper.AuthorizeMessage(suite.ctx, ism.Id, messageId, 5))
+
+	suite.ctx = suite.ctx.WithBlockHeight(15)
+	suite.Require().NoError(suite.zkISMKeeper.EndBlocker(suite.ctx))
+	has, err := suite.zkISMKeeper.HasMessageId(suite.ctx, ism.Id, messageId)
+	suite.Require().NoError(err)
+	suite.Require().True(has)
+
+	suite.ctx = suite.ctx.WithBlockHeight(17)
+	suite.Require().NoError(suite.zkISMKeeper.EndBlocker(suite.ctx))
+	has, err = suite.zkISMKeeper.HasMessageId(suite.ctx, ism.Id, messageId)
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
+
+func (suite *KeeperTestSuite) TestPruneExpiredMessagesLimit() {
+	ism := suite.CreateTestIsm(randBytes(32))
+
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+	messageIds := make([][]byte, 0, types.MaxPrunedMessagesPerBlock+1)
+	for i := range types.MaxPrunedMessagesPerBlock + 1 {
+		messageId := util.GenerateHexAddress([20]byte{0x02}, uint32(types.ModuleTypeZkISM), uint64(i)).Bytes()
+		suite.Require().NoError(suite.zkISMKeeper.AuthorizeMessage(suite.ctx, ism.Id, messageId, 1))
+		messageIds = append(messageIds, messageId)
+	}
+
+	countAuthorized := func() int {
+		var count int
+		for _, messageId := range messageIds {
+			has, err := suite.zkISMKeeper.HasMessageId(suite.ctx, ism.Id, messageId)
+			suite.Require().NoError(err)
+			if has {
+				count++
+			}
+		}
+		return count
+	}
+
+	suite.ctx = suite.ctx.WithBlockHeight(2)
+	suite.Require().NoError(suite.zkISMKeeper.EndBlocker(suite.ctx))
+	suite.Require().Equal(1, countAuthorized())
+
+	suite.ctx = suite.ctx.WithBlockHeight(3)
+	suite.Require().NoError(suite.zkISMKeeper.EndBlocker(suite.ctx))
+	suite.Require().Zero(countAuthorized())
+}
+
+func (suite *KeeperTestSuite) TestGenesisMessageAuthorizations() {
+	ism := suite.CreateTestIsm(randBytes(32))
+	messageId := bytes.Repeat([]byte{0x01}, 32)
+	authorization := types.MessageAuthorization{AuthorizedHeight: 10, ExpiryHeight: 15}
+	params := types.DefaultParams()
+	params.MessageTtl = 50
+
+	suite.SetupTest()
+	suite.Require().NoError(suite.zkISMKeeper.InitGenesis(suite.ctx, &types.GenesisState{
+		Isms: []types.InterchainSecurityModule{ism},
+		Messages: []types.GenesisMessages{{
+			Id:       ism.Id,
+			Messages: []string{types.EncodeHex(messageId)},
+			Authorizations: []types.GenesisMessageAuthorization{
+				{MessageId: types.EncodeHex(messageId), Authorization: authorization},
+			},
+		}},
+		Params: params,
+	}))
+
+	exported, err := suite.zkISMKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, exported.Params)
+	suite.Require().Len(exported.Messages, 1)
+	suite.Require().Equal([]types.GenesisMessageAuthorization{
+		{MessageId: types.EncodeHex(messageId), Authorization: authorization},
+	}, exported.Messages[0].Authorizations)
+
+	// the imported authorization is queued and pruned at its expiry height
+	suite.ctx = suite.ctx.WithBlockHeight(15)
+	suite.Require().NoError(suite.zkISMKeeper.EndBlocker(suite.ctx))
+	has, err := suite.zkISMKeeper.HasMessageId(suite.ctx, ism.Id, messageId)
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
+
+func (suite *KeeperTestSuite) setMessageTTLParam(ttl uint64) error {
+	params := types.DefaultParams()
+	params.MessageTtl = ttl
+	return suite.setParams(params)
+}
+
+func (suite *KeeperTestSuite) setParams(params types.Params) error {
+	_, err := keeper.NewMsgServerImpl(suite.zkISMKeeper).UpdateParams(suite.ctx, &types.MsgUpdateParams{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Params:    params,
+	})
+	return err
+}
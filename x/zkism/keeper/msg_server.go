@@ -3,8 +3,10 @@ package keeper
 import (
 	"bytes"
 	"context"
+	"errors"
 
 	"cosmossdk.io/collections"
+
 	errorsmod "cosmossdk.io/errors"
 	"github.com/celestiaorg/celestia-app/v7/x/zkism/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -33,6 +35,7 @@ func (m msgServer) CreateInterchainSecurityModule(ctx context.Context, msg *type
 		Id:                  ismId,
 		Owner:               msg.Creator,
 		State:               msg.State,
+		ProofSystem:         msg.ProofSystem,
 		Groth16Vkey:         msg.Groth16Vkey,
 		MerkleTreeAddress:   msg.MerkleTreeAddress,
 		StateTransitionVkey: msg.StateTransitionVkey,
@@ -72,12 +75,18 @@ func (m msgServer) UpdateInterchainSecurityModule(ctx context.Context, msg *type
 		return nil, errorsmod.Wrapf(types.ErrInvalidTrustedState, "expected %x, got %x", ism.State, publicValues.State)
 	}
 
-	verifier, err := types.NewSP1Groth16Verifier(ism.Groth16Vkey)
+	params, err := m.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	consumeProofGas(sdk.UnwrapSDKContext(ctx), params, ism.ProofSystem, msg.PublicValues, 0)
+
+	verifier, err := types.NewVerifier(ism.ProofSystem, ism.Groth16Vkey)
 	if err != nil {
 		return nil, err
 	}
 
-	sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(types.DefaultProofVerifyCostGroth16, "groth16 verify")
 	if err := verifier.VerifyProof(msg.Proof, ism.StateTransitionVkey, msg.PublicValues); err != nil {
 		return nil, err
 	}
@@ -135,19 +144,26 @@ func (m msgServer) SubmitMessages(ctx context.Context, msg *types.MsgSubmitMessa
 		return nil, errorsmod.Wrapf(types.ErrInvalidMerkleTreeAddress, "expected %x, got %x", ism.MerkleTreeAddress, publicValues.MerkleTreeAddress)
 	}
 
-	verifier, err := types.NewSP1Groth16Verifier(ism.Groth16Vkey)
+	params, err := m.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	consumeProofGas(sdk.UnwrapSDKContext(ctx), params, ism.ProofSystem, msg.PublicValues, len(publicValues.MessageIds))
+
+	verifier, err := types.NewVerifier(ism.ProofSystem, ism.Groth16Vkey)
 	if err != nil {
 		return nil, err
 	}
 
-	sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(types.DefaultProofVerifyCostGroth16, "groth16 verify")
 	if err := verifier.VerifyProof(msg.Proof, ism.StateMembershipVkey, msg.PublicValues); err != nil {
 		return nil, err
 	}
 
+	ttl := ism.EffectiveMessageTTL(params)
 	messages := make([]string, 0, len(publicValues.MessageIds))
 	for _, messageId := range publicValues.MessageIds {
-		if err := m.messages.Set(ctx, collections.Join(ism.Id.GetInternalId(), messageId[:])); err != nil {
+		if err := m.authorizeMessage(ctx, ism.Id.GetInternalId(), messageId[:], ttl); err != nil {
 			return nil, err
 		}
 
@@ -168,3 +184,247 @@ func (m msgServer) SubmitMessages(ctx context.Context, msg *types.MsgSubmitMessa
 		Messages:  messages,
 	}, nil
 }
+
+// SubmitProofs implements types.MsgServer.
+// The proofs are checked in order against the ism state resulting from the proofs before them and verified using a
+// single batched pairing check. The ism is only updated once all proofs have been verified.
+func (m msgServer) SubmitProofs(ctx context.Context, msg *types.MsgSubmitProofs) (*types.MsgSubmitProofsResponse, error) {
+	ism, err := m.isms.Get(ctx, msg.Id.GetInternalId())
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrIsmNotFound, "failed to get ism: %s", msg.Id.String())
+	}
+
+	// Check if a message proof has already been submitted for the current state root
+	submitted, err := m.submissions.Get(ctx, ism.Id.GetInternalId())
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	var (
+		proofs          = make([]types.ProofInput, 0, len(msg.Proofs))
+		states          = make([]types.InterchainSecurityModule, 0, len(msg.Proofs))
+		messageIds      = make([][][32]byte, 0, len(msg.Proofs))
+		submittedChange bool
+	)
+	for i, proof := range msg.Proofs {
+		switch proof.Type {
+		case types.ProofType_PROOF_TYPE_STATE_TRANSITION:
+			var publicValues types.StateTransitionValues
+			if err := publicValues.Unmarshal(proof.PublicValues); err != nil {
+				return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "proof %d: %s", i, err.Error())
+			}
+
+			if len(publicValues.State) < 32 || len(publicValues.NewState) < 32 {
+				return nil, errorsmod.Wrapf(types.ErrInvalidTrustedState, "proof %d: state must be at least 32 bytes", i)
+			}
+
+			if !bytes.Equal(ism.State, publicValues.State) {
+				return nil, errorsmod.Wrapf(types.ErrInvalidTrustedState, "proof %d: expected %x, got %x", i, ism.State, publicValues.State)
+			}
+
+			// Reset the message proof submitted flag only if the state root has changed
+			if !bytes.Equal(ism.State[:32], publicValues.NewState[:32]) {
+				submitted, submittedChange = false, true
+			}
+
+			ism.State = publicValues.NewState
+			proofs = append(proofs, types.ProofInput{Proof: proof.Proof, ProgramVk: ism.StateTransitionVkey, PublicValues: proof.PublicValues})
+			messageIds = append(messageIds, nil)
+		case types.ProofType_PROOF_TYPE_STATE_MEMBERSHIP:
+			var publicValues types.StateMembershipValues
+			if err := publicValues.Unmarshal(proof.PublicValues); err != nil {
+				return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "proof %d: %s", i, err.Error())
+			}
+
+			if !bytes.Equal(publicValues.StateRoot[:], ism.State[:32]) {
+				return nil, errorsmod.Wrapf(types.ErrInvalidStateRoot, "proof %d: expected %x, got %x", i, ism.State[:32], publicValues.StateRoot)
+			}
+
+			if submitted {
+				return nil, errorsmod.Wrapf(types.ErrMessageProofAlreadySubmitted, "proof %d", i)
+			}
+
+			if !bytes.Equal(publicValues.MerkleTreeAddress[:], ism.MerkleTreeAddress) {
+				return nil, errorsmod.Wrapf(types.ErrInvalidMerkleTreeAddress, "proof %d: expected %x, got %x", i, ism.MerkleTreeAddress, publicValues.MerkleTreeAddress)
+			}
+
+			submitted, submittedChange = true, true
+			proofs = append(proofs, types.ProofInput{Proof: proof.Proof, ProgramVk: ism.StateMembershipVkey, PublicValues: proof.PublicValues})
+			messageIds = append(messageIds, publicValues.MessageIds)
+		default:
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "proof %d: unsupported proof type %s", i, proof.Type)
+		}
+
+		states = append(states, ism)
+	}
+
+	params, err := m.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, proof := range proofs {
+		consumeProofGas(sdk.UnwrapSDKContext(ctx), params, ism.ProofSystem, proof.PublicValues, len(messageIds[i]))
+	}
+
+	verifier, err := types.NewVerifier(ism.ProofSystem, ism.Groth16Vkey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifier.VerifyProofs(proofs); err != nil {
+		return nil, err
+	}
+
+	if err := m.isms.Set(ctx, ism.Id.GetInternalId(), ism); err != nil {
+		return nil, err
+	}
+
+	if submittedChange {
+		if err := m.submissions.Set(ctx, ism.Id.GetInternalId(), submitted); err != nil {
+			return nil, err
+		}
+	}
+
+	ttl := ism.EffectiveMessageTTL(params)
+	messages := make([]string, 0)
+	for i, proof := range msg.Proofs {
+		if proof.Type == types.ProofType_PROOF_TYPE_STATE_TRANSITION {
+			if err := EmitUpdateISMEvent(sdk.UnwrapSDKContext(ctx), states[i]); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		for _, messageId := range messageIds[i] {
+			if err := m.authorizeMessage(ctx, ism.Id.GetInternalId(), messageId[:], ttl); err != nil {
+				return nil, err
+			}
+
+			messages = append(messages, types.EncodeHex(messageId[:]))
+		}
+
+		if err := EmitSubmitMessagesEvent(sdk.UnwrapSDKContext(ctx), states[i], messageIds[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgSubmitProofsResponse{
+		State:    ism.State,
+		Messages: messages,
+	}, nil
+}
+
+// UpdateIsmVerifier implements types.MsgServer.
+func (m msgServer) UpdateIsmVerifier(ctx context.Context, msg *types.MsgUpdateIsmVerifier) (*types.MsgUpdateIsmVerifierResponse, error) {
+	ism, err := m.isms.Get(ctx, msg.Id.GetInternalId())
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrIsmNotFound, "failed to get ism: %s", msg.Id.String())
+	}
+
+	if ism.Owner != msg.Owner {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of ism %s", msg.Owner, msg.Id.String())
+	}
+
+	update := types.PendingVerifierUpdate{
+		ProofSystem:         msg.ProofSystem,
+		Groth16Vkey:         msg.Groth16Vkey,
+		StateTransitionVkey: msg.StateTransitionVkey,
+		StateMembershipVkey: msg.StateMembershipVkey,
+		ActivationTime:      sdk.UnwrapSDKContext(ctx).BlockTime().Add(msg.Delay),
+	}
+
+	if msg.Delay == 0 {
+		if err := m.applyVerifierUpdate(ctx, ism, update); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := m.scheduleVerifierUpdate(ctx, ism, update); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgUpdateIsmVerifierResponse{}, nil
+}
+
+// TransferIsmOwnership implements types.MsgServer.
+func (m msgServer) TransferIsmOwnership(ctx context.Context, msg *types.MsgTransferIsmOwnership) (*types.MsgTransferIsmOwnershipResponse, error) {
+	ism, err := m.isms.Get(ctx, msg.Id.GetInternalId())
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrIsmNotFound, "failed to get ism: %s", msg.Id.String())
+	}
+
+	if ism.Owner != msg.Owner {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of ism %s", msg.Owner, msg.Id.String())
+	}
+
+	ism.Owner = msg.NewOwner
+	if err := m.isms.Set(ctx, ism.Id.GetInternalId(), ism); err != nil {
+		return nil, err
+	}
+
+	if err := EmitTransferIsmOwnershipEvent(sdk.UnwrapSDKContext(ctx), ism, msg.Owner); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferIsmOwnershipResponse{}, nil
+}
+
+// UpdateIsmMessageTtl implements types.MsgServer.
+func (m msgServer) UpdateIsmMessageTtl(ctx context.Context, msg *types.MsgUpdateIsmMessageTtl) (*types.MsgUpdateIsmMessageTtlResponse, error) {
+	ism, err := m.isms.Get(ctx, msg.Id.GetInternalId())
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrIsmNotFound, "failed to get ism: %s", msg.Id.String())
+	}
+
+	if ism.Owner != msg.Owner {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of ism %s", msg.Owner, msg.Id.String())
+	}
+
+	params, err := m.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.MessageTtl != 0 && msg.MessageTtl > params.MessageTtl {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMessageTTL, "message ttl must not exceed %d blocks", params.MessageTtl)
+	}
+
+	ism.MessageTtl = msg.MessageTtl
+	if err := m.isms.Set(ctx, ism.Id.GetInternalId(), ism); err != nil {
+		return nil, err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventUpdateIsmMessageTtl{
+		Id:         ism.Id,
+		MessageTtl: ism.MessageTtl,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateIsmMessageTtlResponse{}, nil
+}
+
+// UpdateParams implements types.MsgServer.
+func (m msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if msg.Authority != m.authority {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority: expected: %s, got: %s", m.authority, msg.Authority)
+	}
+
+	if err := m.params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventUpdateParams{
+		Authority:               msg.Authority,
+		MessageTtl:              msg.Params.MessageTtl,
+		Groth16VerifyCost:       msg.Params.Groth16VerifyCost,
+		PlonkVerifyCost:         msg.Params.PlonkVerifyCost,
+		PublicValuesCostPerByte: msg.Params.PublicValuesCostPerByte,
+		MessageIdCost:           msg.Params.MessageIdCost,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
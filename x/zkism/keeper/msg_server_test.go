@@ -1,12 +1,14 @@
 package keeper_test
 
 import (
+	"bytes"
 	"encoding/hex"
 
 	"github.com/bcp-innovations/hyperlane-cosmos/util"
 	"github.com/celestiaorg/celestia-app/v7/test/util/testfactory"
 	"github.com/celestiaorg/celestia-app/v7/x/zkism/keeper"
 	"github.com/celestiaorg/celestia-app/v7/x/zkism/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -255,3 +257,251 @@ func (suite *KeeperTestSuite) TestSubmitMessagesAfterUpdate() {
 	suite.Require().ErrorIs(err, types.ErrMessageProofAlreadySubmitted)
 	suite.Require().Nil(submitRes2)
 }
+
+func (suite *KeeperTestSuite) TestProofGasSchedule() {
+	trustedState, err := hex.DecodeString("b1d302256aee21b0d2dc21d88612061d1c7bb5bd5a222d98bd29482e6ea33d33d20000000000000069652b91fa76676373f699801562df433d3a8799659e4ca86dbd10c927e343393a0000000000000000000000000000000000000000000000000000a8045f161bf468bf4d4411cc010a975cdd8e50850a6142fc4459071c8132cef8d3c9b547277ef793af2c")
+	suite.Require().NoError(err)
+
+	membershipProof, membershipValues := readStateMembershipProofData(suite.T())
+	transitionProof, _ := readStateTransitionProofData(suite.T())
+
+	var publicValues types.StateMembershipValues
+	suite.Require().NoError(publicValues.Unmarshal(membershipValues))
+	suite.Require().NotEmpty(publicValues.MessageIds)
+
+	// submitMessages returns the gas consumed by submitting the membership proof under the given params
+	submitMessages := func(params types.Params, proof []byte) (uint64, error) {
+		suite.SetupTest()
+		suite.Require().NoError(suite.setParams(params))
+
+		ism := suite.CreateTestIsm(trustedState)
+		msgServer := keeper.NewMsgServerImpl(suite.zkISMKeeper)
+
+		gasBefore := suite.ctx.GasMeter().GasConsumed()
+		_, err := msgServer.SubmitMessages(suite.ctx, &types.MsgSubmitMessages{
+			Id:           ism.Id,
+			Proof:        proof,
+			PublicValues: membershipValues,
+		})
+		return suite.ctx.GasMeter().GasConsumed() - gasBefore, err
+	}
+
+	params := types.DefaultParams()
+	increased := params
+	increased.Groth16VerifyCost += 1000
+	increased.PlonkVerifyCost += 2000
+	increased.PublicValuesCostPerByte += 3
+	increased.MessageIdCost += 5
+
+	// only the groth16 verify cost applies to the sp1 groth16 ism
+	expDiff := uint64(1000) + 3*uint64(len(membershipValues)) + 5*uint64(len(publicValues.MessageIds))
+
+	gasDefault, err := submitMessages(params, membershipProof)
+	suite.Require().NoError(err)
+	suite.Require().GreaterOrEqual(gasDefault, params.Groth16VerifyCost+params.PublicValuesCostPerByte*uint64(len(membershipValues))+params.MessageIdCost*uint64(len(publicValues.MessageIds)))
+
+	gasIncreased, err := submitMessages(increased, membershipProof)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expDiff, gasIncreased-gasDefault)
+
+	// invalid proofs are charged the full cost of verification
+	gasInvalid, err := submitMessages(params, transitionProof)
+	suite.Require().ErrorIs(err, types.ErrInvalidProof)
+
+	gasInvalidIncreased, err := submitMessages(increased, transitionProof)
+	suite.Require().ErrorIs(err, types.ErrInvalidProof)
+	suite.Require().Equal(expDiff, gasInvalidIncreased-gasInvalid)
+}
+
+func (suite *KeeperTestSuite) TestProofSystems() {
+	trustedState, err := hex.DecodeString("fb5c60a71772493fc32293c8047a099aa0548aee4b15e1ee0455f26fb1d76b027500000000000000f3a7136c5a71726713acae63bdcee751f388d911021f3acf33d44322e63f18c3220000000000000000000000000000000000000000000000000000a8045f161bf468bf4d4411cc010a975cdd8e50850a6142fc4459071c8132cef8d3c9b547277ef793af2c")
+	suite.Require().NoError(err)
+
+	owner := sdk.AccAddress(bytes.Repeat([]byte{0x01}, 20)).String()
+	sp1Groth16Proof, _ := readStateTransitionProofData(suite.T())
+
+	testCases := []struct {
+		name        string
+		proofSystem types.ProofSystem
+		dir         string
+	}{
+		{
+			name:        "groth16",
+			proofSystem: types.ProofSystem_PROOF_SYSTEM_GROTH16,
+			dir:         "groth16",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ism := suite.CreateTestIsm(trustedState)
+			ism.Owner = owner
+			suite.Require().NoError(suite.zkISMKeeper.SetIsm(suite.ctx, ism.Id, ism))
+
+			msgServer := keeper.NewMsgServerImpl(suite.zkISMKeeper)
+			_, err := msgServer.UpdateIsmVerifier(suite.ctx, &types.MsgUpdateIsmVerifier{
+				Id:                  ism.Id,
+				Owner:               owner,
+				ProofSystem:         tc.proofSystem,
+				Groth16Vkey:         readTestdata(suite.T(), tc.dir+"/vk.bin"),
+				StateTransitionVkey: ism.StateTransitionVkey,
+				StateMembershipVkey: ism.StateMembershipVkey,
+			})
+			suite.Require().NoError(err)
+
+			updated, err := suite.zkISMKeeper.GetIsm(suite.ctx, ism.Id)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.proofSystem, updated.ProofSystem)
+
+			transitionValues := readTestdata(suite.T(), tc.dir+"/state_transition/public_values.bin")
+
+			// proofs of the previous proof system are no longer accepted
+			_, err = msgServer.UpdateInterchainSecurityModule(suite.ctx, &types.MsgUpdateInterchainSecurityModule{
+				Id:           ism.Id,
+				Proof:        sp1Groth16Proof,
+				PublicValues: transitionValues,
+			})
+			suite.Require().Error(err)
+
+			updateRes, err := msgServer.UpdateInterchainSecurityModule(suite.ctx, &types.MsgUpdateInterchainSecurityModule{
+				Id:           ism.Id,
+				Proof:        readTestdata(suite.T(), tc.dir+"/state_transition/proof.bin"),
+				PublicValues: transitionValues,
+			})
+			suite.Require().NoError(err)
+			suite.Require().NotNil(updateRes)
+
+			submitRes, err := msgServer.SubmitMessages(suite.ctx, &types.MsgSubmitMessages{
+				Id:           ism.Id,
+				Proof:        readTestdata(suite.T(), tc.dir+"/state_membership/proof.bin"),
+				PublicValues: readTestdata(suite.T(), tc.dir+"/state_membership/public_values.bin"),
+			})
+			suite.Require().NoError(err)
+			suite.Require().NotEmpty(submitRes.Messages)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSubmitProofs() {
+	trustedState, err := hex.DecodeString("fb5c60a71772493fc32293c8047a099aa0548aee4b15e1ee0455f26fb1d76b027500000000000000f3a7136c5a71726713acae63bdcee751f388d911021f3acf33d44322e63f18c3220000000000000000000000000000000000000000000000000000a8045f161bf468bf4d4411cc010a975cdd8e50850a6142fc4459071c8132cef8d3c9b547277ef793af2c")
+	suite.Require().NoError(err)
+
+	transitionProof, transitionValues := readStateTransitionProofData(suite.T())
+	membershipProof, membershipValues := readStateMembershipProofData(suite.T())
+
+	transition := types.Proof{Type: types.ProofType_PROOF_TYPE_STATE_TRANSITION, Proof: transitionProof, PublicValues: transitionValues}
+	membership := types.Proof{Type: types.ProofType_PROOF_TYPE_STATE_MEMBERSHIP, Proof: membershipProof, PublicValues: membershipValues}
+
+	var (
+		ism types.InterchainSecurityModule
+		msg *types.MsgSubmitProofs
+	)
+
+	testCases := []struct {
+		name      string
+		setupTest func()
+		expError  error
+	}{
+		{
+			name:      "success",
+			setupTest: func() {},
+			expError:  nil,
+		},
+		{
+			name: "ism not found",
+			setupTest: func() {
+				msg.Id = util.CreateMockHexAddress("ism", 2)
+			},
+			expError: types.ErrIsmNotFound,
+		},
+		{
+			name: "membership proof before state transition",
+			setupTest: func() {
+				msg.Proofs = []types.Proof{membership, transition}
+			},
+			expError: types.ErrInvalidStateRoot,
+		},
+		{
+			name: "duplicate membership proof",
+			setupTest: func() {
+				msg.Proofs = append(msg.Proofs, membership)
+			},
+			expError: types.ErrMessageProofAlreadySubmitted,
+		},
+		{
+			name: "unsupported proof type",
+			setupTest: func() {
+				msg.Proofs[1].Type = types.ProofType_PROOF_TYPE_UNSPECIFIED
+			},
+			expError: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid proof",
+			setupTest: func() {
+				msg.Proofs[1].Proof = transitionProof
+			},
+			expError: types.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ism = suite.CreateTestIsm(trustedState)
+
+			msg = &types.MsgSubmitProofs{
+				Id:     ism.Id,
+				Proofs: []types.Proof{transition, membership},
+			}
+
+			tc.setupTest()
+
+			gasBefore := suite.ctx.GasMeter().GasConsumed()
+			msgServer := keeper.NewMsgServerImpl(suite.zkISMKeeper)
+			res, err := msgServer.SubmitProofs(suite.ctx, msg)
+
+			if tc.expError != nil {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+
+				if msg.Id == ism.Id {
+					// no proof of a failed batch is applied
+					stored, err := suite.zkISMKeeper.GetIsm(suite.ctx, ism.Id)
+					suite.Require().NoError(err)
+					suite.Require().Equal(trustedState, stored.State)
+				}
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().NotNil(res)
+			suite.Require().GreaterOrEqual(suite.ctx.GasMeter().GasConsumed()-gasBefore, uint64(len(msg.Proofs))*types.DefaultProofVerifyCostGroth16)
+
+			transitionPublicValues := new(types.StateTransitionValues)
+			suite.Require().NoError(transitionPublicValues.Unmarshal(transitionValues))
+
+			stored, err := suite.zkISMKeeper.GetIsm(suite.ctx, ism.Id)
+			suite.Require().NoError(err)
+			suite.Require().Equal(transitionPublicValues.NewState, stored.State)
+			suite.Require().Equal(transitionPublicValues.NewState, res.State)
+
+			submitted, err := suite.zkISMKeeper.GetMessageProofSubmitted(suite.ctx, ism.Id)
+			suite.Require().NoError(err)
+			suite.Require().True(submitted)
+
+			membershipPublicValues := new(types.StateMembershipValues)
+			suite.Require().NoError(membershipPublicValues.Unmarshal(membershipValues))
+			suite.Require().Len(res.Messages, len(membershipPublicValues.MessageIds))
+
+			for idx, id := range membershipPublicValues.MessageIds {
+				has, err := suite.zkISMKeeper.HasMessageId(suite.ctx, ism.Id, id[:])
+				suite.Require().NoError(err)
+				suite.Require().True(has)
+
+				suite.Require().Equal(types.EncodeHex(id[:]), res.Messages[idx])
+			}
+		})
+	}
+}
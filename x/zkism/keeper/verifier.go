@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"github.com/celestiaorg/celestia-app/v7/x/zkism/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker applies the pending verifier key updates whose activation time has been reached and prunes expired
+// message ids.
+func (k *Keeper) EndBlocker(ctx context.Context) error {
+	if err := k.applyVerifierUpdates(ctx); err != nil {
+		return err
+	}
+
+	return k.pruneExpiredMessages(ctx)
+}
+
+// applyVerifierUpdates applies the pending verifier key updates whose activation time has been reached.
+// It runs in the EndBlocker, where an error would halt the chain, so an update that can't be applied is logged
+// and dropped instead.
+func (k *Keeper) applyVerifierUpdates(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+
+	var due []collections.Pair[time.Time, uint64]
+	if err := k.verifierUpdates.Walk(ctx, nil, func(key collections.Pair[time.Time, uint64]) (bool, error) {
+		if key.K1().After(blockTime) {
+			return true, nil
+		}
+
+		due = append(due, key)
+		return false, nil
+	}); err != nil {
+		k.Logger(ctx).Error("failed to read pending verifier updates", "err", err)
+		return nil
+	}
+
+	for _, key := range due {
+		// the update is applied on a cached context so that a failure leaves no partial state behind.
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := k.applyDueVerifierUpdate(cacheCtx, key); err != nil {
+			k.Logger(ctx).Error("dropping pending verifier update", "ism", key.K2(), "activation_time", key.K1(), "err", err)
+			k.dropVerifierUpdate(ctx, key)
+			continue
+		}
+
+		write()
+	}
+
+	return nil
+}
+
+// applyDueVerifierUpdate removes the update from the queue and applies it to its ism.
+func (k *Keeper) applyDueVerifierUpdate(ctx context.Context, key collections.Pair[time.Time, uint64]) error {
+	if err := k.verifierUpdates.Remove(ctx, key); err != nil {
+		return err
+	}
+
+	ism, err := k.isms.Get(ctx, key.K2())
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrIsmNotFound, "pending verifier update for unknown ism %d", key.K2())
+	}
+
+	if ism.PendingVerifierUpdate == nil {
+		return nil
+	}
+
+	if err := ism.PendingVerifierUpdate.Validate(); err != nil {
+		return err
+	}
+
+	return k.applyVerifierUpdate(ctx, ism, *ism.PendingVerifierUpdate)
+}
+
+// dropVerifierUpdate removes the update from the queue and clears it from its ism, if it still exists. Failures are
+// logged as the update can't be applied either way.
+func (k *Keeper) dropVerifierUpdate(ctx context.Context, key collections.Pair[time.Time, uint64]) {
+	if err := k.verifierUpdates.Remove(ctx, key); err != nil {
+		k.Logger(ctx).Error("failed to remove pending verifier update", "ism", key.K2(), "err", err)
+	}
+
+	ism, err := k.isms.Get(ctx, key.K2())
+	if err != nil || ism.PendingVerifierUpdate == nil || !ism.PendingVerifierUpdate.ActivationTime.Equal(key.K1()) {
+		return
+	}
+
+	ism.PendingVerifierUpdate = nil
+	if err := k.isms.Set(ctx, key.K2(), ism); err != nil {
+		k.Logger(ctx).Error("failed to clear pending verifier update", "ism", key.K2(), "err", err)
+	}
+}
+
+// scheduleVerifierUpdate stores the update as pending on the ism and queues it for its activation time.
+// Any previously scheduled update of the ism is replaced.
+func (k *Keeper) scheduleVerifierUpdate(ctx context.Context, ism types.InterchainSecurityModule, update types.PendingVerifierUpdate) error {
+	if err := k.removePendingVerifierUpdate(ctx, &ism); err != nil {
+		return err
+	}
+
+	ism.PendingVerifierUpdate = &update
+	if err := k.isms.Set(ctx, ism.Id.GetInternalId(), ism); err != nil {
+		return err
+	}
+
+	if err := k.verifierUpdates.Set(ctx, collections.Join(update.ActivationTime, ism.Id.GetInternalId())); err != nil {
+		return err
+	}
+
+	return EmitScheduleIsmVerifierUpdateEvent(sdk.UnwrapSDKContext(ctx), ism)
+}
+
+// applyVerifierUpdate replaces the verifier keys of the ism with the keys of the update and clears any pending update.
+func (k *Keeper) applyVerifierUpdate(ctx context.Context, ism types.InterchainSecurityModule, update types.PendingVerifierUpdate) error {
+	if err := k.removePendingVerifierUpdate(ctx, &ism); err != nil {
+		return err
+	}
+
+	ism.ProofSystem = update.ProofSystem
+	ism.Groth16Vkey = update.Groth16Vkey
+	ism.StateTransitionVkey = update.StateTransitionVkey
+	ism.StateMembershipVkey = update.StateMembershipVkey
+	if err := k.isms.Set(ctx, ism.Id.GetInternalId(), ism); err != nil {
+		return err
+	}
+
+	return EmitUpdateIsmVerifierEvent(sdk.UnwrapSDKContext(ctx), ism)
+}
+
+// removePendingVerifierUpdate clears the pending verifier update of the ism and removes it from the queue.
+func (k *Keeper) removePendingVerifierUpdate(ctx context.Context, ism *types.InterchainSecurityModule) error {
+	if ism.PendingVerifierUpdate == nil {
+		return nil
+	}
+
+	key := collections.Join(ism.PendingVerifierUpdate.ActivationTime, ism.Id.GetInternalId())
+	if err := k.verifierUpdates.Remove(ctx, key); err != nil {
+		return err
+	}
+
+	ism.PendingVerifierUpdate = nil
+	return nil
+}
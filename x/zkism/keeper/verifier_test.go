@@ -0,0 +1,258 @@
+package keeper_test
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/celestiaorg/celestia-app/v7/x/zkism/keeper"
+	"github.com/celestiaorg/celestia-app/v7/x/zkism/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (suite *KeeperTestSuite) TestUpdateIsmVerifier() {
+	owner := sdk.AccAddress(bytes.Repeat([]byte{0x01}, 20)).String()
+	newStateVkey := bytes.Repeat([]byte{0x02}, 32)
+	newMessageVkey := bytes.Repeat([]byte{0x03}, 32)
+
+	var (
+		ism types.InterchainSecurityModule
+		msg *types.MsgUpdateIsmVerifier
+	)
+
+	testCases := []struct {
+		name      string
+		setupTest func()
+		expError  error
+		expDelay  bool
+	}{
+		{
+			name:      "success, applied immediately",
+			setupTest: func() {},
+			expError:  nil,
+		},
+		{
+			name: "success, applied after delay",
+			setupTest: func() {
+				msg.Delay = time.Hour
+			},
+			expError: nil,
+			expDelay: true,
+		},
+		{
+			name: "ism not found",
+			setupTest: func() {
+				msg.Id = util.CreateMockHexAddress("ism", 2)
+			},
+			expError: types.ErrIsmNotFound,
+		},
+		{
+			name: "signer is not the owner",
+			setupTest: func() {
+				msg.Owner = sdk.AccAddress(bytes.Repeat([]byte{0x02}, 20)).String()
+			},
+			expError: sdkerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ism = suite.CreateTestIsm(randBytes(32))
+			ism.Owner = owner
+			suite.Require().NoError(suite.zkISMKeeper.SetIsm(suite.ctx, ism.Id, ism))
+
+			msg = &types.MsgUpdateIsmVerifier{
+				Id:                  ism.Id,
+				Owner:               owner,
+				Groth16Vkey:         ism.Groth16Vkey,
+				StateTransitionVkey: newStateVkey,
+				StateMembershipVkey: newMessageVkey,
+			}
+
+			tc.setupTest()
+
+			msgServer := keeper.NewMsgServerImpl(suite.zkISMKeeper)
+			res, err := msgServer.UpdateIsmVerifier(suite.ctx, msg)
+
+			if tc.expError != nil {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().NotNil(res)
+
+			if tc.expDelay {
+				pending, err := suite.zkISMKeeper.GetIsm(suite.ctx, ism.Id)
+				suite.Require().NoError(err)
+				suite.Require().Equal(ism.StateTransitionVkey, pending.StateTransitionVkey)
+				suite.Require().NotNil(pending.PendingVerifierUpdate)
+				suite.Require().Equal(suite.ctx.BlockTime().Add(msg.Delay), pending.PendingVerifierUpdate.ActivationTime)
+
+				// the update is not applied before its activation time
+				suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(msg.Delay - time.Second))
+				suite.Require().NoError(suite.zkISMKeeper.EndBlocker(suite.ctx))
+				pending, err = suite.zkISMKeeper.GetIsm(suite.ctx, ism.Id)
+				suite.Require().NoError(err)
+				suite.Require().NotNil(pending.PendingVerifierUpdate)
+
+				suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second))
+				suite.Require().NoError(suite.zkISMKeeper.EndBlocker(suite.ctx))
+			}
+
+			updated, err := suite.zkISMKeeper.GetIsm(suite.ctx, ism.Id)
+			suite.Require().NoError(err)
+			suite.Require().Equal(newStateVkey, updated.StateTransitionVkey)
+			suite.Require().Equal(newMessageVkey, updated.StateMembershipVkey)
+			suite.Require().Nil(updated.PendingVerifierUpdate)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateIsmVerifierReplacesPendingUpdate() {
+	owner := sdk.AccAddress(bytes.Repeat([]byte{0x01}, 20)).String()
+	ism := suite.CreateTestIsm(randBytes(32))
+	ism.Owner = owner
+	suite.Require().NoError(suite.zkISMKeeper.SetIsm(suite.ctx, ism.Id, ism))
+
+	msgServer := keeper.NewMsgServerImpl(suite.zkISMKeeper)
+	for i, delay := range []time.Duration{time.Hour, 2 * time.Hour} {
+		_, err := msgServer.UpdateIsmVerifier(suite.ctx, &types.MsgUpdateIsmVerifier{
+			Id:                  ism.Id,
+			Owner:               owner,
+			Groth16Vkey:         ism.Groth16Vkey,
+			StateTransitionVkey: bytes.Repeat([]byte{byte(i)}, 32),
+			StateMembershipVkey: bytes.Repeat([]byte{byte(i)}, 32),
+			Delay:               delay,
+		})
+		suite.Require().NoError(err)
+	}
+
+	// the activation time of the replaced update has no effect
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(suite.zkISMKeeper.EndBlocker(suite.ctx))
+	pending, err := suite.zkISMKeeper.GetIsm(suite.ctx, ism.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(ism.StateTransitionVkey, pending.StateTransitionVkey)
+	suite.Require().NotNil(pending.PendingVerifierUpdate)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(suite.zkISMKeeper.EndBlocker(suite.ctx))
+	updated, err := suite.zkISMKeeper.GetIsm(suite.ctx, ism.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(bytes.Repeat([]byte{0x01}, 32), updated.StateTransitionVkey)
+	suite.Require().Nil(updated.PendingVerifierUpdate)
+}
+
+func (suite *KeeperTestSuite) TestEndBlockerDropsFailedVerifierUpdates() {
+	activationTime := suite.ctx.BlockTime().Add(time.Hour).UTC()
+
+	invalid := suite.CreateTestIsm(randBytes(32))
+	invalid.PendingVerifierUpdate = &types.PendingVerifierUpdate{
+		// the proof system is not accepted, e.g. because support for it was removed after the update was scheduled
+		ProofSystem:         types.ProofSystem_PROOF_SYSTEM_SP1_PLONK,
+		Groth16Vkey:         invalid.Groth16Vkey,
+		StateTransitionVkey: bytes.Repeat([]byte{0x02}, 32),
+		StateMembershipVkey: bytes.Repeat([]byte{0x03}, 32),
+		ActivationTime:      activationTime,
+	}
+
+	valid := suite.CreateTestIsm(randBytes(32))
+	valid.Id = util.CreateMockHexAddress("ism", 2)
+	valid.PendingVerifierUpdate = &types.PendingVerifierUpdate{
+		Groth16Vkey:         valid.Groth16Vkey,
+		StateTransitionVkey: bytes.Repeat([]byte{0x04}, 32),
+		StateMembershipVkey: bytes.Repeat([]byte{0x05}, 32),
+		ActivationTime:      activationTime,
+	}
+
+	suite.SetupTest()
+	suite.Require().NoError(suite.zkISMKeeper.InitGenesis(suite.ctx, &types.GenesisState{Isms: []types.InterchainSecurityModule{invalid, valid}}))
+
+	// an update queued for an ism that doesn't exist
+	unknownId := util.CreateMockHexAddress("ism", 3)
+	suite.Require().NoError(suite.zkISMKeeper.QueueVerifierUpdate(suite.ctx, unknownId, activationTime))
+
+	suite.ctx = suite.ctx.WithBlockTime(activationTime)
+	suite.Require().NoError(suite.zkISMKeeper.EndBlocker(suite.ctx))
+
+	// the failed updates are dropped without affecting the other updates
+	dropped, err := suite.zkISMKeeper.GetIsm(suite.ctx, invalid.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.ProofSystem_PROOF_SYSTEM_SP1_GROTH16, dropped.ProofSystem)
+	suite.Require().Equal(invalid.StateTransitionVkey, dropped.StateTransitionVkey)
+	suite.Require().Nil(dropped.PendingVerifierUpdate)
+
+	updated, err := suite.zkISMKeeper.GetIsm(suite.ctx, valid.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(valid.PendingVerifierUpdate.StateTransitionVkey, updated.StateTransitionVkey)
+	suite.Require().Nil(updated.PendingVerifierUpdate)
+
+	for _, id := range []util.HexAddress{invalid.Id, valid.Id, unknownId} {
+		queued, err := suite.zkISMKeeper.HasVerifierUpdate(suite.ctx, id, activationTime)
+		suite.Require().NoError(err)
+		suite.Require().False(queued)
+	}
+}
+
+func (suite *KeeperTestSuite) TestTransferIsmOwnership() {
+	owner := sdk.AccAddress(bytes.Repeat([]byte{0x01}, 20)).String()
+	newOwner := sdk.AccAddress(bytes.Repeat([]byte{0x02}, 20)).String()
+
+	ism := suite.CreateTestIsm(randBytes(32))
+	ism.Owner = owner
+	suite.Require().NoError(suite.zkISMKeeper.SetIsm(suite.ctx, ism.Id, ism))
+
+	msgServer := keeper.NewMsgServerImpl(suite.zkISMKeeper)
+
+	// only the owner can transfer the ownership
+	_, err := msgServer.TransferIsmOwnership(suite.ctx, &types.MsgTransferIsmOwnership{Id: ism.Id, Owner: newOwner, NewOwner: newOwner})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.TransferIsmOwnership(suite.ctx, &types.MsgTransferIsmOwnership{Id: ism.Id, Owner: owner, NewOwner: newOwner})
+	suite.Require().NoError(err)
+
+	updated, err := suite.zkISMKeeper.GetIsm(suite.ctx, ism.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(newOwner, updated.Owner)
+
+	// the previous owner can no longer update the verifier
+	_, err = msgServer.UpdateIsmVerifier(suite.ctx, &types.MsgUpdateIsmVerifier{
+		Id:                  ism.Id,
+		Owner:               owner,
+		Groth16Vkey:         ism.Groth16Vkey,
+		StateTransitionVkey: ism.StateTransitionVkey,
+		StateMembershipVkey: ism.StateMembershipVkey,
+	})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
+
+func (suite *KeeperTestSuite) TestGenesisPendingVerifierUpdate() {
+	ism := suite.CreateTestIsm(randBytes(32))
+	ism.PendingVerifierUpdate = &types.PendingVerifierUpdate{
+		Groth16Vkey:         ism.Groth16Vkey,
+		StateTransitionVkey: bytes.Repeat([]byte{0x02}, 32),
+		StateMembershipVkey: bytes.Repeat([]byte{0x03}, 32),
+		ActivationTime:      suite.ctx.BlockTime().Add(time.Hour).UTC(),
+	}
+
+	suite.SetupTest()
+	suite.Require().NoError(suite.zkISMKeeper.InitGenesis(suite.ctx, &types.GenesisState{Isms: []types.InterchainSecurityModule{ism}}))
+
+	exported, err := suite.zkISMKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(exported.Isms, 1)
+	suite.Require().Equal(ism.PendingVerifierUpdate, exported.Isms[0].PendingVerifierUpdate)
+
+	// the imported update is queued and applied at its activation time
+	suite.ctx = suite.ctx.WithBlockTime(ism.PendingVerifierUpdate.ActivationTime)
+	suite.Require().NoError(suite.zkISMKeeper.EndBlocker(suite.ctx))
+	updated, err := suite.zkISMKeeper.GetIsm(suite.ctx, ism.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(ism.PendingVerifierUpdate.StateTransitionVkey, updated.StateTransitionVkey)
+	suite.Require().Nil(updated.PendingVerifierUpdate)
+}
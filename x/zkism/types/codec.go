@@ -13,6 +13,11 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgCreateInterchainSecurityModule{},
 		&MsgUpdateInterchainSecurityModule{},
 		&MsgSubmitMessages{},
+		&MsgSubmitProofs{},
+		&MsgUpdateIsmVerifier{},
+		&MsgTransferIsmOwnership{},
+		&MsgUpdateIsmMessageTtl{},
+		&MsgUpdateParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
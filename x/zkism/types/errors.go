@@ -18,4 +18,7 @@ var (
 	ErrInvalidMerkleTreeAddress     = errorsmod.Register(ModuleName, 9, "invalid merkle tree address")
 	ErrMessageProofAlreadySubmitted = errorsmod.Register(ModuleName, 10, "message proof already submitted for current state root")
 	ErrInvalidPublicValuesLength    = errorsmod.Register(ModuleName, 11, "invalid public values length")
+	ErrInvalidMessageTTL            = errorsmod.Register(ModuleName, 12, "invalid message ttl")
+	ErrUnsupportedProofSystem       = errorsmod.Register(ModuleName, 13, "unsupported proof system")
+	ErrInvalidParams                = errorsmod.Register(ModuleName, 14, "invalid params")
 )
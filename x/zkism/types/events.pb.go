@@ -9,15 +9,19 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -35,12 +39,14 @@ type EventCreateInterchainSecurityModule struct {
 	State string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
 	// merkle tree address in bytes32 format
 	MerkleTreeAddress string `protobuf:"bytes,4,opt,name=merkle_tree_address,json=merkleTreeAddress,proto3" json:"merkle_tree_address,omitempty"`
-	// the sp1 groth16 verifier key (hex-encoded)
+	// the verifier key of the proof system (hex-encoded)
 	Groth16Vkey string `protobuf:"bytes,5,opt,name=groth16_vkey,json=groth16Vkey,proto3" json:"groth16_vkey,omitempty"`
 	// hash-based commitment to the verifier key used for state transition (hex-encoded)
 	StateTransitionVkey string `protobuf:"bytes,6,opt,name=state_transition_vkey,json=stateTransitionVkey,proto3" json:"state_transition_vkey,omitempty"`
 	// hash-based commitment to the verifier key used for state membership (hex-encoded)
 	StateMembershipVkey string `protobuf:"bytes,7,opt,name=state_membership_vkey,json=stateMembershipVkey,proto3" json:"state_membership_vkey,omitempty"`
+	// the proof system used to verify proofs submitted to the ism
+	ProofSystem ProofSystem `protobuf:"varint,8,opt,name=proof_system,json=proofSystem,proto3,enum=celestia.zkism.v1.ProofSystem" json:"proof_system,omitempty"`
 }
 
 func (m *EventCreateInterchainSecurityModule) Reset()         { *m = EventCreateInterchainSecurityModule{} }
@@ -160,47 +166,346 @@ func (m *EventSubmitMessages) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventSubmitMessages proto.InternalMessageInfo
 
+// EventScheduleIsmVerifierUpdate defines the event type emitted when the owner schedules a verifier key update.
+type EventScheduleIsmVerifierUpdate struct {
+	// unique hyperlane identifier
+	Id github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
+	// the new verifier key of the proof system (hex-encoded)
+	Groth16Vkey string `protobuf:"bytes,2,opt,name=groth16_vkey,json=groth16Vkey,proto3" json:"groth16_vkey,omitempty"`
+	// the new hash-based commitment to the verifier key used for state transition (hex-encoded)
+	StateTransitionVkey string `protobuf:"bytes,3,opt,name=state_transition_vkey,json=stateTransitionVkey,proto3" json:"state_transition_vkey,omitempty"`
+	// the new hash-based commitment to the verifier key used for state membership (hex-encoded)
+	StateMembershipVkey string `protobuf:"bytes,4,opt,name=state_membership_vkey,json=stateMembershipVkey,proto3" json:"state_membership_vkey,omitempty"`
+	// the time from which the update is applied
+	ActivationTime time.Time `protobuf:"bytes,5,opt,name=activation_time,json=activationTime,proto3,stdtime" json:"activation_time"`
+	// the new proof system
+	ProofSystem ProofSystem `protobuf:"varint,6,opt,name=proof_system,json=proofSystem,proto3,enum=celestia.zkism.v1.ProofSystem" json:"proof_system,omitempty"`
+}
+
+func (m *EventScheduleIsmVerifierUpdate) Reset()         { *m = EventScheduleIsmVerifierUpdate{} }
+func (m *EventScheduleIsmVerifierUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventScheduleIsmVerifierUpdate) ProtoMessage()    {}
+func (*EventScheduleIsmVerifierUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aae7066334f1a175, []int{3}
+}
+func (m *EventScheduleIsmVerifierUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScheduleIsmVerifierUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScheduleIsmVerifierUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventScheduleIsmVerifierUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScheduleIsmVerifierUpdate.Merge(m, src)
+}
+func (m *EventScheduleIsmVerifierUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScheduleIsmVerifierUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScheduleIsmVerifierUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventScheduleIsmVerifierUpdate proto.InternalMessageInfo
+
+// EventUpdateIsmVerifier defines the event type emitted when the verifier keys of an ism are updated.
+type EventUpdateIsmVerifier struct {
+	// unique hyperlane identifier
+	Id github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
+	// the verifier key of the proof system (hex-encoded)
+	Groth16Vkey string `protobuf:"bytes,2,opt,name=groth16_vkey,json=groth16Vkey,proto3" json:"groth16_vkey,omitempty"`
+	// hash-based commitment to the verifier key used for state transition (hex-encoded)
+	StateTransitionVkey string `protobuf:"bytes,3,opt,name=state_transition_vkey,json=stateTransitionVkey,proto3" json:"state_transition_vkey,omitempty"`
+	// hash-based commitment to the verifier key used for state membership (hex-encoded)
+	StateMembershipVkey string `protobuf:"bytes,4,opt,name=state_membership_vkey,json=stateMembershipVkey,proto3" json:"state_membership_vkey,omitempty"`
+	// the proof system used to verify proofs submitted to the ism
+	ProofSystem ProofSystem `protobuf:"varint,5,opt,name=proof_system,json=proofSystem,proto3,enum=celestia.zkism.v1.ProofSystem" json:"proof_system,omitempty"`
+}
+
+func (m *EventUpdateIsmVerifier) Reset()         { *m = EventUpdateIsmVerifier{} }
+func (m *EventUpdateIsmVerifier) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateIsmVerifier) ProtoMessage()    {}
+func (*EventUpdateIsmVerifier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aae7066334f1a175, []int{4}
+}
+func (m *EventUpdateIsmVerifier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateIsmVerifier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateIsmVerifier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateIsmVerifier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateIsmVerifier.Merge(m, src)
+}
+func (m *EventUpdateIsmVerifier) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateIsmVerifier) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateIsmVerifier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateIsmVerifier proto.InternalMessageInfo
+
+// EventTransferIsmOwnership defines the event type emitted when the ownership of an ism is transferred.
+type EventTransferIsmOwnership struct {
+	// unique hyperlane identifier
+	Id github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
+	// the previous owner of the ism
+	PreviousOwner string `protobuf:"bytes,2,opt,name=previous_owner,json=previousOwner,proto3" json:"previous_owner,omitempty"`
+	// the new owner of the ism
+	NewOwner string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+}
+
+func (m *EventTransferIsmOwnership) Reset()         { *m = EventTransferIsmOwnership{} }
+func (m *EventTransferIsmOwnership) String() string { return proto.CompactTextString(m) }
+func (*EventTransferIsmOwnership) ProtoMessage()    {}
+func (*EventTransferIsmOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aae7066334f1a175, []int{5}
+}
+func (m *EventTransferIsmOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTransferIsmOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTransferIsmOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTransferIsmOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTransferIsmOwnership.Merge(m, src)
+}
+func (m *EventTransferIsmOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTransferIsmOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTransferIsmOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTransferIsmOwnership proto.InternalMessageInfo
+
+// EventUpdateIsmMessageTtl defines the event type emitted when the owner sets the message ttl of an ism.
+type EventUpdateIsmMessageTtl struct {
+	// unique hyperlane identifier
+	Id github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
+	// number of blocks authorized message ids remain valid
+	MessageTtl uint64 `protobuf:"varint,2,opt,name=message_ttl,json=messageTtl,proto3" json:"message_ttl,omitempty"`
+}
+
+func (m *EventUpdateIsmMessageTtl) Reset()         { *m = EventUpdateIsmMessageTtl{} }
+func (m *EventUpdateIsmMessageTtl) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateIsmMessageTtl) ProtoMessage()    {}
+func (*EventUpdateIsmMessageTtl) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aae7066334f1a175, []int{6}
+}
+func (m *EventUpdateIsmMessageTtl) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateIsmMessageTtl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateIsmMessageTtl.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateIsmMessageTtl) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateIsmMessageTtl.Merge(m, src)
+}
+func (m *EventUpdateIsmMessageTtl) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateIsmMessageTtl) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateIsmMessageTtl.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateIsmMessageTtl proto.InternalMessageInfo
+
+// EventUpdateParams defines the event type emitted when the module parameters are updated.
+type EventUpdateParams struct {
+	// the address of the governance account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// number of blocks authorized message ids remain valid
+	MessageTtl uint64 `protobuf:"varint,2,opt,name=message_ttl,json=messageTtl,proto3" json:"message_ttl,omitempty"`
+	// gas consumed for verifying a single groth16 proof
+	Groth16VerifyCost uint64 `protobuf:"varint,3,opt,name=groth16_verify_cost,json=groth16VerifyCost,proto3" json:"groth16_verify_cost,omitempty"`
+	// gas consumed for verifying a single plonk proof
+	PlonkVerifyCost uint64 `protobuf:"varint,4,opt,name=plonk_verify_cost,json=plonkVerifyCost,proto3" json:"plonk_verify_cost,omitempty"`
+	// gas consumed per byte of public values of a verified proof
+	PublicValuesCostPerByte uint64 `protobuf:"varint,5,opt,name=public_values_cost_per_byte,json=publicValuesCostPerByte,proto3" json:"public_values_cost_per_byte,omitempty"`
+	// gas consumed per message id authorized by a state membership proof
+	MessageIdCost uint64 `protobuf:"varint,6,opt,name=message_id_cost,json=messageIdCost,proto3" json:"message_id_cost,omitempty"`
+}
+
+func (m *EventUpdateParams) Reset()         { *m = EventUpdateParams{} }
+func (m *EventUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateParams) ProtoMessage()    {}
+func (*EventUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aae7066334f1a175, []int{7}
+}
+func (m *EventUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateParams.Merge(m, src)
+}
+func (m *EventUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateParams proto.InternalMessageInfo
+
+func (m *EventUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *EventUpdateParams) GetMessageTtl() uint64 {
+	if m != nil {
+		return m.MessageTtl
+	}
+	return 0
+}
+
+func (m *EventUpdateParams) GetGroth16VerifyCost() uint64 {
+	if m != nil {
+		return m.Groth16VerifyCost
+	}
+	return 0
+}
+
+func (m *EventUpdateParams) GetPlonkVerifyCost() uint64 {
+	if m != nil {
+		return m.PlonkVerifyCost
+	}
+	return 0
+}
+
+func (m *EventUpdateParams) GetPublicValuesCostPerByte() uint64 {
+	if m != nil {
+		return m.PublicValuesCostPerByte
+	}
+	return 0
+}
+
+func (m *EventUpdateParams) GetMessageIdCost() uint64 {
+	if m != nil {
+		return m.MessageIdCost
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EventCreateInterchainSecurityModule)(nil), "celestia.zkism.v1.EventCreateInterchainSecurityModule")
 	proto.RegisterType((*EventUpdateInterchainSecurityModule)(nil), "celestia.zkism.v1.EventUpdateInterchainSecurityModule")
 	proto.RegisterType((*EventSubmitMessages)(nil), "celestia.zkism.v1.EventSubmitMessages")
+	proto.RegisterType((*EventScheduleIsmVerifierUpdate)(nil), "celestia.zkism.v1.EventScheduleIsmVerifierUpdate")
+	proto.RegisterType((*EventUpdateIsmVerifier)(nil), "celestia.zkism.v1.EventUpdateIsmVerifier")
+	proto.RegisterType((*EventTransferIsmOwnership)(nil), "celestia.zkism.v1.EventTransferIsmOwnership")
+	proto.RegisterType((*EventUpdateIsmMessageTtl)(nil), "celestia.zkism.v1.EventUpdateIsmMessageTtl")
+	proto.RegisterType((*EventUpdateParams)(nil), "celestia.zkism.v1.EventUpdateParams")
 }
 
 func init() { proto.RegisterFile("celestia/zkism/v1/events.proto", fileDescriptor_aae7066334f1a175) }
 
 var fileDescriptor_aae7066334f1a175 = []byte{
-	// 481 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0xe3, 0xa4, 0x29, 0xe4, 0x60, 0xa9, 0x13, 0x24, 0x13, 0x09, 0xa7, 0x94, 0xa5, 0x4b,
-	0x6c, 0x05, 0x24, 0x06, 0x98, 0x68, 0x85, 0x04, 0x42, 0x59, 0x92, 0xc2, 0xc0, 0x12, 0x9d, 0xed,
-	0x27, 0xfb, 0x14, 0xfb, 0xce, 0xba, 0x7b, 0x36, 0x35, 0x1b, 0x1b, 0x23, 0x13, 0x33, 0x3b, 0x2b,
-	0x1f, 0xa2, 0x63, 0xc5, 0x84, 0x18, 0x2a, 0x94, 0x7c, 0x11, 0xe4, 0x3b, 0xd7, 0x0a, 0x1f, 0x80,
-	0x6e, 0x77, 0xef, 0xff, 0xfb, 0x3f, 0xff, 0xfd, 0x4e, 0x8f, 0xb8, 0x21, 0xa4, 0xa0, 0x90, 0x51,
-	0xff, 0xe3, 0x9a, 0xa9, 0xcc, 0x2f, 0x67, 0x3e, 0x94, 0xc0, 0x51, 0x79, 0xb9, 0x14, 0x28, 0xec,
-	0x83, 0x6b, 0xdd, 0xd3, 0xba, 0x57, 0xce, 0xc6, 0xf7, 0x43, 0xa1, 0x32, 0xa1, 0x56, 0x1a, 0xf0,
-	0xcd, 0xc5, 0xd0, 0xe3, 0x51, 0x2c, 0x62, 0x61, 0xea, 0xf5, 0xc9, 0x54, 0x8f, 0x3e, 0xf5, 0xc8,
-	0xa3, 0x97, 0x75, 0xd3, 0x53, 0x09, 0x14, 0xe1, 0x35, 0x47, 0x90, 0x61, 0x42, 0x19, 0x5f, 0x42,
-	0x58, 0x48, 0x86, 0xd5, 0x5c, 0x44, 0x45, 0x0a, 0xf6, 0x92, 0x74, 0x59, 0xe4, 0x58, 0x87, 0xd6,
-	0xf1, 0xe0, 0xe4, 0xf4, 0xe2, 0x6a, 0xd2, 0xf9, 0x7d, 0x35, 0x79, 0x1e, 0x33, 0x4c, 0x8a, 0xc0,
-	0x0b, 0x45, 0xe6, 0x07, 0x61, 0x3e, 0x65, 0x9c, 0x8b, 0x92, 0x22, 0x13, 0x5c, 0xf9, 0x49, 0x95,
-	0x83, 0x4c, 0x29, 0x87, 0xa9, 0x09, 0xe1, 0x17, 0xc8, 0x52, 0xef, 0x15, 0x9c, 0xbf, 0x88, 0x22,
-	0x09, 0x4a, 0x2d, 0xba, 0x2c, 0xb2, 0x3d, 0xd2, 0x17, 0x1f, 0x38, 0x48, 0xa7, 0xab, 0xfb, 0x3a,
-	0x3f, 0x7f, 0x4c, 0x47, 0x4d, 0xe6, 0x06, 0x5b, 0xa2, 0x64, 0x3c, 0x5e, 0x18, 0xcc, 0x1e, 0x91,
-	0xbe, 0x42, 0x8a, 0xe0, 0xf4, 0x6a, 0x7e, 0x61, 0x2e, 0xb6, 0x47, 0x86, 0x19, 0xc8, 0x75, 0x0a,
-	0x2b, 0x94, 0x00, 0x2b, 0x6a, 0x9c, 0xce, 0x9e, 0x66, 0x0e, 0x8c, 0x74, 0x26, 0x01, 0x9a, 0x96,
-	0xf6, 0x43, 0x72, 0x37, 0x96, 0x02, 0x93, 0xd9, 0xd3, 0x55, 0xb9, 0x86, 0xca, 0xe9, 0x6b, 0xf0,
-	0x4e, 0x53, 0x7b, 0xb7, 0x86, 0xca, 0x7e, 0x4c, 0xee, 0xe9, 0xde, 0x2b, 0x94, 0x94, 0x2b, 0x56,
-	0xff, 0x92, 0x61, 0xf7, 0x35, 0x3b, 0xd4, 0xe2, 0x59, 0xab, 0xfd, 0xeb, 0xc9, 0x20, 0x0b, 0x40,
-	0xaa, 0x84, 0xe5, 0xc6, 0x73, 0x6b, 0xc7, 0x33, 0x6f, 0xb5, 0xda, 0xf3, 0x6c, 0xef, 0xf3, 0xb7,
-	0x49, 0xe7, 0xe8, 0xab, 0xd5, 0xbc, 0xc1, 0xdb, 0x3c, 0xba, 0xf1, 0x37, 0x68, 0x67, 0xda, 0xdd,
-	0x99, 0x69, 0x13, 0xec, 0xbb, 0x45, 0x86, 0x3a, 0xd8, 0xb2, 0x08, 0x32, 0x86, 0x73, 0x50, 0x8a,
-	0xc6, 0xa0, 0xfe, 0x4f, 0x90, 0x07, 0x84, 0x98, 0xf9, 0x49, 0x21, 0xb0, 0x49, 0x33, 0xd0, 0x95,
-	0x85, 0x10, 0x68, 0x8f, 0xc9, 0xed, 0xac, 0xf9, 0xbe, 0xd3, 0x3b, 0xec, 0x1d, 0x0f, 0x16, 0xed,
-	0xdd, 0xa4, 0x3d, 0x79, 0x73, 0xb1, 0x71, 0xad, 0xcb, 0x8d, 0x6b, 0xfd, 0xd9, 0xb8, 0xd6, 0x97,
-	0xad, 0xdb, 0xb9, 0xdc, 0xba, 0x9d, 0x5f, 0x5b, 0xb7, 0xf3, 0x7e, 0xb6, 0x93, 0xed, 0x7a, 0x67,
-	0x84, 0x8c, 0xdb, 0xf3, 0x94, 0xe6, 0xb9, 0x7f, 0xde, 0x6c, 0x19, 0x56, 0x39, 0xa8, 0x60, 0x5f,
-	0xaf, 0xc7, 0x93, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7b, 0x37, 0x47, 0x4a, 0x84, 0x03, 0x00,
-	0x00,
+	// 859 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x93, 0xb4, 0x34, 0xd3, 0xdd, 0x56, 0x71, 0x0b, 0x78, 0x8b, 0xd6, 0x29, 0x8b, 0x84,
+	0x2a, 0xa4, 0xda, 0x6a, 0x11, 0x7b, 0x00, 0x24, 0xb4, 0xad, 0x90, 0x88, 0x50, 0x44, 0xe5, 0x84,
+	0x1e, 0xb8, 0x58, 0x63, 0xfb, 0xc5, 0x19, 0xc5, 0xf6, 0x58, 0x33, 0xe3, 0x64, 0xcd, 0x5f, 0xc0,
+	0x71, 0x4f, 0x88, 0x23, 0x77, 0x24, 0x4e, 0xfc, 0x03, 0x48, 0x1c, 0xf6, 0xb8, 0xe2, 0x84, 0x38,
+	0x2c, 0xa8, 0xbd, 0xf3, 0x37, 0x20, 0xcf, 0x4c, 0x7e, 0xb4, 0x15, 0x2a, 0x54, 0x0a, 0x87, 0xbd,
+	0xe5, 0xcd, 0xfb, 0xbe, 0x99, 0x6f, 0xde, 0x7b, 0xf3, 0xc5, 0xc8, 0x0e, 0x21, 0x01, 0x2e, 0x08,
+	0x76, 0xbf, 0x1e, 0x13, 0x9e, 0xba, 0x93, 0x23, 0x17, 0x26, 0x90, 0x09, 0xee, 0xe4, 0x8c, 0x0a,
+	0x6a, 0xb6, 0x67, 0x79, 0x47, 0xe6, 0x9d, 0xc9, 0xd1, 0xde, 0xc3, 0x9b, 0x14, 0x51, 0xe6, 0xa0,
+	0x19, 0x7b, 0x0f, 0x42, 0xca, 0x53, 0xca, 0x7d, 0x19, 0xb9, 0x2a, 0xd0, 0xa9, 0xdd, 0x98, 0xc6,
+	0x54, 0xad, 0x57, 0xbf, 0xf4, 0x6a, 0x27, 0xa6, 0x34, 0x4e, 0xc0, 0x95, 0x51, 0x50, 0x0c, 0x5d,
+	0x41, 0x52, 0xe0, 0x02, 0xa7, 0xb9, 0x02, 0x3c, 0xfa, 0xa5, 0x81, 0xde, 0xf9, 0xb4, 0x12, 0x75,
+	0xca, 0x00, 0x0b, 0xe8, 0x66, 0x02, 0x58, 0x38, 0xc2, 0x24, 0xeb, 0x43, 0x58, 0x30, 0x22, 0xca,
+	0x1e, 0x8d, 0x8a, 0x04, 0xcc, 0x3e, 0xaa, 0x93, 0xc8, 0x32, 0xf6, 0x8d, 0x83, 0xd6, 0xc9, 0xe9,
+	0xf3, 0x97, 0x9d, 0xda, 0xef, 0x2f, 0x3b, 0x1f, 0xc5, 0x44, 0x8c, 0x8a, 0xc0, 0x09, 0x69, 0xea,
+	0x06, 0x61, 0x7e, 0x48, 0xb2, 0x8c, 0x4e, 0xb0, 0x20, 0x34, 0xe3, 0xee, 0xa8, 0xcc, 0x81, 0x25,
+	0x38, 0x83, 0x43, 0xa5, 0xd2, 0x2d, 0x04, 0x49, 0x9c, 0xcf, 0xe0, 0xe9, 0x93, 0x28, 0x62, 0xc0,
+	0xb9, 0x57, 0x27, 0x91, 0xe9, 0xa0, 0x35, 0x3a, 0xcd, 0x80, 0x59, 0x75, 0xb9, 0xaf, 0xf5, 0xeb,
+	0x4f, 0x87, 0xbb, 0xfa, 0x52, 0x1a, 0xd6, 0x17, 0x8c, 0x64, 0xb1, 0xa7, 0x60, 0xe6, 0x2e, 0x5a,
+	0xe3, 0x02, 0x0b, 0xb0, 0x1a, 0x15, 0xde, 0x53, 0x81, 0xe9, 0xa0, 0x9d, 0x14, 0xd8, 0x38, 0x01,
+	0x5f, 0x30, 0x00, 0x1f, 0x2b, 0xa6, 0xd5, 0x94, 0x98, 0xb6, 0x4a, 0x0d, 0x18, 0x80, 0xde, 0xd2,
+	0x7c, 0x1b, 0xdd, 0x8b, 0x19, 0x15, 0xa3, 0xa3, 0xc7, 0xfe, 0x64, 0x0c, 0xa5, 0xb5, 0x26, 0x81,
+	0x9b, 0x7a, 0xed, 0x7c, 0x0c, 0xa5, 0x79, 0x8c, 0x5e, 0x97, 0x7b, 0xfb, 0x82, 0xe1, 0x8c, 0x93,
+	0xea, 0x4a, 0x0a, 0xbb, 0x2e, 0xb1, 0x3b, 0x32, 0x39, 0x98, 0xe7, 0xae, 0x72, 0x52, 0x48, 0x03,
+	0x60, 0x7c, 0x44, 0x72, 0xc5, 0x79, 0x6d, 0x89, 0xd3, 0x9b, 0xe7, 0x24, 0xe7, 0x09, 0xba, 0x97,
+	0x33, 0x4a, 0x87, 0x3e, 0x2f, 0xb9, 0x80, 0xd4, 0xda, 0xd8, 0x37, 0x0e, 0xb6, 0x8e, 0x6d, 0xe7,
+	0xc6, 0x60, 0x38, 0x67, 0x15, 0xac, 0x2f, 0x51, 0xde, 0x66, 0xbe, 0x08, 0x3e, 0x6c, 0x7e, 0xf3,
+	0x7d, 0xa7, 0xf6, 0xe8, 0x5b, 0x43, 0xb7, 0xf1, 0xcb, 0x3c, 0xfa, 0xdf, 0xdb, 0x38, 0x6f, 0x4b,
+	0x7d, 0xa9, 0x2d, 0x5a, 0xd8, 0x0f, 0x06, 0xda, 0x91, 0xc2, 0xfa, 0x45, 0x90, 0x12, 0xd1, 0x03,
+	0xce, 0x71, 0x0c, 0x7c, 0x35, 0x42, 0x1e, 0x22, 0xa4, 0x5a, 0xc0, 0x28, 0x15, 0x5a, 0x4d, 0x4b,
+	0xae, 0x78, 0x94, 0x0a, 0x73, 0x0f, 0x6d, 0xa4, 0xfa, 0x7c, 0xab, 0xb1, 0xdf, 0x38, 0x68, 0x79,
+	0xf3, 0x58, 0xab, 0xfd, 0xae, 0x81, 0x6c, 0xa5, 0x36, 0x1c, 0x41, 0x55, 0xb0, 0x2e, 0x4f, 0xcf,
+	0x81, 0x91, 0x21, 0x01, 0xa6, 0x2a, 0xbb, 0x1a, 0xe1, 0xd7, 0x47, 0xb2, 0xfe, 0x1f, 0x46, 0xb2,
+	0x71, 0x87, 0x91, 0x6c, 0xfe, 0xf3, 0x48, 0xf6, 0xd0, 0x36, 0x0e, 0x05, 0x51, 0xf2, 0xfd, 0xca,
+	0x2e, 0xe4, 0x03, 0xd9, 0x3c, 0xde, 0x73, 0x94, 0x97, 0x38, 0x33, 0x2f, 0x71, 0x06, 0x33, 0x2f,
+	0x39, 0xd9, 0xa8, 0x0a, 0xf1, 0xec, 0x8f, 0x8e, 0xe1, 0x6d, 0x2d, 0xc8, 0x55, 0xfa, 0xc6, 0x84,
+	0xaf, 0xdf, 0x75, 0xc2, 0x7f, 0xae, 0xa3, 0x37, 0x96, 0x27, 0x7c, 0xd1, 0x98, 0x57, 0xbe, 0x25,
+	0xd7, 0x6b, 0xb8, 0x76, 0xd7, 0x1a, 0xfe, 0x65, 0xa0, 0x07, 0xb2, 0x86, 0x52, 0xd4, 0x10, 0x58,
+	0x97, 0xa7, 0x5f, 0x4c, 0x33, 0x75, 0xd4, 0x6a, 0xca, 0xf8, 0x09, 0xda, 0xca, 0x19, 0x4c, 0x08,
+	0x2d, 0xb8, 0xff, 0xef, 0xbc, 0xfe, 0xfe, 0x0c, 0x2f, 0x95, 0x99, 0x1f, 0xa0, 0x56, 0x06, 0x53,
+	0xcd, 0x6d, 0xdc, 0xc2, 0xdd, 0xc8, 0x60, 0x2a, 0x69, 0x0b, 0x5b, 0xb4, 0xae, 0x0e, 0x8d, 0x36,
+	0xa0, 0x81, 0x48, 0x56, 0x73, 0xdf, 0x0e, 0xda, 0xd4, 0x9e, 0xe2, 0x0b, 0x91, 0xc8, 0xcb, 0x36,
+	0x3d, 0x94, 0xce, 0x4f, 0xd5, 0xc2, 0x7e, 0xac, 0xa3, 0xf6, 0x92, 0xb0, 0x33, 0xcc, 0x70, 0xca,
+	0xcd, 0xc7, 0xa8, 0x85, 0x0b, 0x31, 0xa2, 0x95, 0x61, 0x5b, 0xc6, 0x2d, 0x77, 0x5d, 0x40, 0x6f,
+	0x3d, 0xb4, 0xfa, 0x8b, 0x9c, 0x0f, 0x73, 0xf5, 0x6a, 0x4a, 0x3f, 0xa4, 0x5c, 0xc8, 0x72, 0x36,
+	0xbd, 0xf6, 0x6c, 0xa6, 0x65, 0xe6, 0x94, 0x72, 0x61, 0xbe, 0x87, 0xda, 0x79, 0x42, 0xb3, 0xf1,
+	0x15, 0x74, 0x53, 0xa2, 0xb7, 0x65, 0x62, 0x09, 0xfb, 0x31, 0x7a, 0x2b, 0x2f, 0x82, 0x84, 0x84,
+	0xfe, 0x04, 0x27, 0x05, 0x70, 0x09, 0xf6, 0x73, 0x60, 0x7e, 0x50, 0x0a, 0x65, 0x1e, 0x4d, 0xef,
+	0x4d, 0x05, 0x39, 0x97, 0x88, 0x8a, 0x76, 0x06, 0xec, 0xa4, 0x14, 0x60, 0xbe, 0x8b, 0xb6, 0x67,
+	0xd2, 0x49, 0xa4, 0xce, 0x59, 0x97, 0x8c, 0xfb, 0x7a, 0xb9, 0x1b, 0x55, 0xf0, 0x93, 0xcf, 0x9f,
+	0x5f, 0xd8, 0xc6, 0x8b, 0x0b, 0xdb, 0xf8, 0xf3, 0xc2, 0x36, 0x9e, 0x5d, 0xda, 0xb5, 0x17, 0x97,
+	0x76, 0xed, 0xb7, 0x4b, 0xbb, 0xf6, 0xd5, 0xd1, 0x52, 0xcb, 0x66, 0x2f, 0x82, 0xb2, 0x78, 0xfe,
+	0xfb, 0x10, 0xe7, 0xb9, 0xfb, 0x54, 0x7f, 0x4f, 0xc9, 0x8f, 0xa9, 0x60, 0x5d, 0x5a, 0xd8, 0xfb,
+	0x7f, 0x0f, 0x00, 0x0e, 0x52, 0x23, 0xeb, 0xa1, 0x09, 0x00, 0x00,
 }
 
 func (m *EventCreateInterchainSecurityModule) Marshal() (dAtA []byte, err error) {
@@ -223,6 +528,11 @@ func (m *EventCreateInterchainSecurityModule) MarshalToSizedBuffer(dAtA []byte)
 	_ = i
 	var l int
 	_ = l
+	if m.ProofSystem != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProofSystem))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.StateMembershipVkey) > 0 {
 		i -= len(m.StateMembershipVkey)
 		copy(dAtA[i:], m.StateMembershipVkey)
@@ -367,95 +677,1270 @@ func (m *EventSubmitMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventScheduleIsmVerifierUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventCreateInterchainSecurityModule) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventScheduleIsmVerifierUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScheduleIsmVerifierUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Id.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.ProofSystem != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProofSystem))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.State)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ActivationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ActivationTime):])
+	if err1 != nil {
+		return 0, err1
 	}
-	l = len(m.MerkleTreeAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i -= n1
+	i = encodeVarintEvents(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.StateMembershipVkey) > 0 {
+		i -= len(m.StateMembershipVkey)
+		copy(dAtA[i:], m.StateMembershipVkey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StateMembershipVkey)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Groth16Vkey)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.StateTransitionVkey) > 0 {
+		i -= len(m.StateTransitionVkey)
+		copy(dAtA[i:], m.StateTransitionVkey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StateTransitionVkey)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.StateTransitionVkey)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Groth16Vkey) > 0 {
+		i -= len(m.Groth16Vkey)
+		copy(dAtA[i:], m.Groth16Vkey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Groth16Vkey)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.StateMembershipVkey)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *EventUpdateInterchainSecurityModule) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Id.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = len(m.State)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventUpdateIsmVerifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventSubmitMessages) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventUpdateIsmVerifier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateIsmVerifier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Id.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = len(m.StateRoot)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.ProofSystem != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProofSystem))
+		i--
+		dAtA[i] = 0x28
 	}
-	if len(m.Messages) > 0 {
-		for _, s := range m.Messages {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
+	if len(m.StateMembershipVkey) > 0 {
+		i -= len(m.StateMembershipVkey)
+		copy(dAtA[i:], m.StateMembershipVkey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StateMembershipVkey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StateTransitionVkey) > 0 {
+		i -= len(m.StateTransitionVkey)
+		copy(dAtA[i:], m.StateTransitionVkey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StateTransitionVkey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Groth16Vkey) > 0 {
+		i -= len(m.Groth16Vkey)
+		copy(dAtA[i:], m.Groth16Vkey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Groth16Vkey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTransferIsmOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTransferIsmOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTransferIsmOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PreviousOwner) > 0 {
+		i -= len(m.PreviousOwner)
+		copy(dAtA[i:], m.PreviousOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateIsmMessageTtl) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateIsmMessageTtl) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateIsmMessageTtl) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MessageTtl != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MessageTtl))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MessageIdCost != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MessageIdCost))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PublicValuesCostPerByte != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PublicValuesCostPerByte))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PlonkVerifyCost != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PlonkVerifyCost))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Groth16VerifyCost != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Groth16VerifyCost))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MessageTtl != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MessageTtl))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventCreateInterchainSecurityModule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MerkleTreeAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Groth16Vkey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.StateTransitionVkey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.StateMembershipVkey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ProofSystem != 0 {
+		n += 1 + sovEvents(uint64(m.ProofSystem))
+	}
+	return n
+}
+
+func (m *EventUpdateInterchainSecurityModule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSubmitMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, s := range m.Messages {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventScheduleIsmVerifierUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Groth16Vkey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.StateTransitionVkey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.StateMembershipVkey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ActivationTime)
+	n += 1 + l + sovEvents(uint64(l))
+	if m.ProofSystem != 0 {
+		n += 1 + sovEvents(uint64(m.ProofSystem))
+	}
+	return n
+}
+
+func (m *EventUpdateIsmVerifier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Groth16Vkey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.StateTransitionVkey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.StateMembershipVkey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ProofSystem != 0 {
+		n += 1 + sovEvents(uint64(m.ProofSystem))
+	}
+	return n
+}
+
+func (m *EventTransferIsmOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.PreviousOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUpdateIsmMessageTtl) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.MessageTtl != 0 {
+		n += 1 + sovEvents(uint64(m.MessageTtl))
+	}
+	return n
+}
+
+func (m *EventUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MessageTtl != 0 {
+		n += 1 + sovEvents(uint64(m.MessageTtl))
+	}
+	if m.Groth16VerifyCost != 0 {
+		n += 1 + sovEvents(uint64(m.Groth16VerifyCost))
+	}
+	if m.PlonkVerifyCost != 0 {
+		n += 1 + sovEvents(uint64(m.PlonkVerifyCost))
+	}
+	if m.PublicValuesCostPerByte != 0 {
+		n += 1 + sovEvents(uint64(m.PublicValuesCostPerByte))
+	}
+	if m.MessageIdCost != 0 {
+		n += 1 + sovEvents(uint64(m.MessageIdCost))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventCreateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateInterchainSecurityModule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateInterchainSecurityModule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleTreeAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleTreeAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groth16Vkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groth16Vkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateTransitionVkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateTransitionVkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateMembershipVkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateMembershipVkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofSystem", wireType)
+			}
+			m.ProofSystem = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofSystem |= ProofSystem(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateInterchainSecurityModule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateInterchainSecurityModule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSubmitMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSubmitMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSubmitMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScheduleIsmVerifierUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScheduleIsmVerifierUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScheduleIsmVerifierUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groth16Vkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groth16Vkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateTransitionVkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateTransitionVkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateMembershipVkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateMembershipVkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ActivationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofSystem", wireType)
+			}
+			m.ProofSystem = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofSystem |= ProofSystem(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventCreateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateIsmVerifier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -478,10 +1963,10 @@ func (m *EventCreateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreateInterchainSecurityModule: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateIsmVerifier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreateInterchainSecurityModule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateIsmVerifier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -520,7 +2005,7 @@ func (m *EventCreateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groth16Vkey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -548,11 +2033,11 @@ func (m *EventCreateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Groth16Vkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StateTransitionVkey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -580,11 +2065,11 @@ func (m *EventCreateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = string(dAtA[iNdEx:postIndex])
+			m.StateTransitionVkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MerkleTreeAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StateMembershipVkey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -612,11 +2097,80 @@ func (m *EventCreateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MerkleTreeAddress = string(dAtA[iNdEx:postIndex])
+			m.StateMembershipVkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofSystem", wireType)
+			}
+			m.ProofSystem = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofSystem |= ProofSystem(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTransferIsmOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTransferIsmOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTransferIsmOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groth16Vkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -644,11 +2198,13 @@ func (m *EventCreateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Groth16Vkey = string(dAtA[iNdEx:postIndex])
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StateTransitionVkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -676,11 +2232,11 @@ func (m *EventCreateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StateTransitionVkey = string(dAtA[iNdEx:postIndex])
+			m.PreviousOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StateMembershipVkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -708,7 +2264,7 @@ func (m *EventCreateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StateMembershipVkey = string(dAtA[iNdEx:postIndex])
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -731,7 +2287,7 @@ func (m *EventCreateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateIsmMessageTtl) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -754,10 +2310,10 @@ func (m *EventUpdateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateInterchainSecurityModule: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateIsmMessageTtl: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateInterchainSecurityModule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateIsmMessageTtl: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -795,10 +2351,10 @@ func (m *EventUpdateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageTtl", wireType)
 			}
-			var stringLen uint64
+			m.MessageTtl = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -808,24 +2364,11 @@ func (m *EventUpdateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MessageTtl |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.State = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -847,7 +2390,7 @@ func (m *EventUpdateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSubmitMessages) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -870,15 +2413,15 @@ func (m *EventSubmitMessages) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSubmitMessages: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSubmitMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -906,15 +2449,13 @@ func (m *EventSubmitMessages) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageTtl", wireType)
 			}
-			var stringLen uint64
+			m.MessageTtl = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -924,29 +2465,35 @@ func (m *EventSubmitMessages) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MessageTtl |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groth16VerifyCost", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Groth16VerifyCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Groth16VerifyCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.StateRoot = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlonkVerifyCost", wireType)
 			}
-			var stringLen uint64
+			m.PlonkVerifyCost = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -956,24 +2503,49 @@ func (m *EventSubmitMessages) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PlonkVerifyCost |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicValuesCostPerByte", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			m.PublicValuesCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PublicValuesCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageIdCost", wireType)
+			}
+			m.MessageIdCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessageIdCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Messages = append(m.Messages, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2,17 +2,22 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
-	"github.com/celestiaorg/celestia-app/v7/x/zkism/internal/groth16"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // DefaultGenesis returns the default module genesis.
 func DefaultGenesis() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
 }
 
 // Validate performs basic genesis state validation.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
 	isms := make(map[uint64]struct{}, len(gs.Isms))
 	for i := range gs.Isms {
 		ism := gs.Isms[i]
@@ -38,8 +43,8 @@ func (gs GenesisState) Validate() error {
 			return errorsmod.Wrapf(ErrInvalidMerkleTreeAddress, "ism %s merkle tree address must be 32 bytes", ism.Id.String())
 		}
 
-		if _, err := groth16.NewVerifyingKey(ism.Groth16Vkey); err != nil {
-			return errorsmod.Wrapf(ErrInvalidVerifyingKey, "ism %s invalid groth16 verifying key", ism.Id.String())
+		if _, err := NewVerifier(ism.ProofSystem, ism.Groth16Vkey); err != nil {
+			return errorsmod.Wrapf(err, "ism %s invalid %s verifying key", ism.Id.String(), ism.ProofSystem)
 		}
 
 		if len(ism.StateTransitionVkey) != 32 {
@@ -49,6 +54,12 @@ func (gs GenesisState) Validate() error {
 		if len(ism.StateMembershipVkey) != 32 {
 			return errorsmod.Wrapf(ErrInvalidVerifyingKey, "ism %s program verifying key commitment must be exactly 32 bytes", ism.Id.String())
 		}
+
+		if ism.PendingVerifierUpdate != nil {
+			if err := ism.PendingVerifierUpdate.Validate(); err != nil {
+				return errorsmod.Wrapf(err, "ism %s pending verifier update", ism.Id.String())
+			}
+		}
 	}
 
 	messages := make(map[uint64]struct{}, len(gs.Messages))
@@ -78,6 +89,22 @@ func (gs GenesisState) Validate() error {
 				return errorsmod.Wrapf(sdkerrors.ErrAppConfig, "invalid message id length for ism %s: expected 32 bytes, got %d", msgs.Id.String(), len(msgID))
 			}
 		}
+
+		authorized := make(map[string]struct{}, len(msgs.Messages))
+		for _, msg := range msgs.Messages {
+			authorized[msg] = struct{}{}
+		}
+
+		for _, entry := range msgs.Authorizations {
+			if _, exists := authorized[entry.MessageId]; !exists {
+				return errorsmod.Wrapf(sdkerrors.ErrAppConfig, "authorization for unknown or duplicate message id %q of ism %s", entry.MessageId, msgs.Id.String())
+			}
+			delete(authorized, entry.MessageId)
+
+			if entry.Authorization.ExpiryHeight != 0 && entry.Authorization.ExpiryHeight <= entry.Authorization.AuthorizedHeight {
+				return errorsmod.Wrapf(sdkerrors.ErrAppConfig, "message id %q of ism %s expires before it is authorized", entry.MessageId, msgs.Id.String())
+			}
+		}
 	}
 
 	proofSubmitted := make(map[uint64]struct{}, len(gs.Submissions))
@@ -32,6 +32,8 @@ type GenesisState struct {
 	Messages []GenesisMessages `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages"`
 	// tracks whether a message proof has been submitted for the current state root.
 	Submissions []GenesisProofSubmission `protobuf:"bytes,3,rep,name=submissions,proto3" json:"submissions"`
+	// the module parameters.
+	Params Params `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -88,12 +90,22 @@ func (m *GenesisState) GetSubmissions() []GenesisProofSubmission {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 // GenesisMessages contains the authorized Hyperlane message IDs for a zk execution ism.
 type GenesisMessages struct {
 	// ism identifier
 	Id github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
 	// list of authorized message ids
 	Messages []string `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	// authorization and expiry heights of the authorized message ids. Message ids
+	// without an entry never expire.
+	Authorizations []GenesisMessageAuthorization `protobuf:"bytes,3,rep,name=authorizations,proto3" json:"authorizations"`
 }
 
 func (m *GenesisMessages) Reset()         { *m = GenesisMessages{} }
@@ -136,6 +148,68 @@ func (m *GenesisMessages) GetMessages() []string {
 	return nil
 }
 
+func (m *GenesisMessages) GetAuthorizations() []GenesisMessageAuthorization {
+	if m != nil {
+		return m.Authorizations
+	}
+	return nil
+}
+
+// GenesisMessageAuthorization contains the authorization of a message id.
+type GenesisMessageAuthorization struct {
+	// message identifier
+	MessageId string `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// the authorization of the message id
+	Authorization MessageAuthorization `protobuf:"bytes,2,opt,name=authorization,proto3" json:"authorization"`
+}
+
+func (m *GenesisMessageAuthorization) Reset()         { *m = GenesisMessageAuthorization{} }
+func (m *GenesisMessageAuthorization) String() string { return proto.CompactTextString(m) }
+func (*GenesisMessageAuthorization) ProtoMessage()    {}
+func (*GenesisMessageAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dc65e25b0c76bab, []int{2}
+}
+func (m *GenesisMessageAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisMessageAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisMessageAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisMessageAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisMessageAuthorization.Merge(m, src)
+}
+func (m *GenesisMessageAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisMessageAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisMessageAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisMessageAuthorization proto.InternalMessageInfo
+
+func (m *GenesisMessageAuthorization) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+func (m *GenesisMessageAuthorization) GetAuthorization() MessageAuthorization {
+	if m != nil {
+		return m.Authorization
+	}
+	return MessageAuthorization{}
+}
+
 // GenesisProofSubmission contains the proof submission state for a zk execution ism.
 type GenesisProofSubmission struct {
 	// ism identifier
@@ -148,7 +222,7 @@ func (m *GenesisProofSubmission) Reset()         { *m = GenesisProofSubmission{}
 func (m *GenesisProofSubmission) String() string { return proto.CompactTextString(m) }
 func (*GenesisProofSubmission) ProtoMessage()    {}
 func (*GenesisProofSubmission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dc65e25b0c76bab, []int{2}
+	return fileDescriptor_0dc65e25b0c76bab, []int{3}
 }
 func (m *GenesisProofSubmission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -187,38 +261,44 @@ func (m *GenesisProofSubmission) GetSubmitted() bool {
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "celestia.zkism.v1.GenesisState")
 	proto.RegisterType((*GenesisMessages)(nil), "celestia.zkism.v1.GenesisMessages")
+	proto.RegisterType((*GenesisMessageAuthorization)(nil), "celestia.zkism.v1.GenesisMessageAuthorization")
 	proto.RegisterType((*GenesisProofSubmission)(nil), "celestia.zkism.v1.GenesisProofSubmission")
 }
 
 func init() { proto.RegisterFile("celestia/zkism/v1/genesis.proto", fileDescriptor_0dc65e25b0c76bab) }
 
 var fileDescriptor_0dc65e25b0c76bab = []byte{
-	// 390 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0xcf, 0x8b, 0xda, 0x40,
-	0x14, 0xc7, 0x33, 0x51, 0x8a, 0x8e, 0x85, 0xd2, 0x50, 0x4a, 0x90, 0x36, 0x4a, 0x4e, 0x96, 0x62,
-	0x06, 0xdb, 0x63, 0x4f, 0xb5, 0x2d, 0x6d, 0x29, 0x42, 0x6b, 0x6e, 0xbd, 0xe5, 0xc7, 0x6b, 0x1c,
-	0x9a, 0x64, 0x42, 0xde, 0x44, 0x74, 0x8f, 0x7b, 0xdd, 0xcb, 0xfe, 0x59, 0x1e, 0x3d, 0x2e, 0x7b,
-	0x90, 0x45, 0xef, 0xfb, 0x37, 0x2c, 0x8e, 0xf1, 0x07, 0xea, 0x1e, 0xf7, 0xf6, 0x18, 0xde, 0xe7,
-	0xf3, 0x7d, 0xf3, 0x78, 0xb4, 0x15, 0x40, 0x0c, 0x28, 0xb9, 0xc7, 0x2e, 0xfe, 0x73, 0x4c, 0xd8,
-	0xb8, 0xc7, 0x22, 0x48, 0x01, 0x39, 0x3a, 0x59, 0x2e, 0xa4, 0x30, 0x5e, 0x6e, 0x1b, 0x1c, 0xd5,
-	0xe0, 0x8c, 0x7b, 0xcd, 0xb7, 0xa7, 0x8c, 0x9c, 0x66, 0x50, 0x12, 0xcd, 0x57, 0x91, 0x88, 0x84,
-	0x2a, 0xd9, 0xba, 0xda, 0xbc, 0xda, 0xf7, 0x84, 0x3e, 0xff, 0xbe, 0x31, 0xbb, 0xd2, 0x93, 0x60,
-	0x7c, 0xa3, 0x55, 0x8e, 0x09, 0x9a, 0xa4, 0x5d, 0xe9, 0x34, 0x3e, 0xbc, 0x77, 0x4e, 0x72, 0x9c,
-	0x9f, 0xa9, 0x84, 0x3c, 0x18, 0x79, 0x3c, 0x75, 0x21, 0x28, 0x72, 0x2e, 0xa7, 0x03, 0x11, 0x16,
-	0x31, 0xf4, 0xab, 0xb3, 0x45, 0x4b, 0x1b, 0x2a, 0xdc, 0xf8, 0x4a, 0x6b, 0x09, 0x20, 0x7a, 0x11,
-	0xa0, 0xa9, 0x2b, 0x95, 0x7d, 0x46, 0x55, 0x26, 0x0f, 0xca, 0xce, 0xd2, 0xb0, 0x23, 0x8d, 0x3f,
-	0xb4, 0x81, 0x85, 0x9f, 0x70, 0x44, 0x2e, 0x52, 0x34, 0x2b, 0x4a, 0xf4, 0xee, 0x71, 0xd1, 0xef,
-	0x5c, 0x88, 0x7f, 0xee, 0x8e, 0x28, 0x7d, 0x87, 0x0e, 0xfb, 0x92, 0xd0, 0x17, 0x47, 0xb1, 0x86,
-	0x4b, 0x75, 0x1e, 0x9a, 0xa4, 0x4d, 0x3a, 0xf5, 0xfe, 0x97, 0x35, 0x72, 0xbb, 0x68, 0x7d, 0x8a,
-	0xb8, 0x1c, 0x15, 0xbe, 0x13, 0x88, 0x84, 0xf9, 0x41, 0xd6, 0xe5, 0x69, 0x2a, 0xc6, 0x9e, 0x5c,
-	0x3b, 0xd8, 0x68, 0x9a, 0x41, 0x1e, 0x7b, 0x29, 0x74, 0x03, 0x81, 0x89, 0x40, 0x56, 0x48, 0x1e,
-	0x3b, 0x3f, 0x60, 0xf2, 0x39, 0x0c, 0x73, 0x40, 0x1c, 0xea, 0x3c, 0x34, 0x9a, 0x47, 0x1b, 0xa8,
-	0xef, 0xff, 0x65, 0x5f, 0x11, 0xfa, 0xfa, 0xfc, 0xc8, 0x4f, 0x33, 0xcb, 0x1b, 0x5a, 0x57, 0x3b,
-	0x90, 0x12, 0x42, 0x53, 0x6f, 0x93, 0x4e, 0x6d, 0xb8, 0x7f, 0xe8, 0xff, 0x9a, 0x2d, 0x2d, 0x32,
-	0x5f, 0x5a, 0xe4, 0x6e, 0x69, 0x91, 0xeb, 0x95, 0xa5, 0xcd, 0x57, 0x96, 0x76, 0xb3, 0xb2, 0xb4,
-	0xbf, 0xbd, 0x83, 0xe0, 0xed, 0xd2, 0x45, 0x1e, 0xed, 0xea, 0xae, 0x97, 0x65, 0x6c, 0x52, 0xde,
-	0x9b, 0x3a, 0x36, 0xff, 0x99, 0xba, 0xab, 0x8f, 0x0f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x09, 0x22,
-	0xa8, 0xbd, 0xc2, 0x02, 0x00, 0x00,
+	// 474 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xb3, 0x6e, 0x54, 0x35, 0x1b, 0xfe, 0x88, 0x15, 0x42, 0x26, 0x50, 0x27, 0xf2, 0x85,
+	0x20, 0x14, 0xaf, 0x52, 0x0e, 0x1c, 0x38, 0x35, 0x80, 0xa0, 0x42, 0x95, 0x4a, 0x7c, 0x43, 0x48,
+	0x68, 0x63, 0x2f, 0xce, 0x8a, 0xd8, 0x6b, 0xed, 0xac, 0xa3, 0xa6, 0xaf, 0xc0, 0x05, 0x9e, 0x82,
+	0x57, 0xe9, 0xb1, 0x47, 0xc4, 0xa1, 0xaa, 0x92, 0x17, 0x41, 0xd9, 0x6e, 0x82, 0x9b, 0x86, 0x70,
+	0xea, 0x6d, 0x35, 0xfa, 0xbe, 0xdf, 0xcc, 0x7c, 0xf6, 0xe0, 0x66, 0xc4, 0x47, 0x1c, 0xb4, 0x60,
+	0xf4, 0xe4, 0xab, 0x80, 0x94, 0x8e, 0xbb, 0x34, 0xe1, 0x19, 0x07, 0x01, 0x41, 0xae, 0xa4, 0x96,
+	0xe4, 0xde, 0x42, 0x10, 0x18, 0x41, 0x30, 0xee, 0x36, 0x76, 0xaf, 0x7b, 0xf4, 0x24, 0xe7, 0xd6,
+	0xd1, 0xb8, 0x9f, 0xc8, 0x44, 0x9a, 0x27, 0x9d, 0xbf, 0x2e, 0xab, 0xfe, 0x4f, 0x07, 0xdf, 0x7a,
+	0x7b, 0x49, 0x0e, 0x35, 0xd3, 0x9c, 0xbc, 0xc1, 0x55, 0x01, 0x29, 0xb8, 0xa8, 0xb5, 0xd5, 0xae,
+	0xef, 0x3d, 0x0b, 0xae, 0xf5, 0x09, 0x0e, 0x32, 0xcd, 0x55, 0x34, 0x64, 0x22, 0x0b, 0x79, 0x54,
+	0x28, 0xa1, 0x27, 0x87, 0x32, 0x2e, 0x46, 0xbc, 0x57, 0x3d, 0x3d, 0x6f, 0x56, 0xfa, 0xc6, 0x4e,
+	0x5e, 0xe3, 0x9d, 0x94, 0x03, 0xb0, 0x84, 0x83, 0xeb, 0x18, 0x94, 0xbf, 0x06, 0x65, 0x3b, 0x1f,
+	0x5a, 0xa5, 0x25, 0x2c, 0x9d, 0xe4, 0x03, 0xae, 0x43, 0x31, 0x48, 0x05, 0x80, 0x90, 0x19, 0xb8,
+	0x5b, 0x06, 0xf4, 0xf4, 0xdf, 0xa0, 0x23, 0x25, 0xe5, 0x97, 0x70, 0xe9, 0xb0, 0xbc, 0x32, 0x83,
+	0xbc, 0xc0, 0xdb, 0x39, 0x53, 0x2c, 0x05, 0xb7, 0xda, 0x42, 0xed, 0xfa, 0xde, 0xc3, 0x35, 0xb4,
+	0x23, 0x23, 0xb0, 0x6e, 0x2b, 0xf7, 0x2f, 0x10, 0xbe, 0xbb, 0x32, 0x2f, 0x09, 0xb1, 0x23, 0x62,
+	0x17, 0xb5, 0x50, 0xbb, 0xd6, 0x7b, 0x35, 0x57, 0xff, 0x3e, 0x6f, 0xbe, 0x4c, 0x84, 0x1e, 0x16,
+	0x83, 0x20, 0x92, 0x29, 0x1d, 0x44, 0x79, 0x47, 0x64, 0x99, 0x1c, 0x33, 0x3d, 0x6f, 0x4e, 0x87,
+	0x93, 0x9c, 0xab, 0x11, 0xcb, 0x78, 0x27, 0x92, 0x90, 0x4a, 0xa0, 0x85, 0x16, 0xa3, 0xe0, 0x1d,
+	0x3f, 0xde, 0x8f, 0x63, 0xc5, 0x01, 0xfa, 0x8e, 0x88, 0x49, 0x63, 0x25, 0xba, 0x5a, 0x29, 0x90,
+	0x4f, 0xf8, 0x0e, 0x2b, 0xf4, 0x50, 0x2a, 0x71, 0xc2, 0x74, 0x29, 0x93, 0xe0, 0xbf, 0xe1, 0xee,
+	0x97, 0x6d, 0x76, 0xb5, 0x15, 0x96, 0xff, 0x03, 0xe1, 0x47, 0x1b, 0x5c, 0x64, 0x17, 0x63, 0x3b,
+	0xc9, 0xe7, 0xc5, 0xda, 0xfd, 0x9a, 0xad, 0x1c, 0xc4, 0x24, 0xc4, 0xb7, 0xaf, 0x00, 0x5d, 0xc7,
+	0x24, 0xfc, 0x64, 0xcd, 0x6c, 0x1b, 0x86, 0xba, 0xca, 0xf0, 0xbf, 0x21, 0xfc, 0x60, 0xfd, 0xd7,
+	0xbd, 0x99, 0xf4, 0x1f, 0xe3, 0x9a, 0xf9, 0x5d, 0xb4, 0xe6, 0xb1, 0x59, 0x60, 0xa7, 0xff, 0xb7,
+	0xd0, 0x7b, 0x7f, 0x3a, 0xf5, 0xd0, 0xd9, 0xd4, 0x43, 0x17, 0x53, 0x0f, 0x7d, 0x9f, 0x79, 0x95,
+	0xb3, 0x99, 0x57, 0xf9, 0x35, 0xf3, 0x2a, 0x1f, 0xbb, 0xa5, 0xc6, 0x8b, 0x7d, 0xa5, 0x4a, 0x96,
+	0xef, 0x0e, 0xcb, 0x73, 0x7a, 0x6c, 0x4f, 0xd3, 0xdc, 0xe5, 0x60, 0xdb, 0x9c, 0xe0, 0xf3, 0x3f,
+	0x03, 0x00, 0xf1, 0x74, 0xc4, 0xcd, 0xed, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -241,6 +321,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.Submissions) > 0 {
 		for iNdEx := len(m.Submissions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -306,6 +396,20 @@ func (m *GenesisMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Authorizations) > 0 {
+		for iNdEx := len(m.Authorizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Messages) > 0 {
 		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Messages[iNdEx])
@@ -328,6 +432,46 @@ func (m *GenesisMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GenesisMessageAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisMessageAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisMessageAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Authorization.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MessageId) > 0 {
+		i -= len(m.MessageId)
+		copy(dAtA[i:], m.MessageId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MessageId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisProofSubmission) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -406,6 +550,8 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -423,6 +569,27 @@ func (m *GenesisMessages) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Authorizations) > 0 {
+		for _, e := range m.Authorizations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisMessageAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MessageId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Authorization.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -577,6 +744,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -693,6 +893,155 @@ func (m *GenesisMessages) Unmarshal(dAtA []byte) error {
 			}
 			m.Messages = append(m.Messages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authorizations = append(m.Authorizations, GenesisMessageAuthorization{})
+			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisMessageAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisMessageAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisMessageAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -23,6 +23,13 @@ func TestGenesisStateValidate(t *testing.T) {
 			malleate: func(gs *types.GenesisState) {},
 			expErr:   nil,
 		},
+		{
+			name: "invalid params",
+			malleate: func(gs *types.GenesisState) {
+				gs.Params.Groth16VerifyCost = 0
+			},
+			expErr: types.ErrInvalidParams,
+		},
 		{
 			name: "zero ism identifier",
 			malleate: func(gs *types.GenesisState) {
@@ -114,6 +121,27 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			expErr: sdkerrors.ErrAppConfig,
 		},
+		{
+			name: "authorization for unknown message id",
+			malleate: func(gs *types.GenesisState) {
+				gs.Messages[0].Authorizations[0].MessageId = types.EncodeHex(bytes.Repeat([]byte{0x06}, 32))
+			},
+			expErr: sdkerrors.ErrAppConfig,
+		},
+		{
+			name: "duplicate authorization",
+			malleate: func(gs *types.GenesisState) {
+				gs.Messages[0].Authorizations = append(gs.Messages[0].Authorizations, gs.Messages[0].Authorizations[0])
+			},
+			expErr: sdkerrors.ErrAppConfig,
+		},
+		{
+			name: "authorization expires before it is authorized",
+			malleate: func(gs *types.GenesisState) {
+				gs.Messages[0].Authorizations[0].Authorization.ExpiryHeight = 10
+			},
+			expErr: sdkerrors.ErrAppConfig,
+		},
 		{
 			name: "message proof submitted zero identifier",
 			malleate: func(gs *types.GenesisState) {
@@ -171,6 +199,12 @@ func newValidGenesisState(groth16Vk []byte) types.GenesisState {
 			{
 				Id:       ismID,
 				Messages: []string{types.EncodeHex(bytes.Repeat([]byte{0x05}, 32))},
+				Authorizations: []types.GenesisMessageAuthorization{
+					{
+						MessageId:     types.EncodeHex(bytes.Repeat([]byte{0x05}, 32)),
+						Authorization: types.MessageAuthorization{AuthorizedHeight: 10, ExpiryHeight: 20},
+					},
+				},
 			},
 		},
 		Submissions: []types.GenesisProofSubmission{
@@ -179,5 +213,6 @@ func newValidGenesisState(groth16Vk []byte) types.GenesisState {
 				Submitted: true,
 			},
 		},
+		Params: types.DefaultParams(),
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/bcp-innovations/hyperlane-cosmos/util"
 	ismtypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/01_interchain_security/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -42,3 +43,26 @@ func (ism *InterchainSecurityModule) ModuleType() uint8 {
 func (ism *InterchainSecurityModule) Verify(ctx context.Context, metadata []byte, message util.HyperlaneMessage) (bool, error) {
 	return false, sdkerrors.ErrNotSupported
 }
+
+// ValidateVerifierKeys returns an error if the proof system is not supported, if the verifying key cannot be parsed
+// for the proof system or if either program verifying key commitment is not 32 bytes.
+func ValidateVerifierKeys(proofSystem ProofSystem, vkey, stateTransitionVkey, stateMembershipVkey []byte) error {
+	if _, err := NewVerifier(proofSystem, vkey); err != nil {
+		return errorsmod.Wrapf(err, "invalid %s verifying key", proofSystem)
+	}
+
+	if len(stateTransitionVkey) != 32 {
+		return errorsmod.Wrap(ErrInvalidVerifyingKey, "program verifying key commitment must be exactly 32 bytes")
+	}
+
+	if len(stateMembershipVkey) != 32 {
+		return errorsmod.Wrap(ErrInvalidVerifyingKey, "program verifying key commitment must be exactly 32 bytes")
+	}
+
+	return nil
+}
+
+// Validate performs basic validation of a pending verifier key update.
+func (u *PendingVerifierUpdate) Validate() error {
+	return ValidateVerifierKeys(u.ProofSystem, u.Groth16Vkey, u.StateTransitionVkey, u.StateMembershipVkey)
+}
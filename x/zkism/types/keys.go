@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strings"
+	"time"
 
 	"cosmossdk.io/collections"
 )
@@ -37,16 +38,31 @@ const (
 	// Calculated as: 32 (StateRoot) + 32 (MerkleTreeAddress) + 8 (count) + MaxMessageIdsCount * 32 (MessageIds)
 	MaxStateMembershipValuesBytes = 32 + 32 + 8 + (MaxMessageIdsCount * 32)
 
-	// DefaultProofVerifyCostGroth16 is the default gas cost metered for verifying a groth16 proof.
-	// NOTE: This is informed by benchmark comparisons with Secp256k1 signature verification.
-	// See internal/groth16/bench_test.go
-	DefaultProofVerifyCostGroth16 = 6000
+	// MinProofBytes is the minimum size of an encoded proof accepted by any of the supported proof systems.
+	// A compressed gnark Groth16 proof without commitments is 128 bytes.
+	MinProofBytes = 128
+
+	// MaxProofBytes is the maximum size of an encoded proof accepted by any of the supported proof systems.
+	MaxProofBytes = 4096
+
+	// MaxBatchProofs is the maximum number of proofs which can be submitted in a single MsgSubmitProofs.
+	MaxBatchProofs = 16
+
+	// MaxPrunedMessagesPerBlock is the maximum number of expired message IDs pruned in a single block.
+	MaxPrunedMessagesPerBlock = 1000
+
+	// MaxVerifierUpdateDelay is the maximum delay an owner can set before a verifier key update is applied.
+	MaxVerifierUpdateDelay = 30 * 24 * time.Hour
 )
 
 var (
 	IsmsKeyPrefix               = collections.NewPrefix(0)
 	MessageKeyPrefix            = collections.NewPrefix(1)
 	MessageProofSubmittedPrefix = collections.NewPrefix(2)
+	VerifierUpdateQueuePrefix   = collections.NewPrefix(3)
+	MessageAuthorizationPrefix  = collections.NewPrefix(4)
+	MessageExpiryQueuePrefix    = collections.NewPrefix(5)
+	ParamsKey                   = collections.NewPrefix(6)
 )
 
 // EncodeHex is a convenience function to encode byte slices as 0x prefixed hexadecimal strings.
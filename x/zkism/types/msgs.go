@@ -2,7 +2,6 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
-	"github.com/celestiaorg/celestia-app/v7/x/zkism/internal/groth16"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -11,6 +10,11 @@ var (
 	_ sdk.HasValidateBasic = (*MsgCreateInterchainSecurityModule)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateInterchainSecurityModule)(nil)
 	_ sdk.HasValidateBasic = (*MsgSubmitMessages)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateIsmVerifier)(nil)
+	_ sdk.HasValidateBasic = (*MsgTransferIsmOwnership)(nil)
+	_ sdk.HasValidateBasic = (*MsgSubmitProofs)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateIsmMessageTtl)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
 )
 
 // ValidateBasic implements stateless validation for the HasValidateBasic interface.
@@ -27,32 +31,37 @@ func (msg *MsgCreateInterchainSecurityModule) ValidateBasic() error {
 		return errorsmod.Wrap(ErrInvalidMerkleTreeAddress, "merkle tree address must be 32 bytes")
 	}
 
-	if _, err := groth16.NewVerifyingKey(msg.Groth16Vkey); err != nil {
-		return errorsmod.Wrapf(ErrInvalidVerifyingKey, "invalid groth16 verifying key")
+	return ValidateVerifierKeys(msg.ProofSystem, msg.Groth16Vkey, msg.StateTransitionVkey, msg.StateMembershipVkey)
+}
+
+// ValidateBasic implements stateless validation for the HasValidateBasic interface.
+func (msg *MsgUpdateInterchainSecurityModule) ValidateBasic() error {
+	if msg.Id.IsZeroAddress() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "ism identifier must be non-zero")
 	}
 
-	if len(msg.StateTransitionVkey) != 32 {
-		return errorsmod.Wrap(ErrInvalidVerifyingKey, "program verifying key commitment must be exactly 32 bytes")
+	if len(msg.Proof) < MinProofBytes || len(msg.Proof) > MaxProofBytes {
+		return errorsmod.Wrapf(ErrInvalidProofLength, "expected between %d and %d, got %d", MinProofBytes, MaxProofBytes, len(msg.Proof))
 	}
 
-	if len(msg.StateMembershipVkey) != 32 {
-		return errorsmod.Wrap(ErrInvalidVerifyingKey, "program verifying key commitment must be exactly 32 bytes")
+	if len(msg.PublicValues) > MaxStateTransitionValuesBytes {
+		return errorsmod.Wrapf(ErrInvalidPublicValuesLength, "public values must not exceed %d bytes", MaxStateMembershipValuesBytes)
 	}
 
 	return nil
 }
 
 // ValidateBasic implements stateless validation for the HasValidateBasic interface.
-func (msg *MsgUpdateInterchainSecurityModule) ValidateBasic() error {
+func (msg *MsgSubmitMessages) ValidateBasic() error {
 	if msg.Id.IsZeroAddress() {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "ism identifier must be non-zero")
 	}
 
-	if len(msg.Proof) != (PrefixLen + ProofSize) {
-		return errorsmod.Wrapf(ErrInvalidProofLength, "expected %d, got %d", (PrefixLen + ProofSize), len(msg.Proof))
+	if len(msg.Proof) < MinProofBytes || len(msg.Proof) > MaxProofBytes {
+		return errorsmod.Wrapf(ErrInvalidProofLength, "expected between %d and %d, got %d", MinProofBytes, MaxProofBytes, len(msg.Proof))
 	}
 
-	if len(msg.PublicValues) > MaxStateTransitionValuesBytes {
+	if len(msg.PublicValues) > MaxStateMembershipValuesBytes {
 		return errorsmod.Wrapf(ErrInvalidPublicValuesLength, "public values must not exceed %d bytes", MaxStateMembershipValuesBytes)
 	}
 
@@ -60,18 +69,93 @@ func (msg *MsgUpdateInterchainSecurityModule) ValidateBasic() error {
 }
 
 // ValidateBasic implements stateless validation for the HasValidateBasic interface.
-func (msg *MsgSubmitMessages) ValidateBasic() error {
+func (msg *MsgSubmitProofs) ValidateBasic() error {
 	if msg.Id.IsZeroAddress() {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "ism identifier must be non-zero")
 	}
 
-	if len(msg.Proof) != (PrefixLen + ProofSize) {
-		return errorsmod.Wrapf(ErrInvalidProofLength, "expected %d, got %d", (PrefixLen + ProofSize), len(msg.Proof))
+	if len(msg.Proofs) == 0 || len(msg.Proofs) > MaxBatchProofs {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "number of proofs must be between 1 and %d, got %d", MaxBatchProofs, len(msg.Proofs))
 	}
 
-	if len(msg.PublicValues) > MaxStateMembershipValuesBytes {
-		return errorsmod.Wrapf(ErrInvalidPublicValuesLength, "public values must not exceed %d bytes", MaxStateMembershipValuesBytes)
+	for i, proof := range msg.Proofs {
+		if len(proof.Proof) < MinProofBytes || len(proof.Proof) > MaxProofBytes {
+			return errorsmod.Wrapf(ErrInvalidProofLength, "proof %d: expected between %d and %d, got %d", i, MinProofBytes, MaxProofBytes, len(proof.Proof))
+		}
+
+		switch proof.Type {
+		case ProofType_PROOF_TYPE_STATE_TRANSITION:
+			if len(proof.PublicValues) > MaxStateTransitionValuesBytes {
+				return errorsmod.Wrapf(ErrInvalidPublicValuesLength, "proof %d: public values must not exceed %d bytes", i, MaxStateTransitionValuesBytes)
+			}
+		case ProofType_PROOF_TYPE_STATE_MEMBERSHIP:
+			if len(proof.PublicValues) > MaxStateMembershipValuesBytes {
+				return errorsmod.Wrapf(ErrInvalidPublicValuesLength, "proof %d: public values must not exceed %d bytes", i, MaxStateMembershipValuesBytes)
+			}
+		default:
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "proof %d: unsupported proof type %s", i, proof.Type)
+		}
 	}
 
 	return nil
 }
+
+// ValidateBasic implements stateless validation for the HasValidateBasic interface.
+func (msg *MsgUpdateIsmVerifier) ValidateBasic() error {
+	if msg.Id.IsZeroAddress() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "ism identifier must be non-zero")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+
+	if msg.Delay < 0 || msg.Delay > MaxVerifierUpdateDelay {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "delay must be between 0 and %s", MaxVerifierUpdateDelay)
+	}
+
+	return ValidateVerifierKeys(msg.ProofSystem, msg.Groth16Vkey, msg.StateTransitionVkey, msg.StateMembershipVkey)
+}
+
+// ValidateBasic implements stateless validation for the HasValidateBasic interface.
+func (msg *MsgTransferIsmOwnership) ValidateBasic() error {
+	if msg.Id.IsZeroAddress() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "ism identifier must be non-zero")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.NewOwner); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new owner address: %s", err)
+	}
+
+	if msg.Owner == msg.NewOwner {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "new owner must differ from the current owner")
+	}
+
+	return nil
+}
+
+// ValidateBasic implements stateless validation for the HasValidateBasic interface.
+func (msg *MsgUpdateIsmMessageTtl) ValidateBasic() error {
+	if msg.Id.IsZeroAddress() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "ism identifier must be non-zero")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+
+	return nil
+}
+
+// ValidateBasic implements stateless validation for the HasValidateBasic interface.
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	return msg.Params.Validate()
+}
@@ -3,9 +3,11 @@ package types_test
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/bcp-innovations/hyperlane-cosmos/util"
 	"github.com/celestiaorg/celestia-app/v7/x/zkism/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 )
@@ -53,6 +55,20 @@ func TestMsgCreateInterchainSecurityModuleValidateBasic(t *testing.T) {
 			},
 			expErr: types.ErrInvalidVerifyingKey,
 		},
+		{
+			name: "unsupported proof system",
+			mallate: func() {
+				msg.ProofSystem = types.ProofSystem(99)
+			},
+			expErr: types.ErrUnsupportedProofSystem,
+		},
+		{
+			name: "sp1 plonk proof system is not accepted",
+			mallate: func() {
+				msg.ProofSystem = types.ProofSystem_PROOF_SYSTEM_SP1_PLONK
+			},
+			expErr: types.ErrUnsupportedProofSystem,
+		},
 		{
 			name: "invalid state transition verifying key length",
 			mallate: func() {
@@ -205,3 +221,311 @@ func TestMsgSubmitMessagesValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgUpdateIsmVerifierValidateBasic(t *testing.T) {
+	var msg *types.MsgUpdateIsmVerifier
+
+	groth16Vk := readGroth16Vkey(t)
+
+	tests := []struct {
+		name    string
+		mallate func()
+		expErr  error
+	}{
+		{
+			name:    "success",
+			mallate: func() {},
+			expErr:  nil,
+		},
+		{
+			name: "invalid ism identifier",
+			mallate: func() {
+				msg.Id = util.HexAddress{}
+			},
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid owner address",
+			mallate: func() {
+				msg.Owner = "invalid"
+			},
+			expErr: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "negative delay",
+			mallate: func() {
+				msg.Delay = -time.Second
+			},
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "delay too large",
+			mallate: func() {
+				msg.Delay = types.MaxVerifierUpdateDelay + time.Second
+			},
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid groth16 verifying key",
+			mallate: func() {
+				msg.Groth16Vkey = []byte{0x01}
+			},
+			expErr: types.ErrInvalidVerifyingKey,
+		},
+		{
+			name: "unsupported proof system",
+			mallate: func() {
+				msg.ProofSystem = types.ProofSystem(99)
+			},
+			expErr: types.ErrUnsupportedProofSystem,
+		},
+		{
+			name: "sp1 plonk proof system is not accepted",
+			mallate: func() {
+				msg.ProofSystem = types.ProofSystem_PROOF_SYSTEM_SP1_PLONK
+			},
+			expErr: types.ErrUnsupportedProofSystem,
+		},
+		{
+			name: "invalid state membership verifying key length",
+			mallate: func() {
+				msg.StateMembershipVkey = []byte{0x01}
+			},
+			expErr: types.ErrInvalidVerifyingKey,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg = &types.MsgUpdateIsmVerifier{
+				Id:                  util.CreateMockHexAddress("ism", 1),
+				Owner:               sdk.AccAddress(bytes.Repeat([]byte{0x01}, 20)).String(),
+				Groth16Vkey:         groth16Vk,
+				StateTransitionVkey: bytes.Repeat([]byte{0x01}, 32),
+				StateMembershipVkey: bytes.Repeat([]byte{0x01}, 32),
+				Delay:               time.Hour,
+			}
+
+			tc.mallate()
+
+			err := msg.ValidateBasic()
+			if tc.expErr != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgTransferIsmOwnershipValidateBasic(t *testing.T) {
+	var msg *types.MsgTransferIsmOwnership
+
+	tests := []struct {
+		name    string
+		mallate func()
+		expErr  error
+	}{
+		{
+			name:    "success",
+			mallate: func() {},
+			expErr:  nil,
+		},
+		{
+			name: "invalid ism identifier",
+			mallate: func() {
+				msg.Id = util.HexAddress{}
+			},
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid new owner address",
+			mallate: func() {
+				msg.NewOwner = "invalid"
+			},
+			expErr: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "new owner equals owner",
+			mallate: func() {
+				msg.NewOwner = msg.Owner
+			},
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg = &types.MsgTransferIsmOwnership{
+				Id:       util.CreateMockHexAddress("ism", 1),
+				Owner:    sdk.AccAddress(bytes.Repeat([]byte{0x01}, 20)).String(),
+				NewOwner: sdk.AccAddress(bytes.Repeat([]byte{0x02}, 20)).String(),
+			}
+
+			tc.mallate()
+
+			err := msg.ValidateBasic()
+			if tc.expErr != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgUpdateIsmMessageTtlValidateBasic(t *testing.T) {
+	var msg *types.MsgUpdateIsmMessageTtl
+
+	tests := []struct {
+		name    string
+		mallate func()
+		expErr  error
+	}{
+		{
+			name:    "success",
+			mallate: func() {},
+			expErr:  nil,
+		},
+		{
+			name: "success, zero ttl",
+			mallate: func() {
+				msg.MessageTtl = 0
+			},
+			expErr: nil,
+		},
+		{
+			name: "invalid ism identifier",
+			mallate: func() {
+				msg.Id = util.HexAddress{}
+			},
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid owner address",
+			mallate: func() {
+				msg.Owner = "invalid"
+			},
+			expErr: sdkerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg = &types.MsgUpdateIsmMessageTtl{
+				Id:         util.CreateMockHexAddress("ism", 1),
+				Owner:      sdk.AccAddress(bytes.Repeat([]byte{0x01}, 20)).String(),
+				MessageTtl: 100,
+			}
+
+			tc.mallate()
+
+			err := msg.ValidateBasic()
+			if tc.expErr != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgUpdateParamsValidateBasic(t *testing.T) {
+	msg := &types.MsgUpdateParams{
+		Authority: sdk.AccAddress(bytes.Repeat([]byte{0x01}, 20)).String(),
+		Params:    types.DefaultParams(),
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.Params.PlonkVerifyCost = 0
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidParams)
+
+	msg.Authority = "invalid"
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+}
+
+func TestMsgSubmitProofsValidateBasic(t *testing.T) {
+	var msg *types.MsgSubmitProofs
+
+	tests := []struct {
+		name    string
+		mallate func()
+		expErr  error
+	}{
+		{
+			name:    "success",
+			mallate: func() {},
+			expErr:  nil,
+		},
+		{
+			name: "invalid ism identifier",
+			mallate: func() {
+				msg.Id = util.HexAddress{}
+			},
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "no proofs",
+			mallate: func() {
+				msg.Proofs = nil
+			},
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "too many proofs",
+			mallate: func() {
+				for len(msg.Proofs) <= types.MaxBatchProofs {
+					msg.Proofs = append(msg.Proofs, msg.Proofs[0])
+				}
+			},
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid proof length",
+			mallate: func() {
+				msg.Proofs[1].Proof = []byte{0x01}
+			},
+			expErr: types.ErrInvalidProofLength,
+		},
+		{
+			name: "state transition public values too large",
+			mallate: func() {
+				msg.Proofs[0].PublicValues = make([]byte, types.MaxStateTransitionValuesBytes+1)
+			},
+			expErr: types.ErrInvalidPublicValuesLength,
+		},
+		{
+			name: "unsupported proof type",
+			mallate: func() {
+				msg.Proofs[0].Type = types.ProofType_PROOF_TYPE_UNSPECIFIED
+			},
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg = &types.MsgSubmitProofs{
+				Id: util.CreateMockHexAddress("ism", 1),
+				Proofs: []types.Proof{
+					{Type: types.ProofType_PROOF_TYPE_STATE_TRANSITION, Proof: make([]byte, types.PrefixLen+types.ProofSize)},
+					{Type: types.ProofType_PROOF_TYPE_STATE_MEMBERSHIP, Proof: make([]byte, types.PrefixLen+types.ProofSize)},
+				},
+				Signer: sdk.AccAddress(bytes.Repeat([]byte{0x01}, 20)).String(),
+			}
+
+			tc.mallate()
+
+			err := msg.ValidateBasic()
+			if tc.expErr != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
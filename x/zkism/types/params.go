@@ -0,0 +1,77 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	// DefaultMessageTTL is the default number of blocks authorized message ids remain valid (~1 week at 6 second blocks).
+	DefaultMessageTTL uint64 = 100_800
+
+	// DefaultProofVerifyCostGroth16 is the default gas cost metered for verifying a groth16 proof.
+	// NOTE: This is derived from the ratio of verification times with Secp256k1 signature verification, which
+	// costs 1000 gas: BenchmarkGroth16VerifyProof takes ~1.5ms and BenchmarkSecp256k1VerifySignature ~0.22ms,
+	// i.e. 1.5 / 0.22 * 1000 ≈ 6800 gas.
+	// See internal/groth16/bench_test.go
+	DefaultProofVerifyCostGroth16 uint64 = 6800
+
+	// DefaultProofVerifyCostPlonk is the default gas cost metered for verifying a plonk proof. It is reserved for
+	// the SP1 PLONK proof system, which is not accepted yet.
+	// NOTE: This is derived the same way as DefaultProofVerifyCostGroth16: BenchmarkPlonkVerifyProof took ~2.4ms
+	// against ~0.22ms for BenchmarkSecp256k1VerifySignature, i.e. 2.4 / 0.22 * 1000 ≈ 11000 gas.
+	DefaultProofVerifyCostPlonk uint64 = 11000
+
+	// DefaultPublicValuesCostPerByte is the default gas cost metered per byte of public values of a verified proof.
+	// NOTE: Hashing public values into the public witness (BenchmarkHashBN254) costs far less than a gas unit per
+	// byte when compared with Secp256k1 signature verification, so the smallest non-zero cost is used.
+	// See internal/groth16/bench_test.go
+	DefaultPublicValuesCostPerByte uint64 = 1
+
+	// DefaultMessageIdCost is the default gas cost metered per message id authorized by a state membership proof.
+	// NOTE: This covers the three store deletes (1000 gas each) performed when the message id expires, as pruning
+	// in the EndBlocker is not gas metered.
+	DefaultMessageIdCost uint64 = 3000
+)
+
+// DefaultParams returns the default module parameters.
+func DefaultParams() Params {
+	return Params{
+		MessageTtl:              DefaultMessageTTL,
+		Groth16VerifyCost:       DefaultProofVerifyCostGroth16,
+		PlonkVerifyCost:         DefaultProofVerifyCostPlonk,
+		PublicValuesCostPerByte: DefaultPublicValuesCostPerByte,
+		MessageIdCost:           DefaultMessageIdCost,
+	}
+}
+
+// Validate performs basic validation of the module parameters.
+// The proof verification costs must be non-zero as verification would otherwise not be metered.
+func (p Params) Validate() error {
+	if p.Groth16VerifyCost == 0 {
+		return errorsmod.Wrap(ErrInvalidParams, "groth16 verify cost must be non-zero")
+	}
+
+	if p.PlonkVerifyCost == 0 {
+		return errorsmod.Wrap(ErrInvalidParams, "plonk verify cost must be non-zero")
+	}
+
+	return nil
+}
+
+// ProofVerifyCost returns the gas cost metered for verifying a single proof of the given proof system.
+func (p Params) ProofVerifyCost(proofSystem ProofSystem) uint64 {
+	if proofSystem == ProofSystem_PROOF_SYSTEM_SP1_PLONK {
+		return p.PlonkVerifyCost
+	}
+
+	return p.Groth16VerifyCost
+}
+
+// EffectiveMessageTTL returns the number of blocks message ids authorized for the ism remain valid given the module
+// parameters. Zero means they never expire.
+func (ism *InterchainSecurityModule) EffectiveMessageTTL(params Params) uint64 {
+	if ism.MessageTtl != 0 {
+		return ism.MessageTtl
+	}
+	return params.MessageTtl
+}
@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v7/x/zkism/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		malleate func(params *types.Params)
+		expErr   error
+	}{
+		{
+			name:     "default params",
+			malleate: func(params *types.Params) {},
+			expErr:   nil,
+		},
+		{
+			name: "zero public values and message id costs",
+			malleate: func(params *types.Params) {
+				params.PublicValuesCostPerByte = 0
+				params.MessageIdCost = 0
+			},
+			expErr: nil,
+		},
+		{
+			name: "zero groth16 verify cost",
+			malleate: func(params *types.Params) {
+				params.Groth16VerifyCost = 0
+			},
+			expErr: types.ErrInvalidParams,
+		},
+		{
+			name: "zero plonk verify cost",
+			malleate: func(params *types.Params) {
+				params.PlonkVerifyCost = 0
+			},
+			expErr: types.ErrInvalidParams,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.malleate(&params)
+
+			err := params.Validate()
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsProofVerifyCost(t *testing.T) {
+	params := types.DefaultParams()
+
+	require.Equal(t, params.Groth16VerifyCost, params.ProofVerifyCost(types.ProofSystem_PROOF_SYSTEM_SP1_GROTH16))
+	require.Equal(t, params.Groth16VerifyCost, params.ProofVerifyCost(types.ProofSystem_PROOF_SYSTEM_GROTH16))
+	require.Equal(t, params.PlonkVerifyCost, params.ProofVerifyCost(types.ProofSystem_PROOF_SYSTEM_SP1_PLONK))
+}
@@ -284,6 +284,9 @@ type QueryMessagesResponse struct {
 	Messages []string `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 	// pagination defines the pagination in the response.
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// authorized_messages contains the age and expiry of each message ID, in
+	// the same order as messages.
+	AuthorizedMessages []AuthorizedMessage `protobuf:"bytes,3,rep,name=authorized_messages,json=authorizedMessages,proto3" json:"authorized_messages"`
 }
 
 func (m *QueryMessagesResponse) Reset()         { *m = QueryMessagesResponse{} }
@@ -333,6 +336,169 @@ func (m *QueryMessagesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+func (m *QueryMessagesResponse) GetAuthorizedMessages() []AuthorizedMessage {
+	if m != nil {
+		return m.AuthorizedMessages
+	}
+	return nil
+}
+
+// AuthorizedMessage describes an authorized Hyperlane message ID.
+type AuthorizedMessage struct {
+	// id is the hex-encoded message ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// height at which the message ID was authorized. Zero if unknown.
+	AuthorizedHeight uint64 `protobuf:"varint,2,opt,name=authorized_height,json=authorizedHeight,proto3" json:"authorized_height,omitempty"`
+	// number of blocks since the message ID was authorized. Zero if unknown.
+	Age uint64 `protobuf:"varint,3,opt,name=age,proto3" json:"age,omitempty"`
+	// height from which the message ID is pruned. Zero if it never expires.
+	ExpiryHeight uint64 `protobuf:"varint,4,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *AuthorizedMessage) Reset()         { *m = AuthorizedMessage{} }
+func (m *AuthorizedMessage) String() string { return proto.CompactTextString(m) }
+func (*AuthorizedMessage) ProtoMessage()    {}
+func (*AuthorizedMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8d70e04d17b4c4f, []int{6}
+}
+func (m *AuthorizedMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthorizedMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthorizedMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthorizedMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorizedMessage.Merge(m, src)
+}
+func (m *AuthorizedMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthorizedMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorizedMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthorizedMessage proto.InternalMessageInfo
+
+func (m *AuthorizedMessage) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AuthorizedMessage) GetAuthorizedHeight() uint64 {
+	if m != nil {
+		return m.AuthorizedHeight
+	}
+	return 0
+}
+
+func (m *AuthorizedMessage) GetAge() uint64 {
+	if m != nil {
+		return m.Age
+	}
+	return 0
+}
+
+func (m *AuthorizedMessage) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+// QueryParamsRequest is the request type for the Params rpc method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8d70e04d17b4c4f, []int{7}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Params rpc method.
+type QueryParamsResponse struct {
+	// params defines the module parameters.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8d70e04d17b4c4f, []int{8}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*QueryIsmRequest)(nil), "celestia.zkism.v1.QueryIsmRequest")
 	proto.RegisterType((*QueryIsmResponse)(nil), "celestia.zkism.v1.QueryIsmResponse")
@@ -340,45 +506,57 @@ func init() {
 	proto.RegisterType((*QueryIsmsResponse)(nil), "celestia.zkism.v1.QueryIsmsResponse")
 	proto.RegisterType((*QueryMessagesRequest)(nil), "celestia.zkism.v1.QueryMessagesRequest")
 	proto.RegisterType((*QueryMessagesResponse)(nil), "celestia.zkism.v1.QueryMessagesResponse")
+	proto.RegisterType((*AuthorizedMessage)(nil), "celestia.zkism.v1.AuthorizedMessage")
+	proto.RegisterType((*QueryParamsRequest)(nil), "celestia.zkism.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "celestia.zkism.v1.QueryParamsResponse")
 }
 
 func init() { proto.RegisterFile("celestia/zkism/v1/query.proto", fileDescriptor_f8d70e04d17b4c4f) }
 
 var fileDescriptor_f8d70e04d17b4c4f = []byte{
-	// 519 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
-	0x1c, 0x6d, 0xd2, 0x82, 0x36, 0x23, 0x01, 0xb3, 0x86, 0x18, 0xd1, 0xc8, 0x4a, 0x98, 0x58, 0x05,
-	0xc2, 0x56, 0xc6, 0x37, 0x18, 0x02, 0x54, 0xa1, 0x49, 0x10, 0x0e, 0x48, 0xbb, 0xb9, 0xa9, 0x95,
-	0x59, 0x34, 0x71, 0x96, 0x9f, 0x53, 0x51, 0xfe, 0x5c, 0xb8, 0x70, 0x45, 0xe2, 0x23, 0xf0, 0x65,
-	0x76, 0x9c, 0xc4, 0x85, 0x13, 0x42, 0x2d, 0xdf, 0x82, 0x0b, 0x8a, 0xe3, 0xb4, 0x5b, 0xd7, 0x76,
-	0x52, 0xb5, 0x9b, 0x63, 0xff, 0x7e, 0xef, 0xbd, 0xdf, 0x7b, 0x8e, 0xd1, 0xdd, 0x90, 0xf7, 0x38,
-	0x28, 0xc1, 0xe8, 0x87, 0x77, 0x02, 0x62, 0xda, 0xf7, 0xe9, 0x51, 0xce, 0xb3, 0x01, 0x49, 0x33,
-	0xa9, 0x24, 0x5e, 0xab, 0x8e, 0x89, 0x3e, 0x26, 0x7d, 0xdf, 0x99, 0xd1, 0xa1, 0x06, 0x29, 0x87,
-	0xb2, 0xc3, 0x79, 0x18, 0x4a, 0x88, 0x25, 0xd0, 0x0e, 0x03, 0x5e, 0x42, 0xd1, 0xbe, 0xdf, 0xe1,
-	0x8a, 0xf9, 0x34, 0x65, 0x91, 0x48, 0x98, 0x12, 0x32, 0x31, 0xb5, 0x9b, 0x91, 0x94, 0x51, 0x8f,
-	0x53, 0x96, 0x0a, 0xca, 0x92, 0x44, 0x2a, 0x7d, 0x58, 0x21, 0xad, 0x47, 0x32, 0x92, 0x7a, 0x49,
-	0x8b, 0x55, 0xb9, 0xeb, 0xdd, 0x43, 0x37, 0x5e, 0x17, 0xa8, 0x6d, 0x88, 0x03, 0x7e, 0x94, 0x73,
-	0x50, 0xf8, 0x3a, 0xb2, 0x45, 0x77, 0xc3, 0x6a, 0x5a, 0xad, 0xd5, 0xc0, 0x16, 0x5d, 0xef, 0x2d,
-	0xba, 0x39, 0x29, 0x81, 0x54, 0x26, 0xc0, 0xf1, 0x53, 0x54, 0x17, 0x10, 0xeb, 0xa2, 0x6b, 0xbb,
-	0x8f, 0xc8, 0xb9, 0xb1, 0x48, 0x3b, 0x51, 0x3c, 0x0b, 0x0f, 0x99, 0x48, 0xde, 0xf0, 0x30, 0xcf,
-	0x84, 0x1a, 0xec, 0xcb, 0x6e, 0xde, 0xe3, 0x7b, 0x8d, 0xe3, 0xdf, 0x5b, 0xb5, 0xa0, 0xe8, 0xf6,
-	0x0e, 0x26, 0xc0, 0x50, 0x91, 0x3f, 0x47, 0x68, 0x32, 0x97, 0xc1, 0x7f, 0x40, 0x4a, 0x13, 0x48,
-	0x61, 0x02, 0x29, 0xfd, 0x34, 0x26, 0x90, 0x57, 0x2c, 0xe2, 0xa6, 0x37, 0x38, 0xd5, 0xe9, 0xfd,
-	0xb0, 0xd0, 0xda, 0x29, 0x70, 0x23, 0xfb, 0x19, 0x6a, 0x08, 0x88, 0x61, 0xc3, 0x6a, 0xd6, 0x97,
-	0xd3, 0xad, 0xdb, 0xf1, 0x8b, 0x33, 0x22, 0x6d, 0x2d, 0x72, 0xe7, 0x42, 0x91, 0xa5, 0x86, 0x33,
-	0x2a, 0x13, 0xb4, 0xae, 0x45, 0xee, 0x73, 0x00, 0x16, 0x71, 0x98, 0x13, 0xc1, 0x94, 0x2b, 0xf6,
-	0xd2, 0xae, 0x7c, 0x42, 0xb7, 0xa6, 0xf8, 0x8c, 0x31, 0x0e, 0x5a, 0x89, 0xcd, 0x9e, 0x36, 0x67,
-	0x35, 0x18, 0x7f, 0x5f, 0xda, 0xb4, 0xbb, 0xff, 0x6c, 0x74, 0x45, 0xd3, 0x63, 0x85, 0xea, 0x6d,
-	0x88, 0xb1, 0x37, 0x23, 0x80, 0xa9, 0xdb, 0xe8, 0xdc, 0x5f, 0x58, 0x53, 0xb2, 0x78, 0xdb, 0x5f,
-	0x7e, 0xfe, 0xfd, 0x6e, 0xbb, 0x78, 0x93, 0x9e, 0xff, 0x9b, 0x8a, 0xc4, 0xe8, 0x47, 0xd1, 0xfd,
-	0x8c, 0x33, 0xd4, 0x28, 0x6e, 0x03, 0x5e, 0x04, 0x59, 0x45, 0xe0, 0x6c, 0x2f, 0x2e, 0x32, 0xc4,
-	0x5b, 0x9a, 0xf8, 0x0e, 0xbe, 0x3d, 0x87, 0x18, 0x7f, 0xb5, 0xd0, 0x4a, 0xe5, 0x36, 0xde, 0x99,
-	0x87, 0x39, 0x95, 0xbf, 0xd3, 0xba, 0xb8, 0xd0, 0x08, 0x68, 0x69, 0x01, 0x1e, 0x6e, 0xce, 0x10,
-	0x50, 0x25, 0xa8, 0xa7, 0xdf, 0x7b, 0x79, 0x3c, 0x74, 0xad, 0x93, 0xa1, 0x6b, 0xfd, 0x19, 0xba,
-	0xd6, 0xb7, 0x91, 0x5b, 0x3b, 0x19, 0xb9, 0xb5, 0x5f, 0x23, 0xb7, 0x76, 0xe0, 0x47, 0x42, 0x1d,
-	0xe6, 0x1d, 0x12, 0xca, 0x78, 0x8c, 0x22, 0xb3, 0x68, 0xbc, 0x7e, 0xcc, 0xd2, 0x94, 0xbe, 0x37,
-	0xb8, 0xfa, 0x71, 0xea, 0x5c, 0xd5, 0xaf, 0xc7, 0x93, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x69,
-	0x43, 0x67, 0x9a, 0xf0, 0x04, 0x00, 0x00,
+	// 667 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x4f, 0x13, 0x4f,
+	0x14, 0xef, 0x76, 0xfb, 0x25, 0xf0, 0xbe, 0xfe, 0xa0, 0x03, 0x46, 0x58, 0x71, 0x81, 0x05, 0x81,
+	0x48, 0xdc, 0x49, 0xf1, 0xe0, 0x59, 0x8c, 0x28, 0x31, 0x18, 0xac, 0x07, 0x13, 0x3c, 0x98, 0x69,
+	0x3b, 0xd9, 0x4e, 0x64, 0x77, 0x96, 0x9d, 0x29, 0xa1, 0x18, 0x2f, 0x5e, 0xb8, 0x9a, 0xf8, 0x27,
+	0xf8, 0xcf, 0x70, 0x24, 0xf1, 0xa2, 0x17, 0x63, 0xc0, 0xff, 0xc1, 0xab, 0xe9, 0xcc, 0xec, 0x96,
+	0xb6, 0x5b, 0x48, 0x88, 0xb7, 0xe9, 0x7b, 0xef, 0xf3, 0x79, 0x9f, 0xf7, 0x79, 0x33, 0x5d, 0xb8,
+	0x5b, 0xa7, 0xbb, 0x54, 0x48, 0x46, 0xf0, 0xe1, 0x7b, 0x26, 0x42, 0xbc, 0x5f, 0xc1, 0x7b, 0x2d,
+	0x9a, 0xb4, 0xfd, 0x38, 0xe1, 0x92, 0xa3, 0x72, 0x9a, 0xf6, 0x55, 0xda, 0xdf, 0xaf, 0x38, 0x39,
+	0x08, 0xd9, 0x8e, 0xa9, 0xd0, 0x08, 0xe7, 0x7e, 0x9d, 0x8b, 0x90, 0x0b, 0x5c, 0x23, 0x82, 0x6a,
+	0x2a, 0xbc, 0x5f, 0xa9, 0x51, 0x49, 0x2a, 0x38, 0x26, 0x01, 0x8b, 0x88, 0x64, 0x3c, 0x32, 0xb5,
+	0x33, 0x01, 0xe7, 0xc1, 0x2e, 0xc5, 0x24, 0x66, 0x98, 0x44, 0x11, 0x97, 0x2a, 0x99, 0x32, 0x4d,
+	0x06, 0x3c, 0xe0, 0xea, 0x88, 0x3b, 0x27, 0x1d, 0xf5, 0xe6, 0xe1, 0xe6, 0xab, 0x0e, 0xeb, 0xa6,
+	0x08, 0xab, 0x74, 0xaf, 0x45, 0x85, 0x44, 0x37, 0xa0, 0xc8, 0x1a, 0x53, 0xd6, 0x9c, 0xb5, 0x32,
+	0x56, 0x2d, 0xb2, 0x86, 0xf7, 0x06, 0xc6, 0xbb, 0x25, 0x22, 0xe6, 0x91, 0xa0, 0xe8, 0x09, 0xd8,
+	0x4c, 0x84, 0xaa, 0xe8, 0xff, 0xb5, 0x55, 0x7f, 0x60, 0x2c, 0x7f, 0x33, 0x92, 0x34, 0xa9, 0x37,
+	0x09, 0x8b, 0x5e, 0xd3, 0x7a, 0x2b, 0x61, 0xb2, 0xbd, 0xc5, 0x1b, 0xad, 0x5d, 0xba, 0x5e, 0x3a,
+	0xfe, 0x39, 0x5b, 0xa8, 0x76, 0xd0, 0xde, 0x4e, 0x97, 0x58, 0xa4, 0xcd, 0x37, 0x00, 0xba, 0x73,
+	0x19, 0xfe, 0x25, 0x5f, 0x9b, 0xe0, 0x77, 0x4c, 0xf0, 0xb5, 0x9f, 0xc6, 0x04, 0x7f, 0x9b, 0x04,
+	0xd4, 0x60, 0xab, 0xe7, 0x90, 0xde, 0x57, 0x0b, 0xca, 0xe7, 0xc8, 0x8d, 0xec, 0xa7, 0x50, 0x62,
+	0x22, 0x14, 0x53, 0xd6, 0x9c, 0x7d, 0x35, 0xdd, 0x0a, 0x8e, 0x9e, 0xf5, 0x88, 0x2c, 0x2a, 0x91,
+	0xcb, 0x97, 0x8a, 0xd4, 0x1a, 0x7a, 0x54, 0x46, 0x30, 0xa9, 0x44, 0x6e, 0x51, 0x21, 0x48, 0x40,
+	0xc5, 0x90, 0x15, 0xa0, 0x8d, 0x9c, 0x86, 0x57, 0x71, 0xe5, 0x87, 0x05, 0xb7, 0xfa, 0x1a, 0x1a,
+	0x67, 0x1c, 0x18, 0x0d, 0x4d, 0x4c, 0xb9, 0x33, 0x56, 0xcd, 0x7e, 0xff, 0xb3, 0x71, 0xd1, 0x5b,
+	0x98, 0x20, 0x2d, 0xd9, 0xe4, 0x09, 0x3b, 0xa4, 0x8d, 0x77, 0x59, 0x3f, 0x5b, 0x6d, 0x63, 0x31,
+	0x67, 0x1b, 0x8f, 0xb3, 0x6a, 0x23, 0xd8, 0xac, 0x01, 0x91, 0xfe, 0x84, 0xf0, 0x8e, 0x2c, 0x28,
+	0x0f, 0xd4, 0x0f, 0x38, 0xb9, 0x0a, 0xe5, 0x73, 0x12, 0x9a, 0x94, 0x05, 0x4d, 0xa9, 0x46, 0x2a,
+	0x55, 0xc7, 0xbb, 0x89, 0xe7, 0x2a, 0x8e, 0xc6, 0xc1, 0x26, 0x01, 0x9d, 0xb2, 0x55, 0xba, 0x73,
+	0x44, 0x0b, 0x70, 0x9d, 0x1e, 0xc4, 0x2c, 0x69, 0xa7, 0xd0, 0x92, 0xca, 0x5d, 0xd3, 0x41, 0x0d,
+	0xf3, 0x26, 0x01, 0x29, 0x93, 0xb7, 0x49, 0x42, 0xb2, 0x9b, 0xed, 0xbd, 0x84, 0x89, 0x9e, 0xa8,
+	0x31, 0xfe, 0x11, 0x8c, 0xc4, 0x2a, 0x62, 0x2e, 0xfb, 0x74, 0x8e, 0x0d, 0x1a, 0x62, 0x66, 0x37,
+	0xe5, 0x6b, 0x7f, 0x6c, 0xf8, 0x4f, 0x11, 0x22, 0x09, 0xf6, 0xa6, 0x08, 0x91, 0x97, 0x83, 0xec,
+	0x7b, 0xdb, 0xce, 0xc2, 0x85, 0x35, 0x5a, 0x92, 0xb7, 0xf8, 0xe9, 0xdb, 0xef, 0x2f, 0x45, 0x17,
+	0xcd, 0xe0, 0xc1, 0xff, 0x26, 0x26, 0x42, 0x81, 0x3f, 0xb0, 0xc6, 0x47, 0x94, 0x40, 0xa9, 0xf3,
+	0xb6, 0xd0, 0x45, 0x94, 0xe9, 0xf0, 0xce, 0xe2, 0xc5, 0x45, 0xa6, 0xf1, 0xac, 0x6a, 0x3c, 0x8d,
+	0x6e, 0x0f, 0x69, 0x8c, 0x8e, 0x2c, 0x18, 0x4d, 0x17, 0x8e, 0x96, 0x87, 0x71, 0xf6, 0xbd, 0x26,
+	0x67, 0xe5, 0xf2, 0x42, 0x23, 0x60, 0x45, 0x09, 0xf0, 0xd0, 0x5c, 0x8e, 0x80, 0xf4, 0xba, 0xea,
+	0xe9, 0x0f, 0x61, 0x44, 0x6f, 0x05, 0xdd, 0x1b, 0xc6, 0xde, 0xb3, 0x7e, 0x67, 0xe9, 0xb2, 0x32,
+	0x23, 0x61, 0x5e, 0x49, 0xb8, 0x83, 0xa6, 0x73, 0x24, 0xe8, 0xcd, 0xaf, 0xbf, 0x38, 0x3e, 0x75,
+	0xad, 0x93, 0x53, 0xd7, 0xfa, 0x75, 0xea, 0x5a, 0x9f, 0xcf, 0xdc, 0xc2, 0xc9, 0x99, 0x5b, 0xf8,
+	0x7e, 0xe6, 0x16, 0x76, 0x2a, 0x01, 0x93, 0xcd, 0x56, 0xcd, 0xaf, 0xf3, 0x30, 0x83, 0xf3, 0x24,
+	0xc8, 0xce, 0x0f, 0x48, 0x1c, 0xe3, 0x03, 0x43, 0xa8, 0x3e, 0x33, 0xb5, 0x11, 0xf5, 0x1d, 0x78,
+	0xf8, 0x77, 0x00, 0x71, 0xa0, 0xe1, 0x78, 0xba, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -399,6 +577,8 @@ type QueryClient interface {
 	Isms(ctx context.Context, in *QueryIsmsRequest, opts ...grpc.CallOption) (*QueryIsmsResponse, error)
 	// Messages defines an rpc method for querying authorized Hyperlane message IDs.
 	Messages(ctx context.Context, in *QueryMessagesRequest, opts ...grpc.CallOption) (*QueryMessagesResponse, error)
+	// Params defines an rpc method for querying the module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -436,6 +616,15 @@ func (c *queryClient) Messages(ctx context.Context, in *QueryMessagesRequest, op
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.zkism.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Ism defines an rpc method for querying an ISM by ID.
@@ -444,6 +633,8 @@ type QueryServer interface {
 	Isms(context.Context, *QueryIsmsRequest) (*QueryIsmsResponse, error)
 	// Messages defines an rpc method for querying authorized Hyperlane message IDs.
 	Messages(context.Context, *QueryMessagesRequest) (*QueryMessagesResponse, error)
+	// Params defines an rpc method for querying the module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -459,6 +650,9 @@ func (*UnimplementedQueryServer) Isms(ctx context.Context, req *QueryIsmsRequest
 func (*UnimplementedQueryServer) Messages(ctx context.Context, req *QueryMessagesRequest) (*QueryMessagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Messages not implemented")
 }
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -518,6 +712,24 @@ func _Query_Messages_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.zkism.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.zkism.v1.Query",
@@ -535,6 +747,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Messages",
 			Handler:    _Query_Messages_Handler,
 		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/zkism/v1/query.proto",
@@ -749,6 +965,20 @@ func (m *QueryMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AuthorizedMessages) > 0 {
+		for iNdEx := len(m.AuthorizedMessages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuthorizedMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -773,6 +1003,107 @@ func (m *QueryMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AuthorizedMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthorizedMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthorizedMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Age != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Age))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AuthorizedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuthorizedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -873,6 +1204,54 @@ func (m *QueryMessagesResponse) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if len(m.AuthorizedMessages) > 0 {
+		for _, e := range m.AuthorizedMessages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AuthorizedMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AuthorizedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.AuthorizedHeight))
+	}
+	if m.Age != 0 {
+		n += 1 + sovQuery(uint64(m.Age))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -1468,6 +1847,312 @@ func (m *QueryMessagesResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorizedMessages = append(m.AuthorizedMessages, AuthorizedMessage{})
+			if err := m.AuthorizedMessages[len(m.AuthorizedMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthorizedMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthorizedMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthorizedMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedHeight", wireType)
+			}
+			m.AuthorizedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthorizedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Age", wireType)
+			}
+			m.Age = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Age |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
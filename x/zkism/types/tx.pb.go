@@ -7,22 +7,27 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_bcp_innovations_hyperlane_cosmos_util "github.com/bcp-innovations/hyperlane-cosmos/util"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -38,12 +43,14 @@ type MsgCreateInterchainSecurityModule struct {
 	State []byte `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
 	// merkle tree address in byte32 format
 	MerkleTreeAddress []byte `protobuf:"bytes,3,opt,name=merkle_tree_address,json=merkleTreeAddress,proto3" json:"merkle_tree_address,omitempty"`
-	// the sp1 groth16 verifier key
+	// the verifier key of the proof system
 	Groth16Vkey []byte `protobuf:"bytes,4,opt,name=groth16_vkey,json=groth16Vkey,proto3" json:"groth16_vkey,omitempty"`
 	// hash-based commitment to the verifier key used for state transition
 	StateTransitionVkey []byte `protobuf:"bytes,5,opt,name=state_transition_vkey,json=stateTransitionVkey,proto3" json:"state_transition_vkey,omitempty"`
 	// hash-based commitment to the verifier key used for state membership
 	StateMembershipVkey []byte `protobuf:"bytes,6,opt,name=state_membership_vkey,json=stateMembershipVkey,proto3" json:"state_membership_vkey,omitempty"`
+	// the proof system used to verify proofs submitted to the ism
+	ProofSystem ProofSystem `protobuf:"varint,7,opt,name=proof_system,json=proofSystem,proto3,enum=celestia.zkism.v1.ProofSystem" json:"proof_system,omitempty"`
 }
 
 func (m *MsgCreateInterchainSecurityModule) Reset()         { *m = MsgCreateInterchainSecurityModule{} }
@@ -121,6 +128,13 @@ func (m *MsgCreateInterchainSecurityModule) GetStateMembershipVkey() []byte {
 	return nil
 }
 
+func (m *MsgCreateInterchainSecurityModule) GetProofSystem() ProofSystem {
+	if m != nil {
+		return m.ProofSystem
+	}
+	return ProofSystem_PROOF_SYSTEM_SP1_GROTH16
+}
+
 // MsgCreateInterchainSecurityModuleResponse is the response type for CreateInterchainSecurityModule.
 type MsgCreateInterchainSecurityModuleResponse struct {
 	Id github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
@@ -167,7 +181,7 @@ var xxx_messageInfo_MsgCreateInterchainSecurityModuleResponse proto.InternalMess
 type MsgUpdateInterchainSecurityModule struct {
 	// ism identifier
 	Id github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
-	// proof is the ZK proof bytes (groth16).
+	// proof is the ZK proof bytes, encoded according to the proof system of the ism.
 	Proof []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
 	// the public values used for proof verification.
 	PublicValues []byte `protobuf:"bytes,3,opt,name=public_values,json=publicValues,proto3" json:"public_values,omitempty"`
@@ -283,7 +297,7 @@ func (m *MsgUpdateInterchainSecurityModuleResponse) GetState() []byte {
 type MsgSubmitMessages struct {
 	// ism identifier
 	Id github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
-	// proof is the ZK proof bytes (groth16).
+	// proof is the ZK proof bytes, encoded according to the proof system of the ism.
 	Proof []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
 	// the public values used for proof verification.
 	PublicValues []byte `protobuf:"bytes,3,opt,name=public_values,json=publicValues,proto3" json:"public_values,omitempty"`
@@ -400,635 +414,3030 @@ func (m *MsgSubmitMessagesResponse) GetMessages() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*MsgCreateInterchainSecurityModule)(nil), "celestia.zkism.v1.MsgCreateInterchainSecurityModule")
-	proto.RegisterType((*MsgCreateInterchainSecurityModuleResponse)(nil), "celestia.zkism.v1.MsgCreateInterchainSecurityModuleResponse")
-	proto.RegisterType((*MsgUpdateInterchainSecurityModule)(nil), "celestia.zkism.v1.MsgUpdateInterchainSecurityModule")
-	proto.RegisterType((*MsgUpdateInterchainSecurityModuleResponse)(nil), "celestia.zkism.v1.MsgUpdateInterchainSecurityModuleResponse")
-	proto.RegisterType((*MsgSubmitMessages)(nil), "celestia.zkism.v1.MsgSubmitMessages")
-	proto.RegisterType((*MsgSubmitMessagesResponse)(nil), "celestia.zkism.v1.MsgSubmitMessagesResponse")
+// MsgSubmitProofs is the request type for SubmitProofs.
+type MsgSubmitProofs struct {
+	// ism identifier
+	Id github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
+	// proofs is the ordered list of state transition and state membership proofs.
+	// Each proof is verified against the ism state resulting from the proofs before it.
+	Proofs []Proof `protobuf:"bytes,2,rep,name=proofs,proto3" json:"proofs"`
+	// the tx signer address
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func init() { proto.RegisterFile("celestia/zkism/v1/tx.proto", fileDescriptor_9627100907186bb5) }
-
-var fileDescriptor_9627100907186bb5 = []byte{
-	// 625 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xbd, 0x6e, 0xd4, 0x4c,
-	0x14, 0xdd, 0xd9, 0xfd, 0x92, 0x8f, 0x9d, 0x2c, 0x48, 0x71, 0x02, 0x2c, 0x96, 0x70, 0x92, 0x85,
-	0x22, 0x44, 0xc4, 0xd6, 0x06, 0x44, 0x11, 0x68, 0x92, 0x34, 0x20, 0xe4, 0xc6, 0x09, 0x29, 0x68,
-	0x56, 0xfe, 0xb9, 0xcc, 0x8e, 0xd6, 0xf6, 0x58, 0x33, 0xe3, 0x55, 0x96, 0x2a, 0xe2, 0x09, 0x28,
-	0x28, 0x29, 0x78, 0x84, 0x3c, 0x46, 0xca, 0x94, 0x11, 0x45, 0x84, 0x92, 0x22, 0xaf, 0x81, 0x3c,
-	0xfe, 0x51, 0x42, 0xc8, 0x6e, 0x84, 0x44, 0x41, 0x37, 0xf7, 0xde, 0x73, 0xc7, 0xf7, 0x9e, 0x73,
-	0x6c, 0x63, 0xdd, 0x87, 0x10, 0x84, 0xa4, 0xae, 0xf5, 0x71, 0x40, 0x45, 0x64, 0x0d, 0xbb, 0x96,
-	0xdc, 0x33, 0x13, 0xce, 0x24, 0xd3, 0x66, 0xcb, 0x9a, 0xa9, 0x6a, 0xe6, 0xb0, 0xab, 0xdf, 0xf7,
-	0x99, 0x88, 0x98, 0xb0, 0x22, 0x41, 0x32, 0x68, 0x24, 0x48, 0x8e, 0xd5, 0xe7, 0x09, 0x23, 0x4c,
-	0x1d, 0xad, 0xec, 0x94, 0x67, 0x3b, 0x5f, 0xeb, 0x78, 0xc9, 0x16, 0x64, 0x8b, 0x83, 0x2b, 0xe1,
-	0x4d, 0x2c, 0x81, 0xfb, 0x7d, 0x97, 0xc6, 0xdb, 0xe0, 0xa7, 0x9c, 0xca, 0x91, 0xcd, 0x82, 0x34,
-	0x04, 0xad, 0x8d, 0xff, 0xf7, 0x33, 0x04, 0xe3, 0x6d, 0xb4, 0x88, 0x96, 0x9b, 0x4e, 0x19, 0x6a,
-	0xf3, 0x78, 0x4a, 0x48, 0x57, 0x42, 0xbb, 0xbe, 0x88, 0x96, 0x5b, 0x4e, 0x1e, 0x68, 0x26, 0x9e,
-	0x8b, 0x80, 0x0f, 0x42, 0xe8, 0x49, 0x0e, 0xd0, 0x73, 0x83, 0x80, 0x83, 0x10, 0xed, 0x86, 0xc2,
-	0xcc, 0xe6, 0xa5, 0x1d, 0x0e, 0xb0, 0x91, 0x17, 0xb4, 0x25, 0xdc, 0x22, 0x9c, 0xc9, 0x7e, 0xf7,
-	0x45, 0x6f, 0x38, 0x80, 0x51, 0xfb, 0x3f, 0x05, 0x9c, 0x29, 0x72, 0xbb, 0x03, 0x18, 0x69, 0x6b,
-	0xf8, 0xae, 0xba, 0xbb, 0x27, 0xb9, 0x1b, 0x0b, 0x2a, 0x29, 0x8b, 0x73, 0xec, 0x94, 0xc2, 0xce,
-	0xa9, 0xe2, 0x4e, 0x55, 0xbb, 0xdc, 0x13, 0x41, 0xe4, 0x01, 0x17, 0x7d, 0x9a, 0xe4, 0x3d, 0xd3,
-	0x17, 0x7a, 0xec, 0xaa, 0x96, 0xf5, 0xac, 0xb7, 0x3e, 0x9d, 0x1f, 0xac, 0x94, 0xeb, 0x75, 0xf6,
-	0x11, 0x7e, 0x32, 0x91, 0x1e, 0x07, 0x44, 0xc2, 0x62, 0x01, 0xda, 0x36, 0xae, 0xd3, 0x20, 0x67,
-	0x68, 0x73, 0xeb, 0xf0, 0x64, 0xa1, 0xf6, 0xfd, 0x64, 0xe1, 0x25, 0xa1, 0xb2, 0x9f, 0x7a, 0xa6,
-	0xcf, 0x22, 0xcb, 0xf3, 0x93, 0x55, 0x1a, 0xc7, 0x6c, 0xe8, 0x66, 0x23, 0x0a, 0xab, 0x3f, 0x4a,
-	0x80, 0x87, 0x6e, 0x0c, 0xab, 0x85, 0x68, 0xa9, 0xa4, 0xa1, 0xf9, 0x1a, 0xf6, 0x0a, 0x5e, 0x9c,
-	0x3a, 0x0d, 0x3a, 0xc7, 0x48, 0x29, 0xf4, 0x2e, 0x09, 0xc6, 0x29, 0xf4, 0x37, 0x1e, 0x9d, 0x89,
-	0x9b, 0x70, 0xc6, 0x3e, 0x94, 0xe2, 0xaa, 0x40, 0x7b, 0x84, 0x6f, 0x27, 0xa9, 0x17, 0x52, 0xbf,
-	0x37, 0x74, 0xc3, 0x14, 0x4a, 0x59, 0x5b, 0x79, 0x72, 0x57, 0xe5, 0xb4, 0x7b, 0x78, 0x5a, 0x50,
-	0x12, 0x03, 0x57, 0x5a, 0x36, 0x9d, 0x22, 0x5a, 0x9f, 0xc9, 0xe8, 0x2d, 0x82, 0xce, 0x86, 0x22,
-	0x77, 0xfc, 0x66, 0x15, 0xb9, 0x95, 0xd3, 0xd0, 0x05, 0xa7, 0x75, 0x0e, 0x11, 0x9e, 0xb5, 0x05,
-	0xd9, 0x4e, 0xbd, 0x88, 0x4a, 0x1b, 0x84, 0x70, 0x09, 0x88, 0x7f, 0x93, 0x8d, 0x5d, 0xfc, 0xe0,
-	0xca, 0x26, 0xd5, 0xf6, 0x0f, 0x31, 0xce, 0xad, 0xcc, 0x19, 0x93, 0xc5, 0x4b, 0xd8, 0x54, 0x19,
-	0x87, 0x31, 0xa9, 0xe9, 0xf8, 0x56, 0x54, 0xb4, 0xb4, 0xeb, 0x8b, 0x8d, 0xe5, 0xa6, 0x53, 0xc5,
-	0x6b, 0xdf, 0x1a, 0xb8, 0x61, 0x0b, 0xa2, 0x7d, 0x41, 0xd8, 0x98, 0xf0, 0x9e, 0x3f, 0x37, 0xaf,
-	0x7c, 0x50, 0xcc, 0x89, 0xf6, 0xd7, 0x5f, 0xfd, 0x49, 0x57, 0xb5, 0x59, 0x36, 0xd6, 0x04, 0x73,
-	0x5f, 0x33, 0xd6, 0xf8, 0xae, 0xeb, 0xc6, 0xba, 0xa1, 0xdd, 0x02, 0x7c, 0xe7, 0x17, 0x53, 0x3d,
-	0xfe, 0xfd, 0x7d, 0x97, 0x51, 0xfa, 0xd3, 0x9b, 0xa0, 0xca, 0xa7, 0xe8, 0x53, 0xfb, 0xe7, 0x07,
-	0x2b, 0x68, 0xf3, 0xed, 0xe1, 0xa9, 0x81, 0x8e, 0x4e, 0x0d, 0xf4, 0xe3, 0xd4, 0x40, 0x9f, 0xcf,
-	0x8c, 0xda, 0xd1, 0x99, 0x51, 0x3b, 0x3e, 0x33, 0x6a, 0xef, 0xbb, 0x17, 0x5c, 0x5b, 0x5e, 0xcc,
-	0x38, 0xa9, 0xce, 0xab, 0x6e, 0x92, 0x58, 0x7b, 0xc5, 0xaf, 0x41, 0x8e, 0x12, 0x10, 0xde, 0xb4,
-	0xfa, 0xb2, 0x3f, 0xfb, 0x19, 0x00, 0x00, 0xff, 0xff, 0x4b, 0x3d, 0xcb, 0x03, 0x39, 0x06, 0x00,
-	0x00,
+func (m *MsgSubmitProofs) Reset()         { *m = MsgSubmitProofs{} }
+func (m *MsgSubmitProofs) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitProofs) ProtoMessage()    {}
+func (*MsgSubmitProofs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9627100907186bb5, []int{6}
+}
+func (m *MsgSubmitProofs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitProofs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitProofs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitProofs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitProofs.Merge(m, src)
+}
+func (m *MsgSubmitProofs) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitProofs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitProofs.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgSubmitProofs proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateInterchainSecurityModule defines the rpc method for creating a new zk ISM.
-	CreateInterchainSecurityModule(ctx context.Context, in *MsgCreateInterchainSecurityModule, opts ...grpc.CallOption) (*MsgCreateInterchainSecurityModuleResponse, error)
-	// UpdateInterchainSecurityModule defines the rpc method for updating an existing zk ISM.
-	UpdateInterchainSecurityModule(ctx context.Context, in *MsgUpdateInterchainSecurityModule, opts ...grpc.CallOption) (*MsgUpdateInterchainSecurityModuleResponse, error)
-	// SubmitMessages defines the rpc method for verifying state membership of messages.
-	SubmitMessages(ctx context.Context, in *MsgSubmitMessages, opts ...grpc.CallOption) (*MsgSubmitMessagesResponse, error)
+func (m *MsgSubmitProofs) GetProofs() []Proof {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSubmitProofs) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgSubmitProofsResponse is the response type for SubmitProofs.
+type MsgSubmitProofsResponse struct {
+	// new ism state
+	State []byte `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	// list of authorized messages ids
+	Messages []string `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
 }
 
-func (c *msgClient) CreateInterchainSecurityModule(ctx context.Context, in *MsgCreateInterchainSecurityModule, opts ...grpc.CallOption) (*MsgCreateInterchainSecurityModuleResponse, error) {
-	out := new(MsgCreateInterchainSecurityModuleResponse)
-	err := c.cc.Invoke(ctx, "/celestia.zkism.v1.Msg/CreateInterchainSecurityModule", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitProofsResponse) Reset()         { *m = MsgSubmitProofsResponse{} }
+func (m *MsgSubmitProofsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitProofsResponse) ProtoMessage()    {}
+func (*MsgSubmitProofsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9627100907186bb5, []int{7}
+}
+func (m *MsgSubmitProofsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitProofsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitProofsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSubmitProofsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitProofsResponse.Merge(m, src)
+}
+func (m *MsgSubmitProofsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitProofsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitProofsResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateInterchainSecurityModule(ctx context.Context, in *MsgUpdateInterchainSecurityModule, opts ...grpc.CallOption) (*MsgUpdateInterchainSecurityModuleResponse, error) {
-	out := new(MsgUpdateInterchainSecurityModuleResponse)
-	err := c.cc.Invoke(ctx, "/celestia.zkism.v1.Msg/UpdateInterchainSecurityModule", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSubmitProofsResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitProofsResponse) GetState() []byte {
+	if m != nil {
+		return m.State
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) SubmitMessages(ctx context.Context, in *MsgSubmitMessages, opts ...grpc.CallOption) (*MsgSubmitMessagesResponse, error) {
-	out := new(MsgSubmitMessagesResponse)
-	err := c.cc.Invoke(ctx, "/celestia.zkism.v1.Msg/SubmitMessages", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitProofsResponse) GetMessages() []string {
+	if m != nil {
+		return m.Messages
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateInterchainSecurityModule defines the rpc method for creating a new zk ISM.
-	CreateInterchainSecurityModule(context.Context, *MsgCreateInterchainSecurityModule) (*MsgCreateInterchainSecurityModuleResponse, error)
-	// UpdateInterchainSecurityModule defines the rpc method for updating an existing zk ISM.
-	UpdateInterchainSecurityModule(context.Context, *MsgUpdateInterchainSecurityModule) (*MsgUpdateInterchainSecurityModuleResponse, error)
-	// SubmitMessages defines the rpc method for verifying state membership of messages.
-	SubmitMessages(context.Context, *MsgSubmitMessages) (*MsgSubmitMessagesResponse, error)
+// MsgUpdateIsmVerifier is the request type for UpdateIsmVerifier.
+type MsgUpdateIsmVerifier struct {
+	// ism identifier
+	Id github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
+	// owner is the owner of the ism.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// the new verifier key of the proof system
+	Groth16Vkey []byte `protobuf:"bytes,3,opt,name=groth16_vkey,json=groth16Vkey,proto3" json:"groth16_vkey,omitempty"`
+	// the new hash-based commitment to the verifier key used for state transition
+	StateTransitionVkey []byte `protobuf:"bytes,4,opt,name=state_transition_vkey,json=stateTransitionVkey,proto3" json:"state_transition_vkey,omitempty"`
+	// the new hash-based commitment to the verifier key used for state membership
+	StateMembershipVkey []byte `protobuf:"bytes,5,opt,name=state_membership_vkey,json=stateMembershipVkey,proto3" json:"state_membership_vkey,omitempty"`
+	// delay after which the new verifier keys are applied. A zero delay applies
+	// them immediately. Any previously scheduled update is replaced.
+	Delay time.Duration `protobuf:"bytes,6,opt,name=delay,proto3,stdduration" json:"delay"`
+	// the new proof system
+	ProofSystem ProofSystem `protobuf:"varint,7,opt,name=proof_system,json=proofSystem,proto3,enum=celestia.zkism.v1.ProofSystem" json:"proof_system,omitempty"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgUpdateIsmVerifier) Reset()         { *m = MsgUpdateIsmVerifier{} }
+func (m *MsgUpdateIsmVerifier) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateIsmVerifier) ProtoMessage()    {}
+func (*MsgUpdateIsmVerifier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9627100907186bb5, []int{8}
 }
-
-func (*UnimplementedMsgServer) CreateInterchainSecurityModule(ctx context.Context, req *MsgCreateInterchainSecurityModule) (*MsgCreateInterchainSecurityModuleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateInterchainSecurityModule not implemented")
+func (m *MsgUpdateIsmVerifier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateInterchainSecurityModule(ctx context.Context, req *MsgUpdateInterchainSecurityModule) (*MsgUpdateInterchainSecurityModuleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateInterchainSecurityModule not implemented")
+func (m *MsgUpdateIsmVerifier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateIsmVerifier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) SubmitMessages(ctx context.Context, req *MsgSubmitMessages) (*MsgSubmitMessagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitMessages not implemented")
+func (m *MsgUpdateIsmVerifier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateIsmVerifier.Merge(m, src)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgUpdateIsmVerifier) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateIsmVerifier) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateIsmVerifier.DiscardUnknown(m)
 }
 
-func _Msg_CreateInterchainSecurityModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateInterchainSecurityModule)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateInterchainSecurityModule(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celestia.zkism.v1.Msg/CreateInterchainSecurityModule",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateInterchainSecurityModule(ctx, req.(*MsgCreateInterchainSecurityModule))
+var xxx_messageInfo_MsgUpdateIsmVerifier proto.InternalMessageInfo
+
+func (m *MsgUpdateIsmVerifier) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateInterchainSecurityModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateInterchainSecurityModule)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateInterchainSecurityModule(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celestia.zkism.v1.Msg/UpdateInterchainSecurityModule",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateInterchainSecurityModule(ctx, req.(*MsgUpdateInterchainSecurityModule))
+func (m *MsgUpdateIsmVerifier) GetGroth16Vkey() []byte {
+	if m != nil {
+		return m.Groth16Vkey
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_SubmitMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitMessages)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgUpdateIsmVerifier) GetStateTransitionVkey() []byte {
+	if m != nil {
+		return m.StateTransitionVkey
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitMessages(ctx, in)
+	return nil
+}
+
+func (m *MsgUpdateIsmVerifier) GetStateMembershipVkey() []byte {
+	if m != nil {
+		return m.StateMembershipVkey
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celestia.zkism.v1.Msg/SubmitMessages",
+	return nil
+}
+
+func (m *MsgUpdateIsmVerifier) GetDelay() time.Duration {
+	if m != nil {
+		return m.Delay
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitMessages(ctx, req.(*MsgSubmitMessages))
+	return 0
+}
+
+func (m *MsgUpdateIsmVerifier) GetProofSystem() ProofSystem {
+	if m != nil {
+		return m.ProofSystem
 	}
-	return interceptor(ctx, in, info, handler)
+	return ProofSystem_PROOF_SYSTEM_SP1_GROTH16
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "celestia.zkism.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateInterchainSecurityModule",
-			Handler:    _Msg_CreateInterchainSecurityModule_Handler,
-		},
-		{
-			MethodName: "UpdateInterchainSecurityModule",
-			Handler:    _Msg_UpdateInterchainSecurityModule_Handler,
-		},
-		{
-			MethodName: "SubmitMessages",
-			Handler:    _Msg_SubmitMessages_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "celestia/zkism/v1/tx.proto",
+// MsgUpdateIsmVerifierResponse is the response type for UpdateIsmVerifier.
+type MsgUpdateIsmVerifierResponse struct {
 }
 
-func (m *MsgCreateInterchainSecurityModule) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateIsmVerifierResponse) Reset()         { *m = MsgUpdateIsmVerifierResponse{} }
+func (m *MsgUpdateIsmVerifierResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateIsmVerifierResponse) ProtoMessage()    {}
+func (*MsgUpdateIsmVerifierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9627100907186bb5, []int{9}
+}
+func (m *MsgUpdateIsmVerifierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateIsmVerifierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateIsmVerifierResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateIsmVerifierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateIsmVerifierResponse.Merge(m, src)
+}
+func (m *MsgUpdateIsmVerifierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateIsmVerifierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateIsmVerifierResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateInterchainSecurityModule) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateIsmVerifierResponse proto.InternalMessageInfo
+
+// MsgTransferIsmOwnership is the request type for TransferIsmOwnership.
+type MsgTransferIsmOwnership struct {
+	// ism identifier
+	Id github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
+	// owner is the current owner of the ism.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// new_owner is the address the ownership is transferred to.
+	NewOwner string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
 }
 
-func (m *MsgCreateInterchainSecurityModule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StateMembershipVkey) > 0 {
-		i -= len(m.StateMembershipVkey)
-		copy(dAtA[i:], m.StateMembershipVkey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StateMembershipVkey)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.StateTransitionVkey) > 0 {
-		i -= len(m.StateTransitionVkey)
-		copy(dAtA[i:], m.StateTransitionVkey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StateTransitionVkey)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Groth16Vkey) > 0 {
-		i -= len(m.Groth16Vkey)
-		copy(dAtA[i:], m.Groth16Vkey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Groth16Vkey)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.MerkleTreeAddress) > 0 {
-		i -= len(m.MerkleTreeAddress)
-		copy(dAtA[i:], m.MerkleTreeAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MerkleTreeAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.State) > 0 {
-		i -= len(m.State)
-		copy(dAtA[i:], m.State)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.State)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgTransferIsmOwnership) Reset()         { *m = MsgTransferIsmOwnership{} }
+func (m *MsgTransferIsmOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferIsmOwnership) ProtoMessage()    {}
+func (*MsgTransferIsmOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9627100907186bb5, []int{10}
+}
+func (m *MsgTransferIsmOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferIsmOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferIsmOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgTransferIsmOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferIsmOwnership.Merge(m, src)
+}
+func (m *MsgTransferIsmOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferIsmOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferIsmOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferIsmOwnership proto.InternalMessageInfo
+
+func (m *MsgTransferIsmOwnership) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateInterchainSecurityModuleResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTransferIsmOwnership) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateInterchainSecurityModuleResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgTransferIsmOwnershipResponse is the response type for TransferIsmOwnership.
+type MsgTransferIsmOwnershipResponse struct {
 }
 
-func (m *MsgCreateInterchainSecurityModuleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Id.Size()
-		i -= size
-		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgTransferIsmOwnershipResponse) Reset()         { *m = MsgTransferIsmOwnershipResponse{} }
+func (m *MsgTransferIsmOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferIsmOwnershipResponse) ProtoMessage()    {}
+func (*MsgTransferIsmOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9627100907186bb5, []int{11}
+}
+func (m *MsgTransferIsmOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferIsmOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferIsmOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateInterchainSecurityModule) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgTransferIsmOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferIsmOwnershipResponse.Merge(m, src)
+}
+func (m *MsgTransferIsmOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferIsmOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferIsmOwnershipResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateInterchainSecurityModule) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgTransferIsmOwnershipResponse proto.InternalMessageInfo
+
+// MsgUpdateIsmMessageTtl is the request type for UpdateIsmMessageTtl.
+type MsgUpdateIsmMessageTtl struct {
+	// ism identifier
+	Id github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
+	// owner is the owner of the ism.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// number of blocks authorized message ids remain valid. It must not exceed
+	// the message_ttl module parameter, if set. Zero resets the ism to the
+	// module parameter. It applies to message ids authorized afterwards.
+	MessageTtl uint64 `protobuf:"varint,3,opt,name=message_ttl,json=messageTtl,proto3" json:"message_ttl,omitempty"`
 }
 
-func (m *MsgUpdateInterchainSecurityModule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.PublicValues) > 0 {
-		i -= len(m.PublicValues)
-		copy(dAtA[i:], m.PublicValues)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicValues)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Proof) > 0 {
-		i -= len(m.Proof)
-		copy(dAtA[i:], m.Proof)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Proof)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size := m.Id.Size()
-		i -= size
-		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgUpdateIsmMessageTtl) Reset()         { *m = MsgUpdateIsmMessageTtl{} }
+func (m *MsgUpdateIsmMessageTtl) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateIsmMessageTtl) ProtoMessage()    {}
+func (*MsgUpdateIsmMessageTtl) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9627100907186bb5, []int{12}
+}
+func (m *MsgUpdateIsmMessageTtl) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateIsmMessageTtl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateIsmMessageTtl.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateInterchainSecurityModuleResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateIsmMessageTtl) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateIsmMessageTtl.Merge(m, src)
 }
-
-func (m *MsgUpdateInterchainSecurityModuleResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateIsmMessageTtl) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateIsmMessageTtl) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateIsmMessageTtl.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateInterchainSecurityModuleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.State) > 0 {
-		i -= len(m.State)
-		copy(dAtA[i:], m.State)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.State)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgUpdateIsmMessageTtl proto.InternalMessageInfo
+
+func (m *MsgUpdateIsmMessageTtl) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSubmitMessages) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateIsmMessageTtl) GetMessageTtl() uint64 {
+	if m != nil {
+		return m.MessageTtl
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgSubmitMessages) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateIsmMessageTtlResponse is the response type for UpdateIsmMessageTtl.
+type MsgUpdateIsmMessageTtlResponse struct {
 }
 
-func (m *MsgSubmitMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.PublicValues) > 0 {
-		i -= len(m.PublicValues)
-		copy(dAtA[i:], m.PublicValues)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicValues)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Proof) > 0 {
-		i -= len(m.Proof)
-		copy(dAtA[i:], m.Proof)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Proof)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size := m.Id.Size()
-		i -= size
-		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgUpdateIsmMessageTtlResponse) Reset()         { *m = MsgUpdateIsmMessageTtlResponse{} }
+func (m *MsgUpdateIsmMessageTtlResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateIsmMessageTtlResponse) ProtoMessage()    {}
+func (*MsgUpdateIsmMessageTtlResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9627100907186bb5, []int{13}
+}
+func (m *MsgUpdateIsmMessageTtlResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateIsmMessageTtlResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateIsmMessageTtlResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgSubmitMessagesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateIsmMessageTtlResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateIsmMessageTtlResponse.Merge(m, src)
+}
+func (m *MsgUpdateIsmMessageTtlResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateIsmMessageTtlResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateIsmMessageTtlResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateIsmMessageTtlResponse proto.InternalMessageInfo
+
+// MsgUpdateParams is the request type for UpdateParams.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the zkism parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func (m *MsgSubmitMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Messages) > 0 {
-		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Messages[iNdEx])
-			copy(dAtA[i:], m.Messages[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Messages[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9627100907186bb5, []int{14}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.StateRoot) > 0 {
-		i -= len(m.StateRoot)
-		copy(dAtA[i:], m.StateRoot)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StateRoot)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *MsgCreateInterchainSecurityModule) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.State)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.MerkleTreeAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Groth16Vkey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.StateTransitionVkey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.StateMembershipVkey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return n
+	return Params{}
 }
 
-func (m *MsgCreateInterchainSecurityModuleResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Id.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+// MsgUpdateParamsResponse is the response type for UpdateParams.
+type MsgUpdateParamsResponse struct {
 }
 
-func (m *MsgUpdateInterchainSecurityModule) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Id.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Proof)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PublicValues)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9627100907186bb5, []int{15}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateInterchainSecurityModuleResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateInterchainSecurityModule)(nil), "celestia.zkism.v1.MsgCreateInterchainSecurityModule")
+	proto.RegisterType((*MsgCreateInterchainSecurityModuleResponse)(nil), "celestia.zkism.v1.MsgCreateInterchainSecurityModuleResponse")
+	proto.RegisterType((*MsgUpdateInterchainSecurityModule)(nil), "celestia.zkism.v1.MsgUpdateInterchainSecurityModule")
+	proto.RegisterType((*MsgUpdateInterchainSecurityModuleResponse)(nil), "celestia.zkism.v1.MsgUpdateInterchainSecurityModuleResponse")
+	proto.RegisterType((*MsgSubmitMessages)(nil), "celestia.zkism.v1.MsgSubmitMessages")
+	proto.RegisterType((*MsgSubmitMessagesResponse)(nil), "celestia.zkism.v1.MsgSubmitMessagesResponse")
+	proto.RegisterType((*MsgSubmitProofs)(nil), "celestia.zkism.v1.MsgSubmitProofs")
+	proto.RegisterType((*MsgSubmitProofsResponse)(nil), "celestia.zkism.v1.MsgSubmitProofsResponse")
+	proto.RegisterType((*MsgUpdateIsmVerifier)(nil), "celestia.zkism.v1.MsgUpdateIsmVerifier")
+	proto.RegisterType((*MsgUpdateIsmVerifierResponse)(nil), "celestia.zkism.v1.MsgUpdateIsmVerifierResponse")
+	proto.RegisterType((*MsgTransferIsmOwnership)(nil), "celestia.zkism.v1.MsgTransferIsmOwnership")
+	proto.RegisterType((*MsgTransferIsmOwnershipResponse)(nil), "celestia.zkism.v1.MsgTransferIsmOwnershipResponse")
+	proto.RegisterType((*MsgUpdateIsmMessageTtl)(nil), "celestia.zkism.v1.MsgUpdateIsmMessageTtl")
+	proto.RegisterType((*MsgUpdateIsmMessageTtlResponse)(nil), "celestia.zkism.v1.MsgUpdateIsmMessageTtlResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "celestia.zkism.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "celestia.zkism.v1.MsgUpdateParamsResponse")
+}
+
+func init() { proto.RegisterFile("celestia/zkism/v1/tx.proto", fileDescriptor_9627100907186bb5) }
+
+var fileDescriptor_9627100907186bb5 = []byte{
+	// 1063 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xae, 0x93, 0xa6, 0xdb, 0xbc, 0x84, 0xa2, 0xba, 0x65, 0xeb, 0x5a, 0xac, 0xdb, 0x1a, 0x24,
+	0xda, 0x8a, 0xda, 0x6a, 0x80, 0x22, 0xca, 0x5e, 0xda, 0xe5, 0xc0, 0x6a, 0x15, 0xb1, 0x72, 0x4a,
+	0x0f, 0x1c, 0x88, 0x9c, 0x64, 0xea, 0x58, 0xb5, 0x3d, 0xd6, 0xcc, 0x24, 0x6d, 0x38, 0xad, 0xf8,
+	0x05, 0x08, 0x71, 0xe0, 0x67, 0xf4, 0xc0, 0x91, 0x1b, 0x97, 0x8a, 0xd3, 0x8a, 0xd3, 0x8a, 0xc3,
+	0x2e, 0xb4, 0x87, 0xfd, 0x1b, 0xc8, 0x33, 0xb6, 0x93, 0xb4, 0x49, 0x5c, 0x2a, 0x2a, 0x2d, 0xb7,
+	0xbc, 0x79, 0xdf, 0x7b, 0xf3, 0xde, 0x37, 0xdf, 0xbc, 0x71, 0x40, 0x6d, 0x22, 0x0f, 0x51, 0xe6,
+	0xda, 0xe6, 0x77, 0xc7, 0x2e, 0xf5, 0xcd, 0xee, 0xb6, 0xc9, 0x4e, 0x8d, 0x90, 0x60, 0x86, 0xe5,
+	0xf9, 0xc4, 0x67, 0x70, 0x9f, 0xd1, 0xdd, 0x56, 0x97, 0x9a, 0x98, 0xfa, 0x98, 0x9a, 0x3e, 0x75,
+	0x22, 0xa8, 0x4f, 0x1d, 0x81, 0x55, 0x97, 0x85, 0xa3, 0xce, 0x2d, 0x53, 0x18, 0xb1, 0xeb, 0xc1,
+	0x88, 0x2d, 0x7a, 0x21, 0x4a, 0xdc, 0x8b, 0x0e, 0x76, 0xb0, 0x08, 0x8b, 0x7e, 0xc5, 0xab, 0x9a,
+	0x83, 0xb1, 0xe3, 0x21, 0x93, 0x5b, 0x8d, 0xce, 0x91, 0xd9, 0xea, 0x10, 0x9b, 0xb9, 0x38, 0x10,
+	0x7e, 0xfd, 0x55, 0x0e, 0xd6, 0xaa, 0xd4, 0x79, 0x44, 0x90, 0xcd, 0xd0, 0xe3, 0x80, 0x21, 0xd2,
+	0x6c, 0xdb, 0x6e, 0x50, 0x43, 0xcd, 0x0e, 0x71, 0x59, 0xaf, 0x8a, 0x5b, 0x1d, 0x0f, 0xc9, 0x0a,
+	0xdc, 0x6b, 0x46, 0x08, 0x4c, 0x14, 0x69, 0x55, 0x5a, 0x2f, 0x5a, 0x89, 0x29, 0x2f, 0x42, 0x81,
+	0x32, 0x9b, 0x21, 0x25, 0xb7, 0x2a, 0xad, 0x97, 0x2d, 0x61, 0xc8, 0x06, 0x2c, 0xf8, 0x88, 0x1c,
+	0x7b, 0xa8, 0xce, 0x08, 0x42, 0x75, 0xbb, 0xd5, 0x22, 0x88, 0x52, 0x25, 0xcf, 0x31, 0xf3, 0xc2,
+	0x75, 0x40, 0x10, 0xda, 0x13, 0x0e, 0x79, 0x0d, 0xca, 0x0e, 0xc1, 0xac, 0xbd, 0xbd, 0x53, 0xef,
+	0x1e, 0xa3, 0x9e, 0x32, 0xcd, 0x81, 0xa5, 0x78, 0xed, 0xf0, 0x18, 0xf5, 0xe4, 0x0a, 0xbc, 0xc3,
+	0x73, 0xd7, 0x19, 0xb1, 0x03, 0xea, 0x46, 0x2d, 0x08, 0x6c, 0x81, 0x63, 0x17, 0xb8, 0xf3, 0x20,
+	0xf5, 0x0d, 0xc7, 0xf8, 0xc8, 0x6f, 0x20, 0x42, 0xdb, 0x6e, 0x28, 0x62, 0x66, 0x06, 0x62, 0xaa,
+	0xa9, 0x8f, 0xc7, 0xec, 0x41, 0x39, 0x24, 0x18, 0x1f, 0xd5, 0x69, 0x8f, 0x32, 0xe4, 0x2b, 0xf7,
+	0x56, 0xa5, 0xf5, 0xb9, 0x8a, 0x66, 0x5c, 0x3b, 0x43, 0xe3, 0x69, 0x04, 0xab, 0x71, 0x94, 0x55,
+	0x0a, 0xfb, 0xc6, 0x6e, 0xf9, 0xfb, 0xd7, 0x67, 0x9b, 0x09, 0x43, 0xfa, 0x33, 0x09, 0x36, 0x32,
+	0x19, 0xb6, 0x10, 0x0d, 0x71, 0x40, 0x91, 0x5c, 0x83, 0x9c, 0xdb, 0x12, 0x24, 0xef, 0x3f, 0x3a,
+	0x7f, 0xb9, 0x32, 0xf5, 0xe7, 0xcb, 0x95, 0xcf, 0x1d, 0x97, 0xb5, 0x3b, 0x0d, 0xa3, 0x89, 0x7d,
+	0xb3, 0xd1, 0x0c, 0xb7, 0xdc, 0x20, 0xc0, 0x5d, 0x7e, 0x88, 0xd4, 0x6c, 0xf7, 0x42, 0x44, 0x3c,
+	0x3b, 0x40, 0x5b, 0xb1, 0xa2, 0x3a, 0xcc, 0xf5, 0x8c, 0x2f, 0xd1, 0x69, 0x4c, 0xad, 0x95, 0x73,
+	0x5b, 0xfa, 0x0b, 0x89, 0x1f, 0xf2, 0xd7, 0x61, 0x6b, 0xd2, 0x21, 0xdf, 0xc5, 0xd6, 0x91, 0x3e,
+	0x38, 0x35, 0x89, 0x3e, 0xb8, 0x21, 0xbf, 0x07, 0x6f, 0x85, 0x9d, 0x86, 0xe7, 0x36, 0xeb, 0x5d,
+	0xdb, 0xeb, 0xa0, 0x44, 0x19, 0x65, 0xb1, 0x78, 0xc8, 0xd7, 0xe4, 0xfb, 0x30, 0x43, 0x5d, 0x27,
+	0x40, 0x84, 0xcb, 0xa1, 0x68, 0xc5, 0xd6, 0x6e, 0x29, 0xa2, 0x37, 0x36, 0xf4, 0x3d, 0xd8, 0xc8,
+	0xec, 0x2c, 0x25, 0x37, 0x15, 0xab, 0x34, 0x20, 0x56, 0xfd, 0x5c, 0x82, 0xf9, 0x2a, 0x75, 0x6a,
+	0x9d, 0x86, 0xef, 0xb2, 0x2a, 0xa2, 0xd4, 0x76, 0x10, 0xfd, 0x7f, 0xb2, 0x71, 0x08, 0xcb, 0xd7,
+	0x3a, 0x49, 0xbb, 0x7f, 0x00, 0x20, 0x6e, 0x03, 0xc1, 0x98, 0xc5, 0xf7, 0xb8, 0xc8, 0x57, 0x2c,
+	0x8c, 0x99, 0xac, 0xc2, 0xac, 0x1f, 0x87, 0x28, 0xb9, 0xd5, 0xfc, 0x7a, 0xd1, 0x4a, 0x6d, 0xfd,
+	0x37, 0x09, 0xde, 0x4e, 0x13, 0x73, 0xdd, 0xdf, 0x11, 0x41, 0x3b, 0x30, 0xc3, 0x39, 0x11, 0x25,
+	0x94, 0x2a, 0xca, 0xb8, 0x7b, 0xb7, 0x3f, 0x1d, 0x6d, 0x69, 0xc5, 0xe8, 0x01, 0x76, 0xf2, 0xe3,
+	0xd9, 0x79, 0x02, 0x4b, 0x57, 0x9a, 0x98, 0xac, 0x8c, 0x89, 0x94, 0x9c, 0xe5, 0x61, 0xb1, 0xaf,
+	0x3c, 0xea, 0x1f, 0x22, 0xe2, 0x1e, 0xb9, 0x88, 0xdc, 0x0d, 0x2f, 0x06, 0x14, 0xf0, 0x49, 0xd4,
+	0x5e, 0x8e, 0xe7, 0x55, 0xfe, 0xf8, 0x65, 0x6b, 0x51, 0xc0, 0x8d, 0x18, 0x56, 0x63, 0xc4, 0x0d,
+	0x1c, 0x4b, 0xc0, 0xae, 0x0d, 0xd4, 0xfc, 0xbf, 0x18, 0xa8, 0xd3, 0xb7, 0x18, 0xa8, 0x85, 0xf1,
+	0x03, 0xf5, 0x33, 0x28, 0xb4, 0x90, 0x67, 0x8b, 0xa1, 0x5b, 0xaa, 0x2c, 0x1b, 0xe2, 0x45, 0x32,
+	0x92, 0x17, 0xc9, 0xf8, 0x22, 0x7e, 0x91, 0xf6, 0x67, 0x23, 0xb6, 0x7e, 0x7e, 0xb5, 0x22, 0x59,
+	0x22, 0xe2, 0xbf, 0x98, 0xc5, 0x10, 0x09, 0x40, 0x90, 0xa2, 0x6b, 0xf0, 0xee, 0xa8, 0x13, 0x4b,
+	0x44, 0xa0, 0xff, 0x2d, 0x71, 0x81, 0xf0, 0x9e, 0x8f, 0x10, 0x79, 0x4c, 0xfd, 0xaf, 0x4e, 0x02,
+	0xd1, 0xc9, 0x9b, 0x71, 0xaa, 0x9f, 0x40, 0x31, 0x40, 0x27, 0x75, 0x11, 0x93, 0xcf, 0x88, 0x99,
+	0x0d, 0xd0, 0x09, 0xaf, 0x7f, 0x88, 0x83, 0x35, 0x58, 0x19, 0xd3, 0x62, 0x4a, 0xc3, 0xef, 0x12,
+	0xdc, 0x1f, 0xe4, 0x29, 0x1e, 0x24, 0x07, 0xcc, 0x7b, 0x33, 0x58, 0x58, 0x81, 0x52, 0x7c, 0x0b,
+	0xeb, 0x8c, 0x79, 0x9c, 0x87, 0x69, 0x0b, 0xfc, 0xb4, 0xca, 0xa1, 0x7e, 0x57, 0x41, 0x1b, 0xdd,
+	0x4b, 0xda, 0xee, 0x8f, 0x62, 0xb6, 0x09, 0xc8, 0x53, 0x9b, 0xd8, 0x3e, 0x95, 0x77, 0xa0, 0x68,
+	0x77, 0x58, 0x1b, 0x47, 0x6f, 0x88, 0x22, 0x65, 0x94, 0xd5, 0x87, 0xca, 0x9f, 0xc2, 0x4c, 0xc8,
+	0x33, 0x28, 0xb9, 0x58, 0xec, 0x23, 0xa4, 0xca, 0x01, 0xe9, 0xfc, 0xe2, 0xd6, 0xee, 0x5c, 0x54,
+	0x72, 0x3f, 0x91, 0xbe, 0x0c, 0x4b, 0x57, 0x6a, 0x4a, 0xea, 0xad, 0xfc, 0x7a, 0x0f, 0xf2, 0x55,
+	0xea, 0xc8, 0x3f, 0x49, 0xa0, 0x65, 0x7c, 0xb6, 0x7d, 0x3c, 0x62, 0xfb, 0xcc, 0x4f, 0x11, 0xf5,
+	0xe1, 0x6d, 0xa2, 0xd2, 0x49, 0x1a, 0x95, 0x95, 0xf1, 0xa1, 0x31, 0xa6, 0xac, 0xc9, 0x51, 0xea,
+	0xc3, 0xdb, 0x44, 0xa5, 0x65, 0xb5, 0x60, 0xee, 0xca, 0x03, 0xff, 0xfe, 0xe8, 0x7c, 0xc3, 0x28,
+	0xf5, 0xc3, 0x9b, 0xa0, 0xd2, 0x5d, 0xbe, 0x85, 0xf2, 0xd0, 0x1b, 0xa9, 0x4f, 0x8a, 0x16, 0x18,
+	0x75, 0x33, 0x1b, 0x93, 0xe6, 0xf7, 0x61, 0xfe, 0xfa, 0x83, 0xf3, 0xc1, 0x44, 0x62, 0xfa, 0x40,
+	0xd5, 0xbc, 0x21, 0x30, 0xdd, 0xae, 0x0b, 0x8b, 0x23, 0x87, 0xe1, 0x98, 0x92, 0x47, 0x61, 0xd5,
+	0xca, 0xcd, 0xb1, 0xe9, 0xbe, 0x14, 0x16, 0x46, 0x4d, 0x9f, 0x8d, 0x8c, 0xfa, 0xfb, 0x50, 0x75,
+	0xfb, 0xc6, 0xd0, 0xc1, 0xb3, 0x1b, 0x9a, 0x01, 0xfa, 0xa4, 0x14, 0x02, 0xa3, 0x6e, 0x66, 0x63,
+	0x92, 0xfc, 0x6a, 0xe1, 0xd9, 0xeb, 0xb3, 0x4d, 0x69, 0xff, 0xc9, 0xf9, 0x85, 0x26, 0x3d, 0xbf,
+	0xd0, 0xa4, 0xbf, 0x2e, 0x34, 0xe9, 0x87, 0x4b, 0x6d, 0xea, 0xf9, 0xa5, 0x36, 0xf5, 0xe2, 0x52,
+	0x9b, 0xfa, 0x66, 0x7b, 0x60, 0x90, 0x26, 0x69, 0x31, 0x71, 0xd2, 0xdf, 0x5b, 0x76, 0x18, 0x9a,
+	0xa7, 0xf1, 0x9f, 0x3f, 0xfe, 0xcf, 0xaf, 0x31, 0xc3, 0x1f, 0xd1, 0x8f, 0xfe, 0x19, 0x00, 0x59,
+	0xaf, 0x83, 0x9c, 0x7e, 0x0e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateInterchainSecurityModule defines the rpc method for creating a new zk ISM.
+	CreateInterchainSecurityModule(ctx context.Context, in *MsgCreateInterchainSecurityModule, opts ...grpc.CallOption) (*MsgCreateInterchainSecurityModuleResponse, error)
+	// UpdateInterchainSecurityModule defines the rpc method for updating an existing zk ISM.
+	UpdateInterchainSecurityModule(ctx context.Context, in *MsgUpdateInterchainSecurityModule, opts ...grpc.CallOption) (*MsgUpdateInterchainSecurityModuleResponse, error)
+	// SubmitMessages defines the rpc method for verifying state membership of messages.
+	SubmitMessages(ctx context.Context, in *MsgSubmitMessages, opts ...grpc.CallOption) (*MsgSubmitMessagesResponse, error)
+	// SubmitProofs defines the rpc method for verifying an ordered batch of state
+	// transition and state membership proofs for a zk ISM.
+	SubmitProofs(ctx context.Context, in *MsgSubmitProofs, opts ...grpc.CallOption) (*MsgSubmitProofsResponse, error)
+	// UpdateIsmVerifier defines the rpc method for updating the verifier keys of
+	// a zk ISM. Only the owner of the ISM can update its verifier keys.
+	UpdateIsmVerifier(ctx context.Context, in *MsgUpdateIsmVerifier, opts ...grpc.CallOption) (*MsgUpdateIsmVerifierResponse, error)
+	// TransferIsmOwnership defines the rpc method for transferring the ownership
+	// of a zk ISM. Only the owner of the ISM can transfer its ownership.
+	TransferIsmOwnership(ctx context.Context, in *MsgTransferIsmOwnership, opts ...grpc.CallOption) (*MsgTransferIsmOwnershipResponse, error)
+	// UpdateIsmMessageTtl defines the rpc method for setting the number of blocks
+	// authorized message ids of a zk ISM remain valid. Only the owner of the ISM
+	// can set its message ttl.
+	UpdateIsmMessageTtl(ctx context.Context, in *MsgUpdateIsmMessageTtl, opts ...grpc.CallOption) (*MsgUpdateIsmMessageTtlResponse, error)
+	// UpdateParams defines the rpc method for updating the module parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateInterchainSecurityModule(ctx context.Context, in *MsgCreateInterchainSecurityModule, opts ...grpc.CallOption) (*MsgCreateInterchainSecurityModuleResponse, error) {
+	out := new(MsgCreateInterchainSecurityModuleResponse)
+	err := c.cc.Invoke(ctx, "/celestia.zkism.v1.Msg/CreateInterchainSecurityModule", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.State)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateInterchainSecurityModule(ctx context.Context, in *MsgUpdateInterchainSecurityModule, opts ...grpc.CallOption) (*MsgUpdateInterchainSecurityModuleResponse, error) {
+	out := new(MsgUpdateInterchainSecurityModuleResponse)
+	err := c.cc.Invoke(ctx, "/celestia.zkism.v1.Msg/UpdateInterchainSecurityModule", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgSubmitMessages) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SubmitMessages(ctx context.Context, in *MsgSubmitMessages, opts ...grpc.CallOption) (*MsgSubmitMessagesResponse, error) {
+	out := new(MsgSubmitMessagesResponse)
+	err := c.cc.Invoke(ctx, "/celestia.zkism.v1.Msg/SubmitMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Id.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Proof)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SubmitProofs(ctx context.Context, in *MsgSubmitProofs, opts ...grpc.CallOption) (*MsgSubmitProofsResponse, error) {
+	out := new(MsgSubmitProofsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.zkism.v1.Msg/SubmitProofs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.PublicValues)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateIsmVerifier(ctx context.Context, in *MsgUpdateIsmVerifier, opts ...grpc.CallOption) (*MsgUpdateIsmVerifierResponse, error) {
+	out := new(MsgUpdateIsmVerifierResponse)
+	err := c.cc.Invoke(ctx, "/celestia.zkism.v1.Msg/UpdateIsmVerifier", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) TransferIsmOwnership(ctx context.Context, in *MsgTransferIsmOwnership, opts ...grpc.CallOption) (*MsgTransferIsmOwnershipResponse, error) {
+	out := new(MsgTransferIsmOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/celestia.zkism.v1.Msg/TransferIsmOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgSubmitMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) UpdateIsmMessageTtl(ctx context.Context, in *MsgUpdateIsmMessageTtl, opts ...grpc.CallOption) (*MsgUpdateIsmMessageTtlResponse, error) {
+	out := new(MsgUpdateIsmMessageTtlResponse)
+	err := c.cc.Invoke(ctx, "/celestia.zkism.v1.Msg/UpdateIsmMessageTtl", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.StateRoot)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.zkism.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Messages) > 0 {
-		for _, s := range m.Messages {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateInterchainSecurityModule defines the rpc method for creating a new zk ISM.
+	CreateInterchainSecurityModule(context.Context, *MsgCreateInterchainSecurityModule) (*MsgCreateInterchainSecurityModuleResponse, error)
+	// UpdateInterchainSecurityModule defines the rpc method for updating an existing zk ISM.
+	UpdateInterchainSecurityModule(context.Context, *MsgUpdateInterchainSecurityModule) (*MsgUpdateInterchainSecurityModuleResponse, error)
+	// SubmitMessages defines the rpc method for verifying state membership of messages.
+	SubmitMessages(context.Context, *MsgSubmitMessages) (*MsgSubmitMessagesResponse, error)
+	// SubmitProofs defines the rpc method for verifying an ordered batch of state
+	// transition and state membership proofs for a zk ISM.
+	SubmitProofs(context.Context, *MsgSubmitProofs) (*MsgSubmitProofsResponse, error)
+	// UpdateIsmVerifier defines the rpc method for updating the verifier keys of
+	// a zk ISM. Only the owner of the ISM can update its verifier keys.
+	UpdateIsmVerifier(context.Context, *MsgUpdateIsmVerifier) (*MsgUpdateIsmVerifierResponse, error)
+	// TransferIsmOwnership defines the rpc method for transferring the ownership
+	// of a zk ISM. Only the owner of the ISM can transfer its ownership.
+	TransferIsmOwnership(context.Context, *MsgTransferIsmOwnership) (*MsgTransferIsmOwnershipResponse, error)
+	// UpdateIsmMessageTtl defines the rpc method for setting the number of blocks
+	// authorized message ids of a zk ISM remain valid. Only the owner of the ISM
+	// can set its message ttl.
+	UpdateIsmMessageTtl(context.Context, *MsgUpdateIsmMessageTtl) (*MsgUpdateIsmMessageTtlResponse, error)
+	// UpdateParams defines the rpc method for updating the module parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateInterchainSecurityModule(ctx context.Context, req *MsgCreateInterchainSecurityModule) (*MsgCreateInterchainSecurityModuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInterchainSecurityModule not implemented")
+}
+func (*UnimplementedMsgServer) UpdateInterchainSecurityModule(ctx context.Context, req *MsgUpdateInterchainSecurityModule) (*MsgUpdateInterchainSecurityModuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInterchainSecurityModule not implemented")
+}
+func (*UnimplementedMsgServer) SubmitMessages(ctx context.Context, req *MsgSubmitMessages) (*MsgSubmitMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitMessages not implemented")
+}
+func (*UnimplementedMsgServer) SubmitProofs(ctx context.Context, req *MsgSubmitProofs) (*MsgSubmitProofsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitProofs not implemented")
+}
+func (*UnimplementedMsgServer) UpdateIsmVerifier(ctx context.Context, req *MsgUpdateIsmVerifier) (*MsgUpdateIsmVerifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateIsmVerifier not implemented")
+}
+func (*UnimplementedMsgServer) TransferIsmOwnership(ctx context.Context, req *MsgTransferIsmOwnership) (*MsgTransferIsmOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferIsmOwnership not implemented")
+}
+func (*UnimplementedMsgServer) UpdateIsmMessageTtl(ctx context.Context, req *MsgUpdateIsmMessageTtl) (*MsgUpdateIsmMessageTtlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateIsmMessageTtl not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateInterchainSecurityModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateInterchainSecurityModule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateInterchainSecurityModule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.zkism.v1.Msg/CreateInterchainSecurityModule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateInterchainSecurityModule(ctx, req.(*MsgCreateInterchainSecurityModule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateInterchainSecurityModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateInterchainSecurityModule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateInterchainSecurityModule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.zkism.v1.Msg/UpdateInterchainSecurityModule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateInterchainSecurityModule(ctx, req.(*MsgUpdateInterchainSecurityModule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitMessages)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.zkism.v1.Msg/SubmitMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitMessages(ctx, req.(*MsgSubmitMessages))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitProofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitProofs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitProofs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.zkism.v1.Msg/SubmitProofs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitProofs(ctx, req.(*MsgSubmitProofs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateIsmVerifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateIsmVerifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateIsmVerifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.zkism.v1.Msg/UpdateIsmVerifier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateIsmVerifier(ctx, req.(*MsgUpdateIsmVerifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferIsmOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferIsmOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferIsmOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.zkism.v1.Msg/TransferIsmOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferIsmOwnership(ctx, req.(*MsgTransferIsmOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateIsmMessageTtl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateIsmMessageTtl)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateIsmMessageTtl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.zkism.v1.Msg/UpdateIsmMessageTtl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateIsmMessageTtl(ctx, req.(*MsgUpdateIsmMessageTtl))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.zkism.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.zkism.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateInterchainSecurityModule",
+			Handler:    _Msg_CreateInterchainSecurityModule_Handler,
+		},
+		{
+			MethodName: "UpdateInterchainSecurityModule",
+			Handler:    _Msg_UpdateInterchainSecurityModule_Handler,
+		},
+		{
+			MethodName: "SubmitMessages",
+			Handler:    _Msg_SubmitMessages_Handler,
+		},
+		{
+			MethodName: "SubmitProofs",
+			Handler:    _Msg_SubmitProofs_Handler,
+		},
+		{
+			MethodName: "UpdateIsmVerifier",
+			Handler:    _Msg_UpdateIsmVerifier_Handler,
+		},
+		{
+			MethodName: "TransferIsmOwnership",
+			Handler:    _Msg_TransferIsmOwnership_Handler,
+		},
+		{
+			MethodName: "UpdateIsmMessageTtl",
+			Handler:    _Msg_UpdateIsmMessageTtl_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/zkism/v1/tx.proto",
+}
+
+func (m *MsgCreateInterchainSecurityModule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateInterchainSecurityModule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateInterchainSecurityModule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProofSystem != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProofSystem))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.StateMembershipVkey) > 0 {
+		i -= len(m.StateMembershipVkey)
+		copy(dAtA[i:], m.StateMembershipVkey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StateMembershipVkey)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.StateTransitionVkey) > 0 {
+		i -= len(m.StateTransitionVkey)
+		copy(dAtA[i:], m.StateTransitionVkey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StateTransitionVkey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Groth16Vkey) > 0 {
+		i -= len(m.Groth16Vkey)
+		copy(dAtA[i:], m.Groth16Vkey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Groth16Vkey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MerkleTreeAddress) > 0 {
+		i -= len(m.MerkleTreeAddress)
+		copy(dAtA[i:], m.MerkleTreeAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MerkleTreeAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateInterchainSecurityModuleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateInterchainSecurityModuleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateInterchainSecurityModuleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateInterchainSecurityModule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateInterchainSecurityModule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateInterchainSecurityModule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PublicValues) > 0 {
+		i -= len(m.PublicValues)
+		copy(dAtA[i:], m.PublicValues)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicValues)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateInterchainSecurityModuleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateInterchainSecurityModuleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateInterchainSecurityModuleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PublicValues) > 0 {
+		i -= len(m.PublicValues)
+		copy(dAtA[i:], m.PublicValues)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicValues)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Messages[iNdEx])
+			copy(dAtA[i:], m.Messages[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Messages[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitProofs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitProofs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitProofs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitProofsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitProofsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitProofsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Messages[iNdEx])
+			copy(dAtA[i:], m.Messages[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Messages[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateIsmVerifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateIsmVerifier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateIsmVerifier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProofSystem != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProofSystem))
+		i--
+		dAtA[i] = 0x38
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Delay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Delay):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if len(m.StateMembershipVkey) > 0 {
+		i -= len(m.StateMembershipVkey)
+		copy(dAtA[i:], m.StateMembershipVkey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StateMembershipVkey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StateTransitionVkey) > 0 {
+		i -= len(m.StateTransitionVkey)
+		copy(dAtA[i:], m.StateTransitionVkey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StateTransitionVkey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Groth16Vkey) > 0 {
+		i -= len(m.Groth16Vkey)
+		copy(dAtA[i:], m.Groth16Vkey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Groth16Vkey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateIsmVerifierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateIsmVerifierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateIsmVerifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferIsmOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferIsmOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferIsmOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferIsmOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferIsmOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferIsmOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateIsmMessageTtl) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateIsmMessageTtl) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateIsmMessageTtl) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MessageTtl != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MessageTtl))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateIsmMessageTtlResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateIsmMessageTtlResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateIsmMessageTtlResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateInterchainSecurityModule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MerkleTreeAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Groth16Vkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StateTransitionVkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StateMembershipVkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProofSystem != 0 {
+		n += 1 + sovTx(uint64(m.ProofSystem))
+	}
+	return n
+}
+
+func (m *MsgCreateInterchainSecurityModuleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateInterchainSecurityModule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PublicValues)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateInterchainSecurityModuleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PublicValues)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, s := range m.Messages {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitProofs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Proofs) > 0 {
+		for _, e := range m.Proofs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitProofsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, s := range m.Messages {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateIsmVerifier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Groth16Vkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StateTransitionVkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StateMembershipVkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Delay)
+	n += 1 + l + sovTx(uint64(l))
+	if m.ProofSystem != 0 {
+		n += 1 + sovTx(uint64(m.ProofSystem))
+	}
+	return n
+}
+
+func (m *MsgUpdateIsmVerifierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferIsmOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferIsmOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateIsmMessageTtl) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MessageTtl != 0 {
+		n += 1 + sovTx(uint64(m.MessageTtl))
+	}
+	return n
+}
+
+func (m *MsgUpdateIsmMessageTtlResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateInterchainSecurityModule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateInterchainSecurityModule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = append(m.State[:0], dAtA[iNdEx:postIndex]...)
+			if m.State == nil {
+				m.State = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleTreeAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleTreeAddress = append(m.MerkleTreeAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleTreeAddress == nil {
+				m.MerkleTreeAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groth16Vkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groth16Vkey = append(m.Groth16Vkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.Groth16Vkey == nil {
+				m.Groth16Vkey = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateTransitionVkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateTransitionVkey = append(m.StateTransitionVkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateTransitionVkey == nil {
+				m.StateTransitionVkey = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateMembershipVkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateMembershipVkey = append(m.StateMembershipVkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateMembershipVkey == nil {
+				m.StateMembershipVkey = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofSystem", wireType)
+			}
+			m.ProofSystem = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofSystem |= ProofSystem(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateInterchainSecurityModuleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateInterchainSecurityModuleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateInterchainSecurityModuleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateInterchainSecurityModule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateInterchainSecurityModule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicValues", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicValues = append(m.PublicValues[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicValues == nil {
+				m.PublicValues = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateInterchainSecurityModuleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateInterchainSecurityModuleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateInterchainSecurityModuleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = append(m.State[:0], dAtA[iNdEx:postIndex]...)
+			if m.State == nil {
+				m.State = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicValues", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicValues = append(m.PublicValues[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicValues == nil {
+				m.PublicValues = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitProofs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitProofs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitProofs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proofs = append(m.Proofs, Proof{})
+			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitProofsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1051,15 +3460,49 @@ func (m *MsgCreateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateInterchainSecurityModule: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitProofsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateInterchainSecurityModule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitProofsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = append(m.State[:0], dAtA[iNdEx:postIndex]...)
+			if m.State == nil {
+				m.State = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1087,13 +3530,63 @@ func (m *MsgCreateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Messages = append(m.Messages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateIsmVerifier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateIsmVerifier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateIsmVerifier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1103,31 +3596,31 @@ func (m *MsgCreateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = append(m.State[:0], dAtA[iNdEx:postIndex]...)
-			if m.State == nil {
-				m.State = []byte{}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MerkleTreeAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1137,27 +3630,25 @@ func (m *MsgCreateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MerkleTreeAddress = append(m.MerkleTreeAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.MerkleTreeAddress == nil {
-				m.MerkleTreeAddress = []byte{}
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Groth16Vkey", wireType)
 			}
@@ -1191,7 +3682,7 @@ func (m *MsgCreateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
 				m.Groth16Vkey = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StateTransitionVkey", wireType)
 			}
@@ -1225,7 +3716,7 @@ func (m *MsgCreateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
 				m.StateTransitionVkey = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StateMembershipVkey", wireType)
 			}
@@ -1259,6 +3750,58 @@ func (m *MsgCreateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
 				m.StateMembershipVkey = []byte{}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Delay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofSystem", wireType)
+			}
+			m.ProofSystem = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofSystem |= ProofSystem(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1280,7 +3823,7 @@ func (m *MsgCreateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateInterchainSecurityModuleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateIsmVerifierResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1301,48 +3844,14 @@ func (m *MsgCreateInterchainSecurityModuleResponse) Unmarshal(dAtA []byte) error
 			}
 		}
 		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateInterchainSecurityModuleResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateInterchainSecurityModuleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateIsmVerifierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateIsmVerifierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1364,7 +3873,7 @@ func (m *MsgCreateInterchainSecurityModuleResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *MsgUpdateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferIsmOwnership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1387,10 +3896,10 @@ func (m *MsgUpdateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateInterchainSecurityModule: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferIsmOwnership: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateInterchainSecurityModule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferIsmOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1429,9 +3938,9 @@ func (m *MsgUpdateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1441,63 +3950,27 @@ func (m *MsgUpdateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
-			if m.Proof == nil {
-				m.Proof = []byte{}
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicValues", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicValues = append(m.PublicValues[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicValues == nil {
-				m.PublicValues = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1525,7 +3998,7 @@ func (m *MsgUpdateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1548,7 +4021,7 @@ func (m *MsgUpdateInterchainSecurityModule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateInterchainSecurityModuleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferIsmOwnershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1571,46 +4044,12 @@ func (m *MsgUpdateInterchainSecurityModuleResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateInterchainSecurityModuleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferIsmOwnershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateInterchainSecurityModuleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferIsmOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.State = append(m.State[:0], dAtA[iNdEx:postIndex]...)
-			if m.State == nil {
-				m.State = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1632,7 +4071,7 @@ func (m *MsgUpdateInterchainSecurityModuleResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *MsgSubmitMessages) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateIsmMessageTtl) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1655,10 +4094,10 @@ func (m *MsgSubmitMessages) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitMessages: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateIsmMessageTtl: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateIsmMessageTtl: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1697,9 +4136,9 @@ func (m *MsgSubmitMessages) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1709,31 +4148,29 @@ func (m *MsgSubmitMessages) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
-			if m.Proof == nil {
-				m.Proof = []byte{}
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicValues", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageTtl", wireType)
 			}
-			var byteLen int
+			m.MessageTtl = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1743,58 +4180,61 @@ func (m *MsgSubmitMessages) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.MessageTtl |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicValues = append(m.PublicValues[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicValues == nil {
-				m.PublicValues = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateIsmMessageTtlResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateIsmMessageTtlResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateIsmMessageTtlResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1816,7 +4256,7 @@ func (m *MsgSubmitMessages) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1839,15 +4279,15 @@ func (m *MsgSubmitMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1875,13 +4315,13 @@ func (m *MsgSubmitMessagesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StateRoot = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1891,23 +4331,24 @@ func (m *MsgSubmitMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, string(dAtA[iNdEx:postIndex]))
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1930,6 +4371,56 @@ func (m *MsgSubmitMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
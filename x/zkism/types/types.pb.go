@@ -9,15 +9,19 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,6 +29,77 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ProofSystem defines the proof system, i.e. the proving scheme and proof
+// encoding, used to verify proofs submitted to an ISM.
+type ProofSystem int32
+
+const (
+	// PROOF_SYSTEM_SP1_GROTH16 verifies SP1 Groth16 proofs over BN254. It is the
+	// default for backwards compatibility with ISMs created before proof systems
+	// were introduced.
+	ProofSystem_PROOF_SYSTEM_SP1_GROTH16 ProofSystem = 0
+	// PROOF_SYSTEM_SP1_PLONK is reserved for SP1 PLONK proofs over BN254. It is
+	// not accepted until a verifier is tested against fixtures produced by the
+	// SP1 SDK.
+	ProofSystem_PROOF_SYSTEM_SP1_PLONK ProofSystem = 1
+	// PROOF_SYSTEM_GROTH16 verifies gnark Groth16 proofs over BN254. The public
+	// witness must be the program verifying key commitment followed by the high
+	// and low 128 bits of the SHA-256 digest of the public values.
+	ProofSystem_PROOF_SYSTEM_GROTH16 ProofSystem = 2
+)
+
+var ProofSystem_name = map[int32]string{
+	0: "PROOF_SYSTEM_SP1_GROTH16",
+	1: "PROOF_SYSTEM_SP1_PLONK",
+	2: "PROOF_SYSTEM_GROTH16",
+}
+
+var ProofSystem_value = map[string]int32{
+	"PROOF_SYSTEM_SP1_GROTH16": 0,
+	"PROOF_SYSTEM_SP1_PLONK":   1,
+	"PROOF_SYSTEM_GROTH16":     2,
+}
+
+func (x ProofSystem) String() string {
+	return proto.EnumName(ProofSystem_name, int32(x))
+}
+
+func (ProofSystem) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6a9c62eeaf7a9e81, []int{0}
+}
+
+// ProofType defines the statement proven by a zk proof.
+type ProofType int32
+
+const (
+	// PROOF_TYPE_UNSPECIFIED is the default value.
+	ProofType_PROOF_TYPE_UNSPECIFIED ProofType = 0
+	// PROOF_TYPE_STATE_TRANSITION proves a transition of the trusted state.
+	ProofType_PROOF_TYPE_STATE_TRANSITION ProofType = 1
+	// PROOF_TYPE_STATE_MEMBERSHIP proves the membership of message ids in the trusted state.
+	ProofType_PROOF_TYPE_STATE_MEMBERSHIP ProofType = 2
+)
+
+var ProofType_name = map[int32]string{
+	0: "PROOF_TYPE_UNSPECIFIED",
+	1: "PROOF_TYPE_STATE_TRANSITION",
+	2: "PROOF_TYPE_STATE_MEMBERSHIP",
+}
+
+var ProofType_value = map[string]int32{
+	"PROOF_TYPE_UNSPECIFIED":      0,
+	"PROOF_TYPE_STATE_TRANSITION": 1,
+	"PROOF_TYPE_STATE_MEMBERSHIP": 2,
+}
+
+func (x ProofType) String() string {
+	return proto.EnumName(ProofType_name, int32(x))
+}
+
+func (ProofType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6a9c62eeaf7a9e81, []int{1}
+}
+
 // InterchainSecurityModule defines the Hyperlane interchain security module (ISM).
 type InterchainSecurityModule struct {
 	// unique hyperlane identifier
@@ -35,12 +110,20 @@ type InterchainSecurityModule struct {
 	State []byte `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
 	// merkle tree address in bytes32 format
 	MerkleTreeAddress []byte `protobuf:"bytes,4,opt,name=merkle_tree_address,json=merkleTreeAddress,proto3" json:"merkle_tree_address,omitempty"`
-	// the sp1 groth16 verifier key
+	// the verifier key of the proof system. Despite its name, this is not
+	// necessarily a groth16 verifier key.
 	Groth16Vkey []byte `protobuf:"bytes,5,opt,name=groth16_vkey,json=groth16Vkey,proto3" json:"groth16_vkey,omitempty"`
 	// hash-based commitment to the verifier key used for state transition
 	StateTransitionVkey []byte `protobuf:"bytes,6,opt,name=state_transition_vkey,json=stateTransitionVkey,proto3" json:"state_transition_vkey,omitempty"`
 	// hash-based commitment to the verifier key used for state membership
 	StateMembershipVkey []byte `protobuf:"bytes,7,opt,name=state_membership_vkey,json=stateMembershipVkey,proto3" json:"state_membership_vkey,omitempty"`
+	// verifier key update scheduled by the owner which has not been applied yet
+	PendingVerifierUpdate *PendingVerifierUpdate `protobuf:"bytes,8,opt,name=pending_verifier_update,json=pendingVerifierUpdate,proto3" json:"pending_verifier_update,omitempty"`
+	// number of blocks authorized message ids remain valid, set by the owner. If
+	// zero, the message_ttl module parameter is used.
+	MessageTtl uint64 `protobuf:"varint,9,opt,name=message_ttl,json=messageTtl,proto3" json:"message_ttl,omitempty"`
+	// the proof system used to verify proofs submitted to the ism
+	ProofSystem ProofSystem `protobuf:"varint,10,opt,name=proof_system,json=proofSystem,proto3,enum=celestia.zkism.v1.ProofSystem" json:"proof_system,omitempty"`
 }
 
 func (m *InterchainSecurityModule) Reset()         { *m = InterchainSecurityModule{} }
@@ -76,40 +159,366 @@ func (m *InterchainSecurityModule) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_InterchainSecurityModule proto.InternalMessageInfo
 
+// Params defines the zkism module parameters.
+type Params struct {
+	// number of blocks authorized message ids remain valid before they are
+	// pruned. It is also the maximum message ttl an ism owner can set. If zero,
+	// message ids without an ism specific ttl never expire.
+	MessageTtl uint64 `protobuf:"varint,1,opt,name=message_ttl,json=messageTtl,proto3" json:"message_ttl,omitempty"`
+	// gas consumed for verifying a single groth16 proof. Applies to the
+	// PROOF_SYSTEM_SP1_GROTH16 and PROOF_SYSTEM_GROTH16 proof systems.
+	Groth16VerifyCost uint64 `protobuf:"varint,2,opt,name=groth16_verify_cost,json=groth16VerifyCost,proto3" json:"groth16_verify_cost,omitempty"`
+	// gas consumed for verifying a single plonk proof. Applies to the
+	// PROOF_SYSTEM_SP1_PLONK proof system once it is accepted.
+	PlonkVerifyCost uint64 `protobuf:"varint,3,opt,name=plonk_verify_cost,json=plonkVerifyCost,proto3" json:"plonk_verify_cost,omitempty"`
+	// gas consumed per byte of public values of a verified proof.
+	PublicValuesCostPerByte uint64 `protobuf:"varint,4,opt,name=public_values_cost_per_byte,json=publicValuesCostPerByte,proto3" json:"public_values_cost_per_byte,omitempty"`
+	// gas consumed per message id authorized by a state membership proof.
+	MessageIdCost uint64 `protobuf:"varint,5,opt,name=message_id_cost,json=messageIdCost,proto3" json:"message_id_cost,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a9c62eeaf7a9e81, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMessageTtl() uint64 {
+	if m != nil {
+		return m.MessageTtl
+	}
+	return 0
+}
+
+func (m *Params) GetGroth16VerifyCost() uint64 {
+	if m != nil {
+		return m.Groth16VerifyCost
+	}
+	return 0
+}
+
+func (m *Params) GetPlonkVerifyCost() uint64 {
+	if m != nil {
+		return m.PlonkVerifyCost
+	}
+	return 0
+}
+
+func (m *Params) GetPublicValuesCostPerByte() uint64 {
+	if m != nil {
+		return m.PublicValuesCostPerByte
+	}
+	return 0
+}
+
+func (m *Params) GetMessageIdCost() uint64 {
+	if m != nil {
+		return m.MessageIdCost
+	}
+	return 0
+}
+
+// MessageAuthorization records when a message id was authorized and when it
+// expires.
+type MessageAuthorization struct {
+	// height at which the message id was authorized
+	AuthorizedHeight uint64 `protobuf:"varint,1,opt,name=authorized_height,json=authorizedHeight,proto3" json:"authorized_height,omitempty"`
+	// height from which the message id is pruned. Zero if it never expires.
+	ExpiryHeight uint64 `protobuf:"varint,2,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *MessageAuthorization) Reset()         { *m = MessageAuthorization{} }
+func (m *MessageAuthorization) String() string { return proto.CompactTextString(m) }
+func (*MessageAuthorization) ProtoMessage()    {}
+func (*MessageAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a9c62eeaf7a9e81, []int{2}
+}
+func (m *MessageAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageAuthorization.Merge(m, src)
+}
+func (m *MessageAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageAuthorization proto.InternalMessageInfo
+
+func (m *MessageAuthorization) GetAuthorizedHeight() uint64 {
+	if m != nil {
+		return m.AuthorizedHeight
+	}
+	return 0
+}
+
+func (m *MessageAuthorization) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+// PendingVerifierUpdate defines a verifier key update of an ISM that is
+// applied once its activation time is reached.
+type PendingVerifierUpdate struct {
+	// the new verifier key of the proof system
+	Groth16Vkey []byte `protobuf:"bytes,1,opt,name=groth16_vkey,json=groth16Vkey,proto3" json:"groth16_vkey,omitempty"`
+	// the new hash-based commitment to the verifier key used for state transition
+	StateTransitionVkey []byte `protobuf:"bytes,2,opt,name=state_transition_vkey,json=stateTransitionVkey,proto3" json:"state_transition_vkey,omitempty"`
+	// the new hash-based commitment to the verifier key used for state membership
+	StateMembershipVkey []byte `protobuf:"bytes,3,opt,name=state_membership_vkey,json=stateMembershipVkey,proto3" json:"state_membership_vkey,omitempty"`
+	// the time from which the update is applied
+	ActivationTime time.Time `protobuf:"bytes,4,opt,name=activation_time,json=activationTime,proto3,stdtime" json:"activation_time"`
+	// the new proof system
+	ProofSystem ProofSystem `protobuf:"varint,5,opt,name=proof_system,json=proofSystem,proto3,enum=celestia.zkism.v1.ProofSystem" json:"proof_system,omitempty"`
+}
+
+func (m *PendingVerifierUpdate) Reset()         { *m = PendingVerifierUpdate{} }
+func (m *PendingVerifierUpdate) String() string { return proto.CompactTextString(m) }
+func (*PendingVerifierUpdate) ProtoMessage()    {}
+func (*PendingVerifierUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a9c62eeaf7a9e81, []int{3}
+}
+func (m *PendingVerifierUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingVerifierUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingVerifierUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingVerifierUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingVerifierUpdate.Merge(m, src)
+}
+func (m *PendingVerifierUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingVerifierUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingVerifierUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingVerifierUpdate proto.InternalMessageInfo
+
+func (m *PendingVerifierUpdate) GetGroth16Vkey() []byte {
+	if m != nil {
+		return m.Groth16Vkey
+	}
+	return nil
+}
+
+func (m *PendingVerifierUpdate) GetStateTransitionVkey() []byte {
+	if m != nil {
+		return m.StateTransitionVkey
+	}
+	return nil
+}
+
+func (m *PendingVerifierUpdate) GetStateMembershipVkey() []byte {
+	if m != nil {
+		return m.StateMembershipVkey
+	}
+	return nil
+}
+
+func (m *PendingVerifierUpdate) GetActivationTime() time.Time {
+	if m != nil {
+		return m.ActivationTime
+	}
+	return time.Time{}
+}
+
+func (m *PendingVerifierUpdate) GetProofSystem() ProofSystem {
+	if m != nil {
+		return m.ProofSystem
+	}
+	return ProofSystem_PROOF_SYSTEM_SP1_GROTH16
+}
+
+// Proof is a zk proof along with the public values used for its verification.
+type Proof struct {
+	// type is the statement proven by the proof.
+	Type ProofType `protobuf:"varint,1,opt,name=type,proto3,enum=celestia.zkism.v1.ProofType" json:"type,omitempty"`
+	// proof is the ZK proof bytes, encoded according to the proof system of the ism.
+	Proof []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	// the public values used for proof verification.
+	PublicValues []byte `protobuf:"bytes,3,opt,name=public_values,json=publicValues,proto3" json:"public_values,omitempty"`
+}
+
+func (m *Proof) Reset()         { *m = Proof{} }
+func (m *Proof) String() string { return proto.CompactTextString(m) }
+func (*Proof) ProtoMessage()    {}
+func (*Proof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a9c62eeaf7a9e81, []int{4}
+}
+func (m *Proof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Proof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Proof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Proof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Proof.Merge(m, src)
+}
+func (m *Proof) XXX_Size() int {
+	return m.Size()
+}
+func (m *Proof) XXX_DiscardUnknown() {
+	xxx_messageInfo_Proof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Proof proto.InternalMessageInfo
+
+func (m *Proof) GetType() ProofType {
+	if m != nil {
+		return m.Type
+	}
+	return ProofType_PROOF_TYPE_UNSPECIFIED
+}
+
+func (m *Proof) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *Proof) GetPublicValues() []byte {
+	if m != nil {
+		return m.PublicValues
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("celestia.zkism.v1.ProofSystem", ProofSystem_name, ProofSystem_value)
+	proto.RegisterEnum("celestia.zkism.v1.ProofType", ProofType_name, ProofType_value)
 	proto.RegisterType((*InterchainSecurityModule)(nil), "celestia.zkism.v1.InterchainSecurityModule")
+	proto.RegisterType((*Params)(nil), "celestia.zkism.v1.Params")
+	proto.RegisterType((*MessageAuthorization)(nil), "celestia.zkism.v1.MessageAuthorization")
+	proto.RegisterType((*PendingVerifierUpdate)(nil), "celestia.zkism.v1.PendingVerifierUpdate")
+	proto.RegisterType((*Proof)(nil), "celestia.zkism.v1.Proof")
 }
 
 func init() { proto.RegisterFile("celestia/zkism/v1/types.proto", fileDescriptor_6a9c62eeaf7a9e81) }
 
 var fileDescriptor_6a9c62eeaf7a9e81 = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x92, 0xbf, 0x8e, 0xd3, 0x40,
-	0x10, 0xc6, 0x6d, 0x73, 0x39, 0xc4, 0x72, 0xcd, 0xf9, 0x82, 0x64, 0x4e, 0xc2, 0x39, 0xa8, 0xae,
-	0xb1, 0x57, 0x06, 0x89, 0x02, 0x2a, 0x8e, 0x06, 0x84, 0xd2, 0x24, 0x11, 0x05, 0x8d, 0xb5, 0xb6,
-	0x47, 0xf6, 0xca, 0xf6, 0xae, 0xb5, 0xbb, 0x36, 0x31, 0x4f, 0x40, 0xc9, 0x23, 0xf0, 0x10, 0x3c,
-	0x44, 0xca, 0x88, 0x0a, 0x51, 0x44, 0x28, 0x79, 0x07, 0x6a, 0x94, 0x5d, 0x27, 0x0a, 0xdd, 0xcc,
-	0x7c, 0xbf, 0x6f, 0xf6, 0xcf, 0x0c, 0x7a, 0x92, 0x42, 0x05, 0x52, 0x51, 0x82, 0xbf, 0x94, 0x54,
-	0xd6, 0xb8, 0x8b, 0xb0, 0xea, 0x1b, 0x90, 0x61, 0x23, 0xb8, 0xe2, 0xee, 0xe5, 0x41, 0x0e, 0xb5,
-	0x1c, 0x76, 0xd1, 0xf5, 0xe3, 0x94, 0xcb, 0x9a, 0xcb, 0x58, 0x03, 0xd8, 0x24, 0x86, 0xbe, 0x1e,
-	0xe7, 0x3c, 0xe7, 0xa6, 0xbe, 0x8f, 0x4c, 0xf5, 0xd9, 0x5f, 0x07, 0x79, 0xef, 0x99, 0x02, 0x91,
-	0x16, 0x84, 0xb2, 0x39, 0xa4, 0xad, 0xa0, 0xaa, 0x9f, 0xf2, 0xac, 0xad, 0xc0, 0x9d, 0x23, 0x87,
-	0x66, 0x9e, 0x7d, 0x63, 0xdf, 0x3e, 0xb8, 0x7b, 0xbb, 0xda, 0x4c, 0xac, 0xdf, 0x9b, 0xc9, 0xeb,
-	0x9c, 0xaa, 0xa2, 0x4d, 0xc2, 0x94, 0xd7, 0x38, 0x49, 0x9b, 0x80, 0x32, 0xc6, 0x3b, 0xa2, 0x28,
-	0x67, 0x12, 0x17, 0x7d, 0x03, 0xa2, 0x22, 0x0c, 0x02, 0x73, 0x32, 0x6e, 0x15, 0xad, 0xc2, 0x77,
-	0xb0, 0x7c, 0x93, 0x65, 0x02, 0xa4, 0x9c, 0x39, 0x34, 0x73, 0x43, 0x34, 0xe2, 0x9f, 0x19, 0x08,
-	0xcf, 0xd1, 0x7d, 0xbd, 0x9f, 0x3f, 0x82, 0xf1, 0x70, 0xd1, 0x01, 0x9b, 0x2b, 0x41, 0x59, 0x3e,
-	0x33, 0x98, 0x3b, 0x46, 0x23, 0xa9, 0x88, 0x02, 0xef, 0xde, 0x8d, 0x7d, 0x7b, 0x31, 0x33, 0x89,
-	0x1b, 0xa2, 0xab, 0x1a, 0x44, 0x59, 0x41, 0xac, 0x04, 0x40, 0x4c, 0x8c, 0xd3, 0x3b, 0xd3, 0xcc,
-	0xa5, 0x91, 0x16, 0x02, 0x60, 0x68, 0xe9, 0x3e, 0x45, 0x17, 0xb9, 0xe0, 0xaa, 0x88, 0x5e, 0xc6,
-	0x5d, 0x09, 0xbd, 0x37, 0xd2, 0xe0, 0xc3, 0xa1, 0xf6, 0xb1, 0x84, 0xde, 0x7d, 0x8e, 0x1e, 0xe9,
-	0xde, 0xb1, 0x12, 0x84, 0x49, 0xba, 0x7f, 0x92, 0x61, 0xcf, 0x35, 0x7b, 0xa5, 0xc5, 0xc5, 0x51,
-	0xfb, 0xdf, 0x53, 0x43, 0x9d, 0x80, 0x90, 0x05, 0x6d, 0x8c, 0xe7, 0xfe, 0x89, 0x67, 0x7a, 0xd4,
-	0xf6, 0x9e, 0x57, 0x67, 0x5f, 0xbf, 0x4f, 0xac, 0xbb, 0x0f, 0xab, 0xad, 0x6f, 0xaf, 0xb7, 0xbe,
-	0xfd, 0x67, 0xeb, 0xdb, 0xdf, 0x76, 0xbe, 0xb5, 0xde, 0xf9, 0xd6, 0xaf, 0x9d, 0x6f, 0x7d, 0x8a,
-	0x4e, 0x7e, 0xf8, 0x30, 0x61, 0x2e, 0xf2, 0x63, 0x1c, 0x90, 0xa6, 0xc1, 0xcb, 0x61, 0x25, 0xf4,
-	0x3e, 0x24, 0xe7, 0x7a, 0x98, 0x2f, 0xfe, 0x05, 0x00, 0x00, 0xff, 0xff, 0xac, 0x5e, 0x83, 0x97,
-	0x31, 0x02, 0x00, 0x00,
+	// 907 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xc1, 0x72, 0xda, 0x46,
+	0x18, 0xc7, 0x11, 0x06, 0x37, 0x5e, 0x88, 0x0d, 0x32, 0x6e, 0x54, 0x27, 0x05, 0x4a, 0x67, 0x3a,
+	0x8c, 0x3b, 0x96, 0x0a, 0x9d, 0xc9, 0xa1, 0xed, 0x05, 0x5c, 0x52, 0x33, 0x29, 0x86, 0x91, 0x14,
+	0xcf, 0xa4, 0x97, 0xad, 0x40, 0x6b, 0xb1, 0x83, 0xa4, 0xd5, 0xec, 0x2e, 0xd4, 0xca, 0x13, 0xf4,
+	0x98, 0x47, 0xe8, 0x43, 0xe4, 0xd8, 0x07, 0xc8, 0x31, 0xd3, 0x53, 0xa7, 0x87, 0xb4, 0x63, 0x1f,
+	0xfb, 0x12, 0x1d, 0xed, 0x0a, 0x8c, 0x8b, 0x73, 0x48, 0x6e, 0xec, 0xf7, 0xff, 0x7d, 0xdf, 0xee,
+	0x7e, 0xfb, 0xe7, 0x13, 0xf8, 0x74, 0x82, 0x7c, 0xc4, 0x38, 0x76, 0x8c, 0x17, 0x33, 0xcc, 0x02,
+	0x63, 0xd1, 0x32, 0x78, 0x1c, 0x21, 0xa6, 0x47, 0x94, 0x70, 0xa2, 0x96, 0x97, 0xb2, 0x2e, 0x64,
+	0x7d, 0xd1, 0x3a, 0xfc, 0x64, 0x42, 0x58, 0x40, 0x18, 0x14, 0x80, 0x21, 0x17, 0x92, 0x3e, 0xac,
+	0x78, 0xc4, 0x23, 0x32, 0x9e, 0xfc, 0x4a, 0xa3, 0x35, 0x8f, 0x10, 0xcf, 0x47, 0x86, 0x58, 0x8d,
+	0xe7, 0x17, 0x06, 0xc7, 0x01, 0x62, 0xdc, 0x09, 0x22, 0x09, 0x34, 0x7e, 0xcf, 0x01, 0xad, 0x1f,
+	0x72, 0x44, 0x27, 0x53, 0x07, 0x87, 0x16, 0x9a, 0xcc, 0x29, 0xe6, 0xf1, 0x80, 0xb8, 0x73, 0x1f,
+	0xa9, 0x16, 0xc8, 0x62, 0x57, 0x53, 0xea, 0x4a, 0x73, 0xa7, 0x7b, 0xf2, 0xfa, 0x6d, 0x2d, 0xf3,
+	0xd7, 0xdb, 0xda, 0xb7, 0x1e, 0xe6, 0xd3, 0xf9, 0x58, 0x9f, 0x90, 0xc0, 0x18, 0x4f, 0xa2, 0x63,
+	0x1c, 0x86, 0x64, 0xe1, 0x70, 0x4c, 0x42, 0x66, 0x4c, 0xe3, 0x08, 0x51, 0xdf, 0x09, 0xd1, 0xb1,
+	0x3c, 0x9a, 0x31, 0xe7, 0xd8, 0xd7, 0x4f, 0xd1, 0x65, 0xc7, 0x75, 0x29, 0x62, 0xcc, 0xcc, 0x62,
+	0x57, 0xd5, 0x41, 0x9e, 0xfc, 0x12, 0x22, 0xaa, 0x65, 0x45, 0x5d, 0xed, 0x8f, 0x57, 0xc7, 0x95,
+	0xf4, 0x26, 0x29, 0x66, 0x71, 0x8a, 0x43, 0xcf, 0x94, 0x98, 0x5a, 0x01, 0x79, 0xc6, 0x1d, 0x8e,
+	0xb4, 0xad, 0xba, 0xd2, 0x2c, 0x9a, 0x72, 0xa1, 0xea, 0x60, 0x3f, 0x40, 0x74, 0xe6, 0x23, 0xc8,
+	0x29, 0x42, 0xd0, 0x91, 0x99, 0x5a, 0x4e, 0x30, 0x65, 0x29, 0xd9, 0x14, 0xa1, 0xb4, 0xa4, 0xfa,
+	0x19, 0x28, 0x7a, 0x94, 0xf0, 0x69, 0xeb, 0x31, 0x5c, 0xcc, 0x50, 0xac, 0xe5, 0x05, 0x58, 0x48,
+	0x63, 0xe7, 0x33, 0x14, 0xab, 0x6d, 0x70, 0x20, 0x6a, 0x43, 0x4e, 0x9d, 0x90, 0xe1, 0xe4, 0x4a,
+	0x92, 0xdd, 0x16, 0xec, 0xbe, 0x10, 0xed, 0x95, 0x76, 0x3b, 0x27, 0x40, 0xc1, 0x18, 0x51, 0x36,
+	0xc5, 0x91, 0xcc, 0xf9, 0x68, 0x2d, 0x67, 0xb0, 0xd2, 0x44, 0xce, 0xcf, 0xe0, 0x41, 0x84, 0x42,
+	0x17, 0x87, 0x1e, 0x5c, 0x20, 0x8a, 0x2f, 0x30, 0xa2, 0x70, 0x1e, 0xb9, 0xc9, 0x15, 0xef, 0xd5,
+	0x95, 0x66, 0xa1, 0xdd, 0xd4, 0x37, 0x5e, 0x5e, 0x1f, 0xc9, 0x8c, 0xf3, 0x34, 0xe1, 0x99, 0xe0,
+	0xcd, 0x83, 0xe8, 0xae, 0xb0, 0x5a, 0x03, 0x85, 0x00, 0x31, 0xe6, 0x78, 0x08, 0x72, 0xee, 0x6b,
+	0x3b, 0x75, 0xa5, 0x99, 0x33, 0x41, 0x1a, 0xb2, 0xb9, 0xaf, 0x76, 0x40, 0x31, 0xa2, 0x84, 0x5c,
+	0x40, 0x16, 0x33, 0x8e, 0x02, 0x0d, 0xd4, 0x95, 0xe6, 0x6e, 0xbb, 0x7a, 0xd7, 0xbe, 0x09, 0x66,
+	0x09, 0xca, 0x2c, 0x44, 0x37, 0x8b, 0x6f, 0x72, 0xbf, 0xfe, 0x56, 0xcb, 0x34, 0xfe, 0x55, 0xc0,
+	0xf6, 0xc8, 0xa1, 0x4e, 0xc0, 0xfe, 0xbf, 0xa9, 0xb2, 0xb1, 0xa9, 0x0e, 0xf6, 0x57, 0x4f, 0x90,
+	0x9c, 0x37, 0x86, 0x13, 0xc2, 0xb8, 0xb0, 0x41, 0xce, 0x2c, 0x2f, 0x5f, 0x42, 0x28, 0x27, 0x84,
+	0x71, 0xf5, 0x08, 0x94, 0x23, 0x9f, 0x84, 0xb3, 0x5b, 0xf4, 0x96, 0xa0, 0xf7, 0x84, 0xb0, 0xc6,
+	0x7e, 0x07, 0x1e, 0x46, 0xf3, 0xb1, 0x8f, 0x27, 0x70, 0xe1, 0xf8, 0x73, 0xc4, 0x04, 0x0c, 0x23,
+	0x44, 0xe1, 0x38, 0xe6, 0x48, 0xd8, 0x22, 0x67, 0x3e, 0x90, 0xc8, 0xb9, 0x20, 0x92, 0xb4, 0x11,
+	0xa2, 0xdd, 0x98, 0x23, 0xf5, 0x0b, 0xb0, 0xb7, 0x3c, 0x3a, 0x76, 0xe5, 0x3e, 0x79, 0x91, 0x71,
+	0x3f, 0x0d, 0xf7, 0xdd, 0x04, 0x6f, 0x4c, 0x41, 0x65, 0x20, 0x03, 0x9d, 0x39, 0x9f, 0x12, 0x8a,
+	0x5f, 0x08, 0xe3, 0xab, 0x5f, 0x82, 0xb2, 0x93, 0x06, 0x90, 0x0b, 0xa7, 0x08, 0x7b, 0x53, 0x9e,
+	0x36, 0xa0, 0x74, 0x23, 0x9c, 0x8a, 0xb8, 0xfa, 0x39, 0xb8, 0x8f, 0x2e, 0x23, 0x4c, 0xe3, 0x25,
+	0x28, 0x1b, 0x50, 0x94, 0x41, 0x09, 0x35, 0x5e, 0x65, 0xc1, 0xc1, 0x9d, 0x4f, 0xbe, 0x61, 0x64,
+	0xe5, 0x3d, 0x8c, 0x9c, 0xfd, 0x00, 0x23, 0x6f, 0xbd, 0xdb, 0xc8, 0x03, 0xb0, 0xe7, 0x4c, 0x38,
+	0x96, 0xff, 0x7e, 0x98, 0x4c, 0x16, 0xd1, 0xe8, 0x42, 0xfb, 0x50, 0x97, 0x63, 0x47, 0x5f, 0x8e,
+	0x1d, 0xdd, 0x5e, 0x8e, 0x9d, 0xee, 0xbd, 0x64, 0x8e, 0xbc, 0xfc, 0xbb, 0xa6, 0x98, 0xbb, 0x37,
+	0xc9, 0x89, 0xbc, 0x61, 0xca, 0xfc, 0x7b, 0x9b, 0xb2, 0xc1, 0x41, 0x5e, 0x68, 0xea, 0x57, 0x20,
+	0x97, 0x8c, 0x52, 0xd1, 0x9d, 0xdd, 0xf6, 0xa3, 0x77, 0xd5, 0xb0, 0xe3, 0x08, 0x99, 0x82, 0x4c,
+	0xc6, 0x8c, 0xa8, 0x94, 0x36, 0x49, 0x2e, 0x92, 0xc7, 0xba, 0xe5, 0xab, 0xb4, 0x1d, 0xc5, 0x75,
+	0x27, 0x1d, 0x39, 0xa0, 0xb0, 0x76, 0x22, 0xf5, 0x11, 0xd0, 0x46, 0xe6, 0x70, 0xf8, 0x04, 0x5a,
+	0xcf, 0x2d, 0xbb, 0x37, 0x80, 0xd6, 0xa8, 0x05, 0x7f, 0x30, 0x87, 0xf6, 0x69, 0xeb, 0x71, 0x29,
+	0xa3, 0x1e, 0x82, 0x8f, 0x37, 0xd4, 0xd1, 0x8f, 0xc3, 0xb3, 0xa7, 0x25, 0x45, 0xd5, 0x40, 0xe5,
+	0x96, 0xb6, 0xcc, 0xca, 0x1e, 0x61, 0xb0, 0xb3, 0x3a, 0xf0, 0x4d, 0x09, 0xfb, 0xf9, 0xa8, 0x07,
+	0x9f, 0x9d, 0x59, 0xa3, 0xde, 0x49, 0xff, 0x49, 0xbf, 0xf7, 0x7d, 0x29, 0xa3, 0xd6, 0xc0, 0xc3,
+	0x35, 0xcd, 0xb2, 0x3b, 0x76, 0x0f, 0xda, 0x66, 0xe7, 0xcc, 0xea, 0xdb, 0xfd, 0xe1, 0x59, 0x49,
+	0xb9, 0x13, 0x18, 0xf4, 0x06, 0xdd, 0x9e, 0x69, 0x9d, 0xf6, 0x47, 0xa5, 0x6c, 0xf7, 0xe9, 0xeb,
+	0xab, 0xaa, 0xf2, 0xe6, 0xaa, 0xaa, 0xfc, 0x73, 0x55, 0x55, 0x5e, 0x5e, 0x57, 0x33, 0x6f, 0xae,
+	0xab, 0x99, 0x3f, 0xaf, 0xab, 0x99, 0x9f, 0x5a, 0x6b, 0xa3, 0x7f, 0xd9, 0x50, 0x42, 0xbd, 0xd5,
+	0xef, 0x63, 0x27, 0x8a, 0x8c, 0xcb, 0xf4, 0x63, 0x26, 0xbe, 0x64, 0xe3, 0x6d, 0xe1, 0x80, 0xaf,
+	0xff, 0x1b, 0x00, 0x2e, 0x74, 0xe4, 0x90, 0xeb, 0x06, 0x00, 0x00,
 }
 
 func (m *InterchainSecurityModule) Marshal() (dAtA []byte, err error) {
@@ -132,6 +541,28 @@ func (m *InterchainSecurityModule) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.ProofSystem != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProofSystem))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MessageTtl != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MessageTtl))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PendingVerifierUpdate != nil {
+		{
+			size, err := m.PendingVerifierUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.StateMembershipVkey) > 0 {
 		i -= len(m.StateMembershipVkey)
 		copy(dAtA[i:], m.StateMembershipVkey)
@@ -187,59 +618,927 @@ func (m *InterchainSecurityModule) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *InterchainSecurityModule) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MessageIdCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MessageIdCost))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PublicValuesCostPerByte != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PublicValuesCostPerByte))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PlonkVerifyCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PlonkVerifyCost))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Groth16VerifyCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Groth16VerifyCost))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MessageTtl != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MessageTtl))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Id.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.State)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.AuthorizedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AuthorizedHeight))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.MerkleTreeAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingVerifierUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Groth16Vkey)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *PendingVerifierUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingVerifierUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProofSystem != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProofSystem))
+		i--
+		dAtA[i] = 0x28
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ActivationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ActivationTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTypes(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if len(m.StateMembershipVkey) > 0 {
+		i -= len(m.StateMembershipVkey)
+		copy(dAtA[i:], m.StateMembershipVkey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.StateMembershipVkey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StateTransitionVkey) > 0 {
+		i -= len(m.StateTransitionVkey)
+		copy(dAtA[i:], m.StateTransitionVkey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.StateTransitionVkey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Groth16Vkey) > 0 {
+		i -= len(m.Groth16Vkey)
+		copy(dAtA[i:], m.Groth16Vkey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Groth16Vkey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Proof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Proof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Proof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PublicValues) > 0 {
+		i -= len(m.PublicValues)
+		copy(dAtA[i:], m.PublicValues)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PublicValues)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InterchainSecurityModule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.MerkleTreeAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Groth16Vkey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.StateTransitionVkey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.StateMembershipVkey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.PendingVerifierUpdate != nil {
+		l = m.PendingVerifierUpdate.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.MessageTtl != 0 {
+		n += 1 + sovTypes(uint64(m.MessageTtl))
+	}
+	if m.ProofSystem != 0 {
+		n += 1 + sovTypes(uint64(m.ProofSystem))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MessageTtl != 0 {
+		n += 1 + sovTypes(uint64(m.MessageTtl))
+	}
+	if m.Groth16VerifyCost != 0 {
+		n += 1 + sovTypes(uint64(m.Groth16VerifyCost))
+	}
+	if m.PlonkVerifyCost != 0 {
+		n += 1 + sovTypes(uint64(m.PlonkVerifyCost))
+	}
+	if m.PublicValuesCostPerByte != 0 {
+		n += 1 + sovTypes(uint64(m.PublicValuesCostPerByte))
+	}
+	if m.MessageIdCost != 0 {
+		n += 1 + sovTypes(uint64(m.MessageIdCost))
+	}
+	return n
+}
+
+func (m *MessageAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuthorizedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.AuthorizedHeight))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *PendingVerifierUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Groth16Vkey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
 	}
 	l = len(m.StateTransitionVkey)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	l = len(m.StateMembershipVkey)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	l = len(m.StateMembershipVkey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ActivationTime)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.ProofSystem != 0 {
+		n += 1 + sovTypes(uint64(m.ProofSystem))
+	}
+	return n
+}
+
+func (m *Proof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovTypes(uint64(m.Type))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.PublicValues)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InterchainSecurityModule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterchainSecurityModule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterchainSecurityModule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = append(m.State[:0], dAtA[iNdEx:postIndex]...)
+			if m.State == nil {
+				m.State = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleTreeAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleTreeAddress = append(m.MerkleTreeAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleTreeAddress == nil {
+				m.MerkleTreeAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groth16Vkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groth16Vkey = append(m.Groth16Vkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.Groth16Vkey == nil {
+				m.Groth16Vkey = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateTransitionVkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateTransitionVkey = append(m.StateTransitionVkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateTransitionVkey == nil {
+				m.StateTransitionVkey = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateMembershipVkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateMembershipVkey = append(m.StateMembershipVkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateMembershipVkey == nil {
+				m.StateMembershipVkey = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingVerifierUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingVerifierUpdate == nil {
+				m.PendingVerifierUpdate = &PendingVerifierUpdate{}
+			}
+			if err := m.PendingVerifierUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageTtl", wireType)
+			}
+			m.MessageTtl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessageTtl |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofSystem", wireType)
+			}
+			m.ProofSystem = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofSystem |= ProofSystem(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageTtl", wireType)
+			}
+			m.MessageTtl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessageTtl |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groth16VerifyCost", wireType)
+			}
+			m.Groth16VerifyCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Groth16VerifyCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlonkVerifyCost", wireType)
+			}
+			m.PlonkVerifyCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlonkVerifyCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicValuesCostPerByte", wireType)
+			}
+			m.PublicValuesCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PublicValuesCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageIdCost", wireType)
+			}
+			m.MessageIdCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessageIdCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *MessageAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedHeight", wireType)
+			}
+			m.AuthorizedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthorizedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *InterchainSecurityModule) Unmarshal(dAtA []byte) error {
+func (m *PendingVerifierUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -262,17 +1561,17 @@ func (m *InterchainSecurityModule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InterchainSecurityModule: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingVerifierUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InterchainSecurityModule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingVerifierUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groth16Vkey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -282,31 +1581,31 @@ func (m *InterchainSecurityModule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Groth16Vkey = append(m.Groth16Vkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.Groth16Vkey == nil {
+				m.Groth16Vkey = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StateTransitionVkey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -316,27 +1615,29 @@ func (m *InterchainSecurityModule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.StateTransitionVkey = append(m.StateTransitionVkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateTransitionVkey == nil {
+				m.StateTransitionVkey = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StateMembershipVkey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -363,16 +1664,16 @@ func (m *InterchainSecurityModule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = append(m.State[:0], dAtA[iNdEx:postIndex]...)
-			if m.State == nil {
-				m.State = []byte{}
+			m.StateMembershipVkey = append(m.StateMembershipVkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateMembershipVkey == nil {
+				m.StateMembershipVkey = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MerkleTreeAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationTime", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -382,31 +1683,30 @@ func (m *InterchainSecurityModule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MerkleTreeAddress = append(m.MerkleTreeAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.MerkleTreeAddress == nil {
-				m.MerkleTreeAddress = []byte{}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ActivationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groth16Vkey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofSystem", wireType)
 			}
-			var byteLen int
+			m.ProofSystem = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -416,29 +1716,83 @@ func (m *InterchainSecurityModule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ProofSystem |= ProofSystem(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Groth16Vkey = append(m.Groth16Vkey[:0], dAtA[iNdEx:postIndex]...)
-			if m.Groth16Vkey == nil {
-				m.Groth16Vkey = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
 			}
-			iNdEx = postIndex
-		case 6:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ProofType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StateTransitionVkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -465,14 +1819,14 @@ func (m *InterchainSecurityModule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StateTransitionVkey = append(m.StateTransitionVkey[:0], dAtA[iNdEx:postIndex]...)
-			if m.StateTransitionVkey == nil {
-				m.StateTransitionVkey = []byte{}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StateMembershipVkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicValues", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -499,9 +1853,9 @@ func (m *InterchainSecurityModule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StateMembershipVkey = append(m.StateMembershipVkey[:0], dAtA[iNdEx:postIndex]...)
-			if m.StateMembershipVkey == nil {
-				m.StateMembershipVkey = []byte{}
+			m.PublicValues = append(m.PublicValues[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicValues == nil {
+				m.PublicValues = []byte{}
 			}
 			iNdEx = postIndex
 		default:
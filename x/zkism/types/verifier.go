@@ -7,6 +7,8 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/celestiaorg/celestia-app/v7/x/zkism/internal/groth16"
+	bn254fr "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	bn254 "github.com/consensys/gnark/backend/groth16/bn254" //nolint:revive
 )
 
 const (
@@ -19,6 +21,63 @@ const (
 	ProofSize = 256
 )
 
+var (
+	_ Verifier = (*SP1Groth16Verifier)(nil)
+	_ Verifier = (*Groth16Verifier)(nil)
+)
+
+// Verifier verifies zk proofs created with a specific proof system against the
+// program verifying key commitment and public values they were created for.
+type Verifier interface {
+	// VerifyProof returns nil if the proof is valid, or an error otherwise.
+	VerifyProof(proofBz, programVk, publicValues []byte) error
+	// VerifyProofs returns nil if all of the proofs are valid, or an error otherwise.
+	VerifyProofs(proofs []ProofInput) error
+}
+
+// verifiers is the registry of supported proof systems and the constructors of their verifiers.
+// NOTE: PROOF_SYSTEM_SP1_PLONK is intentionally not registered. A verifier for it can only be added
+// once it is tested against proofs and a verifying key produced by the SP1 SDK.
+var verifiers = map[ProofSystem]func(vkBytes []byte) (Verifier, error){
+	ProofSystem_PROOF_SYSTEM_SP1_GROTH16: func(vkBytes []byte) (Verifier, error) { return NewSP1Groth16Verifier(vkBytes) },
+	ProofSystem_PROOF_SYSTEM_GROTH16:     func(vkBytes []byte) (Verifier, error) { return NewGroth16Verifier(vkBytes) },
+}
+
+// NewVerifier constructs the verifier of the given proof system from the provided verifying key bytes.
+//
+// Returns an error if the proof system is not supported or if the verifying key cannot be parsed.
+func NewVerifier(proofSystem ProofSystem, vkBytes []byte) (Verifier, error) {
+	newVerifier, ok := verifiers[proofSystem]
+	if !ok {
+		return nil, errorsmod.Wrapf(ErrUnsupportedProofSystem, "%s", proofSystem)
+	}
+
+	verifier, err := newVerifier(vkBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return verifier, nil
+}
+
+// ProofInput is a single proof along with the program verifying key
+// commitment and the public values it is verified against.
+type ProofInput struct {
+	Proof        []byte
+	ProgramVk    []byte
+	PublicValues []byte
+}
+
+// publicInputs returns the public inputs of a proof created for the program
+// verifying key commitment and public values.
+func publicInputs(programVk, publicValues []byte) []bn254fr.Element {
+	vkCommitment := new(big.Int).SetBytes(programVk)
+	vkElement := groth16.NewBN254FrElement(vkCommitment)
+	inputsElement := groth16.NewBN254FrElement(groth16.HashBN254(publicValues))
+
+	return []bn254fr.Element{*vkElement, *inputsElement}
+}
+
 // SP1Groth16Verifier encapsulates the state required to verify Groth16 proofs
 // under the SP1 scheme. It stores a verifying key and its hash prefix, which
 // are used to check proof integrity and correctness.
@@ -58,24 +117,12 @@ func (v *SP1Groth16Verifier) Prefix() []byte {
 // The proof must be prefixed with the verifier key hash prefix.
 // Returns nil if the proof is valid, or an error otherwise.
 func (v *SP1Groth16Verifier) VerifyProof(proofBz, programVk, publicValues []byte) error {
-	if len(proofBz) != (PrefixLen + ProofSize) {
-		return errorsmod.Wrapf(ErrInvalidProofLength, "expected %d, got %d", (PrefixLen + ProofSize), len(proofBz))
-	}
-
-	if !bytes.Equal(v.Prefix(), proofBz[:PrefixLen]) {
-		return errorsmod.Wrapf(ErrInvalidProofPrefix, "expected %x, got %x", v.Prefix(), proofBz[:PrefixLen])
-	}
-
-	proof, err := groth16.UnmarshalProof(proofBz[PrefixLen:])
+	proof, inputs, err := v.decodeProof(proofBz, programVk, publicValues)
 	if err != nil {
-		return errorsmod.Wrap(err, "failed to unmarshal proof")
+		return err
 	}
 
-	vkCommitment := new(big.Int).SetBytes(programVk)
-	vkElement := groth16.NewBN254FrElement(vkCommitment)
-	inputsElement := groth16.NewBN254FrElement(groth16.HashBN254(publicValues))
-
-	pubWitness, err := groth16.NewPublicWitness(vkElement, inputsElement)
+	pubWitness, err := groth16.NewPublicWitness(&inputs[0], &inputs[1])
 	if err != nil {
 		return err
 	}
@@ -86,3 +133,46 @@ func (v *SP1Groth16Verifier) VerifyProof(proofBz, programVk, publicValues []byte
 
 	return nil
 }
+
+// VerifyProofs checks that all of the given proofs are valid using the verifier's key.
+// The proofs are verified using a single batched pairing check, which is cheaper than
+// verifying each proof individually. Returns nil if all proofs are valid, or an error
+// if any of them is invalid.
+func (v *SP1Groth16Verifier) VerifyProofs(proofs []ProofInput) error {
+	decoded := make([]*bn254.Proof, len(proofs))
+	inputs := make([][]bn254fr.Element, len(proofs))
+	for i, p := range proofs {
+		proof, proofInputs, err := v.decodeProof(p.Proof, p.ProgramVk, p.PublicValues)
+		if err != nil {
+			return errorsmod.Wrapf(err, "proof %d", i)
+		}
+
+		decoded[i] = proof
+		inputs[i] = proofInputs
+	}
+
+	if err := groth16.BatchVerifyProofs(v.vk, decoded, inputs); err != nil {
+		return errorsmod.Wrapf(ErrInvalidProof, "failed to verify proofs: %s", err.Error())
+	}
+
+	return nil
+}
+
+// decodeProof checks the length and prefix of the proof and decodes it along with
+// the public inputs derived from the program verifying key commitment and public values.
+func (v *SP1Groth16Verifier) decodeProof(proofBz, programVk, publicValues []byte) (*bn254.Proof, []bn254fr.Element, error) {
+	if len(proofBz) != (PrefixLen + ProofSize) {
+		return nil, nil, errorsmod.Wrapf(ErrInvalidProofLength, "expected %d, got %d", (PrefixLen + ProofSize), len(proofBz))
+	}
+
+	if !bytes.Equal(v.Prefix(), proofBz[:PrefixLen]) {
+		return nil, nil, errorsmod.Wrapf(ErrInvalidProofPrefix, "expected %x, got %x", v.Prefix(), proofBz[:PrefixLen])
+	}
+
+	proof, err := groth16.UnmarshalProof(proofBz[PrefixLen:])
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed to unmarshal proof")
+	}
+
+	return proof, publicInputs(programVk, publicValues), nil
+}
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"crypto/sha256"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/celestiaorg/celestia-app/v7/x/zkism/internal/groth16"
+	bn254fr "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	bn254 "github.com/consensys/gnark/backend/groth16/bn254" //nolint:revive
+)
+
+// Groth16Verifier encapsulates the state required to verify plain gnark Groth16
+// proofs. Unlike SP1Groth16Verifier, proofs are not prefixed and are expected in
+// the gnark binary encoding, which supports compressed points and proofs with
+// commitments.
+//
+// This verifier does not follow a layout defined by an external prover, so the
+// public inputs are a contract that circuits must implement exactly: the public
+// witness must consist of, in order,
+//
+//  1. the program verifying key commitment as a BN254 scalar field element,
+//  2. the high 128 bits of the SHA-256 digest of the public values,
+//  3. the low 128 bits of the SHA-256 digest of the public values,
+//
+// where each digest half is interpreted as a big-endian integer. Proofs of
+// circuits exposing any other public inputs fail verification. See
+// groth16PublicInputs.
+type Groth16Verifier struct {
+	vk groth16.VerifyingKey
+}
+
+// NewGroth16Verifier constructs a new Groth16Verifier from the provided
+// verifying key bytes.
+//
+// Returns an error if the verifying key cannot be parsed.
+func NewGroth16Verifier(vkBytes []byte) (*Groth16Verifier, error) {
+	vk, err := groth16.NewVerifyingKey(vkBytes)
+	if err != nil {
+		return nil, ErrInvalidVerifyingKey
+	}
+
+	return &Groth16Verifier{
+		vk: vk,
+	}, nil
+}
+
+// VerifyProof checks that the given proof is valid using the verifier's key,
+// the provided program verifying key commitment, and the public values.
+// Returns nil if the proof is valid, or an error otherwise.
+func (v *Groth16Verifier) VerifyProof(proofBz, programVk, publicValues []byte) error {
+	return v.VerifyProofs([]ProofInput{{Proof: proofBz, ProgramVk: programVk, PublicValues: publicValues}})
+}
+
+// VerifyProofs checks that all of the given proofs are valid using the verifier's key.
+// The proofs are verified using a single batched pairing check where the verifying
+// key permits it. Returns nil if all proofs are valid, or an error if any of them is invalid.
+func (v *Groth16Verifier) VerifyProofs(proofs []ProofInput) error {
+	decoded := make([]*bn254.Proof, len(proofs))
+	inputs := make([][]bn254fr.Element, len(proofs))
+	for i, p := range proofs {
+		proof, err := groth16.ReadProof(p.Proof)
+		if err != nil {
+			return errorsmod.Wrapf(ErrInvalidProof, "proof %d: failed to unmarshal proof: %s", i, err.Error())
+		}
+
+		decoded[i] = proof
+		inputs[i] = groth16PublicInputs(p.ProgramVk, p.PublicValues)
+	}
+
+	if err := groth16.BatchVerifyProofs(v.vk, decoded, inputs); err != nil {
+		return errorsmod.Wrapf(ErrInvalidProof, "failed to verify proofs: %s", err.Error())
+	}
+
+	return nil
+}
+
+// groth16PublicInputs returns the public inputs of a plain Groth16 proof created
+// for the program verifying key commitment and public values. Unlike the SP1
+// layout, which masks the top 3 bits of the digest to fit a single field element,
+// the full SHA-256 digest of the public values is exposed as two 128-bit limbs
+// so that circuits can compare it against the output of a SHA-256 gadget
+// without any truncation.
+//
+// NOTE: The layout is part of the GROTH16 proof system and changing it breaks
+// every circuit built against it.
+func groth16PublicInputs(programVk, publicValues []byte) []bn254fr.Element {
+	vkElement := groth16.NewBN254FrElement(new(big.Int).SetBytes(programVk))
+
+	digest := sha256.Sum256(publicValues)
+	hiElement := groth16.NewBN254FrElement(new(big.Int).SetBytes(digest[:16]))
+	loElement := groth16.NewBN254FrElement(new(big.Int).SetBytes(digest[16:]))
+
+	return []bn254fr.Element{*vkElement, *hiElement, *loElement}
+}
@@ -131,6 +131,163 @@ func TestVerifyProof(t *testing.T) {
 	}
 }
 
+func TestVerifyProofs(t *testing.T) {
+	groth16Vk := readGroth16Vkey(t)
+	proofBz, valuesBz := readProofAndValues(t)
+
+	programVkHex := "0x0017bc91d53b93c46eb842d7f9020a94ea13d8877a21608b34b71fcc4da64f29"
+	programVk, err := hex.DecodeString(strings.TrimPrefix(programVkHex, "0x"))
+	require.NoError(t, err)
+
+	verifier, err := types.NewSP1Groth16Verifier(groth16Vk)
+	require.NoError(t, err)
+
+	validProof := types.ProofInput{Proof: proofBz, ProgramVk: programVk, PublicValues: valuesBz}
+
+	tests := []struct {
+		name     string
+		proofs   []types.ProofInput
+		expError error
+	}{
+		{
+			name:     "valid proofs",
+			proofs:   []types.ProofInput{validProof, validProof},
+			expError: nil,
+		},
+		{
+			name:     "invalid proof length",
+			proofs:   []types.ProofInput{validProof, {Proof: proofBz[:10], ProgramVk: programVk, PublicValues: valuesBz}},
+			expError: types.ErrInvalidProofLength,
+		},
+		{
+			name:     "corrupted values",
+			proofs:   []types.ProofInput{validProof, {Proof: proofBz, ProgramVk: programVk, PublicValues: []byte{0x01, 0x02, 0x03, 0x04}}},
+			expError: types.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := verifier.VerifyProofs(tc.proofs)
+			if tc.expError != nil {
+				require.Error(t, err, "expected error but got none")
+				require.ErrorIs(t, err, tc.expError, "unexpected error")
+			} else {
+				require.NoError(t, err, "expected no error but got one")
+			}
+		})
+	}
+}
+
+func TestNewVerifier(t *testing.T) {
+	sp1Groth16Vk := readGroth16Vkey(t)
+	groth16Vk := readTestdata(t, "groth16/vk.bin")
+
+	tests := []struct {
+		name        string
+		proofSystem types.ProofSystem
+		vk          []byte
+		expError    error
+	}{
+		{
+			name:        "sp1 groth16",
+			proofSystem: types.ProofSystem_PROOF_SYSTEM_SP1_GROTH16,
+			vk:          sp1Groth16Vk,
+			expError:    nil,
+		},
+		{
+			name:        "groth16",
+			proofSystem: types.ProofSystem_PROOF_SYSTEM_GROTH16,
+			vk:          groth16Vk,
+			expError:    nil,
+		},
+		{
+			name:        "invalid verifier key for groth16",
+			proofSystem: types.ProofSystem_PROOF_SYSTEM_GROTH16,
+			vk:          []byte{0x01},
+			expError:    types.ErrInvalidVerifyingKey,
+		},
+		{
+			name:        "sp1 plonk is not accepted",
+			proofSystem: types.ProofSystem_PROOF_SYSTEM_SP1_PLONK,
+			vk:          sp1Groth16Vk,
+			expError:    types.ErrUnsupportedProofSystem,
+		},
+		{
+			name:        "unsupported proof system",
+			proofSystem: types.ProofSystem(99),
+			vk:          sp1Groth16Vk,
+			expError:    types.ErrUnsupportedProofSystem,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			verifier, err := types.NewVerifier(tc.proofSystem, tc.vk)
+
+			if tc.expError != nil {
+				require.Error(t, err, "expected error but got none")
+				require.ErrorIs(t, err, tc.expError, "unexpected error")
+				require.Nil(t, verifier)
+			} else {
+				require.NoError(t, err, "unexpected error constructing verifier")
+				require.NotNil(t, verifier)
+			}
+		})
+	}
+}
+
+func TestVerifierProofSystems(t *testing.T) {
+	stateTransitionVk, err := hex.DecodeString("0017bc91d53b93c46eb842d7f9020a94ea13d8877a21608b34b71fcc4da64f29")
+	require.NoError(t, err)
+
+	stateMembershipVk, err := hex.DecodeString("004959d5fb2c3d5bc1f98e032188dd94fbb5c6b6152df356c7c20be23be824a2")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		proofSystem types.ProofSystem
+		dir         string
+	}{
+		{
+			name:        "groth16",
+			proofSystem: types.ProofSystem_PROOF_SYSTEM_GROTH16,
+			dir:         "groth16",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			verifier, err := types.NewVerifier(tc.proofSystem, readTestdata(t, tc.dir+"/vk.bin"))
+			require.NoError(t, err)
+
+			transition := types.ProofInput{
+				Proof:        readTestdata(t, tc.dir+"/state_transition/proof.bin"),
+				ProgramVk:    stateTransitionVk,
+				PublicValues: readTestdata(t, tc.dir+"/state_transition/public_values.bin"),
+			}
+			membership := types.ProofInput{
+				Proof:        readTestdata(t, tc.dir+"/state_membership/proof.bin"),
+				ProgramVk:    stateMembershipVk,
+				PublicValues: readTestdata(t, tc.dir+"/state_membership/public_values.bin"),
+			}
+
+			require.NoError(t, verifier.VerifyProof(transition.Proof, transition.ProgramVk, transition.PublicValues))
+			require.NoError(t, verifier.VerifyProof(membership.Proof, membership.ProgramVk, membership.PublicValues))
+			require.NoError(t, verifier.VerifyProofs([]types.ProofInput{transition, membership}))
+
+			err = verifier.VerifyProof(transition.Proof, stateMembershipVk, transition.PublicValues)
+			require.ErrorIs(t, err, types.ErrInvalidProof)
+
+			err = verifier.VerifyProof(transition.Proof, transition.ProgramVk, membership.PublicValues)
+			require.ErrorIs(t, err, types.ErrInvalidProof)
+
+			err = verifier.VerifyProofs([]types.ProofInput{transition, {Proof: membership.Proof, ProgramVk: stateTransitionVk, PublicValues: membership.PublicValues}})
+			require.ErrorIs(t, err, types.ErrInvalidProof)
+		})
+	}
+}
+
 func readGroth16Vkey(t *testing.T) []byte {
 	t.Helper()
 
@@ -151,3 +308,12 @@ func readProofAndValues(t *testing.T) ([]byte, []byte) {
 
 	return proofBz, inputsBz
 }
+
+func readTestdata(t *testing.T, path string) []byte {
+	t.Helper()
+
+	bz, err := os.ReadFile("../internal/testdata/" + path)
+	require.NoError(t, err, "failed to read testdata file")
+
+	return bz
+}